@@ -41,7 +41,7 @@ func TestCreateTask(t *testing.T) {
 	}
 
 	for _, test := range tests {
-		testutil.Retry(t, 3, 3*time.Second, func(r *testutil.R) {
+		testutil.RetryBackoff(t, testutil.Backoff{Initial: 3 * time.Second, Max: 6 * time.Second, Multiplier: 2, MaxAttempts: 3}, func(r *testutil.R) {
 			_, err := createTask(tc.ProjectID, locationID, queueID, test.message)
 			if err != nil {
 				r.Errorf("CreateTask(%s): %v", test.name, err)
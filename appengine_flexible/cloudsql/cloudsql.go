@@ -138,7 +138,7 @@ func recordVisit(timestamp int64, addr string) error {
 func queryVisits(limit int64) ([]visit, error) {
 	rows, err := db.Query("SELECT timestamp, userip FROM visits ORDER BY timestamp DESC LIMIT ?", limit)
 	if err != nil {
-		return nil, fmt.Errorf("Could not get recent visits: %v", err)
+		return nil, fmt.Errorf("Could not get recent visits: %w", err)
 	}
 	defer rows.Close()
 
@@ -146,7 +146,7 @@ func queryVisits(limit int64) ([]visit, error) {
 	for rows.Next() {
 		var v visit
 		if err := rows.Scan(&v.timestamp, &v.userIP); err != nil {
-			return nil, fmt.Errorf("Could not get timestamp/user IP out of row: %v", err)
+			return nil, fmt.Errorf("Could not get timestamp/user IP out of row: %w", err)
 		}
 		visits = append(visits, v)
 	}
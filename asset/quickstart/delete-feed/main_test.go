@@ -62,7 +62,7 @@ func TestMain(t *testing.T) {
 		},
 	}
 
-	testutil.Retry(t, 5, 5*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 5 * time.Second, Max: 10 * time.Second, Multiplier: 2, MaxAttempts: 5}, func(r *testutil.R) {
 		if _, err = client.CreateFeed(ctx, req); err != nil {
 			r.Errorf("client.CreateFeed: %v", err)
 		}
@@ -78,7 +78,7 @@ func TestMain(t *testing.T) {
 		t.Fatalf("failed to build app")
 	}
 
-	testutil.Retry(t, 5, 5*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 5 * time.Second, Max: 10 * time.Second, Multiplier: 2, MaxAttempts: 5}, func(r *testutil.R) {
 		stdOut, stdErr, err := m.Run(env, 2*time.Minute, fmt.Sprintf("--feed_id=%s", feedID))
 		if err != nil {
 			r.Errorf("execution failed: %v", err)
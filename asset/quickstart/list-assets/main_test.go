@@ -28,7 +28,7 @@ func TestMain(t *testing.T) {
 	tc := testutil.SystemTest(t)
 	os.Setenv("GOOGLE_CLOUD_PROJECT", tc.ProjectID)
 
-	testutil.Retry(t, 5, 5*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 5 * time.Second, Max: 10 * time.Second, Multiplier: 2, MaxAttempts: 5}, func(r *testutil.R) {
 		oldStdout := os.Stdout
 		re, w, _ := os.Pipe()
 		os.Stdout = w
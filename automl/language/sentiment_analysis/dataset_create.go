@@ -34,7 +34,7 @@ func languageSentimentAnalysisCreateDataset(w io.Writer, projectID string, locat
 	ctx := context.Background()
 	client, err := automl.NewClient(ctx)
 	if err != nil {
-		return fmt.Errorf("NewClient: %v", err)
+		return fmt.Errorf("NewClient: %w", err)
 	}
 	defer client.Close()
 
@@ -52,13 +52,13 @@ func languageSentimentAnalysisCreateDataset(w io.Writer, projectID string, locat
 
 	op, err := client.CreateDataset(ctx, req)
 	if err != nil {
-		return fmt.Errorf("CreateDataset: %v", err)
+		return fmt.Errorf("CreateDataset: %w", err)
 	}
 	fmt.Fprintf(w, "Processing operation name: %q\n", op.Name())
 
 	dataset, err := op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("Wait: %v", err)
+		return fmt.Errorf("Wait: %w", err)
 	}
 
 	fmt.Fprintf(w, "Dataset name: %v\n", dataset.GetName())
@@ -34,7 +34,7 @@ func deleteModel(w io.Writer, projectID string, location string, modelID string)
 	ctx := context.Background()
 	client, err := automl.NewClient(ctx)
 	if err != nil {
-		return fmt.Errorf("NewClient: %v", err)
+		return fmt.Errorf("NewClient: %w", err)
 	}
 	defer client.Close()
 
@@ -44,12 +44,12 @@ func deleteModel(w io.Writer, projectID string, location string, modelID string)
 
 	op, err := client.DeleteModel(ctx, req)
 	if err != nil {
-		return fmt.Errorf("DeleteModel: %v", err)
+		return fmt.Errorf("DeleteModel: %w", err)
 	}
 	fmt.Fprintf(w, "Processing operation name: %q\n", op.Name())
 
 	if err := op.Wait(ctx); err != nil {
-		return fmt.Errorf("Wait: %v", err)
+		return fmt.Errorf("Wait: %w", err)
 	}
 
 	fmt.Fprintf(w, "Model deleted.\n")
@@ -40,7 +40,7 @@ func getModelEvaluation(w io.Writer, projectID string, location string, modelID
 	ctx := context.Background()
 	client, err := automl.NewClient(ctx)
 	if err != nil {
-		return fmt.Errorf("NewClient: %v", err)
+		return fmt.Errorf("NewClient: %w", err)
 	}
 	defer client.Close()
 
@@ -50,7 +50,7 @@ func getModelEvaluation(w io.Writer, projectID string, location string, modelID
 
 	evaluation, err := client.GetModelEvaluation(ctx, req)
 	if err != nil {
-		return fmt.Errorf("GetModelEvaluation: %v", err)
+		return fmt.Errorf("GetModelEvaluation: %w", err)
 	}
 
 	fmt.Fprintf(w, "Model evaluation name: %v\n", evaluation.GetName())
@@ -34,7 +34,7 @@ func getOperationStatus(w io.Writer, projectID string, location string, operatio
 	ctx := context.Background()
 	client, err := automl.NewClient(ctx)
 	if err != nil {
-		return fmt.Errorf("NewClient: %v", err)
+		return fmt.Errorf("NewClient: %w", err)
 	}
 	defer client.Close()
 
@@ -44,7 +44,7 @@ func getOperationStatus(w io.Writer, projectID string, location string, operatio
 
 	op, err := client.LROClient.GetOperation(ctx, req)
 	if err != nil {
-		return fmt.Errorf("GetOperation: %v", err)
+		return fmt.Errorf("GetOperation: %w", err)
 	}
 
 	fmt.Fprintf(w, "Name: %v\n", op.GetName())
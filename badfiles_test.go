@@ -73,6 +73,9 @@ var allowList = []string{
 	"webrisk/internal/webrisk_proto/*.proto",
 	"webrisk/testdata/hashes.gob",
 
+	// Pub/Sub schema definitions.
+	"pubsub/schemas/testdata/*.avsc",
+
 	// Endpoints samples.
 	"endpoints/**/*.proto",
 
@@ -86,6 +89,12 @@ var allowList = []string{
 	// Samples that aren't really code. Legacy.
 	"**/appengine/**/*.txt",
 
+	// Recorded HTTP traffic of tests, see testutil.StorageReplayTest.
+	"**/testdata/replay/*.json",
+
+	// Firestore datasets, see testutil.SeedCollection.
+	"internal/testutil/testdata/firestore/*.json",
+
 	// Test output and configs.
 	"testing/kokoro/*.cfg",
 	"**/sponge_log.log",
@@ -214,7 +214,7 @@ func processStream(ctx context.Context, client *bqStorage.BigQueryReadClient, st
 			Offset:     offset,
 		}, rpcOpts)
 		if err != nil {
-			return fmt.Errorf("Couldn't invoke ReadRows: %v", err)
+			return fmt.Errorf("Couldn't invoke ReadRows: %w", err)
 		}
 
 		// Process the streamed responses.
@@ -226,7 +226,7 @@ func processStream(ctx context.Context, client *bqStorage.BigQueryReadClient, st
 			if err != nil {
 				retries++
 				if retries >= retryLimit {
-					return fmt.Errorf("processStream retries exhausted: %v", err)
+					return fmt.Errorf("processStream retries exhausted: %w", err)
 				}
 				// break the inner loop, and try to recover by starting a new streaming
 				// ReadRows call at the last known good offset.
@@ -255,7 +255,7 @@ func processAvro(ctx context.Context, schema string, ch <-chan *bqStoragepb.Avro
 	// can be long-lived.
 	codec, err := goavro.NewCodec(schema)
 	if err != nil {
-		return fmt.Errorf("couldn't create codec: %v", err)
+		return fmt.Errorf("couldn't create codec: %w", err)
 	}
 
 	for {
@@ -276,7 +276,7 @@ func processAvro(ctx context.Context, schema string, ch <-chan *bqStoragepb.Avro
 					if err == io.EOF {
 						break
 					}
-					return fmt.Errorf("decoding error with %d bytes remaining: %v", len(undecoded), err)
+					return fmt.Errorf("decoding error with %d bytes remaining: %w", len(undecoded), err)
 				}
 				printDatum(datum)
 				undecoded = remainingBytes
@@ -89,7 +89,7 @@ func printResults(w io.Writer, iter *bigquery.RowIterator) error {
 			return nil
 		}
 		if err != nil {
-			return fmt.Errorf("error iterating through results: %v", err)
+			return fmt.Errorf("error iterating through results: %w", err)
 		}
 
 		fmt.Fprintf(w, "url: %s views: %d\n", row.URL, row.ViewCount)
@@ -26,7 +26,7 @@ import (
 func UniqueBQName(prefix string) (string, error) {
 	u, err := uuid.NewV4()
 	if err != nil {
-		return "", fmt.Errorf("failed to generate bq uuid: %v", err)
+		return "", fmt.Errorf("failed to generate bq uuid: %w", err)
 	}
 	return fmt.Sprintf("%s_%s", sanitize(prefix, "_"), sanitize(u.String(), "_")), nil
 }
@@ -35,7 +35,7 @@ func UniqueBQName(prefix string) (string, error) {
 func UniqueBucketName(prefix, projectID string) (string, error) {
 	u, err := uuid.NewV4()
 	if err != nil {
-		return "", fmt.Errorf("failed to generate bucket uuid: %v", err)
+		return "", fmt.Errorf("failed to generate bucket uuid: %w", err)
 	}
 	f := fmt.Sprintf("%s-%s-%s", sanitize(prefix, "-"), sanitize(projectID, "-"), sanitize(u.String(), "-"))
 	// bucket max name length is 63 chars, so we truncate.
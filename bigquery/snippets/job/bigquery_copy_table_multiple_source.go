@@ -32,7 +32,7 @@ func copyMultiTable(projectID, srcDatasetID string, srcTableIDs []string, dstDat
 	ctx := context.Background()
 	client, err := bigquery.NewClient(ctx, projectID)
 	if err != nil {
-		return fmt.Errorf("bigquery.NewClient: %v", err)
+		return fmt.Errorf("bigquery.NewClient: %w", err)
 	}
 	defer client.Close()
 
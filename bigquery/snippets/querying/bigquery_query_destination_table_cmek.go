@@ -33,7 +33,7 @@ func queryWithDestinationCMEK(w io.Writer, projectID, dstDatasetID, dstTableID s
 	ctx := context.Background()
 	client, err := bigquery.NewClient(ctx, projectID)
 	if err != nil {
-		return fmt.Errorf("bigquery.NewClient: %v", err)
+		return fmt.Errorf("bigquery.NewClient: %w", err)
 	}
 	defer client.Close()
 
@@ -33,7 +33,7 @@ func createTableComplexSchema(w io.Writer, projectID, datasetID, tableID string)
 
 	client, err := bigquery.NewClient(ctx, projectID)
 	if err != nil {
-		return fmt.Errorf("bigquery.NewClient: %v", err)
+		return fmt.Errorf("bigquery.NewClient: %w", err)
 	}
 	defer client.Close()
 
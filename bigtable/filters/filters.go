@@ -166,7 +166,7 @@ func readWithFilter(w io.Writer, projectID, instanceID string, tableName string,
 	ctx := context.Background()
 	client, err := bigtable.NewClient(ctx, projectID, instanceID)
 	if err != nil {
-		return fmt.Errorf("bigtable.NewAdminClient: %v", err)
+		return fmt.Errorf("bigtable.NewAdminClient: %w", err)
 	}
 	tbl := client.Open(tableName)
 	err = tbl.ReadRows(ctx, bigtable.RowRange{},
@@ -176,7 +176,7 @@ func readWithFilter(w io.Writer, projectID, instanceID string, tableName string,
 		}, bigtable.RowFilter(filter))
 
 	if err = client.Close(); err != nil {
-		return fmt.Errorf("client.Close(): %v", err)
+		return fmt.Errorf("client.Close(): %w", err)
 	}
 
 	return nil
@@ -32,12 +32,12 @@ func createFamilyGCIntersect(w io.Writer, projectID, instanceID string, tableNam
 
 	adminClient, err := bigtable.NewAdminClient(ctx, projectID, instanceID)
 	if err != nil {
-		return fmt.Errorf("bigtable.NewAdminClient: %v", err)
+		return fmt.Errorf("bigtable.NewAdminClient: %w", err)
 	}
 
 	columnFamilyName := "cf4"
 	if err := adminClient.CreateColumnFamily(ctx, tableName, columnFamilyName); err != nil {
-		return fmt.Errorf("CreateColumnFamily(%s): %v", columnFamilyName, err)
+		return fmt.Errorf("CreateColumnFamily(%s): %w", columnFamilyName, err)
 	}
 
 	// GC rule: Drop cells older than 5 days AND older than the most recent 2 versions
@@ -45,7 +45,7 @@ func createFamilyGCIntersect(w io.Writer, projectID, instanceID string, tableNam
 	maxAgePolicy := bigtable.MaxAgePolicy(maxAge)
 	policy := bigtable.IntersectionPolicy(bigtable.MaxVersionsPolicy(2), maxAgePolicy)
 	if err := adminClient.SetGCPolicy(ctx, tableName, columnFamilyName, policy); err != nil {
-		return fmt.Errorf("SetGCPolicy(%s): %v", policy, err)
+		return fmt.Errorf("SetGCPolicy(%s): %w", policy, err)
 	}
 
 	fmt.Fprintf(w, "created column family %s with policy: %v\n", columnFamilyName, policy)
@@ -32,19 +32,19 @@ func createFamilyGCMaxAge(w io.Writer, projectID, instanceID string, tableName s
 
 	adminClient, err := bigtable.NewAdminClient(ctx, projectID, instanceID)
 	if err != nil {
-		return fmt.Errorf("bigtable.NewAdminClient: %v", err)
+		return fmt.Errorf("bigtable.NewAdminClient: %w", err)
 	}
 
 	columnFamilyName := "cf1"
 	if err := adminClient.CreateColumnFamily(ctx, tableName, columnFamilyName); err != nil {
-		return fmt.Errorf("CreateColumnFamily(%s): %v", columnFamilyName, err)
+		return fmt.Errorf("CreateColumnFamily(%s): %w", columnFamilyName, err)
 	}
 
 	// Set a garbage collection policy of 5 days.
 	maxAge := time.Hour * 24 * 5
 	policy := bigtable.MaxAgePolicy(maxAge)
 	if err := adminClient.SetGCPolicy(ctx, tableName, columnFamilyName, policy); err != nil {
-		return fmt.Errorf("SetGCPolicy(%s): %v", policy, err)
+		return fmt.Errorf("SetGCPolicy(%s): %w", policy, err)
 	}
 
 	fmt.Fprintf(w, "created column family %s with policy: %v\n", columnFamilyName, policy)
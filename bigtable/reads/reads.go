@@ -37,7 +37,7 @@ func readRow(w io.Writer, projectID, instanceID string, tableName string) error
 	ctx := context.Background()
 	client, err := bigtable.NewClient(ctx, projectID, instanceID)
 	if err != nil {
-		return fmt.Errorf("bigtable.NewAdminClient: %v", err)
+		return fmt.Errorf("bigtable.NewAdminClient: %w", err)
 	}
 	tbl := client.Open(tableName)
 	rowkey := "phone#4c410523#20190501"
@@ -62,7 +62,7 @@ func readRowPartial(w io.Writer, projectID, instanceID string, tableName string)
 	ctx := context.Background()
 	client, err := bigtable.NewClient(ctx, projectID, instanceID)
 	if err != nil {
-		return fmt.Errorf("bigtable.NewAdminClient: %v", err)
+		return fmt.Errorf("bigtable.NewAdminClient: %w", err)
 	}
 	tbl := client.Open(tableName)
 	rowkey := "phone#4c410523#20190501"
@@ -87,7 +87,7 @@ func readRows(w io.Writer, projectID, instanceID string, tableName string) error
 	ctx := context.Background()
 	client, err := bigtable.NewClient(ctx, projectID, instanceID)
 	if err != nil {
-		return fmt.Errorf("bigtable.NewAdminClient: %v", err)
+		return fmt.Errorf("bigtable.NewAdminClient: %w", err)
 	}
 	tbl := client.Open(tableName)
 	err = tbl.ReadRows(ctx, bigtable.RowList{"phone#4c410523#20190501", "phone#4c410523#20190502"},
@@ -97,7 +97,7 @@ func readRows(w io.Writer, projectID, instanceID string, tableName string) error
 		})
 
 	if err = client.Close(); err != nil {
-		return fmt.Errorf("client.Close(): %v", err)
+		return fmt.Errorf("client.Close(): %w", err)
 	}
 
 	return nil
@@ -114,7 +114,7 @@ func readRowRange(w io.Writer, projectID, instanceID string, tableName string) e
 	ctx := context.Background()
 	client, err := bigtable.NewClient(ctx, projectID, instanceID)
 	if err != nil {
-		return fmt.Errorf("bigtable.NewAdminClient: %v", err)
+		return fmt.Errorf("bigtable.NewAdminClient: %w", err)
 	}
 	tbl := client.Open(tableName)
 	err = tbl.ReadRows(ctx, bigtable.NewRange("phone#4c410523#20190501", "phone#4c410523#201906201"),
@@ -124,7 +124,7 @@ func readRowRange(w io.Writer, projectID, instanceID string, tableName string) e
 		})
 
 	if err = client.Close(); err != nil {
-		return fmt.Errorf("client.Close(): %v", err)
+		return fmt.Errorf("client.Close(): %w", err)
 	}
 
 	return nil
@@ -141,7 +141,7 @@ func readRowRanges(w io.Writer, projectID, instanceID string, tableName string)
 	ctx := context.Background()
 	client, err := bigtable.NewClient(ctx, projectID, instanceID)
 	if err != nil {
-		return fmt.Errorf("bigtable.NewAdminClient: %v", err)
+		return fmt.Errorf("bigtable.NewAdminClient: %w", err)
 	}
 	tbl := client.Open(tableName)
 	err = tbl.ReadRows(ctx, bigtable.RowRangeList{
@@ -154,7 +154,7 @@ func readRowRanges(w io.Writer, projectID, instanceID string, tableName string)
 		})
 
 	if err = client.Close(); err != nil {
-		return fmt.Errorf("client.Close(): %v", err)
+		return fmt.Errorf("client.Close(): %w", err)
 	}
 
 	return nil
@@ -171,7 +171,7 @@ func readPrefix(w io.Writer, projectID, instanceID string, tableName string) err
 	ctx := context.Background()
 	client, err := bigtable.NewClient(ctx, projectID, instanceID)
 	if err != nil {
-		return fmt.Errorf("bigtable.NewAdminClient: %v", err)
+		return fmt.Errorf("bigtable.NewAdminClient: %w", err)
 	}
 	tbl := client.Open(tableName)
 	err = tbl.ReadRows(ctx, bigtable.PrefixRange("phone#"),
@@ -181,7 +181,7 @@ func readPrefix(w io.Writer, projectID, instanceID string, tableName string) err
 		})
 
 	if err = client.Close(); err != nil {
-		return fmt.Errorf("client.Close(): %v", err)
+		return fmt.Errorf("client.Close(): %w", err)
 	}
 
 	return nil
@@ -198,7 +198,7 @@ func readFilter(w io.Writer, projectID, instanceID string, tableName string) err
 	ctx := context.Background()
 	client, err := bigtable.NewClient(ctx, projectID, instanceID)
 	if err != nil {
-		return fmt.Errorf("bigtable.NewAdminClient: %v", err)
+		return fmt.Errorf("bigtable.NewAdminClient: %w", err)
 	}
 	tbl := client.Open(tableName)
 	err = tbl.ReadRows(ctx, bigtable.RowRange{},
@@ -208,7 +208,7 @@ func readFilter(w io.Writer, projectID, instanceID string, tableName string) err
 		}, bigtable.RowFilter(bigtable.ValueFilter("PQ2A.*$")))
 
 	if err = client.Close(); err != nil {
-		return fmt.Errorf("client.Close(): %v", err)
+		return fmt.Errorf("client.Close(): %w", err)
 	}
 
 	return nil
@@ -40,7 +40,7 @@ func TestWrites(t *testing.T) {
 	tableName := "mobile-time-series-" + tc.ProjectID
 	adminClient.DeleteTable(ctx, tableName)
 
-	testutil.Retry(t, 10, 10*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 10 * time.Second, Max: 20 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		if err := adminClient.CreateTable(ctx, tableName); err != nil {
 			r.Errorf("Could not create table %s: %v", tableName, err)
 		}
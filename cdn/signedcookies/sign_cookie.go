@@ -57,12 +57,12 @@ func signCookie(urlPrefix, keyName string, key []byte, expiration time.Time) (st
 func readKeyFile(path string) ([]byte, error) {
 	b, err := ioutil.ReadFile(path)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read key file: %+v", err)
+		return nil, fmt.Errorf("failed to read key file: %w", err)
 	}
 	d := make([]byte, base64.URLEncoding.DecodedLen(len(b)))
 	n, err := base64.URLEncoding.Decode(d, b)
 	if err != nil {
-		return nil, fmt.Errorf("failed to base64url decode: %+v", err)
+		return nil, fmt.Errorf("failed to base64url decode: %w", err)
 	}
 	return d[:n], nil
 }
@@ -0,0 +1,148 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"cloud.google.com/go/datastore"
+	datastoreadmin "github.com/GoogleCloudPlatform/golang-samples/datastore/admin"
+	tasks "github.com/GoogleCloudPlatform/golang-samples/datastore/snippets"
+)
+
+// Datastore samples create their own client, which connects to the emulator
+// when DATASTORE_EMULATOR_HOST is set.
+
+// taskKey returns the key of the task the flags name.
+func taskKey(a args) (*datastore.Key, error) {
+	id, err := a.int64("id")
+	if err != nil {
+		return nil, err
+	}
+	return datastore.IDKey("Task", id, datastore.NameKey("TaskList", a["list"], nil)), nil
+}
+
+// taskSample registers a Datastore sample that takes the key of a task.
+func taskSample(fn func(w io.Writer, projectID string, key *datastore.Key) error) sample {
+	return sample{
+		flags: []string{"project", "list=default", "id"},
+		run: func(ctx context.Context, w io.Writer, a args) error {
+			key, err := taskKey(a)
+			if err != nil {
+				return err
+			}
+			return fn(w, a["project"], key)
+		},
+	}
+}
+
+var datastoreSamples = map[string]sample{
+	"datastore tasks add": {
+		flags: []string{"project", "list=default", "description", "category=Personal"},
+		run: func(ctx context.Context, w io.Writer, a args) error {
+			_, err := tasks.AddTask(w, a["project"], a["list"], &tasks.Task{
+				Category:    a["category"],
+				Description: a["description"],
+				Created:     time.Now(),
+			})
+			return err
+		},
+	},
+	"datastore tasks list": {
+		flags: []string{"project", "list=default"},
+		run: func(ctx context.Context, w io.Writer, a args) error {
+			_, _, err := tasks.TasksInList(w, a["project"], a["list"])
+			return err
+		},
+	},
+	"datastore tasks list-open": {
+		flags: []string{"project", "list=default", "category=Personal"},
+		run: func(ctx context.Context, w io.Writer, a args) error {
+			_, err := tasks.OpenTasks(w, a["project"], a["list"], a["category"])
+			return err
+		},
+	},
+	"datastore tasks done":   taskSample(tasks.MarkTaskDone),
+	"datastore tasks delete": taskSample(tasks.DeleteTask),
+	"datastore indexes list": {
+		flags: []string{"project"},
+		run: func(ctx context.Context, w io.Writer, a args) error {
+			_, err := datastoreadmin.IndexList(w, a["project"])
+			return err
+		},
+	},
+	"datastore indexes get": {
+		flags: []string{"project", "index"},
+		run: func(ctx context.Context, w io.Writer, a args) error {
+			_, err := datastoreadmin.IndexGet(w, a["project"], a["index"])
+			return err
+		},
+	},
+	"datastore indexes create": {
+		flags: []string{"project", "kind"},
+		run: func(ctx context.Context, w io.Writer, a args) error {
+			_, err := datastoreadmin.IndexCreate(w, a["project"], a["kind"])
+			return err
+		},
+	},
+	"datastore indexes delete": {
+		flags: []string{"project", "index"},
+		run: func(ctx context.Context, w io.Writer, a args) error {
+			return datastoreadmin.IndexDelete(w, a["project"], a["index"])
+		},
+	},
+	"datastore entities export": {
+		flags: []string{"project", "output-url-prefix"},
+		run: func(ctx context.Context, w io.Writer, a args) error {
+			_, err := datastoreadmin.EntitiesExport(w, a["project"], a["output-url-prefix"])
+			return err
+		},
+	},
+	"datastore entities import": {
+		// --input-url is the overall_export_metadata file an export wrote.
+		flags: []string{"project", "input-url"},
+		run: func(ctx context.Context, w io.Writer, a args) error {
+			return datastoreadmin.EntitiesImport(w, a["project"], a["input-url"])
+		},
+	},
+	"datastore operations list": {
+		flags: []string{"project"},
+		run: func(ctx context.Context, w io.Writer, a args) error {
+			_, err := datastoreadmin.OperationList(w, a["project"])
+			return err
+		},
+	},
+	"datastore operations get": {
+		flags: []string{"name"},
+		run: func(ctx context.Context, w io.Writer, a args) error {
+			_, err := datastoreadmin.OperationGet(w, a["name"])
+			return err
+		},
+	},
+	"datastore operations cancel": {
+		flags: []string{"name"},
+		run: func(ctx context.Context, w io.Writer, a args) error {
+			return datastoreadmin.OperationCancel(w, a["name"])
+		},
+	},
+	"datastore operations delete": {
+		flags: []string{"name"},
+		run: func(ctx context.Context, w io.Writer, a args) error {
+			return datastoreadmin.OperationDelete(w, a["name"])
+		},
+	},
+}
@@ -0,0 +1,141 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	firestoreadmin "github.com/GoogleCloudPlatform/golang-samples/firestore/admin"
+	"github.com/GoogleCloudPlatform/golang-samples/internal/endpoint"
+)
+
+// Firestore admin samples create their own client, so they only need the
+// project, the database and the endpoint options from the environment. The
+// document samples, in firestore/firestore_snippets, are a main package and
+// can't be registered.
+
+// collectionIDs splits the --collections flag; empty means all collections.
+func collectionIDs(a args) []string {
+	if a["collections"] == "" {
+		return nil
+	}
+	return strings.Split(a["collections"], ",")
+}
+
+var firestoreSamples = map[string]sample{
+	"firestore databases list": {
+		flags: []string{"project"},
+		run: func(ctx context.Context, w io.Writer, a args) error {
+			_, err := firestoreadmin.ListDatabases(w, a["project"], endpoint.Firestore()...)
+			return err
+		},
+	},
+	"firestore databases get": {
+		flags: []string{"project", "database=(default)"},
+		run: func(ctx context.Context, w io.Writer, a args) error {
+			_, err := firestoreadmin.GetDatabase(w, a["project"], a["database"], endpoint.Firestore()...)
+			return err
+		},
+	},
+	"firestore databases create": {
+		flags: []string{"project", "database", "location"},
+		run: func(ctx context.Context, w io.Writer, a args) error {
+			_, err := firestoreadmin.CreateDatabase(w, a["project"], a["database"], a["location"], endpoint.Firestore()...)
+			return err
+		},
+	},
+	"firestore databases set-delete-protection": {
+		flags: []string{"project", "database", "enabled=true"},
+		run: func(ctx context.Context, w io.Writer, a args) error {
+			enabled, err := a.bool("enabled")
+			if err != nil {
+				return err
+			}
+			_, err = firestoreadmin.UpdateDeleteProtection(w, a["project"], a["database"], enabled, endpoint.Firestore()...)
+			return err
+		},
+	},
+	"firestore databases earliest-read-time": {
+		flags: []string{"project", "database=(default)"},
+		run: func(ctx context.Context, w io.Writer, a args) error {
+			_, err := firestoreadmin.GetEarliestReadTime(w, a["project"], a["database"], endpoint.Firestore()...)
+			return err
+		},
+	},
+	"firestore indexes list": {
+		flags: []string{"project", "database=(default)", "collection-group"},
+		run: func(ctx context.Context, w io.Writer, a args) error {
+			_, err := firestoreadmin.ListIndexes(w, a["project"], a["database"], a["collection-group"], endpoint.Firestore()...)
+			return err
+		},
+	},
+	"firestore indexes create": {
+		flags: []string{"project", "database=(default)", "collection-group"},
+		run: func(ctx context.Context, w io.Writer, a args) error {
+			_, err := firestoreadmin.CreateIndex(w, a["project"], a["database"], a["collection-group"], endpoint.Firestore()...)
+			return err
+		},
+	},
+	"firestore indexes get": {
+		// --name is the full index name, as firestore indexes list prints it.
+		flags: []string{"name"},
+		run: func(ctx context.Context, w io.Writer, a args) error {
+			_, err := firestoreadmin.GetIndex(w, a["name"], endpoint.Firestore()...)
+			return err
+		},
+	},
+	"firestore indexes delete": {
+		flags: []string{"name"},
+		run: func(ctx context.Context, w io.Writer, a args) error {
+			return firestoreadmin.DeleteIndex(w, a["name"], endpoint.Firestore()...)
+		},
+	},
+	"firestore ttl create": {
+		flags: []string{"project", "database=(default)", "collection-group", "field"},
+		run: func(ctx context.Context, w io.Writer, a args) error {
+			_, err := firestoreadmin.CreateTTLPolicy(w, a["project"], a["database"], a["collection-group"], a["field"], endpoint.Firestore()...)
+			return err
+		},
+	},
+	"firestore ttl get": {
+		flags: []string{"project", "database=(default)", "collection-group", "field"},
+		run: func(ctx context.Context, w io.Writer, a args) error {
+			_, err := firestoreadmin.GetTTLPolicy(w, a["project"], a["database"], a["collection-group"], a["field"], endpoint.Firestore()...)
+			return err
+		},
+	},
+	"firestore ttl delete": {
+		flags: []string{"project", "database=(default)", "collection-group", "field"},
+		run: func(ctx context.Context, w io.Writer, a args) error {
+			return firestoreadmin.DeleteTTLPolicy(w, a["project"], a["database"], a["collection-group"], a["field"], endpoint.Firestore()...)
+		},
+	},
+	"firestore documents export": {
+		// --collections is a comma-separated list of collection IDs.
+		flags: []string{"project", "database=(default)", "output-uri-prefix", "collections="},
+		run: func(ctx context.Context, w io.Writer, a args) error {
+			_, err := firestoreadmin.ExportDocuments(w, a["project"], a["database"], a["output-uri-prefix"], collectionIDs(a), endpoint.Firestore()...)
+			return err
+		},
+	},
+	"firestore documents import": {
+		flags: []string{"project", "database=(default)", "input-uri-prefix", "collections="},
+		run: func(ctx context.Context, w io.Writer, a args) error {
+			return firestoreadmin.ImportDocuments(w, a["project"], a["database"], a["input-uri-prefix"], collectionIDs(a), endpoint.Firestore()...)
+		},
+	},
+}
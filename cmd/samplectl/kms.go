@@ -0,0 +1,80 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+
+	"github.com/GoogleCloudPlatform/golang-samples/kms"
+)
+
+// KMS samples take full resource names; the flags below name the parts, so
+// that --project can default to GOOGLE_CLOUD_PROJECT.
+
+// keyRingName returns the name of the key ring the flags name.
+func keyRingName(a args) string {
+	return fmt.Sprintf("projects/%s/locations/%s/keyRings/%s", a["project"], a["location"], a["keyring"])
+}
+
+// keyName returns the name of the key the flags name.
+func keyName(a args) string {
+	return keyRingName(a) + "/cryptoKeys/" + a["key"]
+}
+
+// keySample registers a KMS sample that takes a key.
+func keySample(fn func(w io.Writer, name string) error) sample {
+	return sample{
+		flags: []string{"project", "location=global", "keyring", "key"},
+		run: func(ctx context.Context, w io.Writer, a args) error {
+			return fn(w, keyName(a))
+		},
+	}
+}
+
+var kmsSamples = map[string]sample{
+	"kms keyrings create": {
+		flags: []string{"project", "location=global", "keyring"},
+		run: func(ctx context.Context, w io.Writer, a args) error {
+			return kms.CreateKeyRing(w, fmt.Sprintf("projects/%s/locations/%s", a["project"], a["location"]), a["keyring"])
+		},
+	},
+	"kms keys create": {
+		flags: []string{"project", "location=global", "keyring", "key"},
+		run: func(ctx context.Context, w io.Writer, a args) error {
+			return kms.CreateKeySymmetricEncryptDecrypt(w, keyRingName(a), a["key"])
+		},
+	},
+	"kms keys rotate": keySample(kms.RotateKey),
+	"kms keys encrypt": {
+		flags: []string{"project", "location=global", "keyring", "key", "plaintext"},
+		run: func(ctx context.Context, w io.Writer, a args) error {
+			return kms.EncryptSymmetric(w, keyName(a), a["plaintext"])
+		},
+	},
+	"kms keys decrypt": {
+		// --ciphertext is base64, as kms keys encrypt prints it.
+		flags: []string{"project", "location=global", "keyring", "key", "ciphertext"},
+		run: func(ctx context.Context, w io.Writer, a args) error {
+			ciphertext, err := base64.StdEncoding.DecodeString(a["ciphertext"])
+			if err != nil {
+				return fmt.Errorf("--ciphertext: %w", err)
+			}
+			return kms.DecryptSymmetric(w, keyName(a), ciphertext)
+		},
+	},
+}
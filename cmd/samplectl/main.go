@@ -0,0 +1,105 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command samplectl runs the samples in this repository against your own
+// project, without writing a main for them. Samples are named by product,
+// group and operation, and take their parameters as flags:
+//
+//	samplectl storage objects download --bucket b --object o
+//	samplectl pubsub topics publish --topic t --msg hello
+//	samplectl datastore tasks add --description "Buy milk"
+//
+// It covers the Cloud Storage, Pub/Sub, Firestore admin, Datastore and Cloud
+// KMS samples. Samples that live in a main package, such as the Firestore
+// document samples, can't be imported and are not included.
+//
+// Running samplectl with only part of a name, or none, lists the samples
+// under it with their flags. --project defaults to GOOGLE_CLOUD_PROJECT.
+//
+// The clients connect to the endpoints the environment sets, such as
+// STORAGE_EMULATOR_HOST or GOLANG_SAMPLES_PUBSUB_ENDPOINT; see
+// internal/endpoint.
+//
+// Samples print what they print in the documentation. Adding a sample means
+// exporting it from its package (see the export.go files) and adding it to
+// the registry here.
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+)
+
+// errUsage reports that the command line did not name a sample; the matching
+// samples have already been listed.
+var errUsage = errors.New("usage")
+
+func main() {
+	if err := run(context.Background(), os.Stdout, os.Stderr, os.Args[1:]); err != nil {
+		if err == errUsage {
+			os.Exit(2)
+		}
+		fmt.Fprintf(os.Stderr, "samplectl: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// run runs the sample named by the leading words of args with the flags that
+// follow them. Output goes to w; usage goes to stderr.
+func run(ctx context.Context, w, stderr io.Writer, args []string) error {
+	var words []string
+	for len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		words = append(words, args[0])
+		args = args[1:]
+	}
+	name := strings.Join(words, " ")
+	s, ok := registry[name]
+	if !ok {
+		usage(stderr, name)
+		return errUsage
+	}
+	a, err := s.parse(name, args)
+	if err != nil {
+		return err
+	}
+	if err := s.run(ctx, w, a); err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	return nil
+}
+
+// usage lists the samples whose name starts with prefix.
+func usage(w io.Writer, prefix string) {
+	var names []string
+	for name := range registry {
+		if prefix == "" || name == prefix || strings.HasPrefix(name, prefix+" ") {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		fmt.Fprintf(w, "samplectl: no sample named %q\n", prefix)
+		usage(w, "")
+		return
+	}
+	sort.Strings(names)
+	fmt.Fprintln(w, "usage:")
+	for _, name := range names {
+		fmt.Fprintf(w, "\tsamplectl %s\n", registry[name].usage(name))
+	}
+}
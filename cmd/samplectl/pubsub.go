@@ -0,0 +1,208 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/GoogleCloudPlatform/golang-samples/internal/endpoint"
+	"github.com/GoogleCloudPlatform/golang-samples/pubsub/subscriptions"
+	"github.com/GoogleCloudPlatform/golang-samples/pubsub/topics"
+	"google.golang.org/api/option"
+)
+
+// Pub/Sub samples create their own client, so they only need the project and
+// the endpoint options from the environment.
+
+// topicSample registers a Pub/Sub sample that takes a topic.
+func topicSample(fn func(w io.Writer, projectID, topicID string, opts ...option.ClientOption) error) sample {
+	return sample{
+		flags: []string{"project", "topic"},
+		run: func(ctx context.Context, w io.Writer, a args) error {
+			return fn(w, a["project"], a["topic"], endpoint.PubSub()...)
+		},
+	}
+}
+
+// subscriptionSample registers a Pub/Sub sample that takes a subscription.
+func subscriptionSample(fn func(w io.Writer, projectID, subID string, opts ...option.ClientOption) error) sample {
+	return sample{
+		flags: []string{"project", "subscription"},
+		run: func(ctx context.Context, w io.Writer, a args) error {
+			return fn(w, a["project"], a["subscription"], endpoint.PubSub()...)
+		},
+	}
+}
+
+var pubsubSamples = map[string]sample{
+	"pubsub topics list": {
+		flags: []string{"project"},
+		run: func(ctx context.Context, w io.Writer, a args) error {
+			ts, err := topics.List(a["project"], endpoint.PubSub()...)
+			if err != nil {
+				return err
+			}
+			for _, t := range ts {
+				fmt.Fprintln(w, t.ID())
+			}
+			return nil
+		},
+	},
+	"pubsub topics list-subscriptions": {
+		flags: []string{"project", "topic"},
+		run: func(ctx context.Context, w io.Writer, a args) error {
+			subs, err := topics.ListSubscriptions(a["project"], a["topic"], endpoint.PubSub()...)
+			if err != nil {
+				return err
+			}
+			for _, s := range subs {
+				fmt.Fprintln(w, s.ID())
+			}
+			return nil
+		},
+	},
+	"pubsub topics create": topicSample(topics.Create),
+	"pubsub topics create-with-retention": {
+		flags: []string{"project", "topic", "retention"},
+		run: func(ctx context.Context, w io.Writer, a args) error {
+			retention, err := a.duration("retention")
+			if err != nil {
+				return err
+			}
+			return topics.CreateTopicWithRetention(w, a["project"], a["topic"], retention, endpoint.PubSub()...)
+		},
+	},
+	"pubsub topics update-retention": {
+		flags: []string{"project", "topic", "retention"},
+		run: func(ctx context.Context, w io.Writer, a args) error {
+			retention, err := a.duration("retention")
+			if err != nil {
+				return err
+			}
+			return topics.UpdateTopicRetention(w, a["project"], a["topic"], retention, endpoint.PubSub()...)
+		},
+	},
+	"pubsub topics clear-retention": topicSample(topics.ClearTopicRetention),
+	"pubsub topics update-schema": {
+		flags: []string{"project", "topic", "schema"},
+		run: func(ctx context.Context, w io.Writer, a args) error {
+			return topics.UpdateTopic(w, a["project"], a["topic"], a["schema"], endpoint.PubSub()...)
+		},
+	},
+	"pubsub topics get-ingestion-state": topicSample(topics.GetTopicIngestionState),
+	"pubsub topics delete":              topicSample(topics.Delete),
+	"pubsub topics publish": {
+		flags: []string{"project", "topic", "msg"},
+		run: func(ctx context.Context, w io.Writer, a args) error {
+			return topics.Publish(w, a["project"], a["topic"], a["msg"], endpoint.PubSub()...)
+		},
+	},
+	"pubsub topics publish-with-attributes":   topicSample(topics.PublishWithAttributes),
+	"pubsub topics publish-custom-attributes": topicSample(topics.PublishCustomAttributes),
+	"pubsub topics detach-subscription": {
+		flags: []string{"project", "topic", "subscription"},
+		run: func(ctx context.Context, w io.Writer, a args) error {
+			return topics.DetachTopicSubscription(w, a["project"], a["topic"], a["subscription"], endpoint.PubSub()...)
+		},
+	},
+	"pubsub topics get-iam-policy": topicSample(func(w io.Writer, projectID, topicID string, opts ...option.ClientOption) error {
+		_, err := topics.Policy(w, projectID, topicID, endpoint.PubSub()...)
+		return err
+	}),
+	"pubsub topics test-permissions": topicSample(func(w io.Writer, projectID, topicID string, opts ...option.ClientOption) error {
+		_, err := topics.TestPermissions(w, projectID, topicID, endpoint.PubSub()...)
+		return err
+	}),
+
+	"pubsub subscriptions list": {
+		flags: []string{"project"},
+		run: func(ctx context.Context, w io.Writer, a args) error {
+			subs, err := subscriptions.List(a["project"], endpoint.PubSub()...)
+			if err != nil {
+				return err
+			}
+			for _, s := range subs {
+				fmt.Fprintln(w, s.ID())
+			}
+			return nil
+		},
+	},
+	"pubsub subscriptions create-with-dead-letter": {
+		flags: []string{"project", "subscription", "topic", "dead-letter-topic"},
+		run: func(ctx context.Context, w io.Writer, a args) error {
+			return subscriptions.CreateSubWithDeadLetter(w, a["project"], a["subscription"], a["topic"], a["dead-letter-topic"], endpoint.PubSub()...)
+		},
+	},
+	"pubsub subscriptions update-dead-letter": {
+		flags: []string{"project", "subscription", "dead-letter-topic"},
+		run: func(ctx context.Context, w io.Writer, a args) error {
+			return subscriptions.UpdateDeadLetter(w, a["project"], a["subscription"], a["dead-letter-topic"], endpoint.PubSub()...)
+		},
+	},
+	"pubsub subscriptions remove-dead-letter": subscriptionSample(subscriptions.RemoveDeadLetterTopic),
+	"pubsub subscriptions update-endpoint": {
+		flags: []string{"project", "subscription", "endpoint"},
+		run: func(ctx context.Context, w io.Writer, a args) error {
+			return subscriptions.UpdateEndpoint(w, a["project"], a["subscription"], a["endpoint"], endpoint.PubSub()...)
+		},
+	},
+	"pubsub subscriptions update-ack-deadline": {
+		flags: []string{"project", "subscription", "deadline"},
+		run: func(ctx context.Context, w io.Writer, a args) error {
+			deadline, err := a.duration("deadline")
+			if err != nil {
+				return err
+			}
+			return subscriptions.UpdateAckDeadline(w, a["project"], a["subscription"], deadline, endpoint.PubSub()...)
+		},
+	},
+	"pubsub subscriptions update-expiration-policy": {
+		flags: []string{"project", "subscription", "ttl"},
+		run: func(ctx context.Context, w io.Writer, a args) error {
+			ttl, err := a.duration("ttl")
+			if err != nil {
+				return err
+			}
+			return subscriptions.UpdateExpirationPolicy(w, a["project"], a["subscription"], ttl, endpoint.PubSub()...)
+		},
+	},
+	"pubsub subscriptions update-message-retention": {
+		flags: []string{"project", "subscription", "retention", "retain-acked=false"},
+		run: func(ctx context.Context, w io.Writer, a args) error {
+			retention, err := a.duration("retention")
+			if err != nil {
+				return err
+			}
+			retainAcked, err := a.bool("retain-acked")
+			if err != nil {
+				return err
+			}
+			return subscriptions.UpdateMessageRetention(w, a["project"], a["subscription"], retention, retainAcked, endpoint.PubSub()...)
+		},
+	},
+	"pubsub subscriptions pull":   subscriptionSample(subscriptions.PullMsgsSync),
+	"pubsub subscriptions delete": subscriptionSample(subscriptions.Delete),
+	"pubsub subscriptions detach": subscriptionSample(subscriptions.DetachSubscription),
+	"pubsub subscriptions get-iam-policy": subscriptionSample(func(w io.Writer, projectID, subID string, opts ...option.ClientOption) error {
+		_, err := subscriptions.Policy(w, projectID, subID, endpoint.PubSub()...)
+		return err
+	}),
+	"pubsub subscriptions test-permissions": subscriptionSample(func(w io.Writer, projectID, subID string, opts ...option.ClientOption) error {
+		_, err := subscriptions.TestPermissions(w, projectID, subID, endpoint.PubSub()...)
+		return err
+	}),
+}
@@ -0,0 +1,140 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A sample is one runnable sample. Every sample has the same signature, so
+// that the registry can run any of them from the command line.
+type sample struct {
+	// flags names the flags the sample takes. A flag is required unless it
+	// is written "name=default".
+	flags []string
+	run   func(ctx context.Context, w io.Writer, a args) error
+}
+
+// registry maps a sample name, such as "storage objects download", to the
+// sample.
+var registry = merge(storageSamples, pubsubSamples, firestoreSamples, datastoreSamples, kmsSamples)
+
+func merge(groups ...map[string]sample) map[string]sample {
+	all := make(map[string]sample)
+	for _, group := range groups {
+		for name, s := range group {
+			if _, ok := all[name]; ok {
+				panic(fmt.Sprintf("samplectl: sample %q registered twice", name))
+			}
+			all[name] = s
+		}
+	}
+	return all
+}
+
+// parse parses the flags of one run of the sample.
+func (s sample) parse(name string, arguments []string) (args, error) {
+	fs := flag.NewFlagSet("samplectl "+name, flag.ContinueOnError)
+	fs.SetOutput(ioutil.Discard)
+	values := make(map[string]*string)
+	for _, f := range s.flags {
+		name, def, _ := cutFlag(f)
+		if name == "project" && def == "" {
+			def = os.Getenv("GOOGLE_CLOUD_PROJECT")
+		}
+		values[name] = fs.String(name, def, "")
+	}
+	if err := fs.Parse(arguments); err != nil {
+		return nil, fmt.Errorf("%s: %w", s.usage(name), err)
+	}
+	if fs.NArg() > 0 {
+		return nil, fmt.Errorf("%s: unexpected argument %q", s.usage(name), fs.Arg(0))
+	}
+	a := make(args)
+	for _, f := range s.flags {
+		flagName, _, optional := cutFlag(f)
+		v := *values[flagName]
+		if v == "" && !optional {
+			if flagName == "project" {
+				return nil, fmt.Errorf("%s: missing --project, and GOOGLE_CLOUD_PROJECT is not set", s.usage(name))
+			}
+			return nil, fmt.Errorf("%s: missing --%s", s.usage(name), flagName)
+		}
+		a[flagName] = v
+	}
+	return a, nil
+}
+
+// usage returns the command line that runs the sample.
+func (s sample) usage(name string) string {
+	parts := []string{name}
+	for _, f := range s.flags {
+		flagName, def, optional := cutFlag(f)
+		switch {
+		case flagName == "project":
+			parts = append(parts, "[--project ID]")
+		case optional && def != "":
+			parts = append(parts, fmt.Sprintf("[--%s %s]", flagName, def))
+		case optional:
+			parts = append(parts, fmt.Sprintf("[--%s %s]", flagName, strings.ToUpper(flagName)))
+		default:
+			parts = append(parts, fmt.Sprintf("--%s %s", flagName, strings.ToUpper(flagName)))
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// cutFlag splits a flag spec into its name and default.
+func cutFlag(f string) (name, def string, optional bool) {
+	if i := strings.Index(f, "="); i >= 0 {
+		return f[:i], f[i+1:], true
+	}
+	return f, "", false
+}
+
+// args holds the flag values of one run, by flag name.
+type args map[string]string
+
+func (a args) duration(name string) (time.Duration, error) {
+	d, err := time.ParseDuration(a[name])
+	if err != nil {
+		return 0, fmt.Errorf("--%s: %w", name, err)
+	}
+	return d, nil
+}
+
+func (a args) int64(name string) (int64, error) {
+	n, err := strconv.ParseInt(a[name], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("--%s: %w", name, err)
+	}
+	return n, nil
+}
+
+func (a args) bool(name string) (bool, error) {
+	b, err := strconv.ParseBool(a[name])
+	if err != nil {
+		return false, fmt.Errorf("--%s: %w", name, err)
+	}
+	return b, nil
+}
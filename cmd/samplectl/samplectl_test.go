@@ -0,0 +1,155 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/golang-samples/internal/testutil"
+)
+
+func TestRegistry(t *testing.T) {
+	for name, s := range registry {
+		if len(strings.Fields(name)) != 3 {
+			t.Errorf("sample %q: want a PRODUCT GROUP OPERATION name", name)
+		}
+		seen := make(map[string]bool)
+		for _, f := range s.flags {
+			flagName, _, _ := cutFlag(f)
+			if seen[flagName] {
+				t.Errorf("sample %q: flag --%s declared twice", name, flagName)
+			}
+			seen[flagName] = true
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	os.Setenv("GOOGLE_CLOUD_PROJECT", "env-project")
+	defer os.Unsetenv("GOOGLE_CLOUD_PROJECT")
+
+	s := sample{flags: []string{"project", "bucket", "delim=", "retain-acked=false"}}
+	tests := []struct {
+		in      []string
+		want    args
+		wantErr string
+	}{
+		{
+			in:   []string{"--bucket", "b"},
+			want: args{"project": "env-project", "bucket": "b", "delim": "", "retain-acked": "false"},
+		},
+		{
+			in:   []string{"--project", "p", "--bucket=b", "--delim", "/", "--retain-acked", "true"},
+			want: args{"project": "p", "bucket": "b", "delim": "/", "retain-acked": "true"},
+		},
+		{in: []string{}, wantErr: "missing --bucket"},
+		{in: []string{"--bucket", "b", "--object", "o"}, wantErr: "flag provided but not defined: -object"},
+		{in: []string{"--bucket", "b", "extra"}, wantErr: `unexpected argument "extra"`},
+	}
+	for _, tc := range tests {
+		got, err := s.parse("test sample", tc.in)
+		if tc.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Errorf("parse(%q) got err %v, want %q", tc.in, err, tc.wantErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parse(%q): %v", tc.in, err)
+			continue
+		}
+		if len(got) != len(tc.want) {
+			t.Errorf("parse(%q) got %v, want %v", tc.in, got, tc.want)
+			continue
+		}
+		for k, v := range tc.want {
+			if got[k] != v {
+				t.Errorf("parse(%q) got %v, want %v", tc.in, got, tc.want)
+				break
+			}
+		}
+	}
+}
+
+func TestUsage(t *testing.T) {
+	ctx := context.Background()
+	for _, tc := range []struct {
+		args []string
+		want string
+	}{
+		{nil, "samplectl pubsub topics publish [--project ID] --topic TOPIC --msg MSG\n"},
+		{[]string{"storage", "objects"}, "samplectl storage objects download --bucket BUCKET --object OBJECT [--file FILE]\n"},
+		{[]string{"storage", "nope"}, `no sample named "storage nope"`},
+	} {
+		stderr := new(bytes.Buffer)
+		if err := run(ctx, ioutil.Discard, stderr, tc.args); err != errUsage {
+			t.Errorf("run(%q) got err %v, want errUsage", tc.args, err)
+		}
+		if got := stderr.String(); !strings.Contains(got, tc.want) {
+			t.Errorf("run(%q) usage got %q, want to contain %q", tc.args, got, tc.want)
+		}
+	}
+
+	stderr := new(bytes.Buffer)
+	run(ctx, ioutil.Discard, stderr, []string{"storage", "objects"})
+	if strings.Contains(stderr.String(), "storage buckets") {
+		t.Errorf("usage for storage objects got %q, want only storage objects samples", stderr.String())
+	}
+}
+
+func TestStorageSamples(t *testing.T) {
+	tc := testutil.StorageEmulatorTest(t)
+	ctx := context.Background()
+
+	bucket := testutil.UniqueName("samplectl")
+	dir, err := ioutil.TempDir("", "samplectl")
+	if err != nil {
+		t.Fatalf("ioutil.TempDir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	src := filepath.Join(dir, "src.txt")
+	if err := ioutil.WriteFile(src, []byte("hello"), 0644); err != nil {
+		t.Fatalf("ioutil.WriteFile: %v", err)
+	}
+
+	samplectl := func(args ...string) string {
+		t.Helper()
+		buf := new(bytes.Buffer)
+		if err := run(ctx, buf, ioutil.Discard, args); err != nil {
+			t.Fatalf("samplectl %s: %v", strings.Join(args, " "), err)
+		}
+		return buf.String()
+	}
+
+	samplectl("storage", "buckets", "create", "--project", tc.ProjectID, "--bucket", bucket)
+	defer samplectl("storage", "buckets", "delete", "--bucket", bucket)
+
+	samplectl("storage", "objects", "upload", "--bucket", bucket, "--object", "o.txt", "--file", src)
+	if got := samplectl("storage", "objects", "list", "--bucket", bucket); got != "o.txt\n" {
+		t.Errorf("storage objects list got %q, want %q", got, "o.txt\n")
+	}
+	dst := filepath.Join(dir, "dst.txt")
+	samplectl("storage", "objects", "download", "--bucket", bucket, "--object", "o.txt", "--file", dst)
+	if got, err := ioutil.ReadFile(dst); err != nil || string(got) != "hello" {
+		t.Errorf("storage objects download saved %q (err %v), want %q", got, err, "hello")
+	}
+	samplectl("storage", "objects", "delete", "--bucket", bucket, "--object", "o.txt")
+}
@@ -0,0 +1,222 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"cloud.google.com/go/storage"
+	"github.com/GoogleCloudPlatform/golang-samples/internal/endpoint"
+	"github.com/GoogleCloudPlatform/golang-samples/storage/buckets"
+	"github.com/GoogleCloudPlatform/golang-samples/storage/objects"
+)
+
+// storageSample adapts a storage sample, which takes a client, to the
+// registry. The client lives for one run.
+func storageSample(flags []string, run func(ctx context.Context, w io.Writer, client *storage.Client, a args) error) sample {
+	return sample{
+		flags: flags,
+		run: func(ctx context.Context, w io.Writer, a args) error {
+			client, err := storage.NewClient(ctx, endpoint.Storage()...)
+			if err != nil {
+				return fmt.Errorf("storage.NewClient: %w", err)
+			}
+			defer client.Close()
+			return run(ctx, w, client, a)
+		},
+	}
+}
+
+// bucketSample registers a storage sample that only takes a bucket.
+func bucketSample(fn func(ctx context.Context, w io.Writer, client *storage.Client, bucket string) error) sample {
+	return storageSample([]string{"bucket"}, func(ctx context.Context, w io.Writer, client *storage.Client, a args) error {
+		return fn(ctx, w, client, a["bucket"])
+	})
+}
+
+// objectSample registers a storage sample that takes a bucket and an object.
+func objectSample(fn func(ctx context.Context, w io.Writer, client *storage.Client, bucket, object string) error) sample {
+	return storageSample([]string{"bucket", "object"}, func(ctx context.Context, w io.Writer, client *storage.Client, a args) error {
+		return fn(ctx, w, client, a["bucket"], a["object"])
+	})
+}
+
+// saveTo writes downloaded data to file, when the file flag was given.
+func saveTo(file string, data []byte) error {
+	if file == "" {
+		return nil
+	}
+	return ioutil.WriteFile(file, data, 0644)
+}
+
+var storageSamples = map[string]sample{
+	"storage objects list": bucketSample(objects.ListFiles),
+	"storage objects list-with-prefix": storageSample([]string{"bucket", "prefix", "delim="}, func(ctx context.Context, w io.Writer, client *storage.Client, a args) error {
+		return objects.ListFilesWithPrefix(ctx, w, client, a["bucket"], a["prefix"], a["delim"])
+	}),
+	"storage objects list-versions": bucketSample(objects.ListFilesAllVersion),
+	"storage objects metadata": objectSample(func(ctx context.Context, w io.Writer, client *storage.Client, bucket, object string) error {
+		_, err := objects.GetMetadata(ctx, w, client, bucket, object)
+		return err
+	}),
+	"storage objects upload": storageSample([]string{"bucket", "object", "file"}, func(ctx context.Context, w io.Writer, client *storage.Client, a args) error {
+		return objects.UploadFile(ctx, w, client, a["bucket"], a["object"], a["file"])
+	}),
+	"storage objects upload-if-not-exists": storageSample([]string{"bucket", "object", "content"}, func(ctx context.Context, w io.Writer, client *storage.Client, a args) error {
+		_, err := objects.UploadFileIfNotExists(ctx, w, client, a["bucket"], a["object"], a["content"])
+		return err
+	}),
+	"storage objects upload-with-kms-key": storageSample([]string{"bucket", "object", "key"}, func(ctx context.Context, w io.Writer, client *storage.Client, a args) error {
+		return objects.UploadWithKMSKey(ctx, w, client, a["bucket"], a["object"], a["key"])
+	}),
+	"storage objects upload-with-retry-after": storageSample([]string{"bucket", "object", "file", "max-elapsed=5m"}, func(ctx context.Context, w io.Writer, client *storage.Client, a args) error {
+		maxElapsed, err := a.duration("max-elapsed")
+		if err != nil {
+			return err
+		}
+		return objects.UploadWithRetryAfter(ctx, w, client, a["bucket"], a["object"], a["file"], maxElapsed)
+	}),
+	"storage objects download": storageSample([]string{"bucket", "object", "file="}, func(ctx context.Context, w io.Writer, client *storage.Client, a args) error {
+		data, err := objects.DownloadFile(ctx, w, client, a["bucket"], a["object"])
+		if err != nil {
+			return err
+		}
+		return saveTo(a["file"], data)
+	}),
+	"storage objects download-public": storageSample([]string{"bucket", "object", "file="}, func(ctx context.Context, w io.Writer, client *storage.Client, a args) error {
+		data, err := objects.DownloadPublicFile(ctx, w, client, a["bucket"], a["object"])
+		if err != nil {
+			return err
+		}
+		return saveTo(a["file"], data)
+	}),
+	"storage objects download-requester-pays": storageSample([]string{"bucket", "object", "project"}, func(ctx context.Context, w io.Writer, client *storage.Client, a args) error {
+		return objects.DownloadUsingRequesterPays(ctx, w, client, a["bucket"], a["object"], a["project"])
+	}),
+	"storage objects copy": storageSample([]string{"src-bucket", "src-object", "dst-bucket", "dst-object"}, func(ctx context.Context, w io.Writer, client *storage.Client, a args) error {
+		return objects.CopyFile(ctx, w, client, a["dst-bucket"], a["src-bucket"], a["src-object"], a["dst-object"])
+	}),
+	"storage objects copy-version": storageSample([]string{"bucket", "src-object", "dst-object", "generation"}, func(ctx context.Context, w io.Writer, client *storage.Client, a args) error {
+		gen, err := a.int64("generation")
+		if err != nil {
+			return err
+		}
+		return objects.CopyOldVersionOfObject(ctx, w, client, a["bucket"], a["src-object"], a["dst-object"], gen)
+	}),
+	"storage objects compose": storageSample([]string{"bucket", "object1", "object2", "dst-object"}, func(ctx context.Context, w io.Writer, client *storage.Client, a args) error {
+		return objects.ComposeFile(ctx, w, client, a["bucket"], a["object1"], a["object2"], a["dst-object"])
+	}),
+	"storage objects move":                 objectSample(objects.MoveFile),
+	"storage objects change-storage-class": objectSample(objects.ChangeObjectStorageClass),
+	"storage objects delete":               objectSample(objects.DeleteFile),
+	"storage objects delete-version": storageSample([]string{"bucket", "object", "generation"}, func(ctx context.Context, w io.Writer, client *storage.Client, a args) error {
+		gen, err := a.int64("generation")
+		if err != nil {
+			return err
+		}
+		return objects.DeleteOldVersionOfObject(ctx, w, client, a["bucket"], a["object"], gen)
+	}),
+	"storage objects set-event-based-hold":     objectSample(objects.SetEventBasedHold),
+	"storage objects release-event-based-hold": objectSample(objects.ReleaseEventBasedHold),
+	"storage objects set-temporary-hold":       objectSample(objects.SetTemporaryHold),
+	"storage objects release-temporary-hold":   objectSample(objects.ReleaseTemporaryHold),
+	"storage objects hold-inventory":           bucketSample(objects.HoldInventory),
+	"storage objects enable-versioning":        bucketSample(objects.EnableVersioning),
+	"storage objects disable-versioning":       bucketSample(objects.DisableVersioning),
+	"storage objects signed-url-get": {
+		flags: []string{"bucket", "object", "service-account"},
+		run: func(ctx context.Context, w io.Writer, a args) error {
+			_, err := objects.GenerateV4GetObjectSignedURL(w, a["bucket"], a["object"], a["service-account"])
+			return err
+		},
+	},
+	"storage objects signed-url-put": {
+		flags: []string{"bucket", "object", "service-account"},
+		run: func(ctx context.Context, w io.Writer, a args) error {
+			_, err := objects.GenerateV4PutObjectSignedURL(w, a["bucket"], a["object"], a["service-account"])
+			return err
+		},
+	},
+
+	"storage buckets list": storageSample([]string{"project"}, func(ctx context.Context, w io.Writer, client *storage.Client, a args) error {
+		_, err := buckets.ListBuckets(ctx, w, client, a["project"])
+		return err
+	}),
+	"storage buckets create": storageSample([]string{"project", "bucket"}, func(ctx context.Context, w io.Writer, client *storage.Client, a args) error {
+		return buckets.CreateBucket(ctx, w, client, a["project"], a["bucket"])
+	}),
+	"storage buckets create-class-location": storageSample([]string{"project", "bucket"}, func(ctx context.Context, w io.Writer, client *storage.Client, a args) error {
+		return buckets.CreateBucketClassLocation(ctx, w, client, a["project"], a["bucket"])
+	}),
+	"storage buckets delete": bucketSample(buckets.DeleteBucket),
+	"storage buckets metadata": bucketSample(func(ctx context.Context, w io.Writer, client *storage.Client, bucket string) error {
+		_, err := buckets.GetBucketMetadata(ctx, w, client, bucket)
+		return err
+	}),
+	"storage buckets add-label": storageSample([]string{"bucket", "key", "value"}, func(ctx context.Context, w io.Writer, client *storage.Client, a args) error {
+		return buckets.AddBucketLabel(ctx, w, client, a["bucket"], a["key"], a["value"])
+	}),
+	"storage buckets remove-label": storageSample([]string{"bucket", "key"}, func(ctx context.Context, w io.Writer, client *storage.Client, a args) error {
+		return buckets.RemoveBucketLabel(ctx, w, client, a["bucket"], a["key"])
+	}),
+	"storage buckets enable-lifecycle":  bucketSample(buckets.EnableBucketLifecycleManagement),
+	"storage buckets disable-lifecycle": bucketSample(buckets.DisableBucketLifecycleManagement),
+	"storage buckets get-default-event-based-hold": bucketSample(func(ctx context.Context, w io.Writer, client *storage.Client, bucket string) error {
+		_, err := buckets.GetDefaultEventBasedHold(ctx, w, client, bucket)
+		return err
+	}),
+	"storage buckets enable-default-event-based-hold":  bucketSample(buckets.EnableDefaultEventBasedHold),
+	"storage buckets disable-default-event-based-hold": bucketSample(buckets.DisableDefaultEventBasedHold),
+	"storage buckets get-requester-pays":               bucketSample(buckets.GetRequesterPaysStatus),
+	"storage buckets enable-requester-pays":            bucketSample(buckets.EnableRequesterPays),
+	"storage buckets disable-requester-pays":           bucketSample(buckets.DisableRequesterPays),
+	"storage buckets get-uniform-access": bucketSample(func(ctx context.Context, w io.Writer, client *storage.Client, bucket string) error {
+		_, err := buckets.GetUniformBucketLevelAccess(ctx, w, client, bucket)
+		return err
+	}),
+	"storage buckets enable-uniform-access":  bucketSample(buckets.EnableUniformBucketLevelAccess),
+	"storage buckets disable-uniform-access": bucketSample(buckets.DisableUniformBucketLevelAccess),
+	"storage buckets get-iam-policy": bucketSample(func(ctx context.Context, w io.Writer, client *storage.Client, bucket string) error {
+		_, err := buckets.GetBucketPolicy(ctx, w, client, bucket)
+		return err
+	}),
+	"storage buckets add-iam-member":    bucketSample(buckets.AddBucketIAMMember),
+	"storage buckets remove-iam-member": bucketSample(buckets.RemoveBucketIAMMember),
+	"storage buckets set-public-iam":    bucketSample(buckets.SetBucketPublicIAM),
+	"storage buckets get-retention-policy": bucketSample(func(ctx context.Context, w io.Writer, client *storage.Client, bucket string) error {
+		_, err := buckets.GetRetentionPolicy(ctx, w, client, bucket)
+		return err
+	}),
+	"storage buckets set-retention-policy": storageSample([]string{"bucket", "period"}, func(ctx context.Context, w io.Writer, client *storage.Client, a args) error {
+		period, err := a.duration("period")
+		if err != nil {
+			return err
+		}
+		return buckets.SetRetentionPolicy(ctx, w, client, a["bucket"], period)
+	}),
+	"storage buckets remove-retention-policy": bucketSample(buckets.RemoveRetentionPolicy),
+	"storage buckets lock-retention-policy":   bucketSample(buckets.LockRetentionPolicy),
+	"storage buckets remove-cors":             bucketSample(buckets.RemoveBucketCORSConfiguration),
+	"storage buckets set-default-kms-key": storageSample([]string{"bucket", "key"}, func(ctx context.Context, w io.Writer, client *storage.Client, a args) error {
+		return buckets.SetBucketDefaultKMSKey(ctx, w, client, a["bucket"], a["key"])
+	}),
+	"storage buckets remove-default-kms-key": bucketSample(buckets.RemoveBucketDefaultKMSKey),
+	"storage buckets set-website": storageSample([]string{"bucket", "index", "not-found"}, func(ctx context.Context, w io.Writer, client *storage.Client, a args) error {
+		return buckets.SetBucketWebsiteInfo(ctx, w, client, a["bucket"], a["index"], a["not-found"])
+	}),
+}
@@ -69,14 +69,14 @@ func main() {
 func listClusters(svc *container.Service, projectID, zone string) error {
 	list, err := svc.Projects.Zones.Clusters.List(projectID, zone).Do()
 	if err != nil {
-		return fmt.Errorf("failed to list clusters: %v", err)
+		return fmt.Errorf("failed to list clusters: %w", err)
 	}
 	for _, v := range list.Clusters {
 		fmt.Printf("Cluster %q (%s) master_version: v%s", v.Name, v.Status, v.CurrentMasterVersion)
 
 		poolList, err := svc.Projects.Zones.Clusters.NodePools.List(projectID, zone, v.Name).Do()
 		if err != nil {
-			return fmt.Errorf("failed to list node pools for cluster %q: %v", v.Name, err)
+			return fmt.Errorf("failed to list node pools for cluster %q: %w", v.Name, err)
 		}
 		for _, np := range poolList.NodePools {
 			fmt.Printf("  -> Pool %q (%s) machineType=%s node_version=v%s autoscaling=%v", np.Name, np.Status,
@@ -34,7 +34,7 @@ func createOccurrence(resourceURL, noteID, occProjectID, noteProjectID string) (
 	ctx := context.Background()
 	client, err := containeranalysis.NewClient(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("NewClient: %v", err)
+		return nil, fmt.Errorf("NewClient: %w", err)
 	}
 	defer client.Close()
 
@@ -36,7 +36,7 @@ func getOccurrencesForImage(w io.Writer, resourceURL, projectID string) (int, er
 	ctx := context.Background()
 	client, err := containeranalysis.NewClient(ctx)
 	if err != nil {
-		return -1, fmt.Errorf("NewClient: %v", err)
+		return -1, fmt.Errorf("NewClient: %w", err)
 	}
 	defer client.Close()
 
@@ -52,7 +52,7 @@ func getOccurrencesForImage(w io.Writer, resourceURL, projectID string) (int, er
 			break
 		}
 		if err != nil {
-			return -1, fmt.Errorf("occurrence iteration error: %v", err)
+			return -1, fmt.Errorf("occurrence iteration error: %w", err)
 		}
 		// Write custom code to process each Occurrence here.
 		fmt.Fprintln(w, occ)
@@ -171,7 +171,7 @@ func TestOccurrencesForImage(t *testing.T) {
 	} else if created == nil {
 		t.Error("createOccurrence returns nil Occurrence object")
 	}
-	testutil.Retry(t, v.tryLimit, time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: time.Second, Max: 2 * time.Second, Multiplier: 2, MaxAttempts: v.tryLimit}, func(r *testutil.R) {
 		newCount, err := getOccurrencesForImage(new(bytes.Buffer), v.imageURL, v.projectID)
 		if err != nil {
 			r.Errorf("getOccurrencesForImage(%s): %v", v.imageURL, err)
@@ -203,7 +203,7 @@ func TestOccurrencesForNote(t *testing.T) {
 		t.Error("createOccurrence returns nil Occurrence object")
 	}
 
-	testutil.Retry(t, v.tryLimit, time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: time.Second, Max: 2 * time.Second, Multiplier: 2, MaxAttempts: v.tryLimit}, func(r *testutil.R) {
 		newCount, err := getOccurrencesForNote(new(bytes.Buffer), v.noteID, v.projectID)
 		if err != nil {
 			r.Errorf("getOccurrencesForNote(%s): %v", v.noteID, err)
@@ -228,7 +228,7 @@ func TestPubSub(t *testing.T) {
 	// Create a new subscription if it doesn't exist.
 	createOccurrenceSubscription(v.subID, v.projectID)
 
-	testutil.Retry(t, v.tryLimit, time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: time.Second, Max: 2 * time.Second, Multiplier: 2, MaxAttempts: v.tryLimit}, func(r *testutil.R) {
 		// Use a channel and a goroutine to count incoming messages.
 		c := make(chan int)
 		go func() {
@@ -311,7 +311,7 @@ func TestPollDiscoveryOccurrenceFinished(t *testing.T) {
 	}
 
 	// poll again
-	testutil.Retry(t, v.tryLimit, time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: time.Second, Max: 2 * time.Second, Multiplier: 2, MaxAttempts: v.tryLimit}, func(r *testutil.R) {
 		discOcc, err = pollDiscoveryOccurrenceFinished(v.imageURL, v.projectID, timeout)
 		if err != nil {
 			r.Errorf("error getting discovery occurrence: %v", err)
@@ -349,7 +349,7 @@ func TestFindVulnerabilitiesForImage(t *testing.T) {
 		t.Error("createOccurrence returns nil Occurrence object")
 	}
 
-	testutil.Retry(t, v.tryLimit, time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: time.Second, Max: 2 * time.Second, Multiplier: 2, MaxAttempts: v.tryLimit}, func(r *testutil.R) {
 		occList, err = findVulnerabilityOccurrencesForImage(v.imageURL, v.projectID)
 		if err != nil {
 			r.Errorf("findVulnerabilityOccurrencesForImage(%v): %v", v.imageURL, err)
@@ -442,7 +442,7 @@ func TestFindHighVulnerabilities(t *testing.T) {
 		t.Error("createOccurrence returns nil Occurrence object")
 	}
 	// check after creation
-	testutil.Retry(t, v.tryLimit, time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: time.Second, Max: 2 * time.Second, Multiplier: 2, MaxAttempts: v.tryLimit}, func(r *testutil.R) {
 		occList, err = findHighSeverityVulnerabilitiesForImage(v.imageURL, v.projectID)
 		if err != nil {
 			r.Errorf("findHighSeverityVulnerabilitiesForImage(%s): %v", v.imageURL, err)
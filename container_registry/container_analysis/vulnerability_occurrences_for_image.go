@@ -34,7 +34,7 @@ func findVulnerabilityOccurrencesForImage(resourceURL, projectID string) ([]*gra
 	ctx := context.Background()
 	client, err := containeranalysis.NewClient(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("NewClient: %v", err)
+		return nil, fmt.Errorf("NewClient: %w", err)
 	}
 	defer client.Close()
 
@@ -51,7 +51,7 @@ func findVulnerabilityOccurrencesForImage(resourceURL, projectID string) ([]*gra
 			break
 		}
 		if err != nil {
-			return nil, fmt.Errorf("occurrence iteration error: %v", err)
+			return nil, fmt.Errorf("occurrence iteration error: %w", err)
 		}
 		occurrenceList = append(occurrenceList, occ)
 	}
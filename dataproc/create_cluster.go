@@ -23,8 +23,8 @@ import (
 	"io"
 
 	dataproc "cloud.google.com/go/dataproc/apiv1"
+	dataprocpb "cloud.google.com/go/dataproc/apiv1/dataprocpb"
 	"google.golang.org/api/option"
-	dataprocpb "google.golang.org/genproto/googleapis/cloud/dataproc/v1"
 )
 
 func createCluster(w io.Writer, projectID, region, clusterName string) error {
@@ -37,7 +37,7 @@ func createCluster(w io.Writer, projectID, region, clusterName string) error {
 	endpoint := region + "-dataproc.googleapis.com:443"
 	clusterClient, err := dataproc.NewClusterControllerClient(ctx, option.WithEndpoint(endpoint))
 	if err != nil {
-		return fmt.Errorf("dataproc.NewClusterControllerClient: %v", err)
+		return fmt.Errorf("dataproc.NewClusterControllerClient: %w", err)
 	}
 
 	// Create the cluster config.
@@ -63,12 +63,12 @@ func createCluster(w io.Writer, projectID, region, clusterName string) error {
 	// Create the cluster.
 	op, err := clusterClient.CreateCluster(ctx, req)
 	if err != nil {
-		return fmt.Errorf("CreateCluster: %v", err)
+		return fmt.Errorf("CreateCluster: %w", err)
 	}
 
 	resp, err := op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("CreateCluster.Wait: %v", err)
+		return fmt.Errorf("CreateCluster.Wait: %w", err)
 	}
 
 	// Output a success message.
@@ -23,9 +23,9 @@ import (
 	"time"
 
 	dataproc "cloud.google.com/go/dataproc/apiv1"
+	dataprocpb "cloud.google.com/go/dataproc/apiv1/dataprocpb"
 	"github.com/GoogleCloudPlatform/golang-samples/internal/testutil"
 	"google.golang.org/api/option"
-	dataprocpb "google.golang.org/genproto/googleapis/cloud/dataproc/v1"
 )
 
 func deleteCluster(projectID string, clusterName, region string) error {
@@ -33,7 +33,7 @@ func deleteCluster(projectID string, clusterName, region string) error {
 
 	clusterClient, err := dataproc.NewClusterControllerClient(ctx, option.WithEndpoint(fmt.Sprintf("%s-dataproc.googleapis.com:443", region)))
 	if err != nil {
-		return fmt.Errorf("dataproc.NewClusterControllerClient: %v", err)
+		return fmt.Errorf("dataproc.NewClusterControllerClient: %w", err)
 	}
 
 	req := &dataprocpb.DeleteClusterRequest{
@@ -43,11 +43,11 @@ func deleteCluster(projectID string, clusterName, region string) error {
 	}
 	op, err := clusterClient.DeleteCluster(ctx, req)
 	if err != nil {
-		return fmt.Errorf("DeleteCluster: %v", err)
+		return fmt.Errorf("DeleteCluster: %w", err)
 	}
 
 	if err := op.Wait(ctx); err != nil {
-		return fmt.Errorf("DeleteCluster.Wait: %v", err)
+		return fmt.Errorf("DeleteCluster.Wait: %w", err)
 	}
 	return nil
 }
@@ -63,7 +63,7 @@ func TestDataproc(t *testing.T) {
 
 	buf := new(bytes.Buffer)
 
-	testutil.Retry(t, 3, 30*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 30 * time.Second, Max: 60 * time.Second, Multiplier: 2, MaxAttempts: 3}, func(r *testutil.R) {
 		if err := createCluster(buf, tc.ProjectID, region, clusterName); err != nil {
 			r.Errorf("createCluster got err: %v", err)
 			return
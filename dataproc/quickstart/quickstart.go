@@ -19,9 +19,10 @@
 // and finally delete the cluster.
 //
 // Usage:
-//     go build
-//     ./quickstart --project_id <PROJECT_ID> --region <REGION> \
-//         --cluster_name <CLUSTER_NAME> --job_file_path <GCS_JOB_FILE_PATH>
+//
+//	go build
+//	./quickstart --project_id <PROJECT_ID> --region <REGION> \
+//	    --cluster_name <CLUSTER_NAME> --job_file_path <GCS_JOB_FILE_PATH>
 package main
 
 import (
@@ -33,9 +34,9 @@ import (
 	"regexp"
 
 	dataproc "cloud.google.com/go/dataproc/apiv1"
+	dataprocpb "cloud.google.com/go/dataproc/apiv1/dataprocpb"
 	"cloud.google.com/go/storage"
 	"google.golang.org/api/option"
-	dataprocpb "google.golang.org/genproto/googleapis/cloud/dataproc/v1"
 )
 
 func main() {
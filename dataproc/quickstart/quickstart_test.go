@@ -23,11 +23,11 @@ import (
 	"time"
 
 	dataproc "cloud.google.com/go/dataproc/apiv1"
+	dataprocpb "cloud.google.com/go/dataproc/apiv1/dataprocpb"
 	"cloud.google.com/go/storage"
 	"github.com/GoogleCloudPlatform/golang-samples/internal/testutil"
 	"google.golang.org/api/iterator"
 	"google.golang.org/api/option"
-	dataprocpb "google.golang.org/genproto/googleapis/cloud/dataproc/v1"
 )
 
 var (
@@ -101,7 +101,7 @@ func deleteClusters(ctx context.Context, projectID string) error {
 	endpoint := fmt.Sprintf("%s-dataproc.googleapis.com:443", region)
 	client, err := dataproc.NewClusterControllerClient(ctx, option.WithEndpoint(endpoint))
 	if err != nil {
-		return fmt.Errorf("dataproc.NewClusterControllerClient: %v", err)
+		return fmt.Errorf("dataproc.NewClusterControllerClient: %w", err)
 	}
 
 	lReq := &dataprocpb.ListClustersRequest{ProjectId: projectID, Region: region}
@@ -113,17 +113,17 @@ func deleteClusters(ctx context.Context, projectID string) error {
 			break
 		}
 		if err != nil {
-			return fmt.Errorf("ListClusters.Next: %v", err)
+			return fmt.Errorf("ListClusters.Next: %w", err)
 		}
 		if resp.ClusterName == clusterName {
 			dReq := &dataprocpb.DeleteClusterRequest{ProjectId: projectID, Region: region, ClusterName: clusterName}
 			op, err := client.DeleteCluster(ctx, dReq)
 			if err != nil {
-				return fmt.Errorf("DeleteCluster: %v", err)
+				return fmt.Errorf("DeleteCluster: %w", err)
 			}
 
 			if err := op.Wait(ctx); err != nil {
-				return fmt.Errorf("DeleteCluster.Wait: %v", err)
+				return fmt.Errorf("DeleteCluster.Wait: %w", err)
 			}
 		}
 	}
@@ -140,7 +140,7 @@ func TestQuickstart(t *testing.T) {
 		t.Fatalf("failed to build app")
 	}
 
-	testutil.Retry(t, 3, 30*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 30 * time.Second, Max: 60 * time.Second, Multiplier: 2, MaxAttempts: 3}, func(r *testutil.R) {
 		if err := deleteClusters(context.Background(), tc.ProjectID); err != nil {
 			r.Errorf("failed to deleteClusters: %v", err)
 			return
@@ -0,0 +1,53 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package samples
+
+// [START datastore_admin_index_delete]
+import (
+	"context"
+	"fmt"
+	"io"
+
+	admin "cloud.google.com/go/datastore/admin/apiv1"
+	adminpb "google.golang.org/genproto/googleapis/datastore/admin/v1"
+)
+
+// indexDelete deletes an index.
+func indexDelete(w io.Writer, projectID, indexID string) error {
+	// projectID := "my-project-id"
+	// indexID := "my-index"
+	ctx := context.Background()
+	client, err := admin.NewDatastoreAdminClient(ctx)
+	if err != nil {
+		return fmt.Errorf("admin.NewDatastoreAdminClient: %w", err)
+	}
+	defer client.Close()
+
+	req := &adminpb.DeleteIndexRequest{
+		ProjectId: projectID,
+		IndexId:   indexID,
+	}
+	op, err := client.DeleteIndex(ctx, req)
+	if err != nil {
+		return fmt.Errorf("DeleteIndex: %w", err)
+	}
+	if _, err := op.Wait(ctx); err != nil {
+		return fmt.Errorf("Wait: %w", err)
+	}
+	fmt.Fprintf(w, "Deleted index: %v\n", indexID)
+	return nil
+}
+
+// [END datastore_admin_index_delete]
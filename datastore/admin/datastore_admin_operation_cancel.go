@@ -0,0 +1,46 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package samples
+
+// [START datastore_admin_operation_cancel]
+import (
+	"context"
+	"fmt"
+	"io"
+
+	admin "cloud.google.com/go/datastore/admin/apiv1"
+	"google.golang.org/genproto/googleapis/longrunning"
+)
+
+// operationCancel asks for a running operation to stop. Cancellation is best
+// effort: the operation may still complete. Entities an export already
+// wrote, or an import already loaded, are kept.
+func operationCancel(w io.Writer, name string) error {
+	// name := "projects/my-project-id/operations/operation-id"
+	ctx := context.Background()
+	client, err := admin.NewDatastoreAdminClient(ctx)
+	if err != nil {
+		return fmt.Errorf("admin.NewDatastoreAdminClient: %w", err)
+	}
+	defer client.Close()
+
+	if err := client.CancelOperation(ctx, &longrunning.CancelOperationRequest{Name: name}); err != nil {
+		return fmt.Errorf("CancelOperation: %w", err)
+	}
+	fmt.Fprintf(w, "Cancelled operation: %v\n", name)
+	return nil
+}
+
+// [END datastore_admin_operation_cancel]
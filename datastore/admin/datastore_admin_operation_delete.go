@@ -0,0 +1,45 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package samples
+
+// [START datastore_admin_operation_delete]
+import (
+	"context"
+	"fmt"
+	"io"
+
+	admin "cloud.google.com/go/datastore/admin/apiv1"
+	"google.golang.org/genproto/googleapis/longrunning"
+)
+
+// operationDelete deletes the record of a finished operation, so it is no
+// longer listed. It does not stop a running operation or undo its effects.
+func operationDelete(w io.Writer, name string) error {
+	// name := "projects/my-project-id/operations/operation-id"
+	ctx := context.Background()
+	client, err := admin.NewDatastoreAdminClient(ctx)
+	if err != nil {
+		return fmt.Errorf("admin.NewDatastoreAdminClient: %w", err)
+	}
+	defer client.Close()
+
+	if err := client.DeleteOperation(ctx, &longrunning.DeleteOperationRequest{Name: name}); err != nil {
+		return fmt.Errorf("DeleteOperation: %w", err)
+	}
+	fmt.Fprintf(w, "Deleted operation: %v\n", name)
+	return nil
+}
+
+// [END datastore_admin_operation_delete]
@@ -0,0 +1,45 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package samples
+
+// [START datastore_admin_operation_get]
+import (
+	"context"
+	"fmt"
+	"io"
+
+	admin "cloud.google.com/go/datastore/admin/apiv1"
+	"google.golang.org/genproto/googleapis/longrunning"
+)
+
+// operationGet gets an admin operation by name.
+func operationGet(w io.Writer, name string) (*longrunning.Operation, error) {
+	// name := "projects/my-project-id/operations/operation-id"
+	ctx := context.Background()
+	client, err := admin.NewDatastoreAdminClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("admin.NewDatastoreAdminClient: %w", err)
+	}
+	defer client.Close()
+
+	op, err := client.GetOperation(ctx, &longrunning.GetOperationRequest{Name: name})
+	if err != nil {
+		return nil, fmt.Errorf("GetOperation: %w", err)
+	}
+	fmt.Fprintf(w, "Got operation: %v (done: %v)\n", op.Name, op.Done)
+	return op, nil
+}
+
+// [END datastore_admin_operation_get]
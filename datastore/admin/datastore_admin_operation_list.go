@@ -0,0 +1,58 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package samples
+
+// [START datastore_admin_operation_list]
+import (
+	"context"
+	"fmt"
+	"io"
+
+	admin "cloud.google.com/go/datastore/admin/apiv1"
+	"google.golang.org/api/iterator"
+	"google.golang.org/genproto/googleapis/longrunning"
+)
+
+// operationList lists the admin operations of a project, such as exports,
+// imports and index changes, including recently finished ones.
+func operationList(w io.Writer, projectID string) ([]*longrunning.Operation, error) {
+	// projectID := "my-project-id"
+	ctx := context.Background()
+	client, err := admin.NewDatastoreAdminClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("admin.NewDatastoreAdminClient: %w", err)
+	}
+	defer client.Close()
+
+	req := &longrunning.ListOperationsRequest{
+		Name: fmt.Sprintf("projects/%s", projectID),
+	}
+	it := client.ListOperations(ctx, req)
+	var ops []*longrunning.Operation
+	for {
+		op, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("ListOperations: %w", err)
+		}
+		ops = append(ops, op)
+		fmt.Fprintf(w, "%v (done: %v)\n", op.Name, op.Done)
+	}
+	return ops, nil
+}
+
+// [END datastore_admin_operation_list]
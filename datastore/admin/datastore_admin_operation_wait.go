@@ -0,0 +1,83 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package samples
+
+// [START datastore_admin_operation_wait]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	admin "cloud.google.com/go/datastore/admin/apiv1"
+	gax "github.com/googleapis/gax-go/v2"
+	adminpb "google.golang.org/genproto/googleapis/datastore/admin/v1"
+	"google.golang.org/genproto/googleapis/longrunning"
+	"google.golang.org/grpc/status"
+)
+
+// waitForOperation polls the named operation until it is done and returns
+// it, printing the progress of export and import operations along the way.
+// Use it to wait for an operation started elsewhere, for example by an
+// earlier run of a program or by gcloud.
+func waitForOperation(ctx context.Context, w io.Writer, client *admin.DatastoreAdminClient, name string) (*longrunning.Operation, error) {
+	bo := gax.Backoff{
+		Initial:    time.Second,
+		Max:        time.Minute,
+		Multiplier: 2,
+	}
+	for {
+		op, err := client.GetOperation(ctx, &longrunning.GetOperationRequest{Name: name})
+		if err != nil {
+			return nil, fmt.Errorf("GetOperation: %w", err)
+		}
+		if op.Done {
+			if err := op.GetError(); err != nil {
+				return op, fmt.Errorf("operation %v failed: %w", name, status.FromProto(err).Err())
+			}
+			return op, nil
+		}
+		printProgress(w, op)
+		if err := gax.Sleep(ctx, bo.Pause()); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// printProgress prints how far an export or import operation has come.
+func printProgress(w io.Writer, op *longrunning.Operation) {
+	var (
+		export adminpb.ExportEntitiesMetadata
+		imp    adminpb.ImportEntitiesMetadata
+		p      *adminpb.Progress
+	)
+	switch {
+	case op.GetMetadata().MessageIs(&export):
+		if err := op.GetMetadata().UnmarshalTo(&export); err == nil {
+			p = export.ProgressEntities
+		}
+	case op.GetMetadata().MessageIs(&imp):
+		if err := op.GetMetadata().UnmarshalTo(&imp); err == nil {
+			p = imp.ProgressEntities
+		}
+	}
+	if p == nil {
+		fmt.Fprintf(w, "%v: running\n", op.Name)
+		return
+	}
+	fmt.Fprintf(w, "%v: %d of ~%d entities\n", op.Name, p.WorkCompleted, p.WorkEstimated)
+}
+
+// [END datastore_admin_operation_wait]
@@ -15,11 +15,14 @@
 package samples
 
 import (
+	"bytes"
 	"context"
 	"io/ioutil"
 	"testing"
 
+	admin "cloud.google.com/go/datastore/admin/apiv1"
 	"github.com/GoogleCloudPlatform/golang-samples/internal/testutil"
+	adminpb "google.golang.org/genproto/googleapis/datastore/admin/v1"
 )
 
 func TestAdmin(t *testing.T) {
@@ -29,6 +32,7 @@ func TestAdmin(t *testing.T) {
 	// `Storage Admin`, or `Owner`.
 	// See https://cloud.google.com/datastore/docs/export-import-entities#permissions for full details
 	tc := testutil.SystemTest(t)
+	testutil.SkipIfDatastoreEmulator(t, "Datastore Admin API")
 	ctx := context.Background()
 	client, err := clientCreate(ioutil.Discard)
 	if err != nil {
@@ -36,15 +40,29 @@ func TestAdmin(t *testing.T) {
 	}
 	defer client.Close()
 
+	// Create the index to look up instead of relying on the project to
+	// have one already. Each test indexes its own kind, so tests running in
+	// parallel or on other CI shards do not create and delete the same index.
+	fixture, err := indexCreate(ioutil.Discard, tc.ProjectID, testutil.UniqueName("admin-task"))
+	if err != nil {
+		t.Fatalf("indexCreate: %v", err)
+	}
+	defer indexDelete(ioutil.Discard, tc.ProjectID, fixture.IndexId)
+
 	indices, err := indexList(ioutil.Discard, tc.ProjectID)
 	if err != nil {
 		t.Fatalf("indexList: %v", err)
 	}
-	if len(indices) == 0 {
-		t.Skip("Skipping datastore test. At least one index should present in database.")
+	var listed bool
+	for _, i := range indices {
+		if i.IndexId == fixture.IndexId {
+			listed = true
+		}
 	}
-	want := indices[0].IndexId
-	// Get the first index from the list of indexes.
+	if !listed {
+		t.Errorf("indexList did not list %v", fixture.IndexId)
+	}
+	want := fixture.IndexId
 	got, err := indexGet(ioutil.Discard, tc.ProjectID, want)
 	if err != nil {
 		t.Fatalf("indexGet: %v", err)
@@ -53,7 +71,7 @@ func TestAdmin(t *testing.T) {
 		t.Fatalf("Unexpected indexID: got %v, want %v", got.IndexId, want)
 	}
 	// Create bucket for Export/Import entities.
-	bucketName := tc.ProjectID + "-storage-bucket-test"
+	bucketName := testutil.UniqueName("datastore-admin")
 	testutil.CleanBucket(ctx, t, tc.ProjectID, bucketName)
 
 	resp, err := entitiesExport(ioutil.Discard, tc.ProjectID, "gs://"+bucketName)
@@ -64,3 +82,99 @@ func TestAdmin(t *testing.T) {
 		t.Fatalf("entitiesImport: %v", err)
 	}
 }
+
+func TestIndexCreateDelete(t *testing.T) {
+	tc := testutil.SystemTest(t)
+	testutil.SkipIfDatastoreEmulator(t, "Datastore Admin API")
+
+	index, err := indexCreate(ioutil.Discard, tc.ProjectID, testutil.UniqueName("admin-task"))
+	if err != nil {
+		t.Fatalf("indexCreate: %v", err)
+	}
+	if index.State != adminpb.Index_READY {
+		t.Errorf("indexCreate got state %v, want READY", index.State)
+	}
+
+	if err := indexDelete(ioutil.Discard, tc.ProjectID, index.IndexId); err != nil {
+		t.Fatalf("indexDelete: %v", err)
+	}
+	indices, err := indexList(ioutil.Discard, tc.ProjectID)
+	if err != nil {
+		t.Fatalf("indexList: %v", err)
+	}
+	for _, i := range indices {
+		if i.IndexId == index.IndexId && i.State != adminpb.Index_DELETING {
+			t.Errorf("indexList got index %v in state %v after delete, want it gone", i.IndexId, i.State)
+		}
+	}
+}
+
+func TestOperations(t *testing.T) {
+	tc := testutil.SystemTest(t)
+	testutil.SkipIfDatastoreEmulator(t, "Datastore Admin API")
+	ctx := context.Background()
+	bucketName := testutil.UniqueName("datastore-admin")
+	testutil.CleanBucket(ctx, t, tc.ProjectID, bucketName)
+
+	client, err := admin.NewDatastoreAdminClient(ctx)
+	if err != nil {
+		t.Fatalf("admin.NewDatastoreAdminClient: %v", err)
+	}
+	defer client.Close()
+	startExport := func() string {
+		op, err := client.ExportEntities(ctx, &adminpb.ExportEntitiesRequest{
+			ProjectId:       tc.ProjectID,
+			OutputUrlPrefix: "gs://" + bucketName,
+		})
+		if err != nil {
+			t.Fatalf("ExportEntities: %v", err)
+		}
+		return op.Name()
+	}
+
+	name := startExport()
+	op, err := operationGet(ioutil.Discard, name)
+	if err != nil {
+		t.Fatalf("operationGet: %v", err)
+	}
+	if op.Name != name {
+		t.Errorf("operationGet got %v, want %v", op.Name, name)
+	}
+	ops, err := operationList(ioutil.Discard, tc.ProjectID)
+	if err != nil {
+		t.Fatalf("operationList: %v", err)
+	}
+	var found bool
+	for _, o := range ops {
+		if o.Name == name {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("operationList did not list %v", name)
+	}
+	buf := new(bytes.Buffer)
+	op, err = waitForOperation(ctx, buf, client, name)
+	if err != nil {
+		t.Fatalf("waitForOperation: %v\n%s", err, buf)
+	}
+	if !op.Done {
+		t.Errorf("waitForOperation returned %v before it was done", name)
+	}
+	if err := operationDelete(ioutil.Discard, name); err != nil {
+		t.Errorf("operationDelete: %v", err)
+	}
+
+	// The export may finish before it is cancelled, so only check that
+	// cancelling succeeds and the operation ends.
+	name = startExport()
+	if err := operationCancel(ioutil.Discard, name); err != nil {
+		t.Fatalf("operationCancel: %v", err)
+	}
+	if op, _ := waitForOperation(ctx, ioutil.Discard, client, name); op == nil || !op.Done {
+		t.Errorf("waitForOperation(%v) after cancel got %v, want a done operation", name, op)
+	}
+	if err := operationDelete(ioutil.Discard, name); err != nil {
+		t.Errorf("operationDelete: %v", err)
+	}
+}
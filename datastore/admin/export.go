@@ -0,0 +1,75 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package samples
+
+import (
+	"io"
+
+	adminpb "google.golang.org/genproto/googleapis/datastore/admin/v1"
+	"google.golang.org/genproto/googleapis/longrunning"
+)
+
+// The functions below export the samples that cmd/samplectl is built from, so
+// that the tool runs the same code as the documentation.
+
+// IndexList runs the datastore_admin_index_list sample.
+func IndexList(w io.Writer, projectID string) ([]*adminpb.Index, error) {
+	return indexList(w, projectID)
+}
+
+// IndexGet runs the datastore_admin_index_get sample.
+func IndexGet(w io.Writer, projectID, indexID string) (*adminpb.Index, error) {
+	return indexGet(w, projectID, indexID)
+}
+
+// IndexCreate runs the datastore_admin_index_create sample.
+func IndexCreate(w io.Writer, projectID, kind string) (*adminpb.Index, error) {
+	return indexCreate(w, projectID, kind)
+}
+
+// IndexDelete runs the datastore_admin_index_delete sample.
+func IndexDelete(w io.Writer, projectID, indexID string) error {
+	return indexDelete(w, projectID, indexID)
+}
+
+// EntitiesExport runs the datastore_admin_entities_export sample.
+func EntitiesExport(w io.Writer, projectID, outputURLPrefix string) (*adminpb.ExportEntitiesResponse, error) {
+	return entitiesExport(w, projectID, outputURLPrefix)
+}
+
+// EntitiesImport runs the datastore_admin_entities_import sample.
+func EntitiesImport(w io.Writer, projectID, inputURL string) error {
+	return entitiesImport(w, projectID, inputURL)
+}
+
+// OperationList runs the datastore_admin_operation_list sample.
+func OperationList(w io.Writer, projectID string) ([]*longrunning.Operation, error) {
+	return operationList(w, projectID)
+}
+
+// OperationGet runs the datastore_admin_operation_get sample.
+func OperationGet(w io.Writer, name string) (*longrunning.Operation, error) {
+	return operationGet(w, name)
+}
+
+// OperationCancel runs the datastore_admin_operation_cancel sample.
+func OperationCancel(w io.Writer, name string) error {
+	return operationCancel(w, name)
+}
+
+// OperationDelete runs the datastore_admin_operation_delete sample.
+func OperationDelete(w io.Writer, name string) error {
+	return operationDelete(w, name)
+}
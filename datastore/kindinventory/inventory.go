@@ -0,0 +1,161 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"cloud.google.com/go/datastore"
+	"google.golang.org/api/iterator"
+)
+
+// kindSize is the number of entities of a kind and their size.
+type kindSize struct {
+	Kind     string
+	Entities int64
+	// Bytes is the size of the entities and their indexes, or -1 when the
+	// kind was scanned.
+	Bytes int64
+	// AsOf is when the statistics were computed, or zero when the kind was
+	// scanned.
+	AsOf time.Time
+}
+
+// inventory returns the size of every kind in namespace, sorted by name. If
+// scan is set, or a kind has no statistics, its entities are counted with a
+// keys-only scan.
+func inventory(ctx context.Context, client *datastore.Client, namespace string, scan bool) ([]kindSize, error) {
+	kinds, err := listKinds(ctx, client, namespace)
+	if err != nil {
+		return nil, err
+	}
+	stats := make(map[string]kindSize)
+	if !scan {
+		if stats, err = readStats(ctx, client, namespace); err != nil {
+			return nil, err
+		}
+	}
+
+	sizes := make([]kindSize, 0, len(kinds))
+	for _, kind := range kinds {
+		if s, ok := stats[kind]; ok {
+			sizes = append(sizes, s)
+			continue
+		}
+		n, err := countKeys(ctx, client, namespace, kind)
+		if err != nil {
+			return nil, err
+		}
+		sizes = append(sizes, kindSize{Kind: kind, Entities: n, Bytes: -1})
+	}
+	return sizes, nil
+}
+
+// listKinds returns the kinds in namespace, sorted by name, leaving out
+// the kinds Datastore reserves, such as the statistics kinds.
+func listKinds(ctx context.Context, client *datastore.Client, namespace string) ([]string, error) {
+	keys, err := client.GetAll(ctx, datastore.NewQuery("__kind__").Namespace(namespace).KeysOnly(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("GetAll(__kind__): %w", err)
+	}
+	var kinds []string
+	for _, k := range keys {
+		if !strings.HasPrefix(k.Name, "__") {
+			kinds = append(kinds, k.Name)
+		}
+	}
+	return kinds, nil
+}
+
+// readStats returns the statistics of the kinds in namespace, by kind. The
+// default namespace has its own statistics kind.
+func readStats(ctx context.Context, client *datastore.Client, namespace string) (map[string]kindSize, error) {
+	statKind := "__Stat_Kind__"
+	if namespace != "" {
+		statKind = "__Stat_Ns_Kind__"
+	}
+	// Statistics entities have more properties than are read here, so
+	// load them as property lists instead of structs.
+	var entities []datastore.PropertyList
+	if _, err := client.GetAll(ctx, datastore.NewQuery(statKind).Namespace(namespace), &entities); err != nil {
+		return nil, fmt.Errorf("GetAll(%s): %w", statKind, err)
+	}
+	stats := make(map[string]kindSize, len(entities))
+	for _, e := range entities {
+		s := parseStat(e)
+		stats[s.Kind] = s
+	}
+	return stats, nil
+}
+
+// parseStat reads the size of a kind from its statistics entity.
+func parseStat(e datastore.PropertyList) kindSize {
+	var s kindSize
+	for _, p := range e {
+		switch p.Name {
+		case "kind_name":
+			s.Kind, _ = p.Value.(string)
+		case "count":
+			s.Entities, _ = p.Value.(int64)
+		case "bytes":
+			s.Bytes, _ = p.Value.(int64)
+		case "timestamp":
+			s.AsOf, _ = p.Value.(time.Time)
+		}
+	}
+	return s
+}
+
+// countKeys counts the entities of kind in namespace by reading their keys.
+func countKeys(ctx context.Context, client *datastore.Client, namespace, kind string) (int64, error) {
+	var n int64
+	it := client.Run(ctx, datastore.NewQuery(kind).Namespace(namespace).KeysOnly())
+	for {
+		_, err := it.Next(nil)
+		if err == iterator.Done {
+			return n, nil
+		}
+		if err != nil {
+			return 0, fmt.Errorf("counting %s: %w", kind, err)
+		}
+		n++
+	}
+}
+
+// report prints the sizes as a table, followed by the totals.
+func report(w io.Writer, sizes []kindSize) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "KIND\tENTITIES\tBYTES\tSOURCE")
+	var entities int64
+	for _, s := range sizes {
+		entities += s.Entities
+		bytes, source := "-", "scan"
+		if s.Bytes >= 0 {
+			bytes = fmt.Sprint(s.Bytes)
+			source = "statistics as of " + s.AsOf.Format(time.RFC3339)
+		}
+		fmt.Fprintf(tw, "%s\t%d\t%s\t%s\n", s.Kind, s.Entities, bytes, source)
+	}
+	if err := tw.Flush(); err != nil {
+		return fmt.Errorf("Flush: %w", err)
+	}
+	fmt.Fprintf(w, "%d kind(s), %d entities.\n", len(sizes), entities)
+	return nil
+}
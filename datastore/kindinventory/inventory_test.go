@@ -0,0 +1,112 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/datastore"
+	"github.com/GoogleCloudPlatform/golang-samples/internal/testutil"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseStat(t *testing.T) {
+	asOf := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	e := datastore.PropertyList{
+		{Name: "kind_name", Value: "Task"},
+		{Name: "count", Value: int64(42)},
+		{Name: "bytes", Value: int64(4096)},
+		{Name: "entity_bytes", Value: int64(1024)},
+		{Name: "timestamp", Value: asOf},
+	}
+	want := kindSize{Kind: "Task", Entities: 42, Bytes: 4096, AsOf: asOf}
+	if diff := cmp.Diff(want, parseStat(e)); diff != "" {
+		t.Errorf("parseStat mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestReport(t *testing.T) {
+	asOf := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	buf := new(bytes.Buffer)
+	err := report(buf, []kindSize{
+		{Kind: "Task", Entities: 42, Bytes: 4096, AsOf: asOf},
+		{Kind: "TaskList", Entities: 3, Bytes: -1},
+	})
+	if err != nil {
+		t.Fatalf("report: %v", err)
+	}
+	got := buf.String()
+	for _, want := range []string{
+		"statistics as of 2026-10-01T00:00:00Z",
+		"2 kind(s), 45 entities.",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("report got\n%s\nwant it to contain %q", got, want)
+		}
+	}
+	for _, line := range strings.Split(got, "\n") {
+		if f := strings.Fields(line); len(f) > 0 && f[0] == "TaskList" {
+			if diff := cmp.Diff([]string{"TaskList", "3", "-", "scan"}, f); diff != "" {
+				t.Errorf("report TaskList row mismatch (-want +got):\n%s", diff)
+			}
+		}
+	}
+}
+
+func TestInventory(t *testing.T) {
+	tc := testutil.DatastoreEmulatorTest(t)
+	ctx := context.Background()
+	client, err := datastore.NewClient(ctx, tc.ProjectID)
+	if err != nil {
+		t.Fatalf("datastore.NewClient: %v", err)
+	}
+	defer client.Close()
+
+	// A namespace of its own keeps other tests' kinds out of the
+	// inventory, and is new, so it has no statistics yet.
+	namespace := testutil.UniqueName("kindinventory")
+	var keys []*datastore.Key
+	for kind, n := range map[string]int{"Task": 3, "TaskList": 2} {
+		for i := 0; i < n; i++ {
+			k := datastore.IncompleteKey(kind, nil)
+			k.Namespace = namespace
+			keys = append(keys, k)
+		}
+	}
+	entities := make([]struct{ N int }, len(keys))
+	keys, err = client.PutMulti(ctx, keys, entities)
+	if err != nil {
+		t.Fatalf("PutMulti: %v", err)
+	}
+	defer client.DeleteMulti(ctx, keys)
+
+	for _, scan := range []bool{false, true} {
+		got, err := inventory(ctx, client, namespace, scan)
+		if err != nil {
+			t.Fatalf("inventory(scan=%v): %v", scan, err)
+		}
+		want := []kindSize{
+			{Kind: "Task", Entities: 3, Bytes: -1},
+			{Kind: "TaskList", Entities: 2, Bytes: -1},
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("inventory(scan=%v) mismatch (-want +got):\n%s", scan, diff)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command kindinventory is a sample tool that lists every kind in a
+// Datastore namespace with its number of entities and their size, for
+// example to plan a migration or an export.
+//
+// Counts come from the built-in statistics entities, __Stat_Kind__ or
+// __Stat_Ns_Kind__, when they exist. Datastore updates them about once a
+// day, so they can be out of date, and they are missing in new databases and
+// in the emulator. Kinds without statistics are counted with a keys-only
+// scan, which reads every key of the kind and is billed as one small
+// operation per entity. Pass -scan to count every kind that way.
+//
+// Usage:
+//
+//	kindinventory -project my-project [-namespace tenant-a] [-scan]
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"cloud.google.com/go/datastore"
+)
+
+func main() {
+	var (
+		project   = flag.String("project", "", "Project to list kinds of.")
+		namespace = flag.String("namespace", "", "Namespace to list kinds of; the default namespace if empty.")
+		scan      = flag.Bool("scan", false, "Count every kind with a keys-only scan instead of using statistics.")
+	)
+	flag.Parse()
+	if *project == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err := run(context.Background(), os.Stdout, *project, *namespace, *scan); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(ctx context.Context, w io.Writer, project, namespace string, scan bool) error {
+	client, err := datastore.NewClient(ctx, project)
+	if err != nil {
+		return fmt.Errorf("datastore.NewClient(%q): %w", project, err)
+	}
+	defer client.Close()
+
+	kinds, err := inventory(ctx, client, namespace, scan)
+	if err != nil {
+		return err
+	}
+	return report(w, kinds)
+}
@@ -0,0 +1,131 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"cloud.google.com/go/datastore"
+)
+
+// fetchFunc reads up to limit entities starting at cursor and returns them
+// with the cursor after the last one.
+type fetchFunc func(ctx context.Context, cursor string, limit int) ([]*datastore.Key, []datastore.PropertyList, string, error)
+
+// putFunc writes entities under keys.
+type putFunc func(ctx context.Context, keys []*datastore.Key, entities []datastore.PropertyList) error
+
+// stats describes a migration.
+type stats struct {
+	entities int
+	batches  int
+	elapsed  time.Duration
+}
+
+// rate returns the number of entities migrated per second.
+func (s stats) rate() float64 {
+	if s.elapsed <= 0 {
+		return 0
+	}
+	return float64(s.entities) / s.elapsed.Seconds()
+}
+
+// migrate copies batches of entities from fetch to put, starting at cursor,
+// until a batch comes back short. Keys and key-valued properties are moved
+// to namespace. A batch is only read once the previous one was written, so
+// the logged cursor is always safe to resume from.
+func migrate(ctx context.Context, fetch fetchFunc, put putFunc, namespace string, batchSize int, cursor string) (stats, error) {
+	var s stats
+	start := time.Now()
+	for {
+		keys, entities, next, err := fetch(ctx, cursor, batchSize)
+		if err != nil {
+			s.elapsed = time.Since(start)
+			return s, fmt.Errorf("fetch at cursor %q: %w", cursor, err)
+		}
+		if len(keys) > 0 {
+			for i := range keys {
+				keys[i] = remapKey(keys[i], namespace)
+				entities[i] = remapProperties(entities[i], namespace)
+			}
+			if err := put(ctx, keys, entities); err != nil {
+				s.elapsed = time.Since(start)
+				return s, fmt.Errorf("put at cursor %q: %w", cursor, err)
+			}
+			s.entities += len(keys)
+			s.batches++
+			s.elapsed = time.Since(start)
+			log.Printf("Migrated %d entities (%.1f entities/s), next cursor %q", s.entities, s.rate(), next)
+		}
+		if len(keys) < batchSize {
+			s.elapsed = time.Since(start)
+			return s, nil
+		}
+		cursor = next
+	}
+}
+
+// remapKey returns a copy of k and its parents in namespace. The project of
+// a key is the one of the client it is written with, so it is not part of
+// the key.
+func remapKey(k *datastore.Key, namespace string) *datastore.Key {
+	if k == nil {
+		return nil
+	}
+	return &datastore.Key{
+		Kind:      k.Kind,
+		ID:        k.ID,
+		Name:      k.Name,
+		Parent:    remapKey(k.Parent, namespace),
+		Namespace: namespace,
+	}
+}
+
+// remapProperties returns a copy of props with every key value, including
+// keys in arrays and embedded entities, moved to namespace.
+func remapProperties(props datastore.PropertyList, namespace string) datastore.PropertyList {
+	out := make(datastore.PropertyList, len(props))
+	for i, p := range props {
+		p.Value = remapValue(p.Value, namespace)
+		out[i] = p
+	}
+	return out
+}
+
+func remapValue(v interface{}, namespace string) interface{} {
+	switch v := v.(type) {
+	case *datastore.Key:
+		return remapKey(v, namespace)
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, e := range v {
+			out[i] = remapValue(e, namespace)
+		}
+		return out
+	case *datastore.Entity:
+		if v == nil {
+			return v
+		}
+		return &datastore.Entity{
+			Key:        remapKey(v.Key, namespace),
+			Properties: remapProperties(v.Properties, namespace),
+		}
+	default:
+		return v
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"cloud.google.com/go/datastore"
+	"github.com/google/go-cmp/cmp"
+)
+
+// fakeSource serves n Task entities, using the index of the next entity as
+// cursor.
+func fakeSource(n int) fetchFunc {
+	return func(ctx context.Context, cursor string, limit int) ([]*datastore.Key, []datastore.PropertyList, string, error) {
+		start := 0
+		if cursor != "" {
+			var err error
+			if start, err = strconv.Atoi(cursor); err != nil {
+				return nil, nil, "", err
+			}
+		}
+		var (
+			keys     []*datastore.Key
+			entities []datastore.PropertyList
+		)
+		for i := start; i < n && i < start+limit; i++ {
+			keys = append(keys, &datastore.Key{Kind: "Task", ID: int64(i + 1), Namespace: "src"})
+			entities = append(entities, datastore.PropertyList{{Name: "N", Value: int64(i)}})
+		}
+		return keys, entities, strconv.Itoa(start + len(keys)), nil
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	for _, tc := range []struct {
+		n, batchSize int
+		cursor       string
+		wantEntities int
+		wantBatches  int
+	}{
+		{n: 0, batchSize: 3, wantEntities: 0, wantBatches: 0},
+		{n: 7, batchSize: 3, wantEntities: 7, wantBatches: 3},
+		{n: 6, batchSize: 3, wantEntities: 6, wantBatches: 2},
+		{n: 7, batchSize: 3, cursor: "5", wantEntities: 2, wantBatches: 1},
+	} {
+		var got []*datastore.Key
+		put := func(ctx context.Context, keys []*datastore.Key, entities []datastore.PropertyList) error {
+			got = append(got, keys...)
+			return nil
+		}
+		s, err := migrate(context.Background(), fakeSource(tc.n), put, "dst", tc.batchSize, tc.cursor)
+		if err != nil {
+			t.Fatalf("migrate(n=%d, cursor=%q): %v", tc.n, tc.cursor, err)
+		}
+		if s.entities != tc.wantEntities || s.batches != tc.wantBatches {
+			t.Errorf("migrate(n=%d, cursor=%q) got %d entities in %d batches, want %d in %d", tc.n, tc.cursor, s.entities, s.batches, tc.wantEntities, tc.wantBatches)
+		}
+		if len(got) != tc.wantEntities {
+			t.Errorf("migrate(n=%d, cursor=%q) put %d entities, want %d", tc.n, tc.cursor, len(got), tc.wantEntities)
+		}
+		for _, k := range got {
+			if k.Namespace != "dst" {
+				t.Errorf("migrate put key %v in namespace %q, want dst", k, k.Namespace)
+			}
+		}
+	}
+}
+
+func TestMigratePutError(t *testing.T) {
+	put := func(ctx context.Context, keys []*datastore.Key, entities []datastore.PropertyList) error {
+		return errors.New("unavailable")
+	}
+	s, err := migrate(context.Background(), fakeSource(5), put, "", 2, "")
+	if err == nil {
+		t.Fatalf("migrate got nil error, want put error")
+	}
+	if s.entities != 0 {
+		t.Errorf("migrate got %d entities, want 0", s.entities)
+	}
+}
+
+func TestRemapProperties(t *testing.T) {
+	parent := &datastore.Key{Kind: "TaskList", Name: "default", Namespace: "src"}
+	ref := &datastore.Key{Kind: "User", Name: "alice", Namespace: "src"}
+	props := datastore.PropertyList{
+		{Name: "Owner", Value: ref},
+		{Name: "Watchers", Value: []interface{}{ref, "bob"}},
+		{Name: "Meta", Value: &datastore.Entity{Properties: []datastore.Property{{Name: "List", Value: parent}}}},
+		{Name: "Title", Value: "Buy milk", NoIndex: true},
+	}
+
+	got := remapProperties(props, "dst")
+
+	wantRef := &datastore.Key{Kind: "User", Name: "alice", Namespace: "dst"}
+	wantParent := &datastore.Key{Kind: "TaskList", Name: "default", Namespace: "dst"}
+	want := datastore.PropertyList{
+		{Name: "Owner", Value: wantRef},
+		{Name: "Watchers", Value: []interface{}{wantRef, "bob"}},
+		{Name: "Meta", Value: &datastore.Entity{Properties: []datastore.Property{{Name: "List", Value: wantParent}}}},
+		{Name: "Title", Value: "Buy milk", NoIndex: true},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("remapProperties mismatch (-want +got):\n%s", diff)
+	}
+	if props[0].Value.(*datastore.Key).Namespace != "src" {
+		t.Errorf("remapProperties modified its input")
+	}
+
+	key := remapKey(&datastore.Key{Kind: "Task", ID: 1, Parent: parent, Namespace: "src"}, "dst")
+	if key.Namespace != "dst" || key.Parent.Namespace != "dst" || key.Parent.Name != "default" {
+		t.Errorf("remapKey got %v in %q with parent in %q, want both in dst", key, key.Namespace, key.Parent.Namespace)
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	adminpb "google.golang.org/genproto/googleapis/datastore/admin/v1"
+	"google.golang.org/genproto/googleapis/longrunning"
+)
+
+// startFunc starts an export and returns the name of its operation.
+type startFunc func(ctx context.Context, req *adminpb.ExportEntitiesRequest) (string, error)
+
+// listFunc lists the recent admin operations of the project.
+type listFunc func(ctx context.Context) ([]*longrunning.Operation, error)
+
+// exporter is an http.Handler that starts an export to a dated prefix of
+// bucket, unless one is already running or done.
+type exporter struct {
+	projectID  string
+	bucket     string
+	kinds      []string
+	namespaces []string
+	start      startFunc
+	list       listFunc
+	now        func() time.Time
+}
+
+// exportResponse is the JSON body of a successful response.
+type exportResponse struct {
+	Operation       string `json:"operation"`
+	OutputURLPrefix string `json:"outputUrlPrefix"`
+	// Started is false when an earlier request already started the export.
+	Started bool `json:"started"`
+}
+
+func (e *exporter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	ctx := r.Context()
+	prefix := outputURLPrefix(e.bucket, e.now())
+
+	ops, err := e.list(ctx)
+	if err != nil {
+		log.Printf("list operations: %v", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+	if op := findExport(ops, prefix); op != nil {
+		log.Printf("Export to %v already started as %v", prefix, op.Name)
+		writeJSON(w, http.StatusOK, exportResponse{Operation: op.Name, OutputURLPrefix: prefix})
+		return
+	}
+
+	req := &adminpb.ExportEntitiesRequest{
+		ProjectId:       e.projectID,
+		OutputUrlPrefix: prefix,
+	}
+	if len(e.kinds) > 0 || len(e.namespaces) > 0 {
+		req.EntityFilter = &adminpb.EntityFilter{Kinds: e.kinds, NamespaceIds: e.namespaces}
+	}
+	name, err := e.start(ctx, req)
+	if err != nil {
+		log.Printf("start export to %v: %v", prefix, err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+	// The export runs on its own; the request does not wait for it, so it
+	// stays well within the Cloud Scheduler deadline.
+	log.Printf("Started export to %v as %v", prefix, name)
+	writeJSON(w, http.StatusAccepted, exportResponse{Operation: name, OutputURLPrefix: prefix, Started: true})
+}
+
+// outputURLPrefix returns the export location for the UTC date of t.
+func outputURLPrefix(bucket string, t time.Time) string {
+	return fmt.Sprintf("gs://%s/%s", bucket, t.UTC().Format("2006-01-02"))
+}
+
+// findExport returns the export operation to prefix among ops that is
+// running or succeeded, or nil if there is none. A failed export does not
+// count, so a retried request starts a new one.
+func findExport(ops []*longrunning.Operation, prefix string) *longrunning.Operation {
+	for _, op := range ops {
+		var md adminpb.ExportEntitiesMetadata
+		if !op.GetMetadata().MessageIs(&md) {
+			continue
+		}
+		if err := op.GetMetadata().UnmarshalTo(&md); err != nil {
+			continue
+		}
+		if md.OutputUrlPrefix != prefix {
+			continue
+		}
+		if op.Done && op.GetError() != nil {
+			continue
+		}
+		return op
+	}
+	return nil
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Printf("json.Encode: %v", err)
+	}
+}
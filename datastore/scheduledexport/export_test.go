@@ -0,0 +1,167 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	adminpb "google.golang.org/genproto/googleapis/datastore/admin/v1"
+	"google.golang.org/genproto/googleapis/longrunning"
+	statuspb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func exportOp(t *testing.T, name, prefix string, done, failed bool) *longrunning.Operation {
+	t.Helper()
+	md, err := anypb.New(&adminpb.ExportEntitiesMetadata{OutputUrlPrefix: prefix})
+	if err != nil {
+		t.Fatalf("anypb.New: %v", err)
+	}
+	op := &longrunning.Operation{Name: name, Metadata: md, Done: done}
+	if failed {
+		op.Result = &longrunning.Operation_Error{Error: &statuspb.Status{Code: 13, Message: "internal"}}
+	}
+	return op
+}
+
+func TestExporter(t *testing.T) {
+	now := time.Date(2026, 10, 15, 23, 30, 0, 0, time.FixedZone("PDT", -7*60*60))
+	const prefix = "gs://backups/2026-10-16"
+
+	tests := []struct {
+		name        string
+		ops         []*longrunning.Operation
+		wantCode    int
+		wantStarted bool
+		wantOp      string
+	}{
+		{
+			name:        "no earlier export",
+			wantCode:    http.StatusAccepted,
+			wantStarted: true,
+			wantOp:      "new",
+		},
+		{
+			name:     "running export",
+			ops:      []*longrunning.Operation{exportOp(t, "running", prefix, false, false)},
+			wantCode: http.StatusOK,
+			wantOp:   "running",
+		},
+		{
+			name:     "succeeded export",
+			ops:      []*longrunning.Operation{exportOp(t, "done", prefix, true, false)},
+			wantCode: http.StatusOK,
+			wantOp:   "done",
+		},
+		{
+			name:        "failed export",
+			ops:         []*longrunning.Operation{exportOp(t, "failed", prefix, true, true)},
+			wantCode:    http.StatusAccepted,
+			wantStarted: true,
+			wantOp:      "new",
+		},
+		{
+			name:        "export of another day",
+			ops:         []*longrunning.Operation{exportOp(t, "yesterday", "gs://backups/2026-10-15", true, false)},
+			wantCode:    http.StatusAccepted,
+			wantStarted: true,
+			wantOp:      "new",
+		},
+	}
+	for _, tc := range tests {
+		var got *adminpb.ExportEntitiesRequest
+		e := &exporter{
+			projectID:  "my-project",
+			bucket:     "backups",
+			kinds:      []string{"Task"},
+			namespaces: []string{""},
+			start: func(ctx context.Context, req *adminpb.ExportEntitiesRequest) (string, error) {
+				got = req
+				return "new", nil
+			},
+			list: func(ctx context.Context) ([]*longrunning.Operation, error) {
+				return tc.ops, nil
+			},
+			now: func() time.Time { return now },
+		}
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", nil))
+
+		if rec.Code != tc.wantCode {
+			t.Errorf("%s: got status %d, want %d", tc.name, rec.Code, tc.wantCode)
+		}
+		var resp exportResponse
+		if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("%s: json.Unmarshal(%q): %v", tc.name, rec.Body, err)
+		}
+		want := exportResponse{Operation: tc.wantOp, OutputURLPrefix: prefix, Started: tc.wantStarted}
+		if diff := cmp.Diff(want, resp); diff != "" {
+			t.Errorf("%s: response mismatch (-want +got):\n%s", tc.name, diff)
+		}
+		if tc.wantStarted != (got != nil) {
+			t.Errorf("%s: started export %v, want %v", tc.name, got != nil, tc.wantStarted)
+		}
+		if got != nil {
+			if got.OutputUrlPrefix != prefix || got.ProjectId != "my-project" {
+				t.Errorf("%s: started export of %q to %q, want my-project to %q", tc.name, got.ProjectId, got.OutputUrlPrefix, prefix)
+			}
+			if diff := cmp.Diff([]string{"Task"}, got.EntityFilter.GetKinds()); diff != "" {
+				t.Errorf("%s: kinds mismatch (-want +got):\n%s", tc.name, diff)
+			}
+		}
+	}
+}
+
+func TestExporterErrors(t *testing.T) {
+	e := &exporter{
+		bucket: "backups",
+		start: func(ctx context.Context, req *adminpb.ExportEntitiesRequest) (string, error) {
+			return "", errors.New("permission denied")
+		},
+		list: func(ctx context.Context) ([]*longrunning.Operation, error) {
+			return nil, nil
+		},
+		now: time.Now,
+	}
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("GET got status %d, want %d", rec.Code, http.StatusMethodNotAllowed)
+	}
+
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", nil))
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("POST with failing export got status %d, want %d", rec.Code, http.StatusInternalServerError)
+	}
+}
+
+func TestSplitList(t *testing.T) {
+	got := splitList(`Task, TaskList,""`)
+	if diff := cmp.Diff([]string{"Task", "TaskList", ""}, got); diff != "" {
+		t.Errorf("splitList mismatch (-want +got):\n%s", diff)
+	}
+	if got := splitList(""); got != nil {
+		t.Errorf("splitList(\"\") got %q, want nil", got)
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command scheduledexport is a sample Cloud Run service that exports
+// Datastore entities to Cloud Storage when it receives a request, so that
+// Cloud Scheduler can back up a database on a schedule.
+//
+// Every export goes to a prefix named after the UTC date of the request,
+// e.g. gs://my-backups/2026-10-15. Cloud Scheduler retries failed or timed
+// out requests, so before starting an export the service looks for an
+// export to the same prefix that is running or succeeded and, if there is
+// one, reports it instead of starting another. The check and the start are
+// not atomic: two requests that arrive at the same moment can still both
+// start an export. Each export writes to its own folder under the prefix,
+// so a duplicate wastes work but does not corrupt the backup.
+//
+// The service is configured with environment variables:
+//
+//	BUCKET      bucket to export to, required
+//	PROJECT_ID  project of the database, defaults to GOOGLE_CLOUD_PROJECT
+//	KINDS       comma-separated kinds to export, defaults to all kinds
+//	NAMESPACES  comma-separated namespaces to export, defaults to all
+//	            namespaces; use "" for the default namespace
+//
+// See README.md for how to deploy it, the IAM roles it needs and how to
+// schedule it.
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	admin "cloud.google.com/go/datastore/admin/apiv1"
+	"google.golang.org/api/iterator"
+	adminpb "google.golang.org/genproto/googleapis/datastore/admin/v1"
+	"google.golang.org/genproto/googleapis/longrunning"
+)
+
+func main() {
+	projectID := os.Getenv("PROJECT_ID")
+	if projectID == "" {
+		projectID = os.Getenv("GOOGLE_CLOUD_PROJECT")
+	}
+	bucket := os.Getenv("BUCKET")
+	if projectID == "" || bucket == "" {
+		log.Fatal("PROJECT_ID and BUCKET must be set")
+	}
+
+	ctx := context.Background()
+	client, err := admin.NewDatastoreAdminClient(ctx)
+	if err != nil {
+		log.Fatalf("admin.NewDatastoreAdminClient: %v", err)
+	}
+	defer client.Close()
+
+	e := &exporter{
+		projectID:  projectID,
+		bucket:     bucket,
+		kinds:      splitList(os.Getenv("KINDS")),
+		namespaces: splitList(os.Getenv("NAMESPACES")),
+		start:      adminStarter(client),
+		list:       adminLister(client, projectID),
+		now:        time.Now,
+	}
+	http.Handle("/", e)
+
+	port := os.Getenv("PORT")
+	if port == "" {
+		port = "8080"
+		log.Printf("Defaulting to port %s", port)
+	}
+	log.Printf("Listening on port %s", port)
+	if err := http.ListenAndServe(":"+port, nil); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// splitList splits a comma-separated list. A quoted empty string stands
+// for the default namespace.
+func splitList(s string) []string {
+	if s == "" {
+		return nil
+	}
+	items := strings.Split(s, ",")
+	for i, item := range items {
+		item = strings.TrimSpace(item)
+		if item == `""` {
+			item = ""
+		}
+		items[i] = item
+	}
+	return items
+}
+
+// adminStarter returns a startFunc that starts exports with client.
+func adminStarter(client *admin.DatastoreAdminClient) startFunc {
+	return func(ctx context.Context, req *adminpb.ExportEntitiesRequest) (string, error) {
+		op, err := client.ExportEntities(ctx, req)
+		if err != nil {
+			return "", fmt.Errorf("ExportEntities: %w", err)
+		}
+		return op.Name(), nil
+	}
+}
+
+// adminLister returns a listFunc that lists the admin operations of
+// projectID with client.
+func adminLister(client *admin.DatastoreAdminClient, projectID string) listFunc {
+	return func(ctx context.Context) ([]*longrunning.Operation, error) {
+		it := client.ListOperations(ctx, &longrunning.ListOperationsRequest{
+			Name: fmt.Sprintf("projects/%s", projectID),
+		})
+		var ops []*longrunning.Operation
+		for {
+			op, err := it.Next()
+			if err == iterator.Done {
+				return ops, nil
+			}
+			if err != nil {
+				return nil, fmt.Errorf("ListOperations: %w", err)
+			}
+			ops = append(ops, op)
+		}
+	}
+}
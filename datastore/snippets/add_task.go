@@ -0,0 +1,49 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore_snippets
+
+// [START datastore_add_task]
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"cloud.google.com/go/datastore"
+)
+
+// addTask stores t in the listName task list. Entities with the same parent
+// form an entity group, which can be queried with strong consistency.
+func addTask(w io.Writer, projectID, listName string, t *Task) (*datastore.Key, error) {
+	// projectID := "my-project"
+	// listName := "default"
+	ctx := context.Background()
+	client, err := datastore.NewClient(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("datastore.NewClient: %w", err)
+	}
+	defer client.Close()
+
+	parent := datastore.NameKey("TaskList", listName, nil)
+	// Datastore allocates an ID for the incomplete key when the entity is
+	// stored.
+	key, err := client.Put(ctx, datastore.IncompleteKey("Task", parent), t)
+	if err != nil {
+		return nil, fmt.Errorf("client.Put: %w", err)
+	}
+	fmt.Fprintf(w, "Added task %v\n", key.ID)
+	return key, nil
+}
+
+// [END datastore_add_task]
@@ -0,0 +1,43 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore_snippets
+
+// [START datastore_delete_tasks]
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"cloud.google.com/go/datastore"
+)
+
+// deleteTasks deletes the tasks stored under keys with one DeleteMulti call.
+func deleteTasks(w io.Writer, projectID string, keys []*datastore.Key) error {
+	// projectID := "my-project"
+	ctx := context.Background()
+	client, err := datastore.NewClient(ctx, projectID)
+	if err != nil {
+		return fmt.Errorf("datastore.NewClient: %w", err)
+	}
+	defer client.Close()
+
+	if err := client.DeleteMulti(ctx, keys); err != nil {
+		return fmt.Errorf("client.DeleteMulti: %w", err)
+	}
+	fmt.Fprintf(w, "Deleted %d tasks\n", len(keys))
+	return nil
+}
+
+// [END datastore_delete_tasks]
@@ -0,0 +1,49 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore_snippets
+
+import (
+	"io"
+
+	"cloud.google.com/go/datastore"
+)
+
+// The functions below export the samples that cmd/samplectl is built from, so
+// that the tool runs the same code as the documentation.
+
+// AddTask runs the datastore_add_task sample.
+func AddTask(w io.Writer, projectID, listName string, t *Task) (*datastore.Key, error) {
+	return addTask(w, projectID, listName, t)
+}
+
+// TasksInList runs the datastore_tasks_in_list_query sample.
+func TasksInList(w io.Writer, projectID, listName string) ([]*datastore.Key, []Task, error) {
+	return tasksInList(w, projectID, listName)
+}
+
+// OpenTasks runs the datastore_open_tasks_composite_filter sample.
+func OpenTasks(w io.Writer, projectID, listName, category string) ([]*datastore.Key, error) {
+	return openTasks(w, projectID, listName, category)
+}
+
+// MarkTaskDone runs the datastore_mark_task_done sample.
+func MarkTaskDone(w io.Writer, projectID string, key *datastore.Key) error {
+	return markTaskDone(w, projectID, key)
+}
+
+// DeleteTask runs the datastore_delete_task sample.
+func DeleteTask(w io.Writer, projectID string, key *datastore.Key) error {
+	return deleteTask(w, projectID, key)
+}
@@ -0,0 +1,48 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore_snippets
+
+// [START datastore_get_task]
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"cloud.google.com/go/datastore"
+)
+
+// getTask reads the task stored under key. It returns datastore.ErrNoSuchEntity
+// if there is none.
+func getTask(w io.Writer, projectID string, key *datastore.Key) (*Task, error) {
+	// projectID := "my-project"
+	ctx := context.Background()
+	client, err := datastore.NewClient(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("datastore.NewClient: %w", err)
+	}
+	defer client.Close()
+
+	var t Task
+	if err := client.Get(ctx, key, &t); err != nil {
+		if err == datastore.ErrNoSuchEntity {
+			return nil, err
+		}
+		return nil, fmt.Errorf("client.Get: %w", err)
+	}
+	fmt.Fprintf(w, "Task %v: %v\n", key.ID, t.Description)
+	return &t, nil
+}
+
+// [END datastore_get_task]
@@ -0,0 +1,66 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore_snippets
+
+// [START datastore_get_tasks]
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"cloud.google.com/go/datastore"
+)
+
+// getTasks reads the tasks stored under keys with one GetMulti call. A
+// missing entity does not fail the whole call: GetMulti returns a
+// datastore.MultiError with datastore.ErrNoSuchEntity at its index. The
+// returned slice has a nil entry for each missing task.
+func getTasks(w io.Writer, projectID string, keys []*datastore.Key) ([]*Task, error) {
+	// projectID := "my-project"
+	ctx := context.Background()
+	client, err := datastore.NewClient(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("datastore.NewClient: %w", err)
+	}
+	defer client.Close()
+
+	tasks := make([]*Task, len(keys))
+	for i := range tasks {
+		tasks[i] = new(Task)
+	}
+	err = client.GetMulti(ctx, keys, tasks)
+	if merr, ok := err.(datastore.MultiError); ok {
+		for i, err := range merr {
+			switch err {
+			case nil:
+			case datastore.ErrNoSuchEntity:
+				fmt.Fprintf(w, "Task %v not found\n", keys[i].ID)
+				tasks[i] = nil
+			default:
+				return nil, fmt.Errorf("client.GetMulti: key %v: %w", keys[i], err)
+			}
+		}
+	} else if err != nil {
+		return nil, fmt.Errorf("client.GetMulti: %w", err)
+	}
+	for i, t := range tasks {
+		if t != nil {
+			fmt.Fprintf(w, "Task %v: %v\n", keys[i].ID, t.Description)
+		}
+	}
+	return tasks, nil
+}
+
+// [END datastore_get_tasks]
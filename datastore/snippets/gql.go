@@ -0,0 +1,121 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore_snippets
+
+// [START datastore_gql_helpers]
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"cloud.google.com/go/datastore"
+	datastoreapi "google.golang.org/api/datastore/v1"
+	"google.golang.org/api/option"
+)
+
+// gqlService returns a client for the Datastore REST API. The
+// cloud.google.com/go/datastore client has no GQL support, but the RunQuery
+// method of the API accepts GQL. Unlike datastore.NewClient, the REST client
+// does not look at DATASTORE_EMULATOR_HOST, so the emulator is configured
+// here explicitly.
+func gqlService(ctx context.Context) (*datastoreapi.Service, error) {
+	var opts []option.ClientOption
+	if host := os.Getenv("DATASTORE_EMULATOR_HOST"); host != "" {
+		opts = append(opts, option.WithEndpoint("http://"+host+"/"), option.WithoutAuthentication())
+	}
+	svc, err := datastoreapi.NewService(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("datastoreapi.NewService: %w", err)
+	}
+	return svc, nil
+}
+
+// gqlParameter converts v to a GQL query binding. It supports the value
+// types the samples bind.
+func gqlParameter(projectID string, v interface{}) (*datastoreapi.GqlQueryParameter, error) {
+	var val datastoreapi.Value
+	switch v := v.(type) {
+	case string:
+		val.StringValue = v
+		val.ForceSendFields = []string{"StringValue"}
+	case int:
+		val.IntegerValue = int64(v)
+		val.ForceSendFields = []string{"IntegerValue"}
+	case int64:
+		val.IntegerValue = v
+		val.ForceSendFields = []string{"IntegerValue"}
+	case float64:
+		val.DoubleValue = v
+		val.ForceSendFields = []string{"DoubleValue"}
+	case bool:
+		// Without ForceSendFields, false would be omitted from the request.
+		val.BooleanValue = v
+		val.ForceSendFields = []string{"BooleanValue"}
+	case *datastore.Key:
+		val.KeyValue = apiKey(projectID, v)
+	default:
+		return nil, fmt.Errorf("unsupported binding type %T", v)
+	}
+	return &datastoreapi.GqlQueryParameter{Value: &val}, nil
+}
+
+// apiKey converts k to a REST API key, whose path lists the ancestors first.
+func apiKey(projectID string, k *datastore.Key) *datastoreapi.Key {
+	key := &datastoreapi.Key{
+		PartitionId: &datastoreapi.PartitionId{ProjectId: projectID, NamespaceId: k.Namespace},
+	}
+	for ; k != nil; k = k.Parent {
+		key.Path = append([]*datastoreapi.PathElement{{Kind: k.Kind, Id: k.ID, Name: k.Name}}, key.Path...)
+	}
+	return key
+}
+
+// formatKey formats a REST API key like Task(5629499534213120) or
+// TaskList("default")/Task(5629499534213120).
+func formatKey(k *datastoreapi.Key) string {
+	parts := make([]string, len(k.Path))
+	for i, e := range k.Path {
+		if e.Name != "" {
+			parts[i] = fmt.Sprintf("%s(%q)", e.Kind, e.Name)
+		} else {
+			parts[i] = fmt.Sprintf("%s(%d)", e.Kind, e.Id)
+		}
+	}
+	return strings.Join(parts, "/")
+}
+
+// runGQL runs req and, while the server reports more results, the
+// structured query it parsed the GQL into, starting after the last batch.
+func runGQL(ctx context.Context, svc *datastoreapi.Service, projectID string, req *datastoreapi.RunQueryRequest) ([]*datastoreapi.EntityResult, error) {
+	var results []*datastoreapi.EntityResult
+	for {
+		resp, err := svc.Projects.RunQuery(projectID, req).Context(ctx).Do()
+		if err != nil {
+			return nil, fmt.Errorf("RunQuery: %w", err)
+		}
+		results = append(results, resp.Batch.EntityResults...)
+		if resp.Batch.MoreResults != "NOT_FINISHED" || resp.Query == nil {
+			return results, nil
+		}
+		q := resp.Query
+		q.StartCursor = resp.Batch.EndCursor
+		// The offset, if any, was applied to the first batch.
+		q.Offset = 0
+		req = &datastoreapi.RunQueryRequest{PartitionId: req.PartitionId, Query: q}
+	}
+}
+
+// [END datastore_gql_helpers]
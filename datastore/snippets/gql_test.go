@@ -0,0 +1,81 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore_snippets
+
+import (
+	"fmt"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/datastore"
+	"github.com/GoogleCloudPlatform/golang-samples/internal/testutil"
+)
+
+func TestGQL(t *testing.T) {
+	tc := testutil.DatastoreEmulatorTest(t)
+
+	listName := fmt.Sprintf("go-samples-gql-%d", time.Now().UnixNano())
+	tasks := []*Task{
+		{Category: "Personal", Done: false, Description: "Buy milk", Created: time.Now()},
+		{Category: "Personal", Done: true, Description: "Call mom", Created: time.Now()},
+		{Category: "Work", Done: false, Description: "Write report", Created: time.Now()},
+	}
+	keys, err := putTasks(ioutil.Discard, tc.ProjectID, listName, tasks)
+	if err != nil {
+		t.Fatalf("putTasks: %v", err)
+	}
+	defer deleteTasks(ioutil.Discard, tc.ProjectID, keys)
+	list := datastore.NameKey("TaskList", listName, nil)
+
+	t.Run("gqlQuery", func(t *testing.T) {
+		entities, err := gqlQuery(ioutil.Discard, tc.ProjectID,
+			"SELECT * FROM Task WHERE __key__ HAS ANCESTOR @list AND Category = @category",
+			map[string]interface{}{"list": list, "category": "Personal"})
+		if err != nil {
+			t.Fatalf("gqlQuery: %v", err)
+		}
+		if len(entities) != 2 {
+			t.Fatalf("gqlQuery got %d entities, want 2", len(entities))
+		}
+		for _, e := range entities {
+			if got := e.Properties["Category"].StringValue; got != "Personal" {
+				t.Errorf("gqlQuery got %v with category %q, want Personal", formatKey(e.Key), got)
+			}
+		}
+	})
+
+	t.Run("gqlKeysOnlyQuery", func(t *testing.T) {
+		got, err := gqlKeysOnlyQuery(ioutil.Discard, tc.ProjectID,
+			"SELECT __key__ FROM Task WHERE __key__ HAS ANCESTOR @1 AND Done = @2", list, false)
+		if err != nil {
+			t.Fatalf("gqlKeysOnlyQuery: %v", err)
+		}
+		if len(got) != 2 {
+			t.Fatalf("gqlKeysOnlyQuery got %d keys, want 2", len(got))
+		}
+		for _, k := range got {
+			if id := k.Path[len(k.Path)-1].Id; id != keys[0].ID && id != keys[2].ID {
+				t.Errorf("gqlKeysOnlyQuery got %v, want one of the open tasks", formatKey(k))
+			}
+		}
+	})
+
+	t.Run("unsupportedBinding", func(t *testing.T) {
+		if _, err := gqlKeysOnlyQuery(ioutil.Discard, tc.ProjectID, "SELECT __key__ FROM Task WHERE Created = @1", time.Now()); err == nil {
+			t.Errorf("gqlKeysOnlyQuery with a time binding got nil error, want error")
+		}
+	})
+}
@@ -0,0 +1,52 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore_snippets
+
+// [START datastore_mark_task_done]
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"cloud.google.com/go/datastore"
+)
+
+// markTaskDone updates the task stored under key. Put replaces the whole
+// entity, so the task is read first to keep its other properties.
+func markTaskDone(w io.Writer, projectID string, key *datastore.Key) error {
+	// projectID := "my-project"
+	ctx := context.Background()
+	client, err := datastore.NewClient(ctx, projectID)
+	if err != nil {
+		return fmt.Errorf("datastore.NewClient: %w", err)
+	}
+	defer client.Close()
+
+	var t Task
+	if err := client.Get(ctx, key, &t); err != nil {
+		return fmt.Errorf("client.Get: %w", err)
+	}
+	// A concurrent update between Get and Put would be lost. Use a
+	// transaction when that matters.
+	t.Done = true
+	t.PercentComplete = 100
+	if _, err := client.Put(ctx, key, &t); err != nil {
+		return fmt.Errorf("client.Put: %w", err)
+	}
+	fmt.Fprintf(w, "Task %v done\n", key.ID)
+	return nil
+}
+
+// [END datastore_mark_task_done]
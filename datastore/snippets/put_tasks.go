@@ -0,0 +1,52 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore_snippets
+
+// [START datastore_put_tasks]
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"cloud.google.com/go/datastore"
+)
+
+// putTasks stores tasks in the listName task list with one PutMulti call,
+// which costs a single round trip instead of one per entity. A single call
+// accepts at most 500 entities.
+func putTasks(w io.Writer, projectID, listName string, tasks []*Task) ([]*datastore.Key, error) {
+	// projectID := "my-project"
+	// listName := "default"
+	ctx := context.Background()
+	client, err := datastore.NewClient(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("datastore.NewClient: %w", err)
+	}
+	defer client.Close()
+
+	parent := datastore.NameKey("TaskList", listName, nil)
+	keys := make([]*datastore.Key, len(tasks))
+	for i := range keys {
+		keys[i] = datastore.IncompleteKey("Task", parent)
+	}
+	keys, err = client.PutMulti(ctx, keys, tasks)
+	if err != nil {
+		return nil, fmt.Errorf("client.PutMulti: %w", err)
+	}
+	fmt.Fprintf(w, "Added %d tasks\n", len(keys))
+	return keys, nil
+}
+
+// [END datastore_put_tasks]
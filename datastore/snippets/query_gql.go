@@ -0,0 +1,67 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore_snippets
+
+// [START datastore_gql_query]
+import (
+	"context"
+	"fmt"
+	"io"
+
+	datastoreapi "google.golang.org/api/datastore/v1"
+)
+
+// gqlQuery runs a GQL query with named bindings and returns the matching
+// entities. Bind values with @name instead of writing literals into the
+// query string: the query stays valid whatever the value contains, and
+// literals are rejected unless the request allows them.
+func gqlQuery(w io.Writer, projectID, query string, bindings map[string]interface{}) ([]*datastoreapi.Entity, error) {
+	// projectID := "my-project"
+	// query := "SELECT * FROM Task WHERE __key__ HAS ANCESTOR @list AND Category = @category"
+	// bindings := map[string]interface{}{
+	// 	"list":     datastore.NameKey("TaskList", "default", nil),
+	// 	"category": "Personal",
+	// }
+	ctx := context.Background()
+	svc, err := gqlService(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	gql := &datastoreapi.GqlQuery{
+		QueryString:   query,
+		NamedBindings: make(map[string]datastoreapi.GqlQueryParameter),
+	}
+	for name, v := range bindings {
+		p, err := gqlParameter(projectID, v)
+		if err != nil {
+			return nil, fmt.Errorf("binding @%s: %w", name, err)
+		}
+		gql.NamedBindings[name] = *p
+	}
+	results, err := runGQL(ctx, svc, projectID, &datastoreapi.RunQueryRequest{GqlQuery: gql})
+	if err != nil {
+		return nil, err
+	}
+
+	entities := make([]*datastoreapi.Entity, len(results))
+	for i, r := range results {
+		entities[i] = r.Entity
+		fmt.Fprintf(w, "%v: %d properties\n", formatKey(r.Entity.Key), len(r.Entity.Properties))
+	}
+	return entities, nil
+}
+
+// [END datastore_gql_query]
@@ -0,0 +1,61 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore_snippets
+
+// [START datastore_gql_keys_only_query]
+import (
+	"context"
+	"fmt"
+	"io"
+
+	datastoreapi "google.golang.org/api/datastore/v1"
+)
+
+// gqlKeysOnlyQuery runs a GQL query selecting __key__, with positional
+// bindings @1, @2 and so on taken from args, and returns the matching keys.
+// Like a keys-only query with the client library, it costs a small
+// operation per key instead of an entity read.
+func gqlKeysOnlyQuery(w io.Writer, projectID, query string, args ...interface{}) ([]*datastoreapi.Key, error) {
+	// projectID := "my-project"
+	// query := "SELECT __key__ FROM Task WHERE __key__ HAS ANCESTOR @1 AND Done = @2"
+	// args := []interface{}{datastore.NameKey("TaskList", "default", nil), false}
+	ctx := context.Background()
+	svc, err := gqlService(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	gql := &datastoreapi.GqlQuery{QueryString: query}
+	for i, v := range args {
+		p, err := gqlParameter(projectID, v)
+		if err != nil {
+			return nil, fmt.Errorf("binding @%d: %w", i+1, err)
+		}
+		gql.PositionalBindings = append(gql.PositionalBindings, p)
+	}
+	results, err := runGQL(ctx, svc, projectID, &datastoreapi.RunQueryRequest{GqlQuery: gql})
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]*datastoreapi.Key, len(results))
+	for i, r := range results {
+		keys[i] = r.Entity.Key
+		fmt.Fprintln(w, formatKey(r.Entity.Key))
+	}
+	return keys, nil
+}
+
+// [END datastore_gql_keys_only_query]
@@ -0,0 +1,62 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore_snippets
+
+// [START datastore_open_tasks_composite_filter]
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"cloud.google.com/go/datastore"
+)
+
+// openTasks returns the tasks of a task list that are not done and in
+// category, or have priority 5, combining filters with AndFilter and
+// OrFilter.
+func openTasks(w io.Writer, projectID, listName, category string) ([]*datastore.Key, error) {
+	// projectID := "my-project"
+	// listName := "default"
+	// category := "Personal"
+	ctx := context.Background()
+	client, err := datastore.NewClient(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("datastore.NewClient: %w", err)
+	}
+	defer client.Close()
+
+	parent := datastore.NameKey("TaskList", listName, nil)
+	query := datastore.NewQuery("Task").Ancestor(parent).FilterEntity(
+		datastore.OrFilter{
+			Filters: []datastore.EntityFilter{
+				datastore.AndFilter{
+					Filters: []datastore.EntityFilter{
+						datastore.PropertyFilter{FieldName: "Done", Operator: "=", Value: false},
+						datastore.PropertyFilter{FieldName: "Category", Operator: "=", Value: category},
+					},
+				},
+				datastore.PropertyFilter{FieldName: "Priority", Operator: "=", Value: 5},
+			},
+		},
+	).KeysOnly()
+	keys, err := client.GetAll(ctx, query, nil)
+	if err != nil {
+		return nil, fmt.Errorf("client.GetAll: %w", err)
+	}
+	fmt.Fprintf(w, "%d open %v tasks or top priority tasks\n", len(keys), category)
+	return keys, nil
+}
+
+// [END datastore_open_tasks_composite_filter]
@@ -0,0 +1,49 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore_snippets
+
+// [START datastore_task_keys_query]
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"cloud.google.com/go/datastore"
+)
+
+// taskKeys returns the keys of the tasks of a task list with a keys-only
+// query, which costs a small operation per key instead of an entity read.
+// Use it to count or delete entities without reading them.
+func taskKeys(w io.Writer, projectID, listName string) ([]*datastore.Key, error) {
+	// projectID := "my-project"
+	// listName := "default"
+	ctx := context.Background()
+	client, err := datastore.NewClient(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("datastore.NewClient: %w", err)
+	}
+	defer client.Close()
+
+	parent := datastore.NameKey("TaskList", listName, nil)
+	query := datastore.NewQuery("Task").Ancestor(parent).KeysOnly()
+	keys, err := client.GetAll(ctx, query, nil)
+	if err != nil {
+		return nil, fmt.Errorf("client.GetAll: %w", err)
+	}
+	fmt.Fprintf(w, "%d tasks in %v\n", len(keys), listName)
+	return keys, nil
+}
+
+// [END datastore_task_keys_query]
@@ -0,0 +1,79 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore_snippets
+
+// [START datastore_task_page_query]
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"cloud.google.com/go/datastore"
+	"google.golang.org/api/iterator"
+)
+
+// taskPage returns up to pageSize tasks of a task list, starting at cursor,
+// and the cursor of the next page. Pass an empty cursor for the first page.
+// The cursor is an opaque string that can be handed to a client and sent
+// back with its next request.
+func taskPage(w io.Writer, projectID, listName, cursor string, pageSize int) ([]Task, string, error) {
+	// projectID := "my-project"
+	// listName := "default"
+	// cursor := ""
+	// pageSize := 20
+	ctx := context.Background()
+	client, err := datastore.NewClient(ctx, projectID)
+	if err != nil {
+		return nil, "", fmt.Errorf("datastore.NewClient: %w", err)
+	}
+	defer client.Close()
+
+	parent := datastore.NameKey("TaskList", listName, nil)
+	query := datastore.NewQuery("Task").Ancestor(parent).Limit(pageSize)
+	if cursor != "" {
+		c, err := datastore.DecodeCursor(cursor)
+		if err != nil {
+			return nil, "", fmt.Errorf("datastore.DecodeCursor: %w", err)
+		}
+		query = query.Start(c)
+	}
+
+	var tasks []Task
+	it := client.Run(ctx, query)
+	for {
+		var t Task
+		_, err := it.Next(&t)
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, "", fmt.Errorf("Next: %w", err)
+		}
+		tasks = append(tasks, t)
+	}
+	next, err := it.Cursor()
+	if err != nil {
+		return nil, "", fmt.Errorf("Cursor: %w", err)
+	}
+	// A short page is the last one.
+	if len(tasks) < pageSize {
+		fmt.Fprintf(w, "Last page: %d tasks\n", len(tasks))
+		return tasks, "", nil
+	}
+	fmt.Fprintf(w, "Page of %d tasks\n", len(tasks))
+	return tasks, next.String(), nil
+}
+
+// [END datastore_task_page_query]
@@ -0,0 +1,52 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore_snippets
+
+// [START datastore_tasks_in_list_query]
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"cloud.google.com/go/datastore"
+)
+
+// tasksInList returns the tasks of a task list with an ancestor query.
+// Ancestor queries are strongly consistent: they see every write to the
+// entity group that completed before the query started.
+func tasksInList(w io.Writer, projectID, listName string) ([]*datastore.Key, []Task, error) {
+	// projectID := "my-project"
+	// listName := "default"
+	ctx := context.Background()
+	client, err := datastore.NewClient(ctx, projectID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("datastore.NewClient: %w", err)
+	}
+	defer client.Close()
+
+	parent := datastore.NameKey("TaskList", listName, nil)
+	query := datastore.NewQuery("Task").Ancestor(parent)
+	var tasks []Task
+	keys, err := client.GetAll(ctx, query, &tasks)
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.GetAll: %w", err)
+	}
+	for i, t := range tasks {
+		fmt.Fprintf(w, "%v: %v\n", keys[i].ID, t.Description)
+	}
+	return keys, tasks, nil
+}
+
+// [END datastore_tasks_in_list_query]
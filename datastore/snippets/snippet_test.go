@@ -31,17 +31,6 @@ import (
 	"google.golang.org/api/iterator"
 )
 
-type Task struct {
-	Category        string
-	Done            bool
-	Priority        int
-	Description     string `datastore:",noindex"`
-	PercentComplete float64
-	Created         time.Time
-	Tags            []string
-	Collaborators   []string
-}
-
 func SnippetNewIncompleteKey() {
 	// [START datastore_incomplete_key]
 	taskKey := datastore.IncompleteKey("Task", nil)
@@ -673,7 +662,7 @@ func metadataNamespaces(w io.Writer, projectID string) error {
 	ctx := context.Background()
 	client, err := datastore.NewClient(ctx, projectID)
 	if err != nil {
-		return fmt.Errorf("datastore.NewClient: %v", err)
+		return fmt.Errorf("datastore.NewClient: %w", err)
 	}
 	defer client.Close()
 
@@ -685,7 +674,7 @@ func metadataNamespaces(w io.Writer, projectID string) error {
 		KeysOnly()
 	keys, err := client.GetAll(ctx, query, nil)
 	if err != nil {
-		return fmt.Errorf("client.GetAll: %v", err)
+		return fmt.Errorf("client.GetAll: %w", err)
 	}
 
 	fmt.Fprintln(w, "Namespaces:")
@@ -698,7 +687,7 @@ func metadataNamespaces(w io.Writer, projectID string) error {
 // [END datastore_namespace_run_query]
 
 func TestMetadaNamespaces(t *testing.T) {
-	tc := testutil.SystemTest(t)
+	tc := testutil.DatastoreEmulatorTest(t)
 	buf := &bytes.Buffer{}
 	if err := metadataNamespaces(buf, tc.ProjectID); err != nil {
 		t.Errorf("metadataNamespaces got err: %v, want no error", err)
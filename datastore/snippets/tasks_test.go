@@ -0,0 +1,165 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore_snippets
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/datastore"
+	"github.com/GoogleCloudPlatform/golang-samples/internal/apierrors"
+	"github.com/GoogleCloudPlatform/golang-samples/internal/testutil"
+	"google.golang.org/grpc/codes"
+)
+
+func TestTasks(t *testing.T) {
+	tc := testutil.DatastoreEmulatorTest(t)
+	ctx := context.Background()
+	client, err := datastore.NewClient(ctx, tc.ProjectID)
+	if err != nil {
+		t.Fatalf("datastore.NewClient: %v", err)
+	}
+	defer client.Close()
+
+	listName := fmt.Sprintf("go-samples-tasks-%d", time.Now().UnixNano())
+	defer func() {
+		keys, err := client.GetAll(ctx, datastore.NewQuery("").Ancestor(datastore.NameKey("TaskList", listName, nil)).KeysOnly(), nil)
+		if err != nil {
+			t.Errorf("GetAll: %v", err)
+			return
+		}
+		if err := client.DeleteMulti(ctx, keys); err != nil {
+			t.Errorf("DeleteMulti: %v", err)
+		}
+	}()
+
+	tasks := []*Task{
+		{Category: "Personal", Priority: 4, Description: "Learn Cloud Datastore", Created: time.Now()},
+		{Category: "Personal", Priority: 5, Description: "Buy milk", Created: time.Now()},
+		{Category: "Work", Priority: 4, Description: "Write report", Created: time.Now()},
+	}
+	var keys []*datastore.Key
+	for _, task := range tasks {
+		buf := new(bytes.Buffer)
+		key, err := addTask(buf, tc.ProjectID, listName, task)
+		if err != nil {
+			t.Fatalf("addTask: %v", err)
+		}
+		if got, want := buf.String(), "Added task"; !strings.Contains(got, want) {
+			t.Errorf("addTask got %q, want to contain %q", got, want)
+		}
+		keys = append(keys, key)
+	}
+
+	t.Run("getTask", func(t *testing.T) {
+		got, err := getTask(ioutil.Discard, tc.ProjectID, keys[0])
+		if err != nil {
+			t.Fatalf("getTask: %v", err)
+		}
+		if got.Description != tasks[0].Description {
+			t.Errorf("getTask got description %q, want %q", got.Description, tasks[0].Description)
+		}
+	})
+
+	t.Run("tasksInList", func(t *testing.T) {
+		got, _, err := tasksInList(ioutil.Discard, tc.ProjectID, listName)
+		if err != nil {
+			t.Fatalf("tasksInList: %v", err)
+		}
+		if len(got) != len(tasks) {
+			t.Errorf("tasksInList got %d tasks, want %d", len(got), len(tasks))
+		}
+	})
+
+	t.Run("taskKeys", func(t *testing.T) {
+		got, err := taskKeys(ioutil.Discard, tc.ProjectID, listName)
+		if err != nil {
+			t.Fatalf("taskKeys: %v", err)
+		}
+		if len(got) != len(tasks) {
+			t.Errorf("taskKeys got %d keys, want %d", len(got), len(tasks))
+		}
+	})
+
+	t.Run("taskPriorities", func(t *testing.T) {
+		got, err := taskPriorities(ioutil.Discard, tc.ProjectID, listName)
+		if err != nil {
+			if apierrors.Code(err) == codes.FailedPrecondition {
+				t.Skip("composite index from index.yaml is not ready")
+			}
+			t.Fatalf("taskPriorities: %v", err)
+		}
+		if got[4] != 2 || got[5] != 1 {
+			t.Errorf("taskPriorities got %v, want 2 tasks with priority 4 and 1 with priority 5", got)
+		}
+	})
+
+	t.Run("taskPage", func(t *testing.T) {
+		var n, pages int
+		cursor := ""
+		for {
+			page, next, err := taskPage(ioutil.Discard, tc.ProjectID, listName, cursor, 2)
+			if err != nil {
+				t.Fatalf("taskPage: %v", err)
+			}
+			n += len(page)
+			pages++
+			if next == "" {
+				break
+			}
+			cursor = next
+		}
+		if n != len(tasks) || pages != 2 {
+			t.Errorf("taskPage got %d tasks in %d pages, want %d in 2", n, pages, len(tasks))
+		}
+	})
+
+	t.Run("markTaskDoneAndOpenTasks", func(t *testing.T) {
+		if err := markTaskDone(ioutil.Discard, tc.ProjectID, keys[0]); err != nil {
+			t.Fatalf("markTaskDone: %v", err)
+		}
+		got, err := getTask(ioutil.Discard, tc.ProjectID, keys[0])
+		if err != nil {
+			t.Fatalf("getTask: %v", err)
+		}
+		if !got.Done || got.Description != tasks[0].Description {
+			t.Errorf("markTaskDone got %+v, want done task with original description", got)
+		}
+
+		// Task 0 is done, task 1 has priority 5 and task 2 is in another
+		// category, so only task 1 matches.
+		open, err := openTasks(ioutil.Discard, tc.ProjectID, listName, "Personal")
+		if err != nil {
+			t.Fatalf("openTasks: %v", err)
+		}
+		if len(open) != 1 || !open[0].Equal(keys[1]) {
+			t.Errorf("openTasks got %v, want [%v]", open, keys[1])
+		}
+	})
+
+	t.Run("deleteTask", func(t *testing.T) {
+		if err := deleteTask(ioutil.Discard, tc.ProjectID, keys[2]); err != nil {
+			t.Fatalf("deleteTask: %v", err)
+		}
+		if _, err := getTask(ioutil.Discard, tc.ProjectID, keys[2]); err != datastore.ErrNoSuchEntity {
+			t.Errorf("getTask after delete got err %v, want %v", err, datastore.ErrNoSuchEntity)
+		}
+	})
+}
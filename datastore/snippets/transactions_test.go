@@ -0,0 +1,135 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore_snippets
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"sync"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/datastore"
+	"github.com/GoogleCloudPlatform/golang-samples/internal/testutil"
+)
+
+func TestTransferFunds(t *testing.T) {
+	tc := testutil.DatastoreEmulatorTest(t)
+	ctx := context.Background()
+	client, err := datastore.NewClient(ctx, tc.ProjectID)
+	if err != nil {
+		t.Fatalf("datastore.NewClient: %v", err)
+	}
+	defer client.Close()
+
+	suffix := time.Now().UnixNano()
+	from := datastore.NameKey("BankAccount", fmt.Sprintf("go-samples-from-%d", suffix), nil)
+	to := datastore.NameKey("BankAccount", fmt.Sprintf("go-samples-to-%d", suffix), nil)
+	keys := []*datastore.Key{from, to}
+	if _, err := client.PutMulti(ctx, keys, []BankAccount{{Balance: 100}, {Balance: 0}}); err != nil {
+		t.Fatalf("PutMulti: %v", err)
+	}
+	defer client.DeleteMulti(ctx, keys)
+
+	balances := func() (int64, int64) {
+		t.Helper()
+		accs := make([]BankAccount, 2)
+		if err := client.GetMulti(ctx, keys, accs); err != nil {
+			t.Fatalf("GetMulti: %v", err)
+		}
+		return accs[0].Balance, accs[1].Balance
+	}
+
+	if err := transferFunds(ioutil.Discard, tc.ProjectID, from, to, 30); err != nil {
+		t.Fatalf("transferFunds: %v", err)
+	}
+	if f, tb := balances(); f != 70 || tb != 30 {
+		t.Errorf("after transferFunds got balances %d, %d, want 70, 30", f, tb)
+	}
+
+	if err := transferFunds(ioutil.Discard, tc.ProjectID, from, to, 1000); err == nil {
+		t.Errorf("transferFunds with insufficient funds got nil error, want error")
+	}
+	if f, tb := balances(); f != 70 || tb != 30 {
+		t.Errorf("after failed transferFunds got balances %d, %d, want 70, 30", f, tb)
+	}
+
+	// Concurrent transfers on the same accounts conflict; the retries must
+	// still apply every one of them exactly once.
+	var wg sync.WaitGroup
+	errs := make(chan error, 5)
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- transferFundsWithRetry(ioutil.Discard, tc.ProjectID, from, to, 10, 8)
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Errorf("transferFundsWithRetry: %v", err)
+		}
+	}
+	if f, tb := balances(); f != 20 || tb != 80 {
+		t.Errorf("after transferFundsWithRetry got balances %d, %d, want 20, 80", f, tb)
+	}
+}
+
+func TestBatchTasks(t *testing.T) {
+	tc := testutil.DatastoreEmulatorTest(t)
+
+	listName := fmt.Sprintf("go-samples-batch-%d", time.Now().UnixNano())
+	tasks := []*Task{
+		{Category: "Personal", Description: "Buy milk", Created: time.Now()},
+		{Category: "Work", Description: "Write report", Created: time.Now()},
+	}
+	keys, err := putTasks(ioutil.Discard, tc.ProjectID, listName, tasks)
+	if err != nil {
+		t.Fatalf("putTasks: %v", err)
+	}
+	if len(keys) != len(tasks) {
+		t.Fatalf("putTasks got %d keys, want %d", len(keys), len(tasks))
+	}
+
+	missing := datastore.IDKey("Task", 1, datastore.NameKey("TaskList", listName, nil))
+	got, err := getTasks(ioutil.Discard, tc.ProjectID, append(keys, missing))
+	if err != nil {
+		t.Fatalf("getTasks: %v", err)
+	}
+	for i, task := range tasks {
+		if got[i] == nil || got[i].Description != task.Description {
+			t.Errorf("getTasks got %+v at %d, want description %q", got[i], i, task.Description)
+		}
+	}
+	if got[len(tasks)] != nil {
+		t.Errorf("getTasks got %+v for missing key, want nil", got[len(tasks)])
+	}
+
+	if err := deleteTasks(ioutil.Discard, tc.ProjectID, keys); err != nil {
+		t.Fatalf("deleteTasks: %v", err)
+	}
+	got, err = getTasks(ioutil.Discard, tc.ProjectID, keys)
+	if err != nil {
+		t.Fatalf("getTasks after delete: %v", err)
+	}
+	for i, task := range got {
+		if task != nil {
+			t.Errorf("getTasks after delete got %+v at %d, want nil", task, i)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore_snippets
+
+// [START datastore_transfer_funds]
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"cloud.google.com/go/datastore"
+)
+
+// BankAccount is an entity holding a balance.
+type BankAccount struct {
+	Balance int64
+}
+
+// transferFunds moves amount from one account to another. Both accounts are
+// read and written in one transaction, so either both balances change or
+// neither does. RunInTransaction retries the function when the transaction
+// conflicts with another one, so it must not have side effects outside the
+// transaction.
+func transferFunds(w io.Writer, projectID string, from, to *datastore.Key, amount int64) error {
+	// projectID := "my-project"
+	// from := datastore.NameKey("BankAccount", "alice", nil)
+	// to := datastore.NameKey("BankAccount", "bob", nil)
+	// amount := int64(50)
+	ctx := context.Background()
+	client, err := datastore.NewClient(ctx, projectID)
+	if err != nil {
+		return fmt.Errorf("datastore.NewClient: %w", err)
+	}
+	defer client.Close()
+
+	_, err = client.RunInTransaction(ctx, func(tx *datastore.Transaction) error {
+		keys := []*datastore.Key{from, to}
+		accs := make([]BankAccount, 2)
+		if err := tx.GetMulti(keys, accs); err != nil {
+			return fmt.Errorf("tx.GetMulti: %w", err)
+		}
+		if accs[0].Balance < amount {
+			return fmt.Errorf("account %v has balance %d, need %d", from.Name, accs[0].Balance, amount)
+		}
+		accs[0].Balance -= amount
+		accs[1].Balance += amount
+		if _, err := tx.PutMulti(keys, accs); err != nil {
+			return fmt.Errorf("tx.PutMulti: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("RunInTransaction: %w", err)
+	}
+	fmt.Fprintf(w, "Transferred %d from %v to %v\n", amount, from.Name, to.Name)
+	return nil
+}
+
+// [END datastore_transfer_funds]
@@ -0,0 +1,79 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore_snippets
+
+// [START datastore_transfer_funds_retry]
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"time"
+
+	"cloud.google.com/go/datastore"
+)
+
+// transferFundsWithRetry is transferFunds with its own retry policy.
+// RunInTransaction gives up with datastore.ErrConcurrentTransaction after a
+// few immediate attempts. Under heavy contention on the same entities,
+// waiting with exponential backoff and jitter between attempts gives the
+// competing transactions time to commit.
+func transferFundsWithRetry(w io.Writer, projectID string, from, to *datastore.Key, amount int64, attempts int) error {
+	// projectID := "my-project"
+	// from := datastore.NameKey("BankAccount", "alice", nil)
+	// to := datastore.NameKey("BankAccount", "bob", nil)
+	// amount := int64(50)
+	// attempts := 5
+	ctx := context.Background()
+	client, err := datastore.NewClient(ctx, projectID)
+	if err != nil {
+		return fmt.Errorf("datastore.NewClient: %w", err)
+	}
+	defer client.Close()
+
+	delay := 100 * time.Millisecond
+	for i := 1; ; i++ {
+		_, err = client.RunInTransaction(ctx, func(tx *datastore.Transaction) error {
+			keys := []*datastore.Key{from, to}
+			accs := make([]BankAccount, 2)
+			if err := tx.GetMulti(keys, accs); err != nil {
+				return err
+			}
+			if accs[0].Balance < amount {
+				return fmt.Errorf("account %v has balance %d, need %d", from.Name, accs[0].Balance, amount)
+			}
+			accs[0].Balance -= amount
+			accs[1].Balance += amount
+			_, err := tx.PutMulti(keys, accs)
+			return err
+		}, datastore.MaxAttempts(1))
+		if err != datastore.ErrConcurrentTransaction {
+			break
+		}
+		if i == attempts {
+			return fmt.Errorf("gave up after %d attempts: %w", attempts, err)
+		}
+		fmt.Fprintf(w, "Attempt %d conflicted, retrying\n", i)
+		time.Sleep(delay/2 + time.Duration(rand.Int63n(int64(delay))))
+		delay *= 2
+	}
+	if err != nil {
+		return fmt.Errorf("RunInTransaction: %w", err)
+	}
+	fmt.Fprintf(w, "Transferred %d from %v to %v\n", amount, from.Name, to.Name)
+	return nil
+}
+
+// [END datastore_transfer_funds_retry]
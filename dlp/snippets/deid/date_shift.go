@@ -35,7 +35,7 @@ func deidentifyDateShift(w io.Writer, projectID string, lowerBoundDays, upperBou
 	ctx := context.Background()
 	client, err := dlp.NewClient(ctx)
 	if err != nil {
-		return fmt.Errorf("dlp.NewClient: %v", err)
+		return fmt.Errorf("dlp.NewClient: %w", err)
 	}
 	// Create a configured request.
 	req := &dlppb.DeidentifyContentRequest{
@@ -77,7 +77,7 @@ func deidentifyDateShift(w io.Writer, projectID string, lowerBoundDays, upperBou
 	// Send the request.
 	r, err := client.DeidentifyContent(ctx, req)
 	if err != nil {
-		return fmt.Errorf("DeidentifyContent: %v", err)
+		return fmt.Errorf("DeidentifyContent: %w", err)
 	}
 	// Print the result.
 	fmt.Fprint(w, r.GetItem().GetValue())
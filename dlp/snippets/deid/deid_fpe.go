@@ -41,7 +41,7 @@ func deidentifyFPE(w io.Writer, projectID, input string, infoTypeNames []string,
 	ctx := context.Background()
 	client, err := dlp.NewClient(ctx)
 	if err != nil {
-		return fmt.Errorf("dlp.NewClient: %v", err)
+		return fmt.Errorf("dlp.NewClient: %w", err)
 	}
 	// Convert the info type strings to a list of InfoTypes.
 	var infoTypes []*dlppb.InfoType
@@ -51,7 +51,7 @@ func deidentifyFPE(w io.Writer, projectID, input string, infoTypeNames []string,
 	// Read the key file.
 	keyBytes, err := ioutil.ReadFile(keyFileName)
 	if err != nil {
-		return fmt.Errorf("ReadFile: %v", err)
+		return fmt.Errorf("ReadFile: %w", err)
 	}
 	// Create a configured request.
 	req := &dlppb.DeidentifyContentRequest{
@@ -102,7 +102,7 @@ func deidentifyFPE(w io.Writer, projectID, input string, infoTypeNames []string,
 	// Send the request.
 	r, err := client.DeidentifyContent(ctx, req)
 	if err != nil {
-		return fmt.Errorf("DeidentifyContent: %v", err)
+		return fmt.Errorf("DeidentifyContent: %w", err)
 	}
 	// Print the result.
 	fmt.Fprint(w, r.GetItem().GetValue())
@@ -30,13 +30,13 @@ func deleteJob(w io.Writer, jobName string) error {
 	ctx := context.Background()
 	client, err := dlp.NewClient(ctx)
 	if err != nil {
-		return fmt.Errorf("dlp.NewClient: %v", err)
+		return fmt.Errorf("dlp.NewClient: %w", err)
 	}
 	req := &dlppb.DeleteDlpJobRequest{
 		Name: jobName,
 	}
 	if err = client.DeleteDlpJob(ctx, req); err != nil {
-		return fmt.Errorf("DeleteDlpJob: %v", err)
+		return fmt.Errorf("DeleteDlpJob: %w", err)
 	}
 	fmt.Fprintf(w, "Successfully deleted job")
 	return nil
@@ -123,7 +123,7 @@ func TestRisk(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
-			testutil.Retry(t, 20, 2*time.Second, test.fn)
+			testutil.RetryBackoff(t, testutil.Backoff{Initial: 2 * time.Second, Max: 4 * time.Second, Multiplier: 2, MaxAttempts: 20}, test.fn)
 		})
 	}
 }
@@ -36,7 +36,7 @@ func createInspectTemplate(w io.Writer, projectID string, templateID, displayNam
 
 	client, err := dlp.NewClient(ctx)
 	if err != nil {
-		return fmt.Errorf("dlp.NewClient: %v", err)
+		return fmt.Errorf("dlp.NewClient: %w", err)
 	}
 
 	// Convert the info type strings to a list of InfoTypes.
@@ -64,7 +64,7 @@ func createInspectTemplate(w io.Writer, projectID string, templateID, displayNam
 	// Send the request.
 	resp, err := client.CreateInspectTemplate(ctx, req)
 	if err != nil {
-		return fmt.Errorf("CreateInspectTemplate: %v", err)
+		return fmt.Errorf("CreateInspectTemplate: %w", err)
 	}
 	// Print the result.
 	fmt.Fprintf(w, "Successfully created inspect template: %v", resp.GetName())
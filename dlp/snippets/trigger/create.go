@@ -38,7 +38,7 @@ func createTrigger(w io.Writer, projectID string, triggerID, displayName, descri
 
 	client, err := dlp.NewClient(ctx)
 	if err != nil {
-		return fmt.Errorf("dlp.NewClient: %v", err)
+		return fmt.Errorf("dlp.NewClient: %w", err)
 	}
 
 	// Convert the info type strings to a list of InfoTypes.
@@ -102,7 +102,7 @@ func createTrigger(w io.Writer, projectID string, triggerID, displayName, descri
 	// Send the request.
 	resp, err := client.CreateJobTrigger(ctx, req)
 	if err != nil {
-		return fmt.Errorf("CreateJobTrigger: %v", err)
+		return fmt.Errorf("CreateJobTrigger: %w", err)
 	}
 	fmt.Fprintf(w, "Successfully created trigger: %v", resp.GetName())
 	return nil
@@ -35,7 +35,7 @@ func listTriggers(w io.Writer, projectID string) error {
 
 	client, err := dlp.NewClient(ctx)
 	if err != nil {
-		return fmt.Errorf("dlp.NewClient: %v", err)
+		return fmt.Errorf("dlp.NewClient: %w", err)
 	}
 
 	// Create a configured request.
@@ -50,17 +50,17 @@ func listTriggers(w io.Writer, projectID string) error {
 			break
 		}
 		if err != nil {
-			return fmt.Errorf("Next: %v", err)
+			return fmt.Errorf("Next: %w", err)
 		}
 		fmt.Fprintf(w, "Trigger %v\n", t.GetName())
 		c, err := ptypes.Timestamp(t.GetCreateTime())
 		if err != nil {
-			return fmt.Errorf("CreateTime Timestamp: %v", err)
+			return fmt.Errorf("CreateTime Timestamp: %w", err)
 		}
 		fmt.Fprintf(w, "  Created: %v\n", c.Format(time.RFC1123))
 		u, err := ptypes.Timestamp(t.GetUpdateTime())
 		if err != nil {
-			return fmt.Errorf("UpdateTime Timestamp: %v", err)
+			return fmt.Errorf("UpdateTime Timestamp: %w", err)
 		}
 		fmt.Fprintf(w, "  Updated: %v\n", u.Format(time.RFC1123))
 		fmt.Fprintf(w, "  Display Name: %q\n", t.GetDisplayName())
@@ -0,0 +1,271 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package samples
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	admin "cloud.google.com/go/firestore/apiv1/admin"
+	"cloud.google.com/go/firestore/apiv1/admin/adminpb"
+	"github.com/GoogleCloudPlatform/golang-samples/internal/testutil"
+)
+
+func setup(t *testing.T) (projectID, databaseID, collectionGroup string) {
+	tc := testutil.SystemTest(t)
+	projectID = os.Getenv("GOLANG_SAMPLES_FIRESTORE_PROJECT")
+	if projectID == "" {
+		t.Skip("Skipping firestore test. Set GOLANG_SAMPLES_FIRESTORE_PROJECT.")
+	}
+	// Set GOLANG_SAMPLES_FIRESTORE_DATABASE to use a named database.
+	databaseID = os.Getenv("GOLANG_SAMPLES_FIRESTORE_DATABASE")
+	if databaseID == "" {
+		databaseID = firestore.DefaultDatabaseID
+	}
+	return projectID, databaseID, tc.ProjectID + "-admin"
+}
+
+func TestTTLPolicy(t *testing.T) {
+	projectID, databaseID, collectionGroup := setup(t)
+	field := "expireAt"
+
+	f, err := createTTLPolicy(ioutil.Discard, projectID, databaseID, collectionGroup, field)
+	if err != nil {
+		t.Fatalf("createTTLPolicy: %v", err)
+	}
+	if f.GetTtlConfig() == nil {
+		t.Errorf("createTTLPolicy got no TTL config on %v", f.Name)
+	}
+
+	state, err := getTTLPolicy(ioutil.Discard, projectID, databaseID, collectionGroup, field)
+	if err != nil {
+		t.Fatalf("getTTLPolicy: %v", err)
+	}
+	if state != adminpb.Field_TtlConfig_CREATING && state != adminpb.Field_TtlConfig_ACTIVE {
+		t.Errorf("getTTLPolicy got state %v, want CREATING or ACTIVE", state)
+	}
+
+	if err := deleteTTLPolicy(ioutil.Discard, projectID, databaseID, collectionGroup, field); err != nil {
+		t.Fatalf("deleteTTLPolicy: %v", err)
+	}
+	state, err = getTTLPolicy(ioutil.Discard, projectID, databaseID, collectionGroup, field)
+	if err != nil {
+		t.Fatalf("getTTLPolicy: %v", err)
+	}
+	if state != adminpb.Field_TtlConfig_STATE_UNSPECIFIED {
+		t.Errorf("getTTLPolicy after delete got state %v, want STATE_UNSPECIFIED", state)
+	}
+}
+
+func TestIndexes(t *testing.T) {
+	projectID, databaseID, collectionGroup := setup(t)
+	// Indexes take minutes to build and delete, so use a collection group
+	// no other run can collide with.
+	collectionGroup = fmt.Sprintf("%s-index-%d", collectionGroup, time.Now().Unix())
+
+	index, err := createIndex(ioutil.Discard, projectID, databaseID, collectionGroup)
+	if err != nil {
+		t.Fatalf("createIndex: %v", err)
+	}
+	defer deleteIndex(ioutil.Discard, index.Name)
+	if index.State != adminpb.Index_READY {
+		t.Errorf("createIndex got state %v, want READY", index.State)
+	}
+
+	indexes, err := listIndexes(ioutil.Discard, projectID, databaseID, collectionGroup)
+	if err != nil {
+		t.Fatalf("listIndexes: %v", err)
+	}
+	if len(indexes) != 1 || indexes[0].Name != index.Name {
+		t.Errorf("listIndexes got %v, want only %v", indexes, index.Name)
+	}
+
+	got, err := getIndex(ioutil.Discard, index.Name)
+	if err != nil {
+		t.Fatalf("getIndex: %v", err)
+	}
+	if len(got.Fields) < 2 || got.Fields[0].FieldPath != "state" || got.Fields[1].FieldPath != "population" {
+		t.Errorf("getIndex got fields %v, want state, population", got.Fields)
+	}
+
+	if err := deleteIndex(ioutil.Discard, index.Name); err != nil {
+		t.Fatalf("deleteIndex: %v", err)
+	}
+	indexes, err = listIndexes(ioutil.Discard, projectID, databaseID, collectionGroup)
+	if err != nil {
+		t.Fatalf("listIndexes: %v", err)
+	}
+	if len(indexes) != 0 {
+		t.Errorf("listIndexes after delete got %v, want none", indexes)
+	}
+}
+
+func TestExportImport(t *testing.T) {
+	// The service agent of the project must be able to write to the bucket.
+	// See https://cloud.google.com/firestore/docs/manage-data/export-import#permissions.
+	projectID, databaseID, collection := setup(t)
+	ctx := context.Background()
+	bucket := projectID + "-firestore-export"
+	testutil.CleanBucket(ctx, t, projectID, bucket)
+
+	client, err := firestore.NewClientWithDatabase(ctx, projectID, databaseID)
+	if err != nil {
+		t.Fatalf("firestore.NewClientWithDatabase: %v", err)
+	}
+	defer client.Close()
+	doc := client.Collection(collection).Doc("exported")
+	if _, err := doc.Set(ctx, map[string]interface{}{"name": "exported"}); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+
+	resp, err := exportDocuments(ioutil.Discard, projectID, databaseID, "gs://"+bucket, []string{collection})
+	if err != nil {
+		t.Fatalf("exportDocuments: %v", err)
+	}
+	if _, err := doc.Delete(ctx); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+
+	if err := importDocuments(ioutil.Discard, projectID, databaseID, resp.OutputUriPrefix, []string{collection}); err != nil {
+		t.Fatalf("importDocuments: %v", err)
+	}
+	snap, err := doc.Get(ctx)
+	if err != nil {
+		t.Fatalf("Get after import: %v", err)
+	}
+	if got := snap.Data()["name"]; got != "exported" {
+		t.Errorf("name got %v, want exported", got)
+	}
+}
+
+func TestGetEarliestReadTime(t *testing.T) {
+	projectID, databaseID, _ := setup(t)
+
+	got, err := getEarliestReadTime(ioutil.Discard, projectID, databaseID)
+	if err != nil {
+		t.Fatalf("getEarliestReadTime: %v", err)
+	}
+	// Versions are kept for at least an hour and at most seven days.
+	if age := time.Since(got); age < 50*time.Minute || age > 8*24*time.Hour {
+		t.Errorf("getEarliestReadTime got %v (%v ago), want between one hour and seven days ago", got, age)
+	}
+}
+
+func TestVectorIndex(t *testing.T) {
+	projectID, databaseID, collectionGroup := setup(t)
+	collectionGroup = fmt.Sprintf("%s-vectors-%d", collectionGroup, time.Now().Unix())
+
+	index, err := createVectorIndex(ioutil.Discard, projectID, databaseID, collectionGroup, "embedding", 3)
+	if err != nil {
+		t.Fatalf("createVectorIndex: %v", err)
+	}
+	defer deleteIndex(ioutil.Discard, index.Name)
+	if index.State != adminpb.Index_READY {
+		t.Errorf("createVectorIndex got state %v, want READY", index.State)
+	}
+	if got := index.Fields[0].GetVectorConfig().GetDimension(); got != 3 {
+		t.Errorf("createVectorIndex got dimension %d, want 3", got)
+	}
+}
+
+func TestDatabases(t *testing.T) {
+	projectID, _, _ := setup(t)
+	ctx := context.Background()
+	databaseID := fmt.Sprintf("go-samples-%d", time.Now().Unix())
+
+	db, err := createDatabase(ioutil.Discard, projectID, databaseID, "nam5")
+	if err != nil {
+		t.Fatalf("createDatabase: %v", err)
+	}
+	defer deleteDatabase(ctx, t, db.Name)
+	if db.Type != adminpb.Database_FIRESTORE_NATIVE {
+		t.Errorf("createDatabase got type %v, want FIRESTORE_NATIVE", db.Type)
+	}
+
+	dbs, err := listDatabases(ioutil.Discard, projectID)
+	if err != nil {
+		t.Fatalf("listDatabases: %v", err)
+	}
+	var found bool
+	for _, d := range dbs {
+		if d.Name == db.Name {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("listDatabases got %v, want to contain %v", dbs, db.Name)
+	}
+}
+
+func TestDatabaseManagement(t *testing.T) {
+	projectID, _, _ := setup(t)
+	ctx := context.Background()
+	databaseID := fmt.Sprintf("go-samples-opts-%d", time.Now().Unix())
+
+	db, err := createDatabaseWithOptions(ioutil.Discard, projectID, databaseID, "us-east1", adminpb.Database_FIRESTORE_NATIVE, true, true)
+	if err != nil {
+		t.Fatalf("createDatabaseWithOptions: %v", err)
+	}
+	defer func() {
+		// Delete protection has to be off for the database to be deleted.
+		updateDeleteProtection(ioutil.Discard, projectID, databaseID, false)
+		deleteDatabase(ctx, t, db.Name)
+	}()
+
+	got, err := getDatabase(ioutil.Discard, projectID, databaseID)
+	if err != nil {
+		t.Fatalf("getDatabase: %v", err)
+	}
+	if got.LocationId != "us-east1" {
+		t.Errorf("getDatabase got location %q, want us-east1", got.LocationId)
+	}
+	if got.PointInTimeRecoveryEnablement != adminpb.Database_POINT_IN_TIME_RECOVERY_ENABLED {
+		t.Errorf("getDatabase got PITR %v, want enabled", got.PointInTimeRecoveryEnablement)
+	}
+	if got.DeleteProtectionState != adminpb.Database_DELETE_PROTECTION_ENABLED {
+		t.Errorf("getDatabase got delete protection %v, want enabled", got.DeleteProtectionState)
+	}
+
+	got, err = updateDeleteProtection(ioutil.Discard, projectID, databaseID, false)
+	if err != nil {
+		t.Fatalf("updateDeleteProtection: %v", err)
+	}
+	if got.DeleteProtectionState != adminpb.Database_DELETE_PROTECTION_DISABLED {
+		t.Errorf("updateDeleteProtection got %v, want disabled", got.DeleteProtectionState)
+	}
+}
+
+func deleteDatabase(ctx context.Context, t *testing.T, name string) {
+	t.Helper()
+	client, err := admin.NewFirestoreAdminClient(ctx)
+	if err != nil {
+		t.Fatalf("admin.NewFirestoreAdminClient: %v", err)
+	}
+	defer client.Close()
+
+	op, err := client.DeleteDatabase(ctx, &adminpb.DeleteDatabaseRequest{Name: name})
+	if err != nil {
+		t.Errorf("DeleteDatabase(%q): %v", name, err)
+		return
+	}
+	if _, err := op.Wait(ctx); err != nil {
+		t.Errorf("DeleteDatabase(%q).Wait: %v", name, err)
+	}
+}
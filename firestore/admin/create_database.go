@@ -0,0 +1,67 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package samples
+
+// [START firestore_create_database]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	admin "cloud.google.com/go/firestore/apiv1/admin"
+	"cloud.google.com/go/firestore/apiv1/admin/adminpb"
+	"google.golang.org/api/option"
+)
+
+// createDatabase creates a named Firestore database in Native mode. A
+// project can hold several databases besides the (default) one, for example
+// to isolate environments or tenants.
+func createDatabase(w io.Writer, projectID, databaseID, location string, opts ...option.ClientOption) (*adminpb.Database, error) {
+	// projectID := "my-project-id"
+	// databaseID := "my-database"
+	// location := "nam5"
+	const timeout = 10 * time.Minute
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	client, err := admin.NewFirestoreAdminClient(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("admin.NewFirestoreAdminClient: %w", err)
+	}
+	defer client.Close()
+
+	req := &adminpb.CreateDatabaseRequest{
+		Parent: fmt.Sprintf("projects/%s", projectID),
+		// IDs are 4 to 63 lowercase letters, digits, or hyphens, and
+		// start with a letter.
+		DatabaseId: databaseID,
+		Database: &adminpb.Database{
+			LocationId: location,
+			Type:       adminpb.Database_FIRESTORE_NATIVE,
+		},
+	}
+	op, err := client.CreateDatabase(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("CreateDatabase: %w", err)
+	}
+	db, err := op.Wait(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Wait: %w", err)
+	}
+	fmt.Fprintf(w, "Created database: %v\n", db.Name)
+	return db, nil
+}
+
+// [END firestore_create_database]
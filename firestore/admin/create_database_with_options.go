@@ -0,0 +1,80 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package samples
+
+// [START firestore_create_database_with_options]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	admin "cloud.google.com/go/firestore/apiv1/admin"
+	"cloud.google.com/go/firestore/apiv1/admin/adminpb"
+	"google.golang.org/api/option"
+)
+
+// createDatabaseWithOptions creates a database of the given type, with
+// point-in-time recovery and delete protection set as requested. The
+// location and type cannot be changed later.
+func createDatabaseWithOptions(w io.Writer, projectID, databaseID, location string, dbType adminpb.Database_DatabaseType, pitr, deleteProtection bool, opts ...option.ClientOption) (*adminpb.Database, error) {
+	// projectID := "my-project-id"
+	// databaseID := "my-database"
+	// location := "us-east1"
+	// dbType := adminpb.Database_FIRESTORE_NATIVE
+	// pitr := true
+	// deleteProtection := true
+	const timeout = 10 * time.Minute
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	client, err := admin.NewFirestoreAdminClient(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("admin.NewFirestoreAdminClient: %w", err)
+	}
+	defer client.Close()
+
+	db := &adminpb.Database{
+		LocationId:                    location,
+		Type:                          dbType,
+		PointInTimeRecoveryEnablement: adminpb.Database_POINT_IN_TIME_RECOVERY_DISABLED,
+		DeleteProtectionState:         adminpb.Database_DELETE_PROTECTION_DISABLED,
+	}
+	// With point-in-time recovery, versions of documents are kept for
+	// seven days instead of one hour, and can be read with a read time.
+	if pitr {
+		db.PointInTimeRecoveryEnablement = adminpb.Database_POINT_IN_TIME_RECOVERY_ENABLED
+	}
+	// A database with delete protection cannot be deleted until the
+	// protection is disabled.
+	if deleteProtection {
+		db.DeleteProtectionState = adminpb.Database_DELETE_PROTECTION_ENABLED
+	}
+	op, err := client.CreateDatabase(ctx, &adminpb.CreateDatabaseRequest{
+		Parent:     fmt.Sprintf("projects/%s", projectID),
+		DatabaseId: databaseID,
+		Database:   db,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("CreateDatabase: %w", err)
+	}
+	created, err := op.Wait(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Wait: %w", err)
+	}
+	fmt.Fprintf(w, "Created database: %v (%v, %v)\n", created.Name, created.LocationId, created.Type)
+	return created, nil
+}
+
+// [END firestore_create_database_with_options]
@@ -0,0 +1,70 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package samples
+
+// [START firestore_create_ttl_policy]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	admin "cloud.google.com/go/firestore/apiv1/admin"
+	"cloud.google.com/go/firestore/apiv1/admin/adminpb"
+	"google.golang.org/api/option"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// createTTLPolicy enables a TTL policy on a timestamp field of a collection
+// group. Documents in the group are deleted some time after the point in time
+// stored in the field.
+func createTTLPolicy(w io.Writer, projectID, databaseID, collectionGroup, field string, opts ...option.ClientOption) (*adminpb.Field, error) {
+	// projectID := "my-project-id"
+	// databaseID := "(default)"
+	// collectionGroup := "sessions"
+	// field := "expireAt"
+	const timeout = 10 * time.Minute
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	client, err := admin.NewFirestoreAdminClient(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("admin.NewFirestoreAdminClient: %w", err)
+	}
+	defer client.Close()
+
+	req := &adminpb.UpdateFieldRequest{
+		Field: &adminpb.Field{
+			Name:      fmt.Sprintf("projects/%s/databases/%s/collectionGroups/%s/fields/%s", projectID, databaseID, collectionGroup, field),
+			TtlConfig: &adminpb.Field_TtlConfig{},
+		},
+		// Only the TTL configuration is changed, the field's index
+		// configuration is left as is.
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"ttl_config"}},
+	}
+	op, err := client.UpdateField(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateField: %w", err)
+	}
+	f, err := op.Wait(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Wait: %w", err)
+	}
+	// The policy starts in the CREATING state and becomes ACTIVE once
+	// existing documents have been processed.
+	fmt.Fprintf(w, "TTL policy on %v: %v\n", f.Name, f.GetTtlConfig().GetState())
+	return f, nil
+}
+
+// [END firestore_create_ttl_policy]
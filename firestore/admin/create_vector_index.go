@@ -0,0 +1,79 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package samples
+
+// [START firestore_create_vector_index]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	admin "cloud.google.com/go/firestore/apiv1/admin"
+	"cloud.google.com/go/firestore/apiv1/admin/adminpb"
+	"google.golang.org/api/option"
+)
+
+// createVectorIndex creates the index FindNearest queries need on a vector
+// field. Every vector stored in the field must have the given dimension, up
+// to 2048.
+func createVectorIndex(w io.Writer, projectID, databaseID, collectionGroup, field string, dimension int32, opts ...option.ClientOption) (*adminpb.Index, error) {
+	// projectID := "my-project-id"
+	// databaseID := "(default)"
+	// collectionGroup := "products"
+	// field := "embedding"
+	// dimension := 768
+	const timeout = 30 * time.Minute
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	client, err := admin.NewFirestoreAdminClient(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("admin.NewFirestoreAdminClient: %w", err)
+	}
+	defer client.Close()
+
+	req := &adminpb.CreateIndexRequest{
+		Parent: fmt.Sprintf("projects/%s/databases/%s/collectionGroups/%s", projectID, databaseID, collectionGroup),
+		Index: &adminpb.Index{
+			QueryScope: adminpb.Index_COLLECTION,
+			Fields: []*adminpb.Index_IndexField{
+				{
+					FieldPath: field,
+					ValueMode: &adminpb.Index_IndexField_VectorConfig_{
+						VectorConfig: &adminpb.Index_IndexField_VectorConfig{
+							Dimension: dimension,
+							// Flat is currently the only index type.
+							Type: &adminpb.Index_IndexField_VectorConfig_Flat{
+								Flat: &adminpb.Index_IndexField_VectorConfig_FlatIndex{},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	op, err := client.CreateIndex(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("CreateIndex: %w", err)
+	}
+	index, err := op.Wait(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Wait: %w", err)
+	}
+	fmt.Fprintf(w, "Created vector index: %v\n", index.Name)
+	return index, nil
+}
+
+// [END firestore_create_vector_index]
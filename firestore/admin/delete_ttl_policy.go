@@ -0,0 +1,64 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package samples
+
+// [START firestore_delete_ttl_policy]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	admin "cloud.google.com/go/firestore/apiv1/admin"
+	"cloud.google.com/go/firestore/apiv1/admin/adminpb"
+	"google.golang.org/api/option"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// deleteTTLPolicy disables the TTL policy on a field. Documents that have
+// already expired but not yet been deleted are kept.
+func deleteTTLPolicy(w io.Writer, projectID, databaseID, collectionGroup, field string, opts ...option.ClientOption) error {
+	// projectID := "my-project-id"
+	// databaseID := "(default)"
+	// collectionGroup := "sessions"
+	// field := "expireAt"
+	const timeout = 10 * time.Minute
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	client, err := admin.NewFirestoreAdminClient(ctx, opts...)
+	if err != nil {
+		return fmt.Errorf("admin.NewFirestoreAdminClient: %w", err)
+	}
+	defer client.Close()
+
+	req := &adminpb.UpdateFieldRequest{
+		// Leaving TtlConfig unset while naming it in the mask removes it.
+		Field: &adminpb.Field{
+			Name: fmt.Sprintf("projects/%s/databases/%s/collectionGroups/%s/fields/%s", projectID, databaseID, collectionGroup, field),
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"ttl_config"}},
+	}
+	op, err := client.UpdateField(ctx, req)
+	if err != nil {
+		return fmt.Errorf("UpdateField: %w", err)
+	}
+	if _, err := op.Wait(ctx); err != nil {
+		return fmt.Errorf("Wait: %w", err)
+	}
+	fmt.Fprintf(w, "TTL policy deleted\n")
+	return nil
+}
+
+// [END firestore_delete_ttl_policy]
@@ -0,0 +1,97 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package samples
+
+import (
+	"io"
+	"time"
+
+	"cloud.google.com/go/firestore/apiv1/admin/adminpb"
+	"google.golang.org/api/option"
+)
+
+// The functions below export the samples that cmd/samplectl is built from, so
+// that the tool runs the same code as the documentation.
+
+// ListDatabases runs the firestore_list_databases sample.
+func ListDatabases(w io.Writer, projectID string, opts ...option.ClientOption) ([]*adminpb.Database, error) {
+	return listDatabases(w, projectID, opts...)
+}
+
+// GetDatabase runs the firestore_get_database sample.
+func GetDatabase(w io.Writer, projectID, databaseID string, opts ...option.ClientOption) (*adminpb.Database, error) {
+	return getDatabase(w, projectID, databaseID, opts...)
+}
+
+// CreateDatabase runs the firestore_create_database sample.
+func CreateDatabase(w io.Writer, projectID, databaseID, location string, opts ...option.ClientOption) (*adminpb.Database, error) {
+	return createDatabase(w, projectID, databaseID, location, opts...)
+}
+
+// UpdateDeleteProtection runs the
+// firestore_update_database_delete_protection sample.
+func UpdateDeleteProtection(w io.Writer, projectID, databaseID string, enabled bool, opts ...option.ClientOption) (*adminpb.Database, error) {
+	return updateDeleteProtection(w, projectID, databaseID, enabled, opts...)
+}
+
+// GetEarliestReadTime runs the firestore_get_earliest_read_time sample.
+func GetEarliestReadTime(w io.Writer, projectID, databaseID string, opts ...option.ClientOption) (time.Time, error) {
+	return getEarliestReadTime(w, projectID, databaseID, opts...)
+}
+
+// ListIndexes runs the firestore_list_indexes sample.
+func ListIndexes(w io.Writer, projectID, databaseID, collectionGroup string, opts ...option.ClientOption) ([]*adminpb.Index, error) {
+	return listIndexes(w, projectID, databaseID, collectionGroup, opts...)
+}
+
+// CreateIndex runs the firestore_create_index sample.
+func CreateIndex(w io.Writer, projectID, databaseID, collectionGroup string, opts ...option.ClientOption) (*adminpb.Index, error) {
+	return createIndex(w, projectID, databaseID, collectionGroup, opts...)
+}
+
+// GetIndex runs the firestore_get_index sample.
+func GetIndex(w io.Writer, name string, opts ...option.ClientOption) (*adminpb.Index, error) {
+	return getIndex(w, name, opts...)
+}
+
+// DeleteIndex runs the firestore_delete_index sample.
+func DeleteIndex(w io.Writer, name string, opts ...option.ClientOption) error {
+	return deleteIndex(w, name, opts...)
+}
+
+// CreateTTLPolicy runs the firestore_create_ttl_policy sample.
+func CreateTTLPolicy(w io.Writer, projectID, databaseID, collectionGroup, field string, opts ...option.ClientOption) (*adminpb.Field, error) {
+	return createTTLPolicy(w, projectID, databaseID, collectionGroup, field, opts...)
+}
+
+// GetTTLPolicy runs the firestore_get_ttl_policy sample.
+func GetTTLPolicy(w io.Writer, projectID, databaseID, collectionGroup, field string, opts ...option.ClientOption) (adminpb.Field_TtlConfig_State, error) {
+	return getTTLPolicy(w, projectID, databaseID, collectionGroup, field, opts...)
+}
+
+// DeleteTTLPolicy runs the firestore_delete_ttl_policy sample.
+func DeleteTTLPolicy(w io.Writer, projectID, databaseID, collectionGroup, field string, opts ...option.ClientOption) error {
+	return deleteTTLPolicy(w, projectID, databaseID, collectionGroup, field, opts...)
+}
+
+// ExportDocuments runs the firestore_export_documents sample.
+func ExportDocuments(w io.Writer, projectID, databaseID, outputURIPrefix string, collectionIDs []string, opts ...option.ClientOption) (*adminpb.ExportDocumentsResponse, error) {
+	return exportDocuments(w, projectID, databaseID, outputURIPrefix, collectionIDs, opts...)
+}
+
+// ImportDocuments runs the firestore_import_documents sample.
+func ImportDocuments(w io.Writer, projectID, databaseID, inputURIPrefix string, collectionIDs []string, opts ...option.ClientOption) error {
+	return importDocuments(w, projectID, databaseID, inputURIPrefix, collectionIDs, opts...)
+}
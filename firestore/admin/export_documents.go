@@ -0,0 +1,64 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package samples
+
+// [START firestore_export_documents]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	admin "cloud.google.com/go/firestore/apiv1/admin"
+	"cloud.google.com/go/firestore/apiv1/admin/adminpb"
+	"google.golang.org/api/option"
+)
+
+// exportDocuments exports documents to Cloud Storage. With no collectionIDs
+// every collection is exported.
+func exportDocuments(w io.Writer, projectID, databaseID, outputURIPrefix string, collectionIDs []string, opts ...option.ClientOption) (*adminpb.ExportDocumentsResponse, error) {
+	// projectID := "my-project-id"
+	// databaseID := "(default)"
+	// outputURIPrefix := "gs://bucket-name"
+	// collectionIDs := []string{"cities"}
+	const timeout = 30 * time.Minute
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	client, err := admin.NewFirestoreAdminClient(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("admin.NewFirestoreAdminClient: %w", err)
+	}
+	defer client.Close()
+
+	req := &adminpb.ExportDocumentsRequest{
+		Name:            fmt.Sprintf("projects/%s/databases/%s", projectID, databaseID),
+		CollectionIds:   collectionIDs,
+		OutputUriPrefix: outputURIPrefix,
+	}
+	op, err := client.ExportDocuments(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("ExportDocuments: %w", err)
+	}
+	resp, err := op.Wait(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Wait: %w", err)
+	}
+	// The export is written to a new, timestamped folder under
+	// outputURIPrefix. Pass it to importDocuments to restore it.
+	fmt.Fprintf(w, "Documents exported to %v\n", resp.OutputUriPrefix)
+	return resp, nil
+}
+
+// [END firestore_export_documents]
@@ -0,0 +1,57 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package samples
+
+// [START firestore_get_database]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	admin "cloud.google.com/go/firestore/apiv1/admin"
+	"cloud.google.com/go/firestore/apiv1/admin/adminpb"
+	"google.golang.org/api/option"
+)
+
+// getDatabase gets the settings of a database.
+func getDatabase(w io.Writer, projectID, databaseID string, opts ...option.ClientOption) (*adminpb.Database, error) {
+	// projectID := "my-project-id"
+	// databaseID := "my-database"
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	client, err := admin.NewFirestoreAdminClient(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("admin.NewFirestoreAdminClient: %w", err)
+	}
+	defer client.Close()
+
+	db, err := client.GetDatabase(ctx, &adminpb.GetDatabaseRequest{
+		Name: fmt.Sprintf("projects/%s/databases/%s", projectID, databaseID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("GetDatabase: %w", err)
+	}
+	fmt.Fprintf(w, "Name: %v\n", db.Name)
+	fmt.Fprintf(w, "Location: %v\n", db.LocationId)
+	fmt.Fprintf(w, "Type: %v\n", db.Type)
+	fmt.Fprintf(w, "Concurrency mode: %v\n", db.ConcurrencyMode)
+	fmt.Fprintf(w, "Point-in-time recovery: %v\n", db.PointInTimeRecoveryEnablement)
+	fmt.Fprintf(w, "Delete protection: %v\n", db.DeleteProtectionState)
+	return db, nil
+}
+
+// [END firestore_get_database]
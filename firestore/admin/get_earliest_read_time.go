@@ -0,0 +1,57 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package samples
+
+// [START firestore_get_earliest_read_time]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	admin "cloud.google.com/go/firestore/apiv1/admin"
+	"cloud.google.com/go/firestore/apiv1/admin/adminpb"
+	"google.golang.org/api/option"
+)
+
+// getEarliestReadTime returns the oldest time documents of the database can
+// be read at. It is about one hour ago, or up to seven days ago when
+// point-in-time recovery is enabled.
+func getEarliestReadTime(w io.Writer, projectID, databaseID string, opts ...option.ClientOption) (time.Time, error) {
+	// projectID := "my-project-id"
+	// databaseID := "(default)"
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	client, err := admin.NewFirestoreAdminClient(ctx, opts...)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("admin.NewFirestoreAdminClient: %w", err)
+	}
+	defer client.Close()
+
+	db, err := client.GetDatabase(ctx, &adminpb.GetDatabaseRequest{
+		Name: fmt.Sprintf("projects/%s/databases/%s", projectID, databaseID),
+	})
+	if err != nil {
+		return time.Time{}, fmt.Errorf("GetDatabase: %w", err)
+	}
+	earliest := db.GetEarliestVersionTime().AsTime()
+	fmt.Fprintf(w, "Point-in-time recovery: %v\n", db.GetPointInTimeRecoveryEnablement())
+	fmt.Fprintf(w, "Version retention period: %v\n", db.GetVersionRetentionPeriod().AsDuration())
+	fmt.Fprintf(w, "Earliest read time: %v\n", earliest)
+	return earliest, nil
+}
+
+// [END firestore_get_earliest_read_time]
@@ -0,0 +1,52 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package samples
+
+// [START firestore_get_index]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	admin "cloud.google.com/go/firestore/apiv1/admin"
+	"cloud.google.com/go/firestore/apiv1/admin/adminpb"
+	"google.golang.org/api/option"
+)
+
+// getIndex gets a composite index by its full resource name.
+func getIndex(w io.Writer, name string, opts ...option.ClientOption) (*adminpb.Index, error) {
+	// name := "projects/my-project-id/databases/(default)/collectionGroups/cities/indexes/index-id"
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	client, err := admin.NewFirestoreAdminClient(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("admin.NewFirestoreAdminClient: %w", err)
+	}
+	defer client.Close()
+
+	index, err := client.GetIndex(ctx, &adminpb.GetIndexRequest{Name: name})
+	if err != nil {
+		return nil, fmt.Errorf("GetIndex: %w", err)
+	}
+	fmt.Fprintf(w, "Got index: %v (%v)\n", index.Name, index.State)
+	for _, f := range index.Fields {
+		fmt.Fprintf(w, "\t%v %v\n", f.FieldPath, f.GetOrder())
+	}
+	return index, nil
+}
+
+// [END firestore_get_index]
@@ -0,0 +1,61 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package samples
+
+// [START firestore_get_ttl_policy]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	admin "cloud.google.com/go/firestore/apiv1/admin"
+	"cloud.google.com/go/firestore/apiv1/admin/adminpb"
+	"google.golang.org/api/option"
+)
+
+// getTTLPolicy reports the state of the TTL policy on a field. The state is
+// STATE_UNSPECIFIED if the field has no TTL policy.
+func getTTLPolicy(w io.Writer, projectID, databaseID, collectionGroup, field string, opts ...option.ClientOption) (adminpb.Field_TtlConfig_State, error) {
+	// projectID := "my-project-id"
+	// databaseID := "(default)"
+	// collectionGroup := "sessions"
+	// field := "expireAt"
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	client, err := admin.NewFirestoreAdminClient(ctx, opts...)
+	if err != nil {
+		return 0, fmt.Errorf("admin.NewFirestoreAdminClient: %w", err)
+	}
+	defer client.Close()
+
+	req := &adminpb.GetFieldRequest{
+		Name: fmt.Sprintf("projects/%s/databases/%s/collectionGroups/%s/fields/%s", projectID, databaseID, collectionGroup, field),
+	}
+	f, err := client.GetField(ctx, req)
+	if err != nil {
+		return 0, fmt.Errorf("GetField: %w", err)
+	}
+	state := f.GetTtlConfig().GetState()
+	if f.GetTtlConfig() == nil {
+		fmt.Fprintf(w, "No TTL policy on %v\n", f.Name)
+	} else {
+		fmt.Fprintf(w, "TTL policy on %v: %v\n", f.Name, state)
+	}
+	return state, nil
+}
+
+// [END firestore_get_ttl_policy]
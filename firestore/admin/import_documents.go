@@ -0,0 +1,62 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package samples
+
+// [START firestore_import_documents]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	admin "cloud.google.com/go/firestore/apiv1/admin"
+	"cloud.google.com/go/firestore/apiv1/admin/adminpb"
+	"google.golang.org/api/option"
+)
+
+// importDocuments imports documents from an export. Imported documents
+// overwrite existing documents with the same ID, other documents are left
+// as is. With no collectionIDs every collection in the export is imported.
+func importDocuments(w io.Writer, projectID, databaseID, inputURIPrefix string, collectionIDs []string, opts ...option.ClientOption) error {
+	// projectID := "my-project-id"
+	// databaseID := "(default)"
+	// inputURIPrefix := "gs://bucket-name/2006-01-02T15:04:05_12345"
+	// collectionIDs := []string{"cities"}
+	const timeout = 30 * time.Minute
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	client, err := admin.NewFirestoreAdminClient(ctx, opts...)
+	if err != nil {
+		return fmt.Errorf("admin.NewFirestoreAdminClient: %w", err)
+	}
+	defer client.Close()
+
+	req := &adminpb.ImportDocumentsRequest{
+		Name:           fmt.Sprintf("projects/%s/databases/%s", projectID, databaseID),
+		CollectionIds:  collectionIDs,
+		InputUriPrefix: inputURIPrefix,
+	}
+	op, err := client.ImportDocuments(ctx, req)
+	if err != nil {
+		return fmt.Errorf("ImportDocuments: %w", err)
+	}
+	if err := op.Wait(ctx); err != nil {
+		return fmt.Errorf("Wait: %w", err)
+	}
+	fmt.Fprintf(w, "Documents imported from %v\n", inputURIPrefix)
+	return nil
+}
+
+// [END firestore_import_documents]
@@ -0,0 +1,53 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package samples
+
+// [START firestore_list_databases]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	admin "cloud.google.com/go/firestore/apiv1/admin"
+	"cloud.google.com/go/firestore/apiv1/admin/adminpb"
+	"google.golang.org/api/option"
+)
+
+// listDatabases lists the Firestore databases of a project.
+func listDatabases(w io.Writer, projectID string, opts ...option.ClientOption) ([]*adminpb.Database, error) {
+	// projectID := "my-project-id"
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	client, err := admin.NewFirestoreAdminClient(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("admin.NewFirestoreAdminClient: %w", err)
+	}
+	defer client.Close()
+
+	resp, err := client.ListDatabases(ctx, &adminpb.ListDatabasesRequest{
+		Parent: fmt.Sprintf("projects/%s", projectID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("ListDatabases: %w", err)
+	}
+	for _, db := range resp.Databases {
+		fmt.Fprintf(w, "%v (%v, %v)\n", db.Name, db.LocationId, db.Type)
+	}
+	return resp.Databases, nil
+}
+
+// [END firestore_list_databases]
@@ -0,0 +1,64 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package samples
+
+// [START firestore_list_indexes]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	admin "cloud.google.com/go/firestore/apiv1/admin"
+	"cloud.google.com/go/firestore/apiv1/admin/adminpb"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+)
+
+// listIndexes lists the composite indexes of a collection group.
+func listIndexes(w io.Writer, projectID, databaseID, collectionGroup string, opts ...option.ClientOption) ([]*adminpb.Index, error) {
+	// projectID := "my-project-id"
+	// databaseID := "(default)"
+	// collectionGroup := "cities"
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	client, err := admin.NewFirestoreAdminClient(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("admin.NewFirestoreAdminClient: %w", err)
+	}
+	defer client.Close()
+
+	// Use "-" as the collection group to list the indexes of all groups.
+	req := &adminpb.ListIndexesRequest{
+		Parent: fmt.Sprintf("projects/%s/databases/%s/collectionGroups/%s", projectID, databaseID, collectionGroup),
+	}
+	it := client.ListIndexes(ctx, req)
+	var indexes []*adminpb.Index
+	for {
+		index, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("ListIndexes: %w", err)
+		}
+		indexes = append(indexes, index)
+		fmt.Fprintf(w, "Got index: %v (%v)\n", index.Name, index.State)
+	}
+	return indexes, nil
+}
+
+// [END firestore_list_indexes]
@@ -0,0 +1,67 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package samples
+
+// [START firestore_update_database_delete_protection]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	admin "cloud.google.com/go/firestore/apiv1/admin"
+	"cloud.google.com/go/firestore/apiv1/admin/adminpb"
+	"google.golang.org/api/option"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// updateDeleteProtection enables or disables delete protection on a
+// database.
+func updateDeleteProtection(w io.Writer, projectID, databaseID string, enabled bool, opts ...option.ClientOption) (*adminpb.Database, error) {
+	// projectID := "my-project-id"
+	// databaseID := "my-database"
+	// enabled := false
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	client, err := admin.NewFirestoreAdminClient(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("admin.NewFirestoreAdminClient: %w", err)
+	}
+	defer client.Close()
+
+	state := adminpb.Database_DELETE_PROTECTION_DISABLED
+	if enabled {
+		state = adminpb.Database_DELETE_PROTECTION_ENABLED
+	}
+	op, err := client.UpdateDatabase(ctx, &adminpb.UpdateDatabaseRequest{
+		Database: &adminpb.Database{
+			Name:                  fmt.Sprintf("projects/%s/databases/%s", projectID, databaseID),
+			DeleteProtectionState: state,
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"delete_protection_state"}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("UpdateDatabase: %w", err)
+	}
+	db, err := op.Wait(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Wait: %w", err)
+	}
+	fmt.Fprintf(w, "Delete protection of %v: %v\n", db.Name, db.DeleteProtectionState)
+	return db, nil
+}
+
+// [END firestore_update_database_delete_protection]
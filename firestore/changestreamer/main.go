@@ -0,0 +1,115 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command changestreamer is a sample service that listens to a Firestore
+// collection and publishes every document change to a Pub/Sub topic.
+//
+// Each message holds the document data as JSON, or no data when the
+// document was removed, and has these attributes:
+//
+//	changeKind    ADDED, MODIFIED or REMOVED
+//	documentPath  full path of the document, e.g. cities/SF
+//	readTime      time of the snapshot the change belongs to, RFC 3339
+//
+// Messages are published with the document path as ordering key, so
+// subscribers with message ordering enabled see the changes of a document
+// in order.
+//
+// A listener starts from scratch when it restarts: its first snapshot
+// reports every existing document as added. Use -skip-initial to publish
+// only the changes made while the service runs. Changes made while it is
+// stopped are not published.
+//
+// Usage:
+//
+//	changestreamer -project my-project -collection cities -topic city-changes [-database my-db] [-skip-initial]
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"cloud.google.com/go/firestore"
+	"cloud.google.com/go/pubsub"
+	"github.com/GoogleCloudPlatform/golang-samples/internal/endpoint"
+)
+
+func main() {
+	var (
+		projectID   = flag.String("project", os.Getenv("GOOGLE_CLOUD_PROJECT"), "Project of the database and topic.")
+		databaseID  = flag.String("database", firestore.DefaultDatabaseID, "Firestore database.")
+		collection  = flag.String("collection", "", "Collection to listen to.")
+		topicID     = flag.String("topic", "", "Topic to publish changes to.")
+		skipInitial = flag.Bool("skip-initial", false, "Do not publish the documents that exist at startup.")
+	)
+	flag.Parse()
+	if *projectID == "" || *collection == "" || *topicID == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-sig
+		cancel()
+	}()
+
+	if err := run(ctx, *projectID, *databaseID, *collection, *topicID, *skipInitial); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(ctx context.Context, projectID, databaseID, collection, topicID string, skipInitial bool) error {
+	fsClient, err := firestore.NewClientWithDatabase(ctx, projectID, databaseID, endpoint.Firestore()...)
+	if err != nil {
+		return fmt.Errorf("firestore.NewClientWithDatabase: %w", err)
+	}
+	defer fsClient.Close()
+	psClient, err := pubsub.NewClient(ctx, projectID, endpoint.PubSub()...)
+	if err != nil {
+		return fmt.Errorf("pubsub.NewClient: %w", err)
+	}
+	defer psClient.Close()
+
+	topic := psClient.Topic(topicID)
+	topic.EnableMessageOrdering = true
+	defer topic.Stop()
+
+	it := fsClient.Collection(collection).Snapshots(ctx)
+	defer it.Stop()
+	log.Printf("Streaming changes of %v to %v", collection, topic)
+	return stream(ctx, it, topicPublisher(topic), skipInitial)
+}
+
+// topicPublisher returns a publishFunc that publishes to topic and waits for
+// the server to accept each message.
+func topicPublisher(topic *pubsub.Topic) publishFunc {
+	return func(ctx context.Context, msg *pubsub.Message) error {
+		_, err := topic.Publish(ctx, msg).Get(ctx)
+		if err != nil {
+			// After a failure, publishing on the ordering key is paused
+			// until resumed.
+			topic.ResumePublish(msg.OrderingKey)
+		}
+		return err
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"cloud.google.com/go/pubsub"
+)
+
+// publishFunc publishes a message and returns once it has been accepted.
+type publishFunc func(ctx context.Context, msg *pubsub.Message) error
+
+// snapshotIterator is implemented by *firestore.QuerySnapshotIterator.
+type snapshotIterator interface {
+	Next() (*firestore.QuerySnapshot, error)
+}
+
+// change is a document change, decoupled from the snapshot it came from.
+type change struct {
+	kind     string
+	path     string
+	data     map[string]interface{}
+	readTime time.Time
+}
+
+var kinds = map[firestore.DocumentChangeKind]string{
+	firestore.DocumentAdded:    "ADDED",
+	firestore.DocumentModified: "MODIFIED",
+	firestore.DocumentRemoved:  "REMOVED",
+}
+
+// stream publishes the changes of every snapshot of it until ctx is done or
+// publishing fails. A change is only published once the previous one has
+// been accepted.
+func stream(ctx context.Context, it snapshotIterator, publish publishFunc, skipInitial bool) error {
+	first := true
+	for {
+		snap, err := it.Next()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("Snapshots.Next: %w", err)
+		}
+		if first && skipInitial {
+			log.Printf("Skipping %d existing documents", len(snap.Changes))
+			first = false
+			continue
+		}
+		first = false
+		for _, c := range changes(snap) {
+			if err := publishChange(ctx, c, publish); err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				return err
+			}
+		}
+	}
+}
+
+// changes converts the changes of snap.
+func changes(snap *firestore.QuerySnapshot) []change {
+	var cs []change
+	for _, dc := range snap.Changes {
+		c := change{
+			kind:     kinds[dc.Kind],
+			path:     dc.Doc.Ref.Path,
+			readTime: snap.ReadTime,
+		}
+		if dc.Kind != firestore.DocumentRemoved {
+			c.data = dc.Doc.Data()
+		}
+		cs = append(cs, c)
+	}
+	return cs
+}
+
+func publishChange(ctx context.Context, c change, publish publishFunc) error {
+	msg, err := message(c)
+	if err != nil {
+		return err
+	}
+	if err := publish(ctx, msg); err != nil {
+		return fmt.Errorf("Publish(%v %v): %w", c.kind, c.path, err)
+	}
+	return nil
+}
+
+// message builds the Pub/Sub message for c.
+func message(c change) (*pubsub.Message, error) {
+	msg := &pubsub.Message{
+		Attributes: map[string]string{
+			"changeKind":   c.kind,
+			"documentPath": c.path,
+			"readTime":     c.readTime.UTC().Format(time.RFC3339Nano),
+		},
+		OrderingKey: c.path,
+	}
+	if c.data != nil {
+		data, err := json.Marshal(c.data)
+		if err != nil {
+			return nil, fmt.Errorf("json.Marshal(%v): %w", c.path, err)
+		}
+		msg.Data = data
+	}
+	return msg, nil
+}
@@ -0,0 +1,142 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"cloud.google.com/go/pubsub"
+	"github.com/GoogleCloudPlatform/golang-samples/internal/testutil"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMessage(t *testing.T) {
+	readTime := time.Date(2026, 10, 15, 14, 5, 0, 0, time.UTC)
+	sf := testutil.Dataset(t, "cities")["SF"]
+	tests := []struct {
+		c        change
+		wantData string
+	}{
+		{
+			c:        change{kind: "ADDED", path: "cities/SF", data: sf, readTime: readTime},
+			wantData: `{"country":"USA","name":"San Francisco","population":860000,"regions":["west_coast","norcal"],"state":"CA"}`,
+		},
+		{
+			c:        change{kind: "REMOVED", path: "cities/SF", readTime: readTime},
+			wantData: "",
+		},
+	}
+	for _, tc := range tests {
+		msg, err := message(tc.c)
+		if err != nil {
+			t.Fatalf("message(%v): %v", tc.c.kind, err)
+		}
+		if got := string(msg.Data); got != tc.wantData {
+			t.Errorf("message(%v) got data %q, want %q", tc.c.kind, got, tc.wantData)
+		}
+		wantAttrs := map[string]string{
+			"changeKind":   tc.c.kind,
+			"documentPath": "cities/SF",
+			"readTime":     "2026-10-15T14:05:00Z",
+		}
+		if diff := cmp.Diff(wantAttrs, msg.Attributes); diff != "" {
+			t.Errorf("message(%v) attributes mismatch (-want +got):\n%s", tc.c.kind, diff)
+		}
+		if msg.OrderingKey != "cities/SF" {
+			t.Errorf("message(%v) got ordering key %q, want cities/SF", tc.c.kind, msg.OrderingKey)
+		}
+	}
+}
+
+// fakeIterator returns snaps, then blocks until ctx is done.
+type fakeIterator struct {
+	ctx   context.Context
+	snaps []*firestore.QuerySnapshot
+}
+
+func (it *fakeIterator) Next() (*firestore.QuerySnapshot, error) {
+	if len(it.snaps) == 0 {
+		<-it.ctx.Done()
+		return nil, it.ctx.Err()
+	}
+	snap := it.snaps[0]
+	it.snaps = it.snaps[1:]
+	return snap, nil
+}
+
+func removed(path string) firestore.DocumentChange {
+	return firestore.DocumentChange{
+		Kind: firestore.DocumentRemoved,
+		Doc:  &firestore.DocumentSnapshot{Ref: &firestore.DocumentRef{Path: path}},
+	}
+}
+
+func TestStream(t *testing.T) {
+	snaps := func() []*firestore.QuerySnapshot {
+		return []*firestore.QuerySnapshot{
+			{Changes: []firestore.DocumentChange{removed("cities/SF"), removed("cities/LA")}},
+			{Changes: []firestore.DocumentChange{removed("cities/DC")}},
+		}
+	}
+	tests := []struct {
+		skipInitial bool
+		want        []string
+	}{
+		{skipInitial: false, want: []string{"cities/SF", "cities/LA", "cities/DC"}},
+		{skipInitial: true, want: []string{"cities/DC"}},
+	}
+	for _, tc := range tests {
+		ctx, cancel := context.WithCancel(context.Background())
+		var got []string
+		publish := func(ctx context.Context, msg *pubsub.Message) error {
+			got = append(got, msg.Attributes["documentPath"])
+			if len(got) == len(tc.want) {
+				cancel()
+			}
+			return nil
+		}
+		it := &fakeIterator{ctx: ctx, snaps: snaps()}
+		if err := stream(ctx, it, publish, tc.skipInitial); err != nil {
+			t.Errorf("stream(skipInitial=%v): %v", tc.skipInitial, err)
+		}
+		cancel()
+		if diff := cmp.Diff(tc.want, got); diff != "" {
+			t.Errorf("stream(skipInitial=%v) mismatch (-want +got):\n%s", tc.skipInitial, diff)
+		}
+	}
+}
+
+func TestStreamPublishError(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	it := &fakeIterator{ctx: ctx, snaps: []*firestore.QuerySnapshot{
+		{Changes: []firestore.DocumentChange{removed("cities/SF"), removed("cities/LA")}},
+	}}
+	var published int
+	publish := func(ctx context.Context, msg *pubsub.Message) error {
+		published++
+		return errors.New("publish failed")
+	}
+	if err := stream(ctx, it, publish, false); err == nil {
+		t.Errorf("stream got nil error, want the publish error")
+	}
+	if published != 1 {
+		t.Errorf("stream published %d messages after an error, want 1", published)
+	}
+}
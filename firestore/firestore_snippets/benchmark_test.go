@@ -0,0 +1,70 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"cloud.google.com/go/firestore"
+	"github.com/GoogleCloudPlatform/golang-samples/internal/testutil"
+)
+
+// BenchmarkWrites measures a write sample that takes a client against one
+// that creates a client for every call. Run it with
+//
+//	GOLANG_SAMPLES_BENCHMARK=1 go test -run '^$' -bench Writes ./firestore/firestore_snippets/
+func BenchmarkWrites(b *testing.B) {
+	tc := testutil.BenchmarkTest(b)
+	// TODO(#559): revert this to tc.ProjectID when datastore and firestore
+	// can co-exist in a project.
+	projectID := os.Getenv("GOLANG_SAMPLES_FIRESTORE_PROJECT")
+	if os.Getenv("FIRESTORE_EMULATOR_HOST") != "" {
+		projectID = tc.ProjectID
+	}
+	if projectID == "" {
+		b.Skip("Skipping firestore benchmark. Set GOLANG_SAMPLES_FIRESTORE_PROJECT.")
+	}
+	ctx := context.Background()
+	databaseID := testDatabaseID()
+	client, err := firestore.NewClientWithDatabase(ctx, projectID, databaseID)
+	if err != nil {
+		b.Fatalf("firestore.NewClientWithDatabase: %v", err)
+	}
+	defer client.Close()
+
+	b.Run("addDocAsMap", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if err := addDocAsMap(ctx, client); err != nil {
+				b.Fatalf("addDocAsMap: %v", err)
+			}
+		}
+	})
+
+	city := testutil.UniqueName("benchmark")
+	doc := client.Collection("cities").Doc(city)
+	if _, err := doc.Set(ctx, map[string]int{"population": 0}); err != nil {
+		b.Fatalf("Set: %v", err)
+	}
+	defer doc.Delete(ctx)
+	b.Run("updateDocumentIncrement", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if err := updateDocumentIncrement(projectID, databaseID, city); err != nil {
+				b.Fatalf("updateDocumentIncrement: %v", err)
+			}
+		}
+	})
+}
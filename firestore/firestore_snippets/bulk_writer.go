@@ -0,0 +1,79 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+// [START firestore_bulk_writer]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/api/option"
+)
+
+// bulkWrite writes n documents to collection with a BulkWriter. Unlike a
+// WriteBatch, a BulkWriter is not atomic and has no limit on the number of
+// writes: it sends them in parallel batches, ramps up its write rate
+// gradually, and retries writes that fail with retryable errors.
+func bulkWrite(ctx context.Context, w io.Writer, projectID, databaseID, collection string, n int, opts ...option.ClientOption) error {
+	// projectID := "project-id"
+	// databaseID := "(default)"
+	// collection := "cities"
+	// n := 1000
+	const timeout = time.Minute
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, err := firestore.NewClientWithDatabase(ctx, projectID, databaseID, opts...)
+	if err != nil {
+		return fmt.Errorf("firestore.NewClientWithDatabase: %w", err)
+	}
+	defer client.Close()
+
+	bw := client.BulkWriter(ctx)
+	jobs := make([]*firestore.BulkWriterJob, 0, n)
+	for i := 0; i < n; i++ {
+		doc := client.Collection(collection).Doc(fmt.Sprintf("doc-%05d", i))
+		job, err := bw.Set(doc, map[string]interface{}{"index": i})
+		if err != nil {
+			// Set only fails if the write cannot be enqueued, for example
+			// after End has been called.
+			return fmt.Errorf("BulkWriter.Set: %w", err)
+		}
+		jobs = append(jobs, job)
+	}
+	// End sends all enqueued writes and waits for them. Use Flush instead
+	// to wait for the writes so far while keeping the BulkWriter open.
+	bw.End()
+
+	// Each job reports the result of its own write; one failed write does
+	// not fail the others.
+	var failed int
+	for i, job := range jobs {
+		if _, err := job.Results(); err != nil {
+			failed++
+			fmt.Fprintf(w, "Write %d failed: %v\n", i, err)
+		}
+	}
+	fmt.Fprintf(w, "Wrote %d documents, %d failed\n", n-failed, failed)
+	if failed > 0 {
+		return fmt.Errorf("%d of %d writes failed", failed, n)
+	}
+	return nil
+}
+
+// [END firestore_bulk_writer]
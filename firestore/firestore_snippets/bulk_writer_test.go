@@ -0,0 +1,66 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func TestBulkWriter(t *testing.T) {
+	ctx := context.Background()
+	client, projectID, databaseID, collection := setup(ctx, t)
+	defer client.Close()
+	collection += "-bulk"
+
+	// Start from an empty collection.
+	if _, err := bulkDelete(ctx, ioutil.Discard, projectID, databaseID, collection); err != nil {
+		t.Fatalf("bulkDelete: %v", err)
+	}
+
+	const n = 1200
+	buf := new(bytes.Buffer)
+	if err := bulkWrite(ctx, buf, projectID, databaseID, collection, n); err != nil {
+		t.Fatalf("bulkWrite: %v", err)
+	}
+	if got, want := buf.String(), "Wrote 1200 documents, 0 failed"; !strings.Contains(got, want) {
+		t.Errorf("bulkWrite got %q, want to contain %q", got, want)
+	}
+	docs, err := client.Collection(collection).DocumentRefs(ctx).GetAll()
+	if err != nil {
+		t.Fatalf("DocumentRefs: %v", err)
+	}
+	if len(docs) != n {
+		t.Errorf("got %d documents after bulkWrite, want %d", len(docs), n)
+	}
+
+	deleted, err := bulkDelete(ctx, ioutil.Discard, projectID, databaseID, collection)
+	if err != nil {
+		t.Fatalf("bulkDelete: %v", err)
+	}
+	if deleted != n {
+		t.Errorf("bulkDelete deleted %d documents, want %d", deleted, n)
+	}
+	docs, err = client.Collection(collection).DocumentRefs(ctx).GetAll()
+	if err != nil {
+		t.Fatalf("DocumentRefs: %v", err)
+	}
+	if len(docs) != 0 {
+		t.Errorf("got %d documents after bulkDelete, want 0", len(docs))
+	}
+}
@@ -20,19 +20,25 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"time"
 
 	"cloud.google.com/go/firestore"
 	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
 )
 
 // collectionGroupQuery runs a collection group query over the data created by
 // collectionGroupSetup.
-func collectionGroupQuery(w io.Writer, projectID string) error {
+func collectionGroupQuery(w io.Writer, projectID, databaseID string, opts ...option.ClientOption) error {
 	ctx := context.Background()
 
-	client, err := firestore.NewClient(ctx, projectID)
+	const timeout = 30 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, err := firestore.NewClientWithDatabase(ctx, projectID, databaseID, opts...)
 	if err != nil {
-		return fmt.Errorf("firestore.NewClient: %v", err)
+		return fmt.Errorf("firestore.NewClientWithDatabase: %w", err)
 	}
 	defer client.Close()
 
@@ -19,17 +19,23 @@ package main
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"cloud.google.com/go/firestore"
+	"google.golang.org/api/option"
 )
 
 // collectionGroupSetup sets up a collection group to query.
-func collectionGroupSetup(projectID, cityCollection string) error {
+func collectionGroupSetup(projectID, databaseID, cityCollection string, opts ...option.ClientOption) error {
 	ctx := context.Background()
 
-	client, err := firestore.NewClient(ctx, projectID)
+	const timeout = time.Minute
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, err := firestore.NewClientWithDatabase(ctx, projectID, databaseID, opts...)
 	if err != nil {
-		return fmt.Errorf("firestore.NewClient: %v", err)
+		return fmt.Errorf("firestore.NewClientWithDatabase: %w", err)
 	}
 	defer client.Close()
 
@@ -54,7 +60,7 @@ func collectionGroupSetup(projectID, cityCollection string) error {
 			"name": l.name,
 			"type": l.t,
 		}); err != nil {
-			return fmt.Errorf("Set: %v", err)
+			return fmt.Errorf("Set: %w", err)
 		}
 	}
 
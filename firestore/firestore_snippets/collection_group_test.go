@@ -36,9 +36,10 @@ func TestCollectionGroup(t *testing.T) {
 
 	ctx := context.Background()
 
-	client, err := firestore.NewClient(ctx, projectID)
+	databaseID := testDatabaseID()
+	client, err := firestore.NewClientWithDatabase(ctx, projectID, databaseID)
 	if err != nil {
-		t.Fatalf("firestore.NewClient: %v", err)
+		t.Fatalf("firestore.NewClientWithDatabase: %v", err)
 	}
 	defer client.Close()
 
@@ -53,12 +54,12 @@ func TestCollectionGroup(t *testing.T) {
 		}
 	}
 
-	if err := collectionGroupSetup(projectID, collection); err != nil {
+	if err := collectionGroupSetup(projectID, databaseID, collection); err != nil {
 		t.Fatalf("collectionGroupSetup: %v", err)
 	}
 
 	buf := &bytes.Buffer{}
-	if err := collectionGroupQuery(buf, projectID); err != nil {
+	if err := collectionGroupQuery(buf, projectID, databaseID); err != nil {
 		t.Fatalf("collectionGroupQuery: %v", err)
 	}
 	want := "Legion of Honor"
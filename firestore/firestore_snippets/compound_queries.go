@@ -0,0 +1,80 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+// [START firestore_query_filter_compound_operators]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/api/option"
+)
+
+// compoundQueries runs queries using the in, not-in, array-contains,
+// array-contains-any, and != operators, and a range on a single field.
+func compoundQueries(ctx context.Context, w io.Writer, projectID, databaseID, collection string, opts ...option.ClientOption) (map[string][]string, error) {
+	// projectID := "project-id"
+	// databaseID := "(default)"
+	// collection := "cities"
+	const timeout = 30 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, err := firestore.NewClientWithDatabase(ctx, projectID, databaseID, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("firestore.NewClientWithDatabase: %w", err)
+	}
+	defer client.Close()
+
+	cities := client.Collection(collection)
+	queries := []struct {
+		name  string
+		query firestore.Query
+	}{
+		// in matches any of up to 30 values.
+		{"country in [USA Japan]", cities.Where("country", "in", []string{"USA", "Japan"})},
+		// not-in matches none of the values. Documents without the field
+		// are never returned.
+		{"country not-in [USA Japan]", cities.Where("country", "not-in", []string{"USA", "Japan"})},
+		{"regions array-contains west_coast", cities.Where("regions", "array-contains", "west_coast")},
+		{"regions array-contains-any [west_coast east_coast]", cities.Where("regions", "array-contains-any", []string{"west_coast", "east_coast"})},
+		{"country != USA", cities.Where("country", "!=", "USA")},
+		// Like not-in, != skips documents where the field is missing, so
+		// cities stored without a state do not match.
+		{"state != CA", cities.Where("state", "!=", "CA")},
+		// Range filters on the same field combine into a single interval.
+		{"1000000 <= population < 10000000", cities.Where("population", ">=", 1000000).Where("population", "<", 10000000)},
+	}
+
+	results := make(map[string][]string)
+	for _, q := range queries {
+		docs, err := q.query.Documents(ctx).GetAll()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", q.name, err)
+		}
+		ids := []string{}
+		for _, doc := range docs {
+			ids = append(ids, doc.Ref.ID)
+		}
+		results[q.name] = ids
+		fmt.Fprintf(w, "%s: %v\n", q.name, ids)
+	}
+	return results, nil
+}
+
+// [END firestore_query_filter_compound_operators]
@@ -0,0 +1,64 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+// [START firestore_query_cursor_document_snapshot]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/api/option"
+)
+
+// cursorDocumentSnapshot starts a query after a document. Passing a
+// DocumentSnapshot as the cursor uses the document's values for every
+// OrderBy field, and its ID as a tie breaker, so documents with the same
+// population as the cursor are neither skipped nor repeated.
+func cursorDocumentSnapshot(ctx context.Context, w io.Writer, projectID, databaseID, collection, docID string, opts ...option.ClientOption) ([]string, error) {
+	// projectID := "project-id"
+	// databaseID := "(default)"
+	// collection := "cities"
+	// docID := "SF"
+	const timeout = 30 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, err := firestore.NewClientWithDatabase(ctx, projectID, databaseID, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("firestore.NewClientWithDatabase: %w", err)
+	}
+	defer client.Close()
+
+	cities := client.Collection(collection)
+	dsnap, err := cities.Doc(docID).Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Get: %w", err)
+	}
+	docs, err := cities.OrderBy("population", firestore.Asc).StartAfter(dsnap).Documents(ctx).GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("Documents: %w", err)
+	}
+	var ids []string
+	for _, doc := range docs {
+		ids = append(ids, doc.Ref.ID)
+	}
+	fmt.Fprintf(w, "Cities after %v: %v\n", docID, ids)
+	return ids, nil
+}
+
+// [END firestore_query_cursor_document_snapshot]
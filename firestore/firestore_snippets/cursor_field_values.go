@@ -0,0 +1,71 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+// [START firestore_query_cursor_field_values]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/api/option"
+)
+
+// cursorFieldValues runs queries bounded by cursors on field values. A
+// cursor takes one value for each OrderBy of the query: StartAt and EndAt
+// include documents equal to the cursor, StartAfter and EndBefore exclude
+// them.
+func cursorFieldValues(ctx context.Context, w io.Writer, projectID, databaseID, collection string, opts ...option.ClientOption) (map[string][]string, error) {
+	// projectID := "project-id"
+	// databaseID := "(default)"
+	// collection := "cities"
+	const timeout = 30 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, err := firestore.NewClientWithDatabase(ctx, projectID, databaseID, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("firestore.NewClientWithDatabase: %w", err)
+	}
+	defer client.Close()
+
+	byPopulation := client.Collection(collection).OrderBy("population", firestore.Asc)
+	queries := []struct {
+		name  string
+		query firestore.Query
+	}{
+		{"StartAt(860000)", byPopulation.StartAt(860000)},
+		{"StartAfter(860000)", byPopulation.StartAfter(860000)},
+		{"EndBefore(3900000)", byPopulation.EndBefore(3900000)},
+		{"StartAfter(680000).EndAt(9000000)", byPopulation.StartAfter(680000).EndAt(9000000)},
+	}
+
+	results := make(map[string][]string)
+	for _, q := range queries {
+		docs, err := q.query.Documents(ctx).GetAll()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", q.name, err)
+		}
+		for _, doc := range docs {
+			results[q.name] = append(results[q.name], doc.Ref.ID)
+		}
+		fmt.Fprintf(w, "%s: %v\n", q.name, results[q.name])
+	}
+	return results, nil
+}
+
+// [END firestore_query_cursor_field_values]
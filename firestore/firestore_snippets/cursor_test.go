@@ -0,0 +1,108 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"github.com/GoogleCloudPlatform/golang-samples/internal/testutil"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCursors(t *testing.T) {
+	ctx := context.Background()
+	client, projectID, databaseID, collection := setup(ctx, t)
+	defer client.Close()
+	collection += "-cursors"
+	testutil.SeedCollection(ctx, t, projectID, databaseID, collection, "cities")
+
+	got, err := cursorFieldValues(ctx, ioutil.Discard, projectID, databaseID, collection)
+	if err != nil {
+		t.Fatalf("cursorFieldValues: %v", err)
+	}
+	want := map[string][]string{
+		"StartAt(860000)":                   {"SF", "LA", "TOK", "BJ"},
+		"StartAfter(860000)":                {"LA", "TOK", "BJ"},
+		"EndBefore(3900000)":                {"DC", "SF"},
+		"StartAfter(680000).EndAt(9000000)": {"SF", "LA", "TOK"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("cursorFieldValues mismatch (-want +got):\n%s", diff)
+	}
+
+	ids, err := cursorDocumentSnapshot(ctx, ioutil.Discard, projectID, databaseID, collection, "LA")
+	if err != nil {
+		t.Fatalf("cursorDocumentSnapshot: %v", err)
+	}
+	if diff := cmp.Diff([]string{"TOK", "BJ"}, ids); diff != "" {
+		t.Errorf("cursorDocumentSnapshot mismatch (-want +got):\n%s", diff)
+	}
+
+	pages, err := paginateCollection(ctx, ioutil.Discard, projectID, databaseID, collection, 2)
+	if err != nil {
+		t.Fatalf("paginateCollection: %v", err)
+	}
+	wantPages := [][]string{{"DC", "SF"}, {"LA", "TOK"}, {"BJ"}}
+	if diff := cmp.Diff(wantPages, pages); diff != "" {
+		t.Errorf("paginateCollection mismatch (-want +got):\n%s", diff)
+	}
+
+	var tokenPages [][]string
+	token := ""
+	for {
+		ids, next, err := listCitiesPage(ctx, ioutil.Discard, projectID, databaseID, collection, 2, token)
+		if err != nil {
+			t.Fatalf("listCitiesPage(%q): %v", token, err)
+		}
+		tokenPages = append(tokenPages, ids)
+		if next == "" {
+			break
+		}
+		token = next
+	}
+	if diff := cmp.Diff(wantPages, tokenPages); diff != "" {
+		t.Errorf("listCitiesPage mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestPageToken(t *testing.T) {
+	for _, c := range []pageCursor{
+		{Value: int64(860000), ID: "SF"},
+		{Value: "San Francisco", ID: "SF"},
+		{Value: time.Date(2026, 1, 2, 3, 4, 5, 6, time.UTC), ID: "SF"},
+	} {
+		token, err := c.token()
+		if err != nil {
+			t.Fatalf("token(%v): %v", c, err)
+		}
+		p, err := newPager(firestore.Query{}, "population", firestore.Asc, token)
+		if err != nil {
+			t.Fatalf("newPager(%q): %v", token, err)
+		}
+		if diff := cmp.Diff(&c, p.cursor); diff != "" {
+			t.Errorf("newPager(%q) cursor mismatch (-want +got):\n%s", token, diff)
+		}
+	}
+
+	for _, bad := range []string{"not a token!", "aGVsbG8"} {
+		if _, err := newPager(firestore.Query{}, "population", firestore.Asc, bad); err == nil {
+			t.Errorf("newPager(%q) got nil error, want invalid page token", bad)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+// [START firestore_data_delete_fields]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/api/option"
+)
+
+// deleteFields removes fields from a document, leaving its other fields
+// as is. Each path can name a top-level field or a field nested in a map.
+func deleteFields(ctx context.Context, w io.Writer, projectID, databaseID, collection, docID string, paths []firestore.FieldPath, opts ...option.ClientOption) error {
+	// projectID := "project-id"
+	// databaseID := "(default)"
+	// collection := "cities"
+	// docID := "SF"
+	// paths := []firestore.FieldPath{
+	// 	{"capital"},
+	// 	{"address", "zip"},    // The zip key of the address map.
+	// 	{"tags.2024", "note"}, // Keys can contain dots.
+	// }
+	const timeout = 30 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, err := firestore.NewClientWithDatabase(ctx, projectID, databaseID, opts...)
+	if err != nil {
+		return fmt.Errorf("firestore.NewClientWithDatabase: %w", err)
+	}
+	defer client.Close()
+
+	// Update.Path also accepts dotted paths like "address.zip", but
+	// FieldPath is needed when a key contains a dot or other special
+	// characters.
+	var updates []firestore.Update
+	for _, p := range paths {
+		updates = append(updates, firestore.Update{FieldPath: p, Value: firestore.Delete})
+	}
+	if _, err := client.Collection(collection).Doc(docID).Update(ctx, updates); err != nil {
+		return fmt.Errorf("Update: %w", err)
+	}
+	fmt.Fprintf(w, "Deleted %d fields from %v\n", len(paths), docID)
+	return nil
+}
+
+// [END firestore_data_delete_fields]
@@ -0,0 +1,59 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+
+	"cloud.google.com/go/firestore"
+	"github.com/GoogleCloudPlatform/golang-samples/internal/testutil"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDeleteFields(t *testing.T) {
+	ctx := context.Background()
+	client, projectID, databaseID, collection := setup(ctx, t)
+	defer client.Close()
+	collection += "-delete-fields"
+	testutil.SeedCollection(ctx, t, projectID, databaseID, collection, "city_fields")
+
+	paths := []firestore.FieldPath{
+		{"capital"},
+		{"address", "zip"},
+		{"tags.2024", "note"},
+	}
+	if err := deleteFields(ctx, ioutil.Discard, projectID, databaseID, collection, "SF", paths); err != nil {
+		t.Fatalf("deleteFields: %v", err)
+	}
+
+	snap, err := client.Collection(collection).Doc("SF").Get(ctx)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	want := map[string]interface{}{
+		"name": "San Francisco",
+		"address": map[string]interface{}{
+			"street": "1 Dr Carlton B Goodlett Pl",
+		},
+		"tags.2024": map[string]interface{}{
+			"other": "keep me",
+		},
+	}
+	if diff := cmp.Diff(want, snap.Data()); diff != "" {
+		t.Errorf("deleteFields mismatch (-want +got):\n%s", diff)
+	}
+}
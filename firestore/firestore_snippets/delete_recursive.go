@@ -0,0 +1,101 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+// [START firestore_data_delete_doc_recursive]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+)
+
+// deleteDocumentRecursive deletes a document and every document in its
+// subcollections, at any depth. Deleting only the document leaves its
+// subcollections in place: they are still returned by queries and still
+// billed, but no longer reachable from the parent.
+func deleteDocumentRecursive(ctx context.Context, w io.Writer, projectID, databaseID, docPath string, opts ...option.ClientOption) (int, error) {
+	// projectID := "project-id"
+	// databaseID := "(default)"
+	// docPath := "cities/SF"
+	const timeout = time.Minute
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, err := firestore.NewClientWithDatabase(ctx, projectID, databaseID, opts...)
+	if err != nil {
+		return 0, fmt.Errorf("firestore.NewClientWithDatabase: %w", err)
+	}
+	defer client.Close()
+
+	bw := client.BulkWriter(ctx)
+	var jobs []*firestore.BulkWriterJob
+	if err := enqueueDeletes(ctx, bw, client.Doc(docPath), &jobs); err != nil {
+		bw.End()
+		return 0, err
+	}
+	bw.End()
+
+	var deleted int
+	for _, job := range jobs {
+		if _, err := job.Results(); err != nil {
+			return deleted, fmt.Errorf("BulkWriter: %w", err)
+		}
+		deleted++
+	}
+	fmt.Fprintf(w, "Deleted %d documents under %v\n", deleted, docPath)
+	return deleted, nil
+}
+
+// enqueueDeletes adds a delete for doc and for all of its descendants to bw.
+func enqueueDeletes(ctx context.Context, bw *firestore.BulkWriter, doc *firestore.DocumentRef, jobs *[]*firestore.BulkWriterJob) error {
+	cols := doc.Collections(ctx)
+	for {
+		col, err := cols.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("Collections(%v): %w", doc.Path, err)
+		}
+		// DocumentRefs also returns documents that do not exist but have
+		// subcollections of their own.
+		refs := col.DocumentRefs(ctx)
+		for {
+			ref, err := refs.Next()
+			if err == iterator.Done {
+				break
+			}
+			if err != nil {
+				return fmt.Errorf("DocumentRefs(%v): %w", col.Path, err)
+			}
+			if err := enqueueDeletes(ctx, bw, ref, jobs); err != nil {
+				return err
+			}
+		}
+	}
+	job, err := bw.Delete(doc)
+	if err != nil {
+		return fmt.Errorf("BulkWriter.Delete(%v): %w", doc.Path, err)
+	}
+	*jobs = append(*jobs, job)
+	return nil
+}
+
+// [END firestore_data_delete_doc_recursive]
@@ -0,0 +1,60 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/golang-samples/internal/testutil"
+	"google.golang.org/api/iterator"
+)
+
+func TestDeleteDocumentRecursive(t *testing.T) {
+	ctx := context.Background()
+	client, projectID, databaseID, collection := setup(ctx, t)
+	defer client.Close()
+	collection += "-recursive"
+	testutil.SeedCollection(ctx, t, projectID, databaseID, collection, "city_tree")
+
+	city := client.Collection(collection).Doc("SF")
+	docs := []string{
+		"",
+		"/neighborhoods/mission",
+		"/neighborhoods/mission/landmarks/dolores-park",
+		"/neighborhoods/soma/landmarks/moscone",
+		"/landmarks/golden-gate",
+	}
+
+	got, err := deleteDocumentRecursive(ctx, ioutil.Discard, projectID, databaseID, collection+"/SF")
+	if err != nil {
+		t.Fatalf("deleteDocumentRecursive: %v", err)
+	}
+	// neighborhoods/soma was never written, but its delete is still sent.
+	if want := len(docs) + 1; got != want {
+		t.Errorf("deleteDocumentRecursive got %d deleted, want %d", got, want)
+	}
+
+	if _, err := city.Collections(ctx).Next(); err != iterator.Done {
+		t.Errorf("Collections.Next got err %v, want iterator.Done", err)
+	}
+	for _, d := range docs {
+		snap, err := client.Doc(city.Path + d).Get(ctx)
+		if snap.Exists() {
+			t.Errorf("Doc(%q) still exists, err %v", d, err)
+		}
+	}
+}
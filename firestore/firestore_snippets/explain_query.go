@@ -0,0 +1,90 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+// [START firestore_query_explain]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+)
+
+// explainQuery reports how Firestore plans to run a query. With analyze
+// false, only the plan is returned and the query is not run. With analyze
+// true, the query runs and its results and execution statistics are
+// returned too, and billed as a normal query.
+//
+// Many more documents or index entries scanned than results returned
+// usually means a missing composite index.
+func explainQuery(ctx context.Context, w io.Writer, projectID, databaseID, collection string, analyze bool, opts ...option.ClientOption) (*firestore.ExplainMetrics, error) {
+	// projectID := "project-id"
+	// databaseID := "(default)"
+	// collection := "cities"
+	const timeout = 30 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, err := firestore.NewClientWithDatabase(ctx, projectID, databaseID, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("firestore.NewClientWithDatabase: %w", err)
+	}
+	defer client.Close()
+
+	q := client.Collection(collection).Where("state", "==", "CA").
+		WithRunOptions(firestore.ExplainOptions{Analyze: analyze})
+	it := q.Documents(ctx)
+	defer it.Stop()
+	// Metrics are only available once the iterator is exhausted.
+	for {
+		_, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("Documents: %w", err)
+		}
+	}
+	metrics, err := it.ExplainMetrics()
+	if err != nil {
+		return nil, fmt.Errorf("ExplainMetrics: %w", err)
+	}
+
+	if ps := metrics.PlanSummary; ps != nil {
+		for _, index := range ps.IndexesUsed {
+			fmt.Fprintf(w, "Index used: %v\n", *index)
+		}
+	}
+	if es := metrics.ExecutionStats; es != nil {
+		fmt.Fprintf(w, "Results returned: %d\n", es.ResultsReturned)
+		fmt.Fprintf(w, "Read operations: %d\n", es.ReadOperations)
+		if es.ExecutionDuration != nil {
+			fmt.Fprintf(w, "Execution duration: %v\n", *es.ExecutionDuration)
+		}
+		if es.DebugStats != nil {
+			// Includes documents_scanned and index_entries_scanned.
+			for k, v := range *es.DebugStats {
+				fmt.Fprintf(w, "%s: %v\n", k, v)
+			}
+		}
+	}
+	return metrics, nil
+}
+
+// [END firestore_query_explain]
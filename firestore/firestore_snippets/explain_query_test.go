@@ -0,0 +1,53 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/golang-samples/internal/testutil"
+)
+
+func TestExplainQuery(t *testing.T) {
+	ctx := context.Background()
+	client, projectID, databaseID, collection := setup(ctx, t)
+	defer client.Close()
+	collection += "-explain"
+	testutil.SeedCollection(ctx, t, projectID, databaseID, collection, "cities")
+
+	metrics, err := explainQuery(ctx, ioutil.Discard, projectID, databaseID, collection, false)
+	if err != nil {
+		t.Fatalf("explainQuery(plan only): %v", err)
+	}
+	if metrics.PlanSummary == nil || len(metrics.PlanSummary.IndexesUsed) == 0 {
+		t.Errorf("explainQuery(plan only) got plan %+v, want at least one index used", metrics.PlanSummary)
+	}
+	if metrics.ExecutionStats != nil {
+		t.Errorf("explainQuery(plan only) got execution stats %+v, want none", metrics.ExecutionStats)
+	}
+
+	metrics, err = explainQuery(ctx, ioutil.Discard, projectID, databaseID, collection, true)
+	if err != nil {
+		t.Fatalf("explainQuery(analyze): %v", err)
+	}
+	if metrics.ExecutionStats == nil {
+		t.Fatalf("explainQuery(analyze) got no execution stats")
+	}
+	if got, want := metrics.ExecutionStats.ResultsReturned, int64(2); got != want {
+		t.Errorf("explainQuery(analyze) got %d results returned, want %d", got, want)
+	}
+}
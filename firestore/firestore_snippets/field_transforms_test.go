@@ -0,0 +1,92 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/GoogleCloudPlatform/golang-samples/internal/testutil"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestUpdateServerTimestamp(t *testing.T) {
+	ctx := context.Background()
+	client, projectID, databaseID, _ := setup(ctx, t)
+	defer client.Close()
+	// The test only touches its own documents, so it shares the collection.
+	collection := testutil.SharedCollection(ctx, t, projectID, databaseID)
+	docID := testutil.Prefix(t) + "timestamp"
+
+	doc := client.Collection(collection).Doc(docID)
+	if _, err := doc.Set(ctx, map[string]interface{}{"name": "timestamp"}); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	if err := updateServerTimestamp(ctx, ioutil.Discard, projectID, databaseID, collection, docID); err != nil {
+		t.Fatalf("updateServerTimestamp: %v", err)
+	}
+	snap, err := doc.Get(ctx)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	got, ok := snap.Data()["updatedAt"].(time.Time)
+	if !ok {
+		t.Fatalf("updatedAt got %T, want time.Time", snap.Data()["updatedAt"])
+	}
+	if !got.Equal(snap.UpdateTime) {
+		t.Errorf("updatedAt got %v, want the document update time %v", got, snap.UpdateTime)
+	}
+}
+
+func TestArrayUnionAndRemove(t *testing.T) {
+	ctx := context.Background()
+	client, projectID, databaseID, _ := setup(ctx, t)
+	defer client.Close()
+	// The test only touches its own documents, so it shares the collection.
+	collection := testutil.SharedCollection(ctx, t, projectID, databaseID)
+	docID := testutil.Prefix(t) + "arrays"
+
+	doc := client.Collection(collection).Doc(docID)
+	if _, err := doc.Set(ctx, City{Name: "arrays", Regions: []string{"a", "b"}}); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	regions := func() []string {
+		snap, err := doc.Get(ctx)
+		if err != nil {
+			t.Fatalf("Get: %v", err)
+		}
+		var c City
+		if err := snap.DataTo(&c); err != nil {
+			t.Fatalf("DataTo: %v", err)
+		}
+		return c.Regions
+	}
+
+	if err := addRegions(ctx, ioutil.Discard, projectID, databaseID, collection, docID, []string{"b", "c"}); err != nil {
+		t.Fatalf("addRegions: %v", err)
+	}
+	if diff := cmp.Diff([]string{"a", "b", "c"}, regions()); diff != "" {
+		t.Errorf("addRegions mismatch (-want +got):\n%s", diff)
+	}
+
+	if err := removeRegions(ctx, ioutil.Discard, projectID, databaseID, collection, docID, []string{"a", "x"}); err != nil {
+		t.Fatalf("removeRegions: %v", err)
+	}
+	if diff := cmp.Diff([]string{"b", "c"}, regions()); diff != "" {
+		t.Errorf("removeRegions mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+// [START firestore_data_get_all_documents_batch]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// getAllCities reads the cities with the given IDs in a single call, and
+// compares it with reading them one at a time. It returns the IDs of the
+// cities that exist and of those that do not.
+func getAllCities(ctx context.Context, w io.Writer, projectID, databaseID, collection string, ids []string, opts ...option.ClientOption) (found, missing []string, err error) {
+	// projectID := "project-id"
+	// databaseID := "(default)"
+	// collection := "cities"
+	// ids := []string{"SF", "LA", "DC"}
+	const timeout = 30 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, err := firestore.NewClientWithDatabase(ctx, projectID, databaseID, opts...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("firestore.NewClientWithDatabase: %w", err)
+	}
+	defer client.Close()
+
+	refs := make([]*firestore.DocumentRef, len(ids))
+	for i, id := range ids {
+		refs[i] = client.Collection(collection).Doc(id)
+	}
+
+	start := time.Now()
+	// GetAll returns one snapshot per ref, in the same order. A missing
+	// document is not an error: its snapshot just does not exist.
+	snaps, err := client.GetAll(ctx, refs)
+	if err != nil {
+		return nil, nil, fmt.Errorf("GetAll: %w", err)
+	}
+	batched := time.Since(start)
+	for _, snap := range snaps {
+		if !snap.Exists() {
+			missing = append(missing, snap.Ref.ID)
+			continue
+		}
+		found = append(found, snap.Ref.ID)
+	}
+
+	// Each Get is a round trip to the server, and a missing document is a
+	// NotFound error.
+	start = time.Now()
+	for _, ref := range refs {
+		_, err := ref.Get(ctx)
+		if err != nil && status.Code(err) != codes.NotFound {
+			return nil, nil, fmt.Errorf("Get: %w", err)
+		}
+	}
+	sequential := time.Since(start)
+
+	fmt.Fprintf(w, "Found %v, missing %v\n", found, missing)
+	fmt.Fprintf(w, "GetAll of %d documents: %v\n", len(refs), batched)
+	fmt.Fprintf(w, "%d sequential Gets: %v\n", len(refs), sequential)
+	return found, missing, nil
+}
+
+// [END firestore_data_get_all_documents_batch]
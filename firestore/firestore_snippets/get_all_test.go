@@ -0,0 +1,48 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/golang-samples/internal/testutil"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGetAllCities(t *testing.T) {
+	ctx := context.Background()
+	client, projectID, databaseID, collection := setup(ctx, t)
+	defer client.Close()
+	collection += "-get-all"
+	testutil.SeedCollection(ctx, t, projectID, databaseID, collection, "cities")
+
+	buf := &bytes.Buffer{}
+	found, missing, err := getAllCities(ctx, buf, projectID, databaseID, collection, []string{"SF", "NYC", "TOK", "LA", "PAR"})
+	if err != nil {
+		t.Fatalf("getAllCities: %v", err)
+	}
+	if diff := cmp.Diff([]string{"SF", "TOK", "LA"}, found); diff != "" {
+		t.Errorf("getAllCities found mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"NYC", "PAR"}, missing); diff != "" {
+		t.Errorf("getAllCities missing mismatch (-want +got):\n%s", diff)
+	}
+	if got, want := buf.String(), "5 sequential Gets"; !strings.Contains(got, want) {
+		t.Errorf("getAllCities got %q, want to contain %q", got, want)
+	}
+}
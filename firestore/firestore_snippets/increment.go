@@ -19,20 +19,27 @@ package main
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"cloud.google.com/go/firestore"
+	"google.golang.org/api/option"
 )
 
 // updateDocumentIncrement increments the population of the city document in the
 // cities collection by 50.
-func updateDocumentIncrement(projectID, city string) error {
+func updateDocumentIncrement(projectID, databaseID, city string, opts ...option.ClientOption) error {
 	// projectID := "my-project"
+	// databaseID := "(default)"
 
 	ctx := context.Background()
 
-	client, err := firestore.NewClient(ctx, projectID)
+	const timeout = 30 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, err := firestore.NewClientWithDatabase(ctx, projectID, databaseID, opts...)
 	if err != nil {
-		return fmt.Errorf("firestore.NewClient: %v", err)
+		return fmt.Errorf("firestore.NewClientWithDatabase: %w", err)
 	}
 
 	dc := client.Collection("cities").Doc(city)
@@ -40,7 +47,7 @@ func updateDocumentIncrement(projectID, city string) error {
 		{Path: "population", Value: firestore.Increment(50)},
 	})
 	if err != nil {
-		return fmt.Errorf("Update: %v", err)
+		return fmt.Errorf("Update: %w", err)
 	}
 
 	return nil
@@ -34,9 +34,10 @@ func TestUpdateDocumentIncrement(t *testing.T) {
 
 	ctx := context.Background()
 
-	client, err := firestore.NewClient(ctx, projectID)
+	databaseID := testDatabaseID()
+	client, err := firestore.NewClientWithDatabase(ctx, projectID, databaseID)
 	if err != nil {
-		t.Fatalf("firestore.NewClient: %v", err)
+		t.Fatalf("firestore.NewClientWithDatabase: %v", err)
 	}
 	defer client.Close()
 
@@ -45,7 +46,7 @@ func TestUpdateDocumentIncrement(t *testing.T) {
 	data := map[string]int{"population": 100}
 	dc.Set(ctx, data)
 
-	if err := updateDocumentIncrement(projectID, city); err != nil {
+	if err := updateDocumentIncrement(projectID, databaseID, city); err != nil {
 		t.Fatalf("updateDocumentIncrement: %v", err)
 	}
 
@@ -22,19 +22,21 @@ import (
 	"time"
 
 	"cloud.google.com/go/firestore"
+	"google.golang.org/api/option"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 // listenChanges listens to a query, returning the list of document changes.
-func listenChanges(ctx context.Context, w io.Writer, projectID, collection string) error {
+func listenChanges(ctx context.Context, w io.Writer, projectID, databaseID, collection string, opts ...option.ClientOption) error {
 	// projectID := "project-id"
+	// databaseID := "(default)"
 	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
-	client, err := firestore.NewClient(ctx, projectID)
+	client, err := firestore.NewClientWithDatabase(ctx, projectID, databaseID, opts...)
 	if err != nil {
-		return fmt.Errorf("firestore.NewClient: %v", err)
+		return fmt.Errorf("firestore.NewClientWithDatabase: %w", err)
 	}
 	defer client.Close()
 
@@ -46,7 +48,7 @@ func listenChanges(ctx context.Context, w io.Writer, projectID, collection strin
 			return nil
 		}
 		if err != nil {
-			return fmt.Errorf("Snapshots.Next: %v", err)
+			return fmt.Errorf("Snapshots.Next: %w", err)
 		}
 		if snap != nil {
 			for _, change := range snap.Changes {
@@ -0,0 +1,116 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+// [START firestore_listen_query_diffs]
+import (
+	"context"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/api/option"
+)
+
+// listenDiffs listens to a collection until ctx is done and writes a line
+// for every change: "+" for an added document, "~" for each modified field,
+// and "-" for a removed document. The first snapshot reports every existing
+// document as added.
+func listenDiffs(ctx context.Context, w io.Writer, projectID, databaseID, collection string, opts ...option.ClientOption) error {
+	// projectID := "project-id"
+	// databaseID := "(default)"
+	// collection := "cities"
+	client, err := firestore.NewClientWithDatabase(ctx, projectID, databaseID, opts...)
+	if err != nil {
+		return fmt.Errorf("firestore.NewClientWithDatabase: %w", err)
+	}
+	defer client.Close()
+
+	// Changes only carry the new version of a document, so keep the last
+	// seen version to diff against.
+	seen := make(map[string]map[string]interface{})
+	it := client.Collection(collection).Snapshots(ctx)
+	defer it.Stop()
+	for {
+		snap, err := it.Next()
+		if err != nil {
+			// The iterator returns an error once ctx is done.
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("Snapshots.Next: %w", err)
+		}
+		for _, change := range snap.Changes {
+			id := change.Doc.Ref.ID
+			switch change.Kind {
+			case firestore.DocumentAdded:
+				data := change.Doc.Data()
+				fmt.Fprintf(w, "+ %s %s\n", id, formatFields(data))
+				seen[id] = data
+			case firestore.DocumentModified:
+				data := change.Doc.Data()
+				for _, d := range diffFields(seen[id], data) {
+					fmt.Fprintf(w, "~ %s %s\n", id, d)
+				}
+				seen[id] = data
+			case firestore.DocumentRemoved:
+				fmt.Fprintf(w, "- %s\n", id)
+				delete(seen, id)
+			}
+		}
+	}
+}
+
+// formatFields formats data as key=value pairs sorted by key.
+func formatFields(data map[string]interface{}) string {
+	var fields []string
+	for k, v := range data {
+		fields = append(fields, fmt.Sprintf("%s=%v", k, v))
+	}
+	sort.Strings(fields)
+	return "{" + strings.Join(fields, ", ") + "}"
+}
+
+// diffFields describes each field that differs between old and new, sorted by
+// field name.
+func diffFields(old, new map[string]interface{}) []string {
+	keys := make(map[string]bool)
+	for k := range old {
+		keys[k] = true
+	}
+	for k := range new {
+		keys[k] = true
+	}
+	var diffs []string
+	for k := range keys {
+		o, inOld := old[k]
+		n, inNew := new[k]
+		switch {
+		case !inOld:
+			diffs = append(diffs, fmt.Sprintf("%s: <none> -> %v", k, n))
+		case !inNew:
+			diffs = append(diffs, fmt.Sprintf("%s: %v -> <none>", k, o))
+		case !reflect.DeepEqual(o, n):
+			diffs = append(diffs, fmt.Sprintf("%s: %v -> %v", k, o, n))
+		}
+	}
+	sort.Strings(diffs)
+	return diffs
+}
+
+// [END firestore_listen_query_diffs]
@@ -0,0 +1,76 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"github.com/GoogleCloudPlatform/golang-samples/internal/testutil"
+)
+
+func TestListenDiffs(t *testing.T) {
+	ctx := context.Background()
+	client, projectID, databaseID, collection := setup(ctx, t)
+	defer client.Close()
+	collection += "-diffs"
+	testutil.SeedCollection(ctx, t, projectID, databaseID, collection, "cities")
+
+	ctx, cancel := context.WithTimeout(ctx, duration)
+	defer cancel()
+
+	buf := &bytes.Buffer{}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if err := listenDiffs(ctx, buf, projectID, databaseID, collection); err != nil {
+			t.Errorf("listenDiffs: %v", err)
+		}
+	}()
+
+	// Give the listener time to receive the initial snapshot.
+	time.Sleep(2 * time.Second)
+	cities := client.Collection(collection)
+	if _, err := cities.Doc("LA").Update(ctx, []firestore.Update{
+		{Path: "population", Value: 4000000},
+		{Path: "state", Value: firestore.Delete},
+	}); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	if _, err := cities.Doc("SEA").Set(ctx, City{Name: "Seattle", State: "WA", Country: "USA"}); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	if _, err := cities.Doc("DC").Delete(ctx); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	<-done
+
+	got := buf.String()
+	for _, want := range []string{
+		"+ TOK {capital=true, country=Japan, name=Tokyo, population=9000000, regions=[kanto honshu]}\n",
+		"~ LA population: 3900000 -> 4000000\n",
+		"~ LA state: CA -> <none>\n",
+		"+ SEA {country=USA, name=Seattle, state=WA}\n",
+		"- DC\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("listenDiffs got\n----\n%s\n----\nWant to contain:\n----\n%s\n----", got, want)
+		}
+	}
+}
@@ -22,22 +22,24 @@ import (
 	"time"
 
 	"cloud.google.com/go/firestore"
+	"google.golang.org/api/option"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 // listenDocument listens to a single document.
-func listenDocument(ctx context.Context, w io.Writer, projectID, collection string) error {
+func listenDocument(ctx context.Context, w io.Writer, projectID, databaseID, collection string, opts ...option.ClientOption) error {
 	// projectID := "project-id"
+	// databaseID := "(default)"
 	// [START firestore_listen_detach]
 	// Сontext with timeout stops listening to changes.
 	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 	// [END firestore_listen_detach]
 
-	client, err := firestore.NewClient(ctx, projectID)
+	client, err := firestore.NewClientWithDatabase(ctx, projectID, databaseID, opts...)
 	if err != nil {
-		return fmt.Errorf("firestore.NewClient: %v", err)
+		return fmt.Errorf("firestore.NewClientWithDatabase: %w", err)
 	}
 	defer client.Close()
 
@@ -49,7 +51,7 @@ func listenDocument(ctx context.Context, w io.Writer, projectID, collection stri
 			return nil
 		}
 		if err != nil {
-			return fmt.Errorf("Snapshots.Next: %v", err)
+			return fmt.Errorf("Snapshots.Next: %w", err)
 		}
 		if !snap.Exists() {
 			fmt.Fprintf(w, "Document no longer exists\n")
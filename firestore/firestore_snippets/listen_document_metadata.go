@@ -0,0 +1,71 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+// [START firestore_listen_document_metadata]
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/api/option"
+)
+
+// watchDocument listens to a document until ctx is cancelled. The first
+// snapshot is the state of the document when listening starts, and each
+// later one follows a change to it. It returns the number of changes seen
+// after the first snapshot.
+func watchDocument(ctx context.Context, w io.Writer, projectID, databaseID, collection, docID string, opts ...option.ClientOption) (int, error) {
+	// projectID := "project-id"
+	// databaseID := "(default)"
+	// collection := "cities"
+	// docID := "SF"
+	client, err := firestore.NewClientWithDatabase(ctx, projectID, databaseID, opts...)
+	if err != nil {
+		return 0, fmt.Errorf("firestore.NewClientWithDatabase: %w", err)
+	}
+	defer client.Close()
+
+	it := client.Collection(collection).Doc(docID).Snapshots(ctx)
+	defer it.Stop()
+	changes := 0
+	for initial := true; ; initial = false {
+		snap, err := it.Next()
+		if err != nil {
+			// Cancelling ctx is the way to stop listening, not an error.
+			if ctx.Err() != nil {
+				return changes, nil
+			}
+			return changes, fmt.Errorf("Snapshots.Next: %w", err)
+		}
+		switch {
+		case initial && !snap.Exists():
+			fmt.Fprintf(w, "Initial: %v does not exist (read at %v)\n", docID, snap.ReadTime)
+		case initial:
+			fmt.Fprintf(w, "Initial: %v (updated at %v)\n", snap.Data(), snap.UpdateTime)
+		case !snap.Exists():
+			// The listener keeps running and reports the document again
+			// if it is recreated.
+			changes++
+			fmt.Fprintf(w, "Deleted at %v\n", snap.ReadTime)
+		default:
+			changes++
+			fmt.Fprintf(w, "Updated: %v (at %v)\n", snap.Data(), snap.UpdateTime)
+		}
+	}
+}
+
+// [END firestore_listen_document_metadata]
@@ -0,0 +1,85 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"github.com/GoogleCloudPlatform/golang-samples/internal/testutil"
+)
+
+func TestWatchDocument(t *testing.T) {
+	ctx := context.Background()
+	client, projectID, databaseID, collection := setup(ctx, t)
+	defer client.Close()
+	collection += "-watch"
+
+	testutil.SeedCollection(ctx, t, projectID, databaseID, collection, "cities")
+	doc := client.Collection(collection).Doc("SF")
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	buf := &bytes.Buffer{}
+	type result struct {
+		changes int
+		err     error
+	}
+	done := make(chan result)
+	go func() {
+		changes, err := watchDocument(ctx, buf, projectID, databaseID, collection, "SF")
+		done <- result{changes, err}
+	}()
+
+	// Give the listener time to receive the initial snapshot, and each
+	// change time to arrive before the next one.
+	time.Sleep(2 * time.Second)
+	if _, err := doc.Update(ctx, []firestore.Update{{Path: "population", Value: 870000}}); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	time.Sleep(time.Second)
+	if _, err := doc.Delete(ctx); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	time.Sleep(2 * time.Second)
+	cancel()
+
+	select {
+	case r := <-done:
+		if r.err != nil {
+			t.Fatalf("watchDocument: %v", r.err)
+		}
+		if r.changes != 2 {
+			t.Errorf("watchDocument got %d changes, want 2", r.changes)
+		}
+	case <-time.After(duration):
+		t.Fatalf("watchDocument did not return after ctx was cancelled")
+	}
+
+	got := buf.String()
+	for _, want := range []string{
+		"Initial: map[country:USA name:San Francisco population:860000 regions:[west_coast norcal] state:CA]",
+		"Updated: map[country:USA name:San Francisco population:870000 regions:[west_coast norcal] state:CA]",
+		"Deleted at",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("watchDocument got\n----\n%s\n----\nWant to contain:\n----\n%s\n----", got, want)
+		}
+	}
+}
@@ -23,20 +23,22 @@ import (
 
 	"cloud.google.com/go/firestore"
 	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 // listenMultiple listens to a query, returning the names of all cities
 // for a state.
-func listenMultiple(ctx context.Context, w io.Writer, projectID, collection string) error {
+func listenMultiple(ctx context.Context, w io.Writer, projectID, databaseID, collection string, opts ...option.ClientOption) error {
 	// projectID := "project-id"
+	// databaseID := "(default)"
 	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
-	client, err := firestore.NewClient(ctx, projectID)
+	client, err := firestore.NewClientWithDatabase(ctx, projectID, databaseID, opts...)
 	if err != nil {
-		return fmt.Errorf("firestore.NewClient: %v", err)
+		return fmt.Errorf("firestore.NewClientWithDatabase: %w", err)
 	}
 	defer client.Close()
 
@@ -48,7 +50,7 @@ func listenMultiple(ctx context.Context, w io.Writer, projectID, collection stri
 			return nil
 		}
 		if err != nil {
-			return fmt.Errorf("Snapshots.Next: %v", err)
+			return fmt.Errorf("Snapshots.Next: %w", err)
 		}
 		if snap != nil {
 			for {
@@ -57,7 +59,7 @@ func listenMultiple(ctx context.Context, w io.Writer, projectID, collection stri
 					break
 				}
 				if err != nil {
-					return fmt.Errorf("Documents.Next: %v", err)
+					return fmt.Errorf("Documents.Next: %w", err)
 				}
 				fmt.Fprintf(w, "Current cities in California: %v\n", doc.Ref.ID)
 			}
@@ -30,73 +30,64 @@ import (
 
 var duration time.Duration = 15 * time.Second
 
-func setup(ctx context.Context, t *testing.T) (*firestore.Client, string, string) {
+// testDatabaseID returns the database the tests run against. Set
+// GOLANG_SAMPLES_FIRESTORE_DATABASE to use a named database.
+func testDatabaseID() string {
+	if id := os.Getenv("GOLANG_SAMPLES_FIRESTORE_DATABASE"); id != "" {
+		return id
+	}
+	return firestore.DefaultDatabaseID
+}
+
+func setup(ctx context.Context, t *testing.T) (*firestore.Client, string, string, string) {
 	tc := testutil.SystemTest(t)
 	projectID := os.Getenv("GOLANG_SAMPLES_FIRESTORE_PROJECT")
 	if projectID == "" {
 		t.Skip("Skipping firestore test. Set GOLANG_SAMPLES_FIRESTORE_PROJECT.")
 	}
+	databaseID := testDatabaseID()
 	collection := tc.ProjectID + "-collection-cities"
 
-	client, err := firestore.NewClient(ctx, projectID)
+	client, err := firestore.NewClientWithDatabase(ctx, projectID, databaseID)
 	if err != nil {
-		t.Fatalf("firestore.NewClient: %v", err)
+		t.Fatalf("firestore.NewClientWithDatabase: %v", err)
 	}
-	return client, projectID, collection
+	return client, projectID, databaseID, collection
 }
 
 func TestListen(t *testing.T) {
 	ctx := context.Background()
-	client, projectID, collection := setup(ctx, t)
+	client, projectID, databaseID, collection := setup(ctx, t)
 	defer client.Close()
 
+	testutil.SeedCollection(ctx, t, projectID, databaseID, collection, "cities")
+
 	ctx, cancel := context.WithTimeout(ctx, duration)
 	defer cancel()
 
-	// Delete all docs first to make sure setup works.
-	docs, err := client.Collection(collection).Documents(ctx).GetAll()
-	if err == nil {
-		for _, doc := range docs {
-			doc.Ref.Delete(ctx)
-		}
-	}
-	cityCollection := []struct {
-		city, name, state string
-	}{
-		{city: "SF", name: "San Francisco", state: "CA"},
-		{city: "LA", name: "Los Angeles", state: "CA"},
-		{city: "DC", name: "Washington D.C."},
-	}
-
-	for _, c := range cityCollection {
-		if _, err := client.Collection(collection).Doc(c.city).Set(ctx, map[string]string{
-			"name":  c.name,
-			"state": c.state,
-		}); err != nil {
-			t.Fatalf("Set: %v", err)
-		}
-	}
-	if err := listenDocument(ctx, ioutil.Discard, projectID, collection); err != nil {
+	if err := listenDocument(ctx, ioutil.Discard, projectID, databaseID, collection); err != nil {
 		t.Errorf("listenDocument: %v", err)
 	}
 }
 func TestListenMultiple(t *testing.T) {
 	ctx := context.Background()
-	client, projectID, collection := setup(ctx, t)
+	client, projectID, databaseID, collection := setup(ctx, t)
 	defer client.Close()
 
 	ctx, cancel := context.WithTimeout(ctx, duration)
 	defer cancel()
 
-	if err := listenMultiple(ctx, ioutil.Discard, projectID, collection); err != nil {
+	if err := listenMultiple(ctx, ioutil.Discard, projectID, databaseID, collection); err != nil {
 		t.Errorf("listenMultiple: %v", err)
 	}
 }
 
 func TestListenChanges(t *testing.T) {
 	ctx := context.Background()
-	client, projectID, collection := setup(ctx, t)
+	client, projectID, databaseID, collection := setup(ctx, t)
 	defer client.Close()
+	// The update below needs LA to exist.
+	testutil.SeedCollection(ctx, t, projectID, databaseID, collection, "cities")
 
 	ctx, cancel := context.WithTimeout(ctx, duration)
 	defer cancel()
@@ -105,7 +96,7 @@ func TestListenChanges(t *testing.T) {
 	c := make(chan *bytes.Buffer)
 	go func() {
 		defer close(c)
-		err := listenChanges(ctx, buf, projectID, collection)
+		err := listenChanges(ctx, buf, projectID, databaseID, collection)
 		if err != nil {
 			t.Errorf("listenChanges: %v", err)
 		}
@@ -128,13 +119,13 @@ func TestListenChanges(t *testing.T) {
 
 func TestListenErrors(t *testing.T) {
 	ctx := context.Background()
-	client, projectID, collection := setup(ctx, t)
+	client, projectID, databaseID, collection := setup(ctx, t)
 	defer client.Close()
 
 	ctx, cancel := context.WithTimeout(ctx, duration)
 	defer cancel()
 
-	if err := listenErrors(ctx, ioutil.Discard, projectID, collection); err != nil {
+	if err := listenErrors(ctx, ioutil.Discard, projectID, databaseID, collection); err != nil {
 		t.Errorf("listenErrors: %v", err)
 	}
 }
@@ -22,6 +22,7 @@ import (
 	"os"
 
 	"cloud.google.com/go/firestore"
+	"github.com/GoogleCloudPlatform/golang-samples/internal/endpoint"
 )
 
 // [START fs_class_definition]
@@ -47,8 +48,13 @@ func main() {
 	if projectID == "" {
 		log.Fatalf("Set Firebase project ID via GCLOUD_PROJECT env variable.")
 	}
+	// Set FIRESTORE_DATABASE to use a named database.
+	databaseID := os.Getenv("FIRESTORE_DATABASE")
+	if databaseID == "" {
+		databaseID = firestore.DefaultDatabaseID
+	}
 
-	client, err := firestore.NewClient(ctx, projectID)
+	client, err := firestore.NewClientWithDatabase(ctx, projectID, databaseID, endpoint.Firestore()...)
 	if err != nil {
 		log.Fatalf("Cannot create client: %v", err)
 	}
@@ -149,5 +155,3 @@ func main() {
 		log.Fatalf("Cannot delete collectionL %v", err)
 	}
 }
-
-// TODO(jbd): Add tests.
@@ -0,0 +1,49 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/GoogleCloudPlatform/golang-samples/internal/testutil"
+)
+
+// TestMainSamples builds the command and runs it as a user would, covering
+// the samples that only main calls.
+func TestMainSamples(t *testing.T) {
+	testutil.SystemTest(t)
+	// TODO(#559): revert this to testutil.SystemTest(t).ProjectID
+	// when datastore and firestore can co-exist in a project.
+	projectID := os.Getenv("GOLANG_SAMPLES_FIRESTORE_PROJECT")
+	if projectID == "" {
+		t.Skip("Skipping firestore test. Set GOLANG_SAMPLES_FIRESTORE_PROJECT.")
+	}
+
+	out := testutil.RunMain(t, 5*time.Minute, map[string]string{
+		"GCLOUD_PROJECT":     projectID,
+		"FIRESTORE_DATABASE": testDatabaseID(),
+	})
+	for _, want := range []string{
+		"Retrieved doc as map: map[",
+		"Retrieved doc as entity: &{",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("main output does not contain %q:\n%s", want, out)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+// [START firestore_query_filter_or]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/api/option"
+)
+
+// orQueries runs disjunctions built from PropertyFilter, OrFilter, and
+// AndFilter with Query.WhereEntity.
+//
+// OR queries have some limitations:
+//   - After the filter is converted to disjunctive normal form it may have at
+//     most 30 disjunctions, so nesting in or array-contains-any inside an OR
+//     quickly reaches the limit.
+//   - not-in cannot be combined with OR, in, or array-contains-any.
+//   - Disjunctions on different fields may need a composite index for each
+//     branch when combined with an OrderBy or a range filter.
+func orQueries(ctx context.Context, w io.Writer, projectID, databaseID, collection string, opts ...option.ClientOption) (map[string][]string, error) {
+	// projectID := "project-id"
+	// databaseID := "(default)"
+	// collection := "cities"
+	const timeout = 30 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, err := firestore.NewClientWithDatabase(ctx, projectID, databaseID, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("firestore.NewClientWithDatabase: %w", err)
+	}
+	defer client.Close()
+
+	cities := client.Collection(collection)
+	queries := []struct {
+		name  string
+		query firestore.Query
+	}{
+		// An OR across two different fields.
+		{"state == CA || capital == true", cities.WhereEntity(firestore.OrFilter{
+			Filters: []firestore.EntityFilter{
+				firestore.PropertyFilter{Path: "state", Operator: "==", Value: "CA"},
+				firestore.PropertyFilter{Path: "capital", Operator: "==", Value: true},
+			},
+		})},
+		// An OR nested inside an AND.
+		{"country == USA && (population < 700000 || population > 3000000)", cities.WhereEntity(firestore.AndFilter{
+			Filters: []firestore.EntityFilter{
+				firestore.PropertyFilter{Path: "country", Operator: "==", Value: "USA"},
+				firestore.OrFilter{
+					Filters: []firestore.EntityFilter{
+						firestore.PropertyFilter{Path: "population", Operator: "<", Value: 700000},
+						firestore.PropertyFilter{Path: "population", Operator: ">", Value: 3000000},
+					},
+				},
+			},
+		})},
+	}
+
+	results := make(map[string][]string)
+	for _, q := range queries {
+		docs, err := q.query.Documents(ctx).GetAll()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", q.name, err)
+		}
+		ids := []string{}
+		for _, doc := range docs {
+			ids = append(ids, doc.Ref.ID)
+		}
+		results[q.name] = ids
+		fmt.Fprintf(w, "%s: %v\n", q.name, ids)
+	}
+	return results, nil
+}
+
+// [END firestore_query_filter_or]
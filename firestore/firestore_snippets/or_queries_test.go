@@ -0,0 +1,48 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"sort"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/golang-samples/internal/testutil"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestORQueries(t *testing.T) {
+	ctx := context.Background()
+	client, projectID, databaseID, collection := setup(ctx, t)
+	defer client.Close()
+	collection += "-or"
+	testutil.SeedCollection(ctx, t, projectID, databaseID, collection, "cities")
+
+	got, err := orQueries(ctx, ioutil.Discard, projectID, databaseID, collection)
+	if err != nil {
+		t.Fatalf("orQueries: %v", err)
+	}
+	for _, ids := range got {
+		sort.Strings(ids)
+	}
+	want := map[string][]string{
+		"state == CA || capital == true":                                  {"BJ", "LA", "SF", "TOK"},
+		"country == USA && (population < 700000 || population > 3000000)": {"DC", "LA"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("orQueries mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+// [START firestore_query_order_limit_examples]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/api/option"
+)
+
+// orderAndLimit runs queries ordered on a single field, which need no
+// composite index, with Limit and LimitToLast.
+func orderAndLimit(ctx context.Context, w io.Writer, projectID, databaseID, collection string, opts ...option.ClientOption) (map[string][]string, error) {
+	// projectID := "project-id"
+	// databaseID := "(default)"
+	// collection := "cities"
+	const timeout = 30 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, err := firestore.NewClientWithDatabase(ctx, projectID, databaseID, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("firestore.NewClientWithDatabase: %w", err)
+	}
+	defer client.Close()
+
+	cities := client.Collection(collection)
+	queries := []struct {
+		name  string
+		query firestore.Query
+	}{
+		{"first 3 by name", cities.OrderBy("name", firestore.Asc).Limit(3)},
+		{"2 most populated", cities.OrderBy("population", firestore.Desc).Limit(2)},
+		// LimitToLast keeps the last documents but still returns them in
+		// the order of the query. It requires an OrderBy.
+		{"last 2 by population", cities.OrderBy("population", firestore.Asc).LimitToLast(2)},
+		// With a range filter, the first OrderBy must be on the filtered
+		// field.
+		{"2 smallest above 1000000", cities.Where("population", ">", 1000000).OrderBy("population", firestore.Asc).Limit(2)},
+	}
+
+	results := make(map[string][]string)
+	for _, q := range queries {
+		docs, err := q.query.Documents(ctx).GetAll()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", q.name, err)
+		}
+		ids := []string{}
+		for _, doc := range docs {
+			ids = append(ids, doc.Ref.ID)
+		}
+		results[q.name] = ids
+		fmt.Fprintf(w, "%s: %v\n", q.name, ids)
+	}
+	return results, nil
+}
+
+// [END firestore_query_order_limit_examples]
@@ -0,0 +1,59 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/golang-samples/internal/apierrors"
+	"github.com/GoogleCloudPlatform/golang-samples/internal/testutil"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+)
+
+func TestOrderAndLimit(t *testing.T) {
+	ctx := context.Background()
+	client, projectID, databaseID, collection := setup(ctx, t)
+	defer client.Close()
+	collection += "-order"
+	testutil.SeedCollection(ctx, t, projectID, databaseID, collection, "cities")
+
+	got, err := orderAndLimit(ctx, ioutil.Discard, projectID, databaseID, collection)
+	if err != nil {
+		t.Fatalf("orderAndLimit: %v", err)
+	}
+	want := map[string][]string{
+		"first 3 by name":          {"BJ", "LA", "SF"},
+		"2 most populated":         {"BJ", "TOK"},
+		"last 2 by population":     {"TOK", "BJ"},
+		"2 smallest above 1000000": {"LA", "TOK"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("orderAndLimit mismatch (-want +got):\n%s", diff)
+	}
+
+	ids, err := orderByMultiple(ctx, ioutil.Discard, projectID, databaseID, collection)
+	if err != nil {
+		if apierrors.Code(err) == codes.FailedPrecondition {
+			t.Skipf("orderByMultiple: missing composite index on %q: %v", collection, err)
+		}
+		t.Fatalf("orderByMultiple: %v", err)
+	}
+	if diff := cmp.Diff([]string{"BJ", "TOK", "LA", "SF", "DC"}, ids); diff != "" {
+		t.Errorf("orderByMultiple mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+// [START firestore_query_order_multiple_fields]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/api/option"
+)
+
+// orderByMultiple orders cities by country, then by population within a
+// country, most populated first. Ordering on several fields needs a
+// composite index, here on country ascending and population descending.
+// The error returned without it contains a link to create it.
+func orderByMultiple(ctx context.Context, w io.Writer, projectID, databaseID, collection string, opts ...option.ClientOption) ([]string, error) {
+	// projectID := "project-id"
+	// databaseID := "(default)"
+	// collection := "cities"
+	const timeout = 30 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, err := firestore.NewClientWithDatabase(ctx, projectID, databaseID, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("firestore.NewClientWithDatabase: %w", err)
+	}
+	defer client.Close()
+
+	docs, err := client.Collection(collection).
+		OrderBy("country", firestore.Asc).
+		OrderBy("population", firestore.Desc).
+		Documents(ctx).GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("Documents: %w", err)
+	}
+	var ids []string
+	for _, doc := range docs {
+		ids = append(ids, doc.Ref.ID)
+		fmt.Fprintf(w, "%v: %v, %v\n", doc.Ref.ID, doc.Data()["country"], doc.Data()["population"])
+	}
+	return ids, nil
+}
+
+// [END firestore_query_order_multiple_fields]
@@ -0,0 +1,79 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+// [START firestore_query_cursor_paginate_collection]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/api/option"
+)
+
+// paginateCollection reads a whole collection one page at a time. Each page
+// starts after the last document of the previous one, so the query never
+// skips over documents it has already read, unlike an offset.
+func paginateCollection(ctx context.Context, w io.Writer, projectID, databaseID, collection string, pageSize int, opts ...option.ClientOption) ([][]string, error) {
+	// projectID := "project-id"
+	// databaseID := "(default)"
+	// collection := "cities"
+	// pageSize := 100
+	// The walk reads every page, so it gets longer than a listing.
+	const timeout = time.Minute
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, err := firestore.NewClientWithDatabase(ctx, projectID, databaseID, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("firestore.NewClientWithDatabase: %w", err)
+	}
+	defer client.Close()
+
+	// Order by a unique field, or end with the document ID, so every
+	// document has a well-defined position.
+	query := client.Collection(collection).OrderBy("population", firestore.Asc).OrderBy(firestore.DocumentID, firestore.Asc)
+
+	var pages [][]string
+	var last *firestore.DocumentSnapshot
+	for {
+		q := query.Limit(pageSize)
+		if last != nil {
+			q = q.StartAfter(last)
+		}
+		docs, err := q.Documents(ctx).GetAll()
+		if err != nil {
+			return nil, fmt.Errorf("Documents: %w", err)
+		}
+		if len(docs) == 0 {
+			break
+		}
+		var page []string
+		for _, doc := range docs {
+			page = append(page, doc.Ref.ID)
+		}
+		pages = append(pages, page)
+		fmt.Fprintf(w, "Page %d: %v\n", len(pages), page)
+		if len(docs) < pageSize {
+			break
+		}
+		last = docs[len(docs)-1]
+	}
+	return pages, nil
+}
+
+// [END firestore_query_cursor_paginate_collection]
@@ -0,0 +1,168 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+// [START firestore_query_paginate_with_token]
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/gob"
+	"fmt"
+	"io"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+)
+
+func init() {
+	// Timestamps are the only non-basic type a cursor value can hold here.
+	gob.Register(time.Time{})
+}
+
+// pageCursor is the position after the last document of a page: the value
+// of the ordering field and the document ID, which breaks ties.
+type pageCursor struct {
+	Value interface{}
+	ID    string
+}
+
+// token encodes the cursor as a URL-safe string.
+func (c *pageCursor) token() (string, error) {
+	var b bytes.Buffer
+	if err := gob.NewEncoder(&b).Encode(c); err != nil {
+		return "", fmt.Errorf("encoding page token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b.Bytes()), nil
+}
+
+// pager reads the results of a collection query one page at a time, and
+// hands out an opaque token for the position after each page. A REST API
+// returns the token to its client as nextPageToken, and passes the one the
+// client sends back to newPager to continue where the last page ended, with
+// no state kept on the server.
+//
+// The token only encodes a position, so a client that alters it can only
+// skip ahead or back within the same query; apply any access filters to
+// the query itself.
+type pager struct {
+	query  firestore.Query
+	field  string
+	cursor *pageCursor
+	done   bool
+}
+
+// newPager returns a pager over query, which must be a collection query,
+// ordered by field. field must hold a number, string, boolean or timestamp
+// in every document. An empty token starts at the first page.
+func newPager(query firestore.Query, field string, dir firestore.Direction, token string) (*pager, error) {
+	// Ordering by document ID after field gives every document a unique
+	// position, even when many share a value of field.
+	p := &pager{
+		query: query.OrderBy(field, dir).OrderBy(firestore.DocumentID, dir),
+		field: field,
+	}
+	if token == "" {
+		return p, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("invalid page token: %w", err)
+	}
+	p.cursor = new(pageCursor)
+	if err := gob.NewDecoder(bytes.NewReader(b)).Decode(p.cursor); err != nil {
+		return nil, fmt.Errorf("invalid page token: %w", err)
+	}
+	return p, nil
+}
+
+// NextPage returns up to pageSize documents, and a token for the page after
+// them. The token is empty after the last page. Calling NextPage again
+// continues with the next page; after the last one it returns iterator.Done.
+func (p *pager) NextPage(ctx context.Context, pageSize int) (docs []*firestore.DocumentSnapshot, token string, err error) {
+	if pageSize < 1 {
+		return nil, "", fmt.Errorf("page size %d, want at least 1", pageSize)
+	}
+	if p.done {
+		return nil, "", iterator.Done
+	}
+	q := p.query
+	if p.cursor != nil {
+		q = q.StartAfter(p.cursor.Value, p.cursor.ID)
+	}
+	// Ask for one more document than the page holds, to learn whether
+	// there is another page without returning an empty last page.
+	docs, err = q.Limit(pageSize + 1).Documents(ctx).GetAll()
+	if err != nil {
+		return nil, "", fmt.Errorf("Documents: %w", err)
+	}
+	if len(docs) <= pageSize {
+		p.done = true
+		return docs, "", nil
+	}
+	docs = docs[:pageSize]
+
+	last := docs[len(docs)-1]
+	value, err := last.DataAt(p.field)
+	if err != nil {
+		return nil, "", fmt.Errorf("DataAt(%q): %w", p.field, err)
+	}
+	p.cursor = &pageCursor{Value: value, ID: last.Ref.ID}
+	token, err = p.cursor.token()
+	if err != nil {
+		return nil, "", err
+	}
+	return docs, token, nil
+}
+
+// listCitiesPage returns one page of cities by population, as a handler for
+// GET /cities?pageSize=N&pageToken=T would, along with the token for the
+// next page.
+func listCitiesPage(ctx context.Context, w io.Writer, projectID, databaseID, collection string, pageSize int, pageToken string, opts ...option.ClientOption) (ids []string, nextPageToken string, err error) {
+	// projectID := "project-id"
+	// databaseID := "(default)"
+	// collection := "cities"
+	// pageSize := 100
+	// pageToken := "" // from the request; empty for the first page
+	// Each call reads one page, so it gets a single request's timeout.
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, err := firestore.NewClientWithDatabase(ctx, projectID, databaseID, opts...)
+	if err != nil {
+		return nil, "", fmt.Errorf("firestore.NewClientWithDatabase: %w", err)
+	}
+	defer client.Close()
+
+	p, err := newPager(client.Collection(collection).Query, "population", firestore.Asc, pageToken)
+	if err != nil {
+		// Report this to the API client as a 400 Bad Request.
+		return nil, "", err
+	}
+	docs, nextPageToken, err := p.NextPage(ctx, pageSize)
+	if err != nil {
+		return nil, "", err
+	}
+	for _, doc := range docs {
+		ids = append(ids, doc.Ref.ID)
+	}
+	fmt.Fprintf(w, "Page: %v, next page token: %q\n", ids, nextPageToken)
+	return ids, nextPageToken, nil
+}
+
+// [END firestore_query_paginate_with_token]
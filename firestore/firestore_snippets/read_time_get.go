@@ -0,0 +1,59 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+// [START firestore_read_time_get]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/api/option"
+)
+
+// getAtReadTime reads a document as it was at readTime.
+//
+// Without point-in-time recovery, readTime can be at most one hour in the
+// past. With it enabled, readTime can go back up to seven days, but must be
+// a whole minute when more than an hour old.
+func getAtReadTime(ctx context.Context, w io.Writer, projectID, databaseID, collection, docID string, readTime time.Time, opts ...option.ClientOption) (map[string]interface{}, error) {
+	// projectID := "project-id"
+	// databaseID := "(default)"
+	// collection := "cities"
+	// docID := "SF"
+	// readTime := time.Now().Add(-30 * time.Minute)
+	const timeout = 30 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, err := firestore.NewClientWithDatabase(ctx, projectID, databaseID, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("firestore.NewClientWithDatabase: %w", err)
+	}
+	defer client.Close()
+
+	// Every read made through the returned client happens at readTime.
+	// Writes are not allowed.
+	snap, err := client.WithReadOptions(firestore.ReadTime(readTime)).Collection(collection).Doc(docID).Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Get: %w", err)
+	}
+	fmt.Fprintf(w, "%v at %v: %v\n", docID, readTime, snap.Data())
+	return snap.Data(), nil
+}
+
+// [END firestore_read_time_get]
@@ -0,0 +1,59 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+// [START firestore_read_time_query]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/api/option"
+)
+
+// queryAtReadTime runs a query against the documents as they were at
+// readTime, for example to compare the current state of a collection with
+// an earlier one, or to recover documents deleted by mistake.
+func queryAtReadTime(ctx context.Context, w io.Writer, projectID, databaseID, collection string, readTime time.Time, opts ...option.ClientOption) ([]string, error) {
+	// projectID := "project-id"
+	// databaseID := "(default)"
+	// collection := "cities"
+	// readTime := time.Now().Add(-30 * time.Minute)
+	const timeout = 30 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, err := firestore.NewClientWithDatabase(ctx, projectID, databaseID, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("firestore.NewClientWithDatabase: %w", err)
+	}
+	defer client.Close()
+
+	docs, err := client.WithReadOptions(firestore.ReadTime(readTime)).
+		Collection(collection).Where("state", "==", "CA").Documents(ctx).GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("Documents: %w", err)
+	}
+	var ids []string
+	for _, doc := range docs {
+		ids = append(ids, doc.Ref.ID)
+	}
+	fmt.Fprintf(w, "Cities in CA at %v: %v\n", readTime, ids)
+	return ids, nil
+}
+
+// [END firestore_read_time_query]
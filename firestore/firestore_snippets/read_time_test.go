@@ -0,0 +1,65 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"sort"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"github.com/GoogleCloudPlatform/golang-samples/internal/testutil"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestReadTime(t *testing.T) {
+	ctx := context.Background()
+	client, projectID, databaseID, collection := setup(ctx, t)
+	defer client.Close()
+	collection += "-read-time"
+	testutil.SeedCollection(ctx, t, projectID, databaseID, collection, "cities")
+
+	// Let the read time fall strictly between the seed and the changes.
+	time.Sleep(time.Second)
+	readTime := time.Now()
+	time.Sleep(time.Second)
+
+	cities := client.Collection(collection)
+	if _, err := cities.Doc("SF").Update(ctx, []firestore.Update{{Path: "population", Value: 900000}}); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	if _, err := cities.Doc("LA").Delete(ctx); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+
+	data, err := getAtReadTime(ctx, ioutil.Discard, projectID, databaseID, collection, "SF", readTime)
+	if err != nil {
+		t.Fatalf("getAtReadTime: %v", err)
+	}
+	if got, want := data["population"], int64(860000); got != want {
+		t.Errorf("getAtReadTime got population %v, want %v", got, want)
+	}
+
+	ids, err := queryAtReadTime(ctx, ioutil.Discard, projectID, databaseID, collection, readTime)
+	if err != nil {
+		t.Fatalf("queryAtReadTime: %v", err)
+	}
+	sort.Strings(ids)
+	if diff := cmp.Diff([]string{"LA", "SF"}, ids); diff != "" {
+		t.Errorf("queryAtReadTime mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+// [START firestore_retry_transient_errors]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"github.com/GoogleCloudPlatform/golang-samples/internal/apierrors"
+	gax "github.com/googleapis/gax-go/v2"
+	"google.golang.org/api/option"
+)
+
+// retry calls f until it succeeds, fails with an error that is not
+// retryable, or ctx is done, waiting longer after each failure. Aborted means
+// the call contended with another write, and Unavailable that the service
+// could not be reached; apierrors.Retryable lists the rest.
+//
+// Only retry calls that are safe to repeat. A Set or Delete is, but an
+// Update with firestore.Increment may be applied twice when the first
+// attempt failed after the write was committed. Transactions already retry
+// on contention and need no extra retries.
+func retry(ctx context.Context, bo gax.Backoff, f func() error) error {
+	for {
+		err := f()
+		if err == nil || !apierrors.Retryable(err) {
+			return err
+		}
+		if gax.Sleep(ctx, bo.Pause()) != nil {
+			// ctx is done, report the last error from f.
+			return err
+		}
+	}
+}
+
+// setWithRetry writes a document, retrying transient errors.
+func setWithRetry(ctx context.Context, w io.Writer, projectID, databaseID, collection, docID string, data interface{}, opts ...option.ClientOption) error {
+	// projectID := "project-id"
+	// databaseID := "(default)"
+	// collection := "cities"
+	// docID := "SF"
+	const timeout = time.Minute
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	client, err := firestore.NewClientWithDatabase(ctx, projectID, databaseID, opts...)
+	if err != nil {
+		return fmt.Errorf("firestore.NewClientWithDatabase: %w", err)
+	}
+	defer client.Close()
+
+	bo := gax.Backoff{
+		Initial:    100 * time.Millisecond,
+		Max:        5 * time.Second,
+		Multiplier: 2,
+	}
+	attempts := 0
+	err = retry(ctx, bo, func() error {
+		attempts++
+		_, err := client.Collection(collection).Doc(docID).Set(ctx, data)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("Set: %w", err)
+	}
+	fmt.Fprintf(w, "Wrote %v in %d attempts\n", docID, attempts)
+	return nil
+}
+
+// [END firestore_retry_transient_errors]
@@ -0,0 +1,112 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/GoogleCloudPlatform/golang-samples/internal/testutil"
+	"github.com/google/go-cmp/cmp"
+	gax "github.com/googleapis/gax-go/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRetry(t *testing.T) {
+	bo := gax.Backoff{Initial: time.Millisecond, Max: 10 * time.Millisecond}
+	tests := []struct {
+		name         string
+		errs         []error
+		wantCode     codes.Code
+		wantAttempts int
+	}{
+		{
+			name:         "success",
+			errs:         []error{nil},
+			wantCode:     codes.OK,
+			wantAttempts: 1,
+		},
+		{
+			name:         "transient errors",
+			errs:         []error{status.Error(codes.Aborted, ""), status.Error(codes.Unavailable, ""), nil},
+			wantCode:     codes.OK,
+			wantAttempts: 3,
+		},
+		{
+			name:         "permanent error",
+			errs:         []error{status.Error(codes.Aborted, ""), status.Error(codes.InvalidArgument, ""), nil},
+			wantCode:     codes.InvalidArgument,
+			wantAttempts: 2,
+		},
+		{
+			name:         "not a status",
+			errs:         []error{errors.New("boom"), nil},
+			wantCode:     codes.Unknown,
+			wantAttempts: 1,
+		},
+	}
+	for _, tc := range tests {
+		attempts := 0
+		err := retry(context.Background(), bo, func() error {
+			attempts++
+			return tc.errs[attempts-1]
+		})
+		if got := status.Code(err); got != tc.wantCode {
+			t.Errorf("%s: retry got code %v, want %v", tc.name, got, tc.wantCode)
+		}
+		if attempts != tc.wantAttempts {
+			t.Errorf("%s: retry got %d attempts, want %d", tc.name, attempts, tc.wantAttempts)
+		}
+	}
+}
+
+func TestRetryContextDone(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	bo := gax.Backoff{Initial: time.Millisecond, Max: 10 * time.Millisecond}
+
+	err := retry(ctx, bo, func() error {
+		return status.Error(codes.Unavailable, "")
+	})
+	if got := status.Code(err); got != codes.Unavailable {
+		t.Errorf("retry got code %v, want the last error's code %v", got, codes.Unavailable)
+	}
+}
+
+func TestSetWithRetry(t *testing.T) {
+	ctx := context.Background()
+	client, projectID, databaseID, collection := setup(ctx, t)
+	defer client.Close()
+	collection += "-retry"
+	testutil.SeedCollection(ctx, t, projectID, databaseID, collection, "cities")
+
+	city := City{Name: "San Francisco", Population: 870000}
+	if err := setWithRetry(ctx, ioutil.Discard, projectID, databaseID, collection, "SF", city); err != nil {
+		t.Fatalf("setWithRetry: %v", err)
+	}
+	snap, err := client.Collection(collection).Doc("SF").Get(ctx)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	// Set replaces the seeded document, so its other fields are gone.
+	want := map[string]interface{}{"name": "San Francisco", "population": int64(870000)}
+	if diff := cmp.Diff(want, snap.Data()); diff != "" {
+		t.Errorf("setWithRetry mismatch (-want +got):\n%s", diff)
+	}
+}
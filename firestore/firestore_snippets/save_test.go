@@ -36,7 +36,7 @@ func TestSave(t *testing.T) {
 
 	ctx := context.Background()
 
-	client, err := firestore.NewClient(ctx, projectID)
+	client, err := firestore.NewClientWithDatabase(ctx, projectID, testDatabaseID())
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -31,9 +31,9 @@ func TestDistributedCounter(t *testing.T) {
 	}
 
 	ctx := context.Background()
-	client, err := firestore.NewClient(ctx, projectID)
+	client, err := firestore.NewClientWithDatabase(ctx, projectID, testDatabaseID())
 	if err != nil {
-		t.Fatalf("firestore.NewClient: %v", err)
+		t.Fatalf("firestore.NewClientWithDatabase: %v", err)
 	}
 	defer client.Close()
 
@@ -0,0 +1,141 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+// [START firestore_data_struct_mapping]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"cloud.google.com/go/civil"
+	"cloud.google.com/go/firestore"
+	"google.golang.org/api/option"
+)
+
+// Event is how the application represents an event.
+type Event struct {
+	Title     string
+	Day       civil.Date
+	Notes     string
+	Attendees []string
+	Created   time.Time
+}
+
+// eventDoc is how an Event is stored. Firestore maps exported fields to
+// document fields named by the firestore tag:
+//   - omitempty leaves the field out of the document when it is the zero
+//     value, instead of storing "" or null.
+//   - serverTimestamp stores the commit time when the field is the zero
+//     time, like firestore.ServerTimestamp.
+//   - "-" skips the field entirely.
+//
+// Types Firestore does not support, like civil.Date, need converting to
+// one it does. Storing dates as "2006-01-02" strings keeps them readable
+// and sortable.
+type eventDoc struct {
+	Title     string    `firestore:"title"`
+	Day       string    `firestore:"day"`
+	Notes     string    `firestore:"notes,omitempty"`
+	Attendees []string  `firestore:"attendees,omitempty"`
+	Created   time.Time `firestore:"created,serverTimestamp"`
+	Cached    string    `firestore:"-"`
+}
+
+func toEventDoc(e Event) eventDoc {
+	return eventDoc{
+		Title:     e.Title,
+		Day:       e.Day.String(),
+		Notes:     e.Notes,
+		Attendees: e.Attendees,
+		Created:   e.Created,
+	}
+}
+
+func (d eventDoc) toEvent() (Event, error) {
+	day, err := civil.ParseDate(d.Day)
+	if err != nil {
+		return Event{}, fmt.Errorf("civil.ParseDate: %w", err)
+	}
+	return Event{
+		Title:     d.Title,
+		Day:       day,
+		Notes:     d.Notes,
+		Attendees: d.Attendees,
+		Created:   d.Created,
+	}, nil
+}
+
+// writeEvent stores e as the docID document.
+func writeEvent(ctx context.Context, w io.Writer, projectID, databaseID, collection, docID string, e Event, opts ...option.ClientOption) error {
+	// projectID := "project-id"
+	// databaseID := "(default)"
+	// collection := "events"
+	// docID := "launch"
+	const timeout = 30 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, err := firestore.NewClientWithDatabase(ctx, projectID, databaseID, opts...)
+	if err != nil {
+		return fmt.Errorf("firestore.NewClientWithDatabase: %w", err)
+	}
+	defer client.Close()
+
+	if _, err := client.Collection(collection).Doc(docID).Set(ctx, toEventDoc(e)); err != nil {
+		return fmt.Errorf("Set: %w", err)
+	}
+	fmt.Fprintf(w, "Stored event %v on %v\n", docID, e.Day)
+	return nil
+}
+
+// readEvent reads the docID document into an Event.
+func readEvent(ctx context.Context, w io.Writer, projectID, databaseID, collection, docID string, opts ...option.ClientOption) (Event, error) {
+	// projectID := "project-id"
+	// databaseID := "(default)"
+	// collection := "events"
+	// docID := "launch"
+	const timeout = 30 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, err := firestore.NewClientWithDatabase(ctx, projectID, databaseID, opts...)
+	if err != nil {
+		return Event{}, fmt.Errorf("firestore.NewClientWithDatabase: %w", err)
+	}
+	defer client.Close()
+
+	snap, err := client.Collection(collection).Doc(docID).Get(ctx)
+	if err != nil {
+		return Event{}, fmt.Errorf("Get: %w", err)
+	}
+	// DataAt reads a single field, without decoding the whole document.
+	title, err := snap.DataAt("title")
+	if err != nil {
+		return Event{}, fmt.Errorf("DataAt: %w", err)
+	}
+	fmt.Fprintf(w, "Reading event %q\n", title)
+
+	// DataTo decodes the document into a struct. Document fields without a
+	// matching struct field are ignored.
+	var d eventDoc
+	if err := snap.DataTo(&d); err != nil {
+		return Event{}, fmt.Errorf("DataTo: %w", err)
+	}
+	return d.toEvent()
+}
+
+// [END firestore_data_struct_mapping]
@@ -0,0 +1,68 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/GoogleCloudPlatform/golang-samples/internal/testutil"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestStructMapping(t *testing.T) {
+	ctx := context.Background()
+	client, projectID, databaseID, _ := setup(ctx, t)
+	defer client.Close()
+	// The test only touches its own documents, so it shares the collection.
+	collection := testutil.SharedCollection(ctx, t, projectID, databaseID)
+	docID := testutil.Prefix(t) + "launch"
+
+	e := Event{
+		Title:     "Launch",
+		Day:       civil.Date{Year: 2026, Month: time.October, Day: 15},
+		Attendees: []string{"alice", "bob"},
+	}
+	if err := writeEvent(ctx, ioutil.Discard, projectID, databaseID, collection, docID, e); err != nil {
+		t.Fatalf("writeEvent: %v", err)
+	}
+
+	snap, err := client.Collection(collection).Doc(docID).Get(ctx)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	data := snap.Data()
+	if _, ok := data["notes"]; ok {
+		t.Errorf("notes got stored as %q, want omitted", data["notes"])
+	}
+	if got, want := data["day"], "2026-10-15"; got != want {
+		t.Errorf("day got %v, want %v", got, want)
+	}
+	if created, ok := data["created"].(time.Time); !ok || !created.Equal(snap.UpdateTime) {
+		t.Errorf("created got %v, want the server commit time %v", data["created"], snap.UpdateTime)
+	}
+
+	got, err := readEvent(ctx, ioutil.Discard, projectID, databaseID, collection, docID)
+	if err != nil {
+		t.Fatalf("readEvent: %v", err)
+	}
+	e.Created = snap.UpdateTime
+	if diff := cmp.Diff(e, got); diff != "" {
+		t.Errorf("readEvent mismatch (-want +got):\n%s", diff)
+	}
+}
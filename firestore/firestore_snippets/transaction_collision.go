@@ -0,0 +1,92 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+// [START firestore_transaction_collision]
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/api/option"
+)
+
+// collideTransactions runs two transactions that both increment the count
+// field of the same document, and makes them collide: each reads the
+// document before either writes it. Firestore aborts one of them, and
+// RunTransaction retries it with a fresh read, so no increment is lost. It
+// returns the number of attempts each transaction took.
+func collideTransactions(ctx context.Context, w io.Writer, projectID, databaseID, collection, docID string, opts ...option.ClientOption) ([2]int, error) {
+	// projectID := "project-id"
+	// databaseID := "(default)"
+	// collection := "counters"
+	// docID := "visits"
+	const timeout = 30 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, err := firestore.NewClientWithDatabase(ctx, projectID, databaseID, opts...)
+	if err != nil {
+		return [2]int{}, fmt.Errorf("firestore.NewClientWithDatabase: %w", err)
+	}
+	defer client.Close()
+
+	ref := client.Collection(collection).Doc(docID)
+	var (
+		attempts [2]int
+		errs     [2]error
+		mu       sync.Mutex
+		read     sync.WaitGroup // Done once both first attempts have read.
+		wg       sync.WaitGroup
+	)
+	read.Add(2)
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+				attempts[i]++
+				doc, err := tx.Get(ref)
+				if err != nil {
+					return err
+				}
+				count, err := doc.DataAt("count")
+				if err != nil {
+					return err
+				}
+				mu.Lock()
+				fmt.Fprintf(w, "Transaction %d, attempt %d: read count %v\n", i, attempts[i], count)
+				mu.Unlock()
+				if attempts[i] == 1 {
+					read.Done()
+					read.Wait()
+				}
+				return tx.Update(ref, []firestore.Update{{Path: "count", Value: count.(int64) + 1}})
+			})
+		}(i)
+	}
+	wg.Wait()
+	for i, err := range errs {
+		if err != nil {
+			return attempts, fmt.Errorf("transaction %d: %w", i, err)
+		}
+	}
+	return attempts, nil
+}
+
+// [END firestore_transaction_collision]
@@ -0,0 +1,85 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+// [START firestore_transaction_max_attempts]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/api/option"
+)
+
+// movePopulation moves amount people from one city to another. The
+// transaction is attempted at most maxAttempts times (the default is 5)
+// before RunTransaction gives up and returns the last error.
+func movePopulation(ctx context.Context, w io.Writer, projectID, databaseID, collection, from, to string, amount int64, maxAttempts int, opts ...option.ClientOption) error {
+	// projectID := "project-id"
+	// databaseID := "(default)"
+	// collection := "cities"
+	// from, to := "SF", "LA"
+	// amount := 1000
+	// maxAttempts := 10
+	const timeout = 30 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, err := firestore.NewClientWithDatabase(ctx, projectID, databaseID, opts...)
+	if err != nil {
+		return fmt.Errorf("firestore.NewClientWithDatabase: %w", err)
+	}
+	defer client.Close()
+
+	fromRef := client.Collection(collection).Doc(from)
+	toRef := client.Collection(collection).Doc(to)
+	attempts := 0
+	err = client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		attempts++
+		// All reads must come before any write.
+		fromDoc, err := tx.Get(fromRef)
+		if err != nil {
+			return err
+		}
+		toDoc, err := tx.Get(toRef)
+		if err != nil {
+			return err
+		}
+		var fromCity, toCity City
+		if err := fromDoc.DataTo(&fromCity); err != nil {
+			return err
+		}
+		if err := toDoc.DataTo(&toCity); err != nil {
+			return err
+		}
+		if fromCity.Population < amount {
+			// Errors other than conflicts are not retried.
+			return fmt.Errorf("%v only has %d people", from, fromCity.Population)
+		}
+		if err := tx.Update(fromRef, []firestore.Update{{Path: "population", Value: fromCity.Population - amount}}); err != nil {
+			return err
+		}
+		return tx.Update(toRef, []firestore.Update{{Path: "population", Value: toCity.Population + amount}})
+	}, firestore.MaxAttempts(maxAttempts))
+	if err != nil {
+		return fmt.Errorf("RunTransaction after %d attempts: %w", attempts, err)
+	}
+	fmt.Fprintf(w, "Moved %d people from %v to %v in %d attempts\n", amount, from, to, attempts)
+	return nil
+}
+
+// [END firestore_transaction_max_attempts]
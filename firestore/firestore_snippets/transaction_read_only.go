@@ -0,0 +1,70 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+// [START firestore_transaction_read_only]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/api/option"
+)
+
+// totalPopulation sums the population of every city in a read-only
+// transaction. All reads see the same consistent snapshot of the database,
+// and since the transaction takes no locks it never blocks or aborts writers.
+func totalPopulation(ctx context.Context, w io.Writer, projectID, databaseID, collection string, opts ...option.ClientOption) (int64, error) {
+	// projectID := "project-id"
+	// databaseID := "(default)"
+	// collection := "cities"
+	const timeout = 30 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, err := firestore.NewClientWithDatabase(ctx, projectID, databaseID, opts...)
+	if err != nil {
+		return 0, fmt.Errorf("firestore.NewClientWithDatabase: %w", err)
+	}
+	defer client.Close()
+
+	var total int64
+	err = client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		// The function can run more than once, so reset any state it
+		// accumulates.
+		total = 0
+		docs, err := tx.Documents(client.Collection(collection)).GetAll()
+		if err != nil {
+			return err
+		}
+		for _, doc := range docs {
+			var c City
+			if err := doc.DataTo(&c); err != nil {
+				return err
+			}
+			total += c.Population
+		}
+		return nil
+	}, firestore.ReadOnly)
+	if err != nil {
+		return 0, fmt.Errorf("RunTransaction: %w", err)
+	}
+	fmt.Fprintf(w, "Total population: %d\n", total)
+	return total, nil
+}
+
+// [END firestore_transaction_read_only]
@@ -0,0 +1,82 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/golang-samples/internal/testutil"
+)
+
+func TestTransactions(t *testing.T) {
+	ctx := context.Background()
+	client, projectID, databaseID, collection := setup(ctx, t)
+	defer client.Close()
+	collection += "-transactions"
+	testutil.SeedCollection(ctx, t, projectID, databaseID, collection, "cities")
+
+	const want = 860000 + 3900000 + 680000 + 9000000 + 21500000
+	total, err := totalPopulation(ctx, ioutil.Discard, projectID, databaseID, collection)
+	if err != nil {
+		t.Fatalf("totalPopulation: %v", err)
+	}
+	if total != want {
+		t.Errorf("totalPopulation got %d, want %d", total, want)
+	}
+
+	if err := movePopulation(ctx, ioutil.Discard, projectID, databaseID, collection, "SF", "LA", 60000, 10); err != nil {
+		t.Fatalf("movePopulation: %v", err)
+	}
+	if err := movePopulation(ctx, ioutil.Discard, projectID, databaseID, collection, "DC", "LA", 1000000, 10); err == nil {
+		t.Errorf("movePopulation(more than DC has) got nil error, want an error")
+	}
+	for id, want := range map[string]int64{"SF": 800000, "LA": 3960000, "DC": 680000} {
+		snap, err := client.Collection(collection).Doc(id).Get(ctx)
+		if err != nil {
+			t.Fatalf("Get(%q): %v", id, err)
+		}
+		if got := snap.Data()["population"]; got != want {
+			t.Errorf("%v population got %v, want %v", id, got, want)
+		}
+	}
+}
+
+func TestCollideTransactions(t *testing.T) {
+	ctx := context.Background()
+	client, projectID, databaseID, collection := setup(ctx, t)
+	defer client.Close()
+	collection += "-transactions"
+
+	doc := client.Collection(collection).Doc("counter")
+	if _, err := doc.Set(ctx, map[string]interface{}{"count": 0}); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+
+	attempts, err := collideTransactions(ctx, ioutil.Discard, projectID, databaseID, collection, "counter")
+	if err != nil {
+		t.Fatalf("collideTransactions: %v", err)
+	}
+	t.Logf("collideTransactions took %v attempts", attempts)
+
+	snap, err := doc.Get(ctx)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if got, want := snap.Data()["count"], int64(2); got != want {
+		t.Errorf("count got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+// [START firestore_ttl_set_expiration]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/api/option"
+)
+
+// setExpiration stores the time a document expires in its expireAt field.
+// Once a TTL policy is enabled on the field, the document is deleted
+// after that time, typically within 24 hours.
+func setExpiration(ctx context.Context, w io.Writer, projectID, databaseID, collection, docID string, ttl time.Duration, opts ...option.ClientOption) (time.Time, error) {
+	// projectID := "project-id"
+	// databaseID := "(default)"
+	// collection := "sessions"
+	// docID := "session-id"
+	// ttl := 7 * 24 * time.Hour
+	const timeout = 30 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, err := firestore.NewClientWithDatabase(ctx, projectID, databaseID, opts...)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("firestore.NewClientWithDatabase: %w", err)
+	}
+	defer client.Close()
+
+	// The field must hold a timestamp. Documents where it is missing or
+	// holds another type never expire.
+	expireAt := time.Now().Add(ttl)
+	_, err = client.Collection(collection).Doc(docID).Set(ctx, map[string]interface{}{
+		"expireAt": expireAt,
+	}, firestore.MergeAll)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("Set: %w", err)
+	}
+	fmt.Fprintf(w, "%v expires at %v\n", docID, expireAt)
+	return expireAt, nil
+}
+
+// [END firestore_ttl_set_expiration]
@@ -0,0 +1,58 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/GoogleCloudPlatform/golang-samples/internal/testutil"
+)
+
+func TestSetExpiration(t *testing.T) {
+	ctx := context.Background()
+	client, projectID, databaseID, _ := setup(ctx, t)
+	defer client.Close()
+	// The test only touches its own documents, so it shares the collection.
+	collection := testutil.SharedCollection(ctx, t, projectID, databaseID)
+	docID := testutil.Prefix(t) + "session"
+
+	doc := client.Collection(collection).Doc(docID)
+	if _, err := doc.Set(ctx, map[string]interface{}{"user": "alice"}); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+
+	want, err := setExpiration(ctx, ioutil.Discard, projectID, databaseID, collection, docID, time.Hour)
+	if err != nil {
+		t.Fatalf("setExpiration: %v", err)
+	}
+	snap, err := doc.Get(ctx)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	got, ok := snap.Data()["expireAt"].(time.Time)
+	if !ok {
+		t.Fatalf("expireAt got %T, want time.Time", snap.Data()["expireAt"])
+	}
+	// Firestore stores timestamps with microsecond precision.
+	if d := got.Sub(want); d < -time.Millisecond || d > time.Millisecond {
+		t.Errorf("expireAt got %v, want %v", got, want)
+	}
+	if got := snap.Data()["user"]; got != "alice" {
+		t.Errorf("user got %v, want alice", got)
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+// [START firestore_data_set_array_remove]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/api/option"
+)
+
+// removeRegions removes every occurrence of regions from the regions array
+// of a document. Values that are not in the array are ignored.
+func removeRegions(ctx context.Context, w io.Writer, projectID, databaseID, collection, docID string, regions []string, opts ...option.ClientOption) error {
+	// projectID := "project-id"
+	// databaseID := "(default)"
+	// collection := "cities"
+	// docID := "SF"
+	const timeout = 30 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, err := firestore.NewClientWithDatabase(ctx, projectID, databaseID, opts...)
+	if err != nil {
+		return fmt.Errorf("firestore.NewClientWithDatabase: %w", err)
+	}
+	defer client.Close()
+
+	elems := make([]interface{}, len(regions))
+	for i, r := range regions {
+		elems[i] = r
+	}
+	_, err = client.Collection(collection).Doc(docID).Update(ctx, []firestore.Update{
+		{Path: "regions", Value: firestore.ArrayRemove(elems...)},
+	})
+	if err != nil {
+		return fmt.Errorf("Update: %w", err)
+	}
+	fmt.Fprintf(w, "Removed %v from %v\n", regions, docID)
+	return nil
+}
+
+// [END firestore_data_set_array_remove]
@@ -0,0 +1,56 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+// [START firestore_data_set_server_timestamp_field]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/api/option"
+)
+
+// updateServerTimestamp sets the updatedAt field of a document to the time
+// the server applies the write. firestore.ServerTimestamp is a sentinel: it
+// is never stored as is, so reading the document back returns a time.Time.
+func updateServerTimestamp(ctx context.Context, w io.Writer, projectID, databaseID, collection, docID string, opts ...option.ClientOption) error {
+	// projectID := "project-id"
+	// databaseID := "(default)"
+	// collection := "cities"
+	// docID := "SF"
+	const timeout = 30 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, err := firestore.NewClientWithDatabase(ctx, projectID, databaseID, opts...)
+	if err != nil {
+		return fmt.Errorf("firestore.NewClientWithDatabase: %w", err)
+	}
+	defer client.Close()
+
+	wr, err := client.Collection(collection).Doc(docID).Update(ctx, []firestore.Update{
+		{Path: "updatedAt", Value: firestore.ServerTimestamp},
+	})
+	if err != nil {
+		return fmt.Errorf("Update: %w", err)
+	}
+	fmt.Fprintf(w, "Updated %v at %v\n", docID, wr.UpdateTime)
+	return nil
+}
+
+// [END firestore_data_set_server_timestamp_field]
@@ -0,0 +1,63 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+// [START firestore_vector_search]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/api/option"
+)
+
+// vectorSearch returns the IDs of the limit documents whose embedding is
+// nearest to query, nearest first. The collection needs a vector index on
+// the embedding field with the dimension of query.
+func vectorSearch(ctx context.Context, w io.Writer, projectID, databaseID, collection string, query []float64, measure firestore.DistanceMeasure, limit int, opts ...option.ClientOption) ([]string, error) {
+	// projectID := "project-id"
+	// databaseID := "(default)"
+	// collection := "products"
+	// query := []float64{0.1, 0.2, 0.3}
+	// measure := firestore.DistanceMeasureCosine
+	// limit := 10
+	const timeout = 30 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, err := firestore.NewClientWithDatabase(ctx, projectID, databaseID, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("firestore.NewClientWithDatabase: %w", err)
+	}
+	defer client.Close()
+
+	// FindNearest can follow Where filters, which then need a composite
+	// index that includes the vector field. limit can be at most 1000.
+	vq := client.Collection(collection).FindNearest("embedding", firestore.Vector64(query), limit, measure, nil)
+	docs, err := vq.Documents(ctx).GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("FindNearest: %w", err)
+	}
+	var ids []string
+	for _, doc := range docs {
+		ids = append(ids, doc.Ref.ID)
+	}
+	fmt.Fprintf(w, "Nearest documents: %v\n", ids)
+	return ids, nil
+}
+
+// [END firestore_vector_search]
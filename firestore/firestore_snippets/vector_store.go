@@ -0,0 +1,63 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+// [START firestore_vector_store]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/api/option"
+)
+
+// Product is a document with an embedding that can be searched with
+// FindNearest.
+type Product struct {
+	Name string `firestore:"name"`
+	// Fields of type Vector32 or Vector64 are stored as vectors. A plain
+	// []float32 or []float64 is stored as an array and cannot be searched.
+	Embedding firestore.Vector32 `firestore:"embedding"`
+}
+
+// storeEmbeddings writes one product per entry of embeddings, keyed by
+// document ID. All embeddings must have the dimension of the vector index.
+func storeEmbeddings(ctx context.Context, w io.Writer, projectID, databaseID, collection string, embeddings map[string][]float32, opts ...option.ClientOption) error {
+	// projectID := "project-id"
+	// databaseID := "(default)"
+	// collection := "products"
+	const timeout = 30 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, err := firestore.NewClientWithDatabase(ctx, projectID, databaseID, opts...)
+	if err != nil {
+		return fmt.Errorf("firestore.NewClientWithDatabase: %w", err)
+	}
+	defer client.Close()
+
+	for id, e := range embeddings {
+		p := Product{Name: id, Embedding: firestore.Vector32(e)}
+		if _, err := client.Collection(collection).Doc(id).Set(ctx, p); err != nil {
+			return fmt.Errorf("Set(%q): %w", id, err)
+		}
+	}
+	fmt.Fprintf(w, "Stored %d embeddings\n", len(embeddings))
+	return nil
+}
+
+// [END firestore_vector_store]
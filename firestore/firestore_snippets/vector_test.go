@@ -0,0 +1,62 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+
+	"cloud.google.com/go/firestore"
+	"github.com/GoogleCloudPlatform/golang-samples/internal/apierrors"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+)
+
+func TestVectorSearch(t *testing.T) {
+	ctx := context.Background()
+	client, projectID, databaseID, collection := setup(ctx, t)
+	defer client.Close()
+	collection += "-vectors"
+
+	embeddings := map[string][]float32{
+		"x":  {1, 0, 0},
+		"y":  {0, 1, 0},
+		"z":  {0, 0, 1},
+		"xy": {0.9, 0.1, 0},
+	}
+	if err := storeEmbeddings(ctx, ioutil.Discard, projectID, databaseID, collection, embeddings); err != nil {
+		t.Fatalf("storeEmbeddings: %v", err)
+	}
+
+	for _, measure := range []firestore.DistanceMeasure{
+		firestore.DistanceMeasureEuclidean,
+		firestore.DistanceMeasureCosine,
+		firestore.DistanceMeasureDotProduct,
+	} {
+		got, err := vectorSearch(ctx, ioutil.Discard, projectID, databaseID, collection, []float64{1, 0, 0}, measure, 2)
+		if err != nil {
+			// The vector index is created by createVectorIndex in
+			// firestore/admin and takes minutes to build.
+			if apierrors.Code(err) == codes.FailedPrecondition {
+				t.Skipf("vectorSearch: missing vector index on %q: %v", collection, err)
+			}
+			t.Fatalf("vectorSearch(%v): %v", measure, err)
+		}
+		if diff := cmp.Diff([]string{"x", "xy"}, got); diff != "" {
+			t.Errorf("vectorSearch(%v) mismatch (-want +got):\n%s", measure, diff)
+		}
+	}
+}
@@ -22,7 +22,7 @@ import (
 	"io"
 
 	gaming "cloud.google.com/go/gaming/apiv1"
-	gamingpb "google.golang.org/genproto/googleapis/cloud/gaming/v1"
+	gamingpb "cloud.google.com/go/gaming/apiv1/gamingpb"
 )
 
 // createCluster registers a game server cluster.
@@ -35,7 +35,7 @@ func createCluster(w io.Writer, projectID, location, realmID, clusterID, gkeClus
 	ctx := context.Background()
 	client, err := gaming.NewGameServerClustersClient(ctx)
 	if err != nil {
-		return fmt.Errorf("NewGameServerClustersClient: %v", err)
+		return fmt.Errorf("NewGameServerClustersClient: %w", err)
 	}
 	defer client.Close()
 
@@ -57,11 +57,11 @@ func createCluster(w io.Writer, projectID, location, realmID, clusterID, gkeClus
 
 	op, err := client.CreateGameServerCluster(ctx, req)
 	if err != nil {
-		return fmt.Errorf("CreateGameServerCluster: %v", err)
+		return fmt.Errorf("CreateGameServerCluster: %w", err)
 	}
 	resp, err := op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("Wait: %v", err)
+		return fmt.Errorf("Wait: %w", err)
 	}
 
 	fmt.Fprintf(w, "Cluster created: %v", resp.Name)
@@ -22,7 +22,7 @@ import (
 	"io"
 
 	gaming "cloud.google.com/go/gaming/apiv1"
-	gamingpb "google.golang.org/genproto/googleapis/cloud/gaming/v1"
+	gamingpb "cloud.google.com/go/gaming/apiv1/gamingpb"
 )
 
 // fleet is the spec portion of an agones Fleet.  It must be in JSON format.
@@ -96,7 +96,7 @@ func createGameServerConfig(w io.Writer, projectID, deploymentID, configID strin
 	ctx := context.Background()
 	client, err := gaming.NewGameServerConfigsClient(ctx)
 	if err != nil {
-		return fmt.Errorf("NewGameServerConfigsClient: %v", err)
+		return fmt.Errorf("NewGameServerConfigsClient: %w", err)
 	}
 	defer client.Close()
 
@@ -116,11 +116,11 @@ func createGameServerConfig(w io.Writer, projectID, deploymentID, configID strin
 
 	op, err := client.CreateGameServerConfig(ctx, req)
 	if err != nil {
-		return fmt.Errorf("CreateGameServerConfig: %v", err)
+		return fmt.Errorf("CreateGameServerConfig: %w", err)
 	}
 	resp, err := op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("Wait: %v", err)
+		return fmt.Errorf("Wait: %w", err)
 	}
 
 	fmt.Fprintf(w, "Config created: %v", resp.Name)
@@ -22,7 +22,7 @@ import (
 	"io"
 
 	gaming "cloud.google.com/go/gaming/apiv1"
-	gamingpb "google.golang.org/genproto/googleapis/cloud/gaming/v1"
+	gamingpb "cloud.google.com/go/gaming/apiv1/gamingpb"
 )
 
 // createGameServerDeployment creates a game server deployment.
@@ -32,7 +32,7 @@ func createGameServerDeployment(w io.Writer, projectID, deploymentID string) err
 	ctx := context.Background()
 	client, err := gaming.NewGameServerDeploymentsClient(ctx)
 	if err != nil {
-		return fmt.Errorf("NewGameServerDeploymentsClient: %v", err)
+		return fmt.Errorf("NewGameServerDeploymentsClient: %w", err)
 	}
 	defer client.Close()
 
@@ -49,11 +49,11 @@ func createGameServerDeployment(w io.Writer, projectID, deploymentID string) err
 
 	op, err := client.CreateGameServerDeployment(ctx, req)
 	if err != nil {
-		return fmt.Errorf("CreateGameServerDeployment: %v", err)
+		return fmt.Errorf("CreateGameServerDeployment: %w", err)
 	}
 	resp, err := op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("Wait: %v", err)
+		return fmt.Errorf("Wait: %w", err)
 	}
 
 	fmt.Fprintf(w, "Deployment created: %v", resp.Name)
@@ -22,7 +22,7 @@ import (
 	"io"
 
 	gaming "cloud.google.com/go/gaming/apiv1"
-	gamingpb "google.golang.org/genproto/googleapis/cloud/gaming/v1"
+	gamingpb "cloud.google.com/go/gaming/apiv1/gamingpb"
 )
 
 // deleteCluster unregisters a game server cluster.
@@ -34,7 +34,7 @@ func deleteCluster(w io.Writer, projectID, location, realmID, clusterID string)
 	ctx := context.Background()
 	client, err := gaming.NewGameServerClustersClient(ctx)
 	if err != nil {
-		return fmt.Errorf("NewGameServerClustersClient: %v", err)
+		return fmt.Errorf("NewGameServerClustersClient: %w", err)
 	}
 	defer client.Close()
 
@@ -44,11 +44,11 @@ func deleteCluster(w io.Writer, projectID, location, realmID, clusterID string)
 
 	op, err := client.DeleteGameServerCluster(ctx, req)
 	if err != nil {
-		return fmt.Errorf("DeleteGameServerCluster: %v", err)
+		return fmt.Errorf("DeleteGameServerCluster: %w", err)
 	}
 	err = op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("Wait: %v", err)
+		return fmt.Errorf("Wait: %w", err)
 	}
 
 	fmt.Fprintf(w, "Cluster deleted.")
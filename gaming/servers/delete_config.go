@@ -22,7 +22,7 @@ import (
 	"io"
 
 	gaming "cloud.google.com/go/gaming/apiv1"
-	gamingpb "google.golang.org/genproto/googleapis/cloud/gaming/v1"
+	gamingpb "cloud.google.com/go/gaming/apiv1/gamingpb"
 )
 
 // deleteGameServerConfig deletes a game server config.
@@ -33,7 +33,7 @@ func deleteGameServerConfig(w io.Writer, projectID, deploymentID, configID strin
 	ctx := context.Background()
 	client, err := gaming.NewGameServerConfigsClient(ctx)
 	if err != nil {
-		return fmt.Errorf("NewGameServerConfigsClient: %v", err)
+		return fmt.Errorf("NewGameServerConfigsClient: %w", err)
 	}
 	defer client.Close()
 
@@ -43,11 +43,11 @@ func deleteGameServerConfig(w io.Writer, projectID, deploymentID, configID strin
 
 	op, err := client.DeleteGameServerConfig(ctx, req)
 	if err != nil {
-		return fmt.Errorf("DeleteGameServerConfig: %v", err)
+		return fmt.Errorf("DeleteGameServerConfig: %w", err)
 	}
 	err = op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("Wait: %v", err)
+		return fmt.Errorf("Wait: %w", err)
 	}
 
 	fmt.Fprintf(w, "Config deleted.")
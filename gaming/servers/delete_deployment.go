@@ -22,7 +22,7 @@ import (
 	"io"
 
 	gaming "cloud.google.com/go/gaming/apiv1"
-	gamingpb "google.golang.org/genproto/googleapis/cloud/gaming/v1"
+	gamingpb "cloud.google.com/go/gaming/apiv1/gamingpb"
 )
 
 // deleteGameServerDeployment deletes a game server deployment.
@@ -32,7 +32,7 @@ func deleteGameServerDeployment(w io.Writer, projectID, deploymentID string) err
 	ctx := context.Background()
 	client, err := gaming.NewGameServerDeploymentsClient(ctx)
 	if err != nil {
-		return fmt.Errorf("NewGameServerDeploymentsClient: %v", err)
+		return fmt.Errorf("NewGameServerDeploymentsClient: %w", err)
 	}
 	defer client.Close()
 
@@ -42,11 +42,11 @@ func deleteGameServerDeployment(w io.Writer, projectID, deploymentID string) err
 
 	op, err := client.DeleteGameServerDeployment(ctx, req)
 	if err != nil {
-		return fmt.Errorf("DeleteGameServerDeployment: %v", err)
+		return fmt.Errorf("DeleteGameServerDeployment: %w", err)
 	}
 	err = op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("Wait: %v", err)
+		return fmt.Errorf("Wait: %w", err)
 	}
 
 	fmt.Fprintf(w, "Deployment deleted.")
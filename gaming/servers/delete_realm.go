@@ -22,7 +22,7 @@ import (
 	"io"
 
 	gaming "cloud.google.com/go/gaming/apiv1"
-	gamingpb "google.golang.org/genproto/googleapis/cloud/gaming/v1"
+	gamingpb "cloud.google.com/go/gaming/apiv1/gamingpb"
 )
 
 // deleteRealm deletes a game server realm.
@@ -33,7 +33,7 @@ func deleteRealm(w io.Writer, projectID, location, realmID string) error {
 	ctx := context.Background()
 	client, err := gaming.NewRealmsClient(ctx)
 	if err != nil {
-		return fmt.Errorf("NewRealmsClient: %v", err)
+		return fmt.Errorf("NewRealmsClient: %w", err)
 	}
 	defer client.Close()
 
@@ -43,11 +43,11 @@ func deleteRealm(w io.Writer, projectID, location, realmID string) error {
 
 	op, err := client.DeleteRealm(ctx, req)
 	if err != nil {
-		return fmt.Errorf("DeleteRealm: %v", err)
+		return fmt.Errorf("DeleteRealm: %w", err)
 	}
 	err = op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("Wait: %v", err)
+		return fmt.Errorf("Wait: %w", err)
 	}
 
 	fmt.Fprintf(w, "Realm deleted.")
@@ -22,7 +22,7 @@ import (
 	"io"
 
 	gaming "cloud.google.com/go/gaming/apiv1"
-	gamingpb "google.golang.org/genproto/googleapis/cloud/gaming/v1"
+	gamingpb "cloud.google.com/go/gaming/apiv1/gamingpb"
 )
 
 // getGameServerCluster retrieves info on a game server cluster.
@@ -34,7 +34,7 @@ func getGameServerCluster(w io.Writer, projectID, location, realmID, clusterID s
 	ctx := context.Background()
 	client, err := gaming.NewGameServerClustersClient(ctx)
 	if err != nil {
-		return fmt.Errorf("NewGameServerClustersClient: %v", err)
+		return fmt.Errorf("NewGameServerClustersClient: %w", err)
 	}
 	defer client.Close()
 
@@ -44,7 +44,7 @@ func getGameServerCluster(w io.Writer, projectID, location, realmID, clusterID s
 
 	resp, err := client.GetGameServerCluster(ctx, req)
 	if err != nil {
-		return fmt.Errorf("GetGameServerCluster: %v", err)
+		return fmt.Errorf("GetGameServerCluster: %w", err)
 	}
 
 	fmt.Fprintf(w, "Cluster retrieved: %v", resp.Name)
@@ -22,7 +22,7 @@ import (
 	"io"
 
 	gaming "cloud.google.com/go/gaming/apiv1"
-	gamingpb "google.golang.org/genproto/googleapis/cloud/gaming/v1"
+	gamingpb "cloud.google.com/go/gaming/apiv1/gamingpb"
 )
 
 // getGameServerConfig retrieves info on a game server config.
@@ -33,7 +33,7 @@ func getGameServerConfig(w io.Writer, projectID, deploymentID, configID string)
 	ctx := context.Background()
 	client, err := gaming.NewGameServerConfigsClient(ctx)
 	if err != nil {
-		return fmt.Errorf("NewGameServerConfigsClient: %v", err)
+		return fmt.Errorf("NewGameServerConfigsClient: %w", err)
 	}
 	defer client.Close()
 
@@ -43,7 +43,7 @@ func getGameServerConfig(w io.Writer, projectID, deploymentID, configID string)
 
 	resp, err := client.GetGameServerConfig(ctx, req)
 	if err != nil {
-		return fmt.Errorf("GetGameServerConfig: %v", err)
+		return fmt.Errorf("GetGameServerConfig: %w", err)
 	}
 
 	fmt.Fprintf(w, "Config retrieved: %v", resp.Name)
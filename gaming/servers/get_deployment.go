@@ -22,7 +22,7 @@ import (
 	"io"
 
 	gaming "cloud.google.com/go/gaming/apiv1"
-	gamingpb "google.golang.org/genproto/googleapis/cloud/gaming/v1"
+	gamingpb "cloud.google.com/go/gaming/apiv1/gamingpb"
 )
 
 // getGameServerDeployment retrieves info on a game server deployment.
@@ -32,7 +32,7 @@ func getGameServerDeployment(w io.Writer, projectID, deploymentID string) error
 	ctx := context.Background()
 	client, err := gaming.NewGameServerDeploymentsClient(ctx)
 	if err != nil {
-		return fmt.Errorf("NewGameServerDeploymentsClient: %v", err)
+		return fmt.Errorf("NewGameServerDeploymentsClient: %w", err)
 	}
 	defer client.Close()
 
@@ -42,7 +42,7 @@ func getGameServerDeployment(w io.Writer, projectID, deploymentID string) error
 
 	resp, err := client.GetGameServerDeployment(ctx, req)
 	if err != nil {
-		return fmt.Errorf("GetGameServerDeployment: %v", err)
+		return fmt.Errorf("GetGameServerDeployment: %w", err)
 	}
 
 	fmt.Fprintf(w, "Deployment retrieved: %v", resp.Name)
@@ -22,7 +22,7 @@ import (
 	"io"
 
 	gaming "cloud.google.com/go/gaming/apiv1"
-	gamingpb "google.golang.org/genproto/googleapis/cloud/gaming/v1"
+	gamingpb "cloud.google.com/go/gaming/apiv1/gamingpb"
 )
 
 // getRealm retrieves info on a realm.
@@ -33,7 +33,7 @@ func getRealm(w io.Writer, projectID, location, realmID string) error {
 	ctx := context.Background()
 	client, err := gaming.NewRealmsClient(ctx)
 	if err != nil {
-		return fmt.Errorf("NewRealmsClient: %v", err)
+		return fmt.Errorf("NewRealmsClient: %w", err)
 	}
 	defer client.Close()
 
@@ -43,7 +43,7 @@ func getRealm(w io.Writer, projectID, location, realmID string) error {
 
 	resp, err := client.GetRealm(ctx, req)
 	if err != nil {
-		return fmt.Errorf("GetRealm: %v", err)
+		return fmt.Errorf("GetRealm: %w", err)
 	}
 
 	fmt.Fprintf(w, "Realm retrieved: %v", resp.Name)
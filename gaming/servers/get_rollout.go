@@ -22,7 +22,7 @@ import (
 	"io"
 
 	gaming "cloud.google.com/go/gaming/apiv1"
-	gamingpb "google.golang.org/genproto/googleapis/cloud/gaming/v1"
+	gamingpb "cloud.google.com/go/gaming/apiv1/gamingpb"
 )
 
 // getGameServerDeploymentRollout retrieves info on a game server deployment's rollout.
@@ -32,7 +32,7 @@ func getGameServerDeploymentRollout(w io.Writer, projectID, deploymentID string)
 	ctx := context.Background()
 	client, err := gaming.NewGameServerDeploymentsClient(ctx)
 	if err != nil {
-		return fmt.Errorf("NewGameServerDeploymentsClient: %v", err)
+		return fmt.Errorf("NewGameServerDeploymentsClient: %w", err)
 	}
 	defer client.Close()
 
@@ -42,7 +42,7 @@ func getGameServerDeploymentRollout(w io.Writer, projectID, deploymentID string)
 
 	resp, err := client.GetGameServerDeploymentRollout(ctx, req)
 	if err != nil {
-		return fmt.Errorf("GetGameServerDeploymentRollout: %v", err)
+		return fmt.Errorf("GetGameServerDeploymentRollout: %w", err)
 	}
 
 	fmt.Fprintf(w, "Rollout default: %v\n", resp.DefaultGameServerConfig)
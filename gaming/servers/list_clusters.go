@@ -22,8 +22,8 @@ import (
 	"io"
 
 	gaming "cloud.google.com/go/gaming/apiv1"
+	gamingpb "cloud.google.com/go/gaming/apiv1/gamingpb"
 	"google.golang.org/api/iterator"
-	gamingpb "google.golang.org/genproto/googleapis/cloud/gaming/v1"
 )
 
 // listGameServerClusters lists the clusters registered with a realm.
@@ -34,7 +34,7 @@ func listGameServerClusters(w io.Writer, projectID, location, realmID string) er
 	ctx := context.Background()
 	client, err := gaming.NewGameServerClustersClient(ctx)
 	if err != nil {
-		return fmt.Errorf("NewGameServerClustersClient: %v", err)
+		return fmt.Errorf("NewGameServerClustersClient: %w", err)
 	}
 	defer client.Close()
 
@@ -49,7 +49,7 @@ func listGameServerClusters(w io.Writer, projectID, location, realmID string) er
 			break
 		}
 		if err != nil {
-			return fmt.Errorf("Next: %v", err)
+			return fmt.Errorf("Next: %w", err)
 		}
 
 		fmt.Fprintf(w, "Cluster listed: %v\n", resp.Name)
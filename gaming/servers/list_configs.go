@@ -22,8 +22,8 @@ import (
 	"io"
 
 	gaming "cloud.google.com/go/gaming/apiv1"
+	gamingpb "cloud.google.com/go/gaming/apiv1/gamingpb"
 	"google.golang.org/api/iterator"
-	gamingpb "google.golang.org/genproto/googleapis/cloud/gaming/v1"
 )
 
 // listGameServerConfigs lists the game server configs.
@@ -33,7 +33,7 @@ func listGameServerConfigs(w io.Writer, projectID, deploymentID string) error {
 	ctx := context.Background()
 	client, err := gaming.NewGameServerConfigsClient(ctx)
 	if err != nil {
-		return fmt.Errorf("NewGameServerConfigsClient: %v", err)
+		return fmt.Errorf("NewGameServerConfigsClient: %w", err)
 	}
 	defer client.Close()
 
@@ -48,7 +48,7 @@ func listGameServerConfigs(w io.Writer, projectID, deploymentID string) error {
 			break
 		}
 		if err != nil {
-			return fmt.Errorf("Next: %v", err)
+			return fmt.Errorf("Next: %w", err)
 		}
 
 		fmt.Fprintf(w, "Config listed: %v\n", resp.Name)
@@ -22,8 +22,8 @@ import (
 	"io"
 
 	gaming "cloud.google.com/go/gaming/apiv1"
+	gamingpb "cloud.google.com/go/gaming/apiv1/gamingpb"
 	"google.golang.org/api/iterator"
-	gamingpb "google.golang.org/genproto/googleapis/cloud/gaming/v1"
 )
 
 // listGameServerDeployments lists the game server deployments.
@@ -33,7 +33,7 @@ func listGameServerDeployments(w io.Writer, projectID string) error {
 	ctx := context.Background()
 	client, err := gaming.NewGameServerDeploymentsClient(ctx)
 	if err != nil {
-		return fmt.Errorf("NewGameServerDeploymentsClient: %v", err)
+		return fmt.Errorf("NewGameServerDeploymentsClient: %w", err)
 	}
 	defer client.Close()
 
@@ -48,7 +48,7 @@ func listGameServerDeployments(w io.Writer, projectID string) error {
 			break
 		}
 		if err != nil {
-			return fmt.Errorf("Next: %v", err)
+			return fmt.Errorf("Next: %w", err)
 		}
 
 		fmt.Fprintf(w, "Deployment listed: %v\n", resp.Name)
@@ -22,8 +22,8 @@ import (
 	"io"
 
 	gaming "cloud.google.com/go/gaming/apiv1"
+	gamingpb "cloud.google.com/go/gaming/apiv1/gamingpb"
 	"google.golang.org/api/iterator"
-	gamingpb "google.golang.org/genproto/googleapis/cloud/gaming/v1"
 )
 
 // listRealms lists the realms in a location.
@@ -33,7 +33,7 @@ func listRealms(w io.Writer, projectID, location string) error {
 	ctx := context.Background()
 	client, err := gaming.NewRealmsClient(ctx)
 	if err != nil {
-		return fmt.Errorf("NewRealmsClient: %v", err)
+		return fmt.Errorf("NewRealmsClient: %w", err)
 	}
 	defer client.Close()
 
@@ -48,7 +48,7 @@ func listRealms(w io.Writer, projectID, location string) error {
 			break
 		}
 		if err != nil {
-			return fmt.Errorf("Next: %v", err)
+			return fmt.Errorf("Next: %w", err)
 		}
 
 		fmt.Fprintf(w, "Realm listed: %v\n", resp.Name)
@@ -22,7 +22,7 @@ import (
 	"io"
 
 	gaming "cloud.google.com/go/gaming/apiv1"
-	gamingpb "google.golang.org/genproto/googleapis/cloud/gaming/v1"
+	gamingpb "cloud.google.com/go/gaming/apiv1/gamingpb"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
@@ -34,7 +34,7 @@ func updateRolloutDefaultConfig(w io.Writer, projectID, deploymentID, configID s
 	ctx := context.Background()
 	client, err := gaming.NewGameServerDeploymentsClient(ctx)
 	if err != nil {
-		return fmt.Errorf("NewGameServerDeploymentsClient: %v", err)
+		return fmt.Errorf("NewGameServerDeploymentsClient: %w", err)
 	}
 	defer client.Close()
 
@@ -53,11 +53,11 @@ func updateRolloutDefaultConfig(w io.Writer, projectID, deploymentID, configID s
 
 	op, err := client.UpdateGameServerDeploymentRollout(ctx, req)
 	if err != nil {
-		return fmt.Errorf("UpdateGameServerDeploymentRollout: %v", err)
+		return fmt.Errorf("UpdateGameServerDeploymentRollout: %w", err)
 	}
 	resp, err := op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("Wait: %v", err)
+		return fmt.Errorf("Wait: %w", err)
 	}
 
 	fmt.Fprintf(w, "Deployment rollout updated: %v", resp.Name)
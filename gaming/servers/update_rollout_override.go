@@ -22,7 +22,7 @@ import (
 	"io"
 
 	gaming "cloud.google.com/go/gaming/apiv1"
-	gamingpb "google.golang.org/genproto/googleapis/cloud/gaming/v1"
+	gamingpb "cloud.google.com/go/gaming/apiv1/gamingpb"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
@@ -36,7 +36,7 @@ func updateRolloutOverrideConfig(w io.Writer, projectID, realmLocation, realmID,
 	ctx := context.Background()
 	client, err := gaming.NewGameServerDeploymentsClient(ctx)
 	if err != nil {
-		return fmt.Errorf("NewGameServerDeploymentsClient: %v", err)
+		return fmt.Errorf("NewGameServerDeploymentsClient: %w", err)
 	}
 	defer client.Close()
 
@@ -68,11 +68,11 @@ func updateRolloutOverrideConfig(w io.Writer, projectID, realmLocation, realmID,
 
 	op, err := client.UpdateGameServerDeploymentRollout(ctx, req)
 	if err != nil {
-		return fmt.Errorf("UpdateGameServerDeploymentRollout: %v", err)
+		return fmt.Errorf("UpdateGameServerDeploymentRollout: %w", err)
 	}
 	resp, err := op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("Wait: %v", err)
+		return fmt.Errorf("Wait: %w", err)
 	}
 
 	fmt.Fprintf(w, "Deployment rollout updated: %v", resp.Name)
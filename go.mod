@@ -1,32 +1,61 @@
 module github.com/GoogleCloudPlatform/golang-samples
 
-go 1.11
+go 1.22
 
 require (
-	cloud.google.com/go v0.73.0
-	cloud.google.com/go/bigquery v1.14.0
-	cloud.google.com/go/bigtable v1.4.0
-	cloud.google.com/go/datastore v1.2.0
-	cloud.google.com/go/firestore v1.3.0
-	cloud.google.com/go/logging v1.0.0
-	cloud.google.com/go/pubsub v1.6.1
-	cloud.google.com/go/spanner v1.10.0
-	cloud.google.com/go/storage v1.11.0
+	cloud.google.com/go v0.115.1
+	cloud.google.com/go/asset v1.20.0
+	cloud.google.com/go/automl v1.14.0
+	cloud.google.com/go/bigquery v1.62.0
+	cloud.google.com/go/bigtable v1.31.0
+	cloud.google.com/go/cloudtasks v1.13.0
+	cloud.google.com/go/compute/metadata v0.5.0
+	cloud.google.com/go/containeranalysis v0.13.0
+	cloud.google.com/go/datacatalog v1.22.0
+	cloud.google.com/go/dataproc v1.12.0
+	cloud.google.com/go/datastore v1.19.0
+	cloud.google.com/go/dialogflow v1.57.0
+	cloud.google.com/go/dlp v1.18.0
+	cloud.google.com/go/errorreporting v0.3.1
+	cloud.google.com/go/firestore v1.17.0
+	cloud.google.com/go/gaming v1.10.1
+	cloud.google.com/go/iam v1.2.1
+	cloud.google.com/go/kms v1.19.1
+	cloud.google.com/go/language v1.14.0
+	cloud.google.com/go/logging v1.11.0
+	cloud.google.com/go/monitoring v1.21.0
+	cloud.google.com/go/profiler v0.4.1
+	cloud.google.com/go/pubsub v1.44.0
+	cloud.google.com/go/pubsublite v1.8.2
+	cloud.google.com/go/secretmanager v1.14.0
+	cloud.google.com/go/securitycenter v1.35.0
+	cloud.google.com/go/servicedirectory v1.12.0
+	cloud.google.com/go/spanner v1.67.0
+	cloud.google.com/go/speech v1.25.0
+	cloud.google.com/go/storage v1.43.0
+	cloud.google.com/go/storagetransfer v1.11.0
+	cloud.google.com/go/talent v1.7.0
+	cloud.google.com/go/texttospeech v1.8.0
+	cloud.google.com/go/translate v1.12.0
+	cloud.google.com/go/video v1.23.0
+	cloud.google.com/go/videointelligence v1.12.0
+	cloud.google.com/go/vision v1.2.0
 	contrib.go.opencensus.io/exporter/stackdriver v0.13.4
+	firebase.google.com/go/v4 v4.14.1
+	github.com/GoogleCloudPlatform/opentelemetry-operations-go/exporter/trace v1.24.0
 	github.com/aws/aws-sdk-go v1.36.2
 	github.com/bmatcuk/doublestar/v2 v2.0.4
-	github.com/bmizerany/assert v0.0.0-20160611221934-b7ed37b82869 // indirect
 	github.com/bradfitz/gomemcache v0.0.0-20190913173617-a41fca850d0b
 	github.com/dgrijalva/jwt-go v3.2.0+incompatible
 	github.com/eclipse/paho.mqtt.golang v1.2.0
 	github.com/fluent/fluent-logger-golang v1.5.0
 	github.com/go-sql-driver/mysql v1.5.0
 	github.com/gofrs/uuid v3.3.0+incompatible
-	github.com/golang/protobuf v1.4.3
+	github.com/golang/protobuf v1.5.4
 	github.com/gomodule/redigo v2.0.0+incompatible
-	github.com/google/go-cmp v0.5.4
-	github.com/google/uuid v1.1.2
-	github.com/googleapis/gax-go/v2 v2.0.5
+	github.com/google/go-cmp v0.6.0
+	github.com/google/uuid v1.6.0
+	github.com/googleapis/gax-go/v2 v2.13.0
 	github.com/gorilla/mux v1.8.0
 	github.com/gorilla/websocket v1.4.2
 	github.com/h2non/filetype v1.1.0
@@ -34,23 +63,85 @@ require (
 	github.com/linkedin/goavro/v2 v2.10.0
 	github.com/mailgun/mailgun-go/v3 v3.6.4
 	github.com/mailjet/mailjet-apiv3-go v0.0.0-20190724151621-55e56f74078c
-	github.com/philhofer/fwd v1.0.0 // indirect
-	github.com/sendgrid/smtpapi-go v0.6.0 // indirect
-	github.com/tinylib/msgp v1.1.2 // indirect
-	go.opencensus.io v0.22.5
-	golang.org/x/exp v0.0.0-20201203231725-fa01524bc59d
-	golang.org/x/net v0.0.0-20201202161906-c7110b5ffcbb
-	golang.org/x/oauth2 v0.0.0-20201207163604-931764155e3f
-	golang.org/x/text v0.3.4
-	google.golang.org/api v0.36.0
-	google.golang.org/appengine v1.6.7
-	google.golang.org/genproto v0.0.0-20201207150747-9ee31aac76e7
-	google.golang.org/grpc v1.34.0
+	go.opencensus.io v0.24.0
+	go.opentelemetry.io/otel v1.29.0
+	go.opentelemetry.io/otel/sdk v1.29.0
+	go.opentelemetry.io/otel/trace v1.29.0
+	golang.org/x/exp v0.0.0-20231006140011-7918f672742d
+	golang.org/x/net v0.29.0
+	golang.org/x/oauth2 v0.23.0
+	golang.org/x/text v0.18.0
+	google.golang.org/api v0.197.0
+	google.golang.org/appengine v1.6.8
+	google.golang.org/genproto v0.0.0-20240903143218-8af14fe29dc1
+	google.golang.org/genproto/googleapis/api v0.0.0-20240903143218-8af14fe29dc1
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20240903143218-8af14fe29dc1
+	google.golang.org/grpc v1.66.2
 	google.golang.org/grpc/examples v0.0.0-20200707005602-4258d12073b4
-	google.golang.org/protobuf v1.25.0
+	google.golang.org/protobuf v1.34.2
 	gopkg.in/sendgrid/sendgrid-go.v2 v2.0.0
 	gopkg.in/yaml.v2 v2.4.0
 )
 
+require (
+	cel.dev/expr v0.16.0 // indirect
+	cloud.google.com/go/accesscontextmanager v1.9.0 // indirect
+	cloud.google.com/go/auth v0.9.3 // indirect
+	cloud.google.com/go/auth/oauth2adapt v0.2.4 // indirect
+	cloud.google.com/go/container v1.39.0 // indirect
+	cloud.google.com/go/grafeas v0.3.10 // indirect
+	cloud.google.com/go/longrunning v0.6.0 // indirect
+	cloud.google.com/go/orgpolicy v1.13.0 // indirect
+	cloud.google.com/go/osconfig v1.14.0 // indirect
+	cloud.google.com/go/trace v1.11.0 // indirect
+	cloud.google.com/go/vision/v2 v2.9.0 // indirect
+	github.com/GoogleCloudPlatform/grpc-gcp-go/grpcgcp v1.5.0 // indirect
+	github.com/GoogleCloudPlatform/opentelemetry-operations-go/internal/resourcemapping v0.48.0 // indirect
+	github.com/MicahParks/keyfunc v1.9.0 // indirect
+	github.com/apache/arrow/go/v15 v15.0.2 // indirect
+	github.com/bmizerany/assert v0.0.0-20160611221934-b7ed37b82869 // indirect
+	github.com/census-instrumentation/opencensus-proto v0.4.1 // indirect
+	github.com/cespare/xxhash/v2 v2.3.0 // indirect
+	github.com/cncf/xds/go v0.0.0-20240822171458-6449f94b4d59 // indirect
+	github.com/envoyproxy/go-control-plane v0.13.0 // indirect
+	github.com/envoyproxy/protoc-gen-validate v1.1.0 // indirect
+	github.com/felixge/httpsnoop v1.0.4 // indirect
+	github.com/go-chi/chi v4.0.0+incompatible // indirect
+	github.com/go-logr/logr v1.4.2 // indirect
+	github.com/go-logr/stdr v1.2.2 // indirect
+	github.com/goccy/go-json v0.10.2 // indirect
+	github.com/golang-jwt/jwt/v4 v4.5.0 // indirect
+	github.com/golang/groupcache v0.0.0-20210331224755-41bb18bfe9da // indirect
+	github.com/golang/snappy v0.0.4 // indirect
+	github.com/google/flatbuffers v23.5.26+incompatible // indirect
+	github.com/google/pprof v0.0.0-20240528025155-186aa0362fba // indirect
+	github.com/google/s2a-go v0.1.8 // indirect
+	github.com/googleapis/enterprise-certificate-proxy v0.3.4 // indirect
+	github.com/jmespath/go-jmespath v0.4.0 // indirect
+	github.com/klauspost/compress v1.16.7 // indirect
+	github.com/klauspost/cpuid/v2 v2.2.5 // indirect
+	github.com/mailru/easyjson v0.0.0-20180823135443-60711f1a8329 // indirect
+	github.com/philhofer/fwd v1.0.0 // indirect
+	github.com/pierrec/lz4/v4 v4.1.18 // indirect
+	github.com/pkg/errors v0.9.1 // indirect
+	github.com/planetscale/vtprotobuf v0.6.1-0.20240319094008-0393e58bdf10 // indirect
+	github.com/rogpeppe/go-internal v1.12.0 // indirect
+	github.com/sendgrid/smtpapi-go v0.6.0 // indirect
+	github.com/tinylib/msgp v1.1.2 // indirect
+	github.com/zeebo/xxh3 v1.0.2 // indirect
+	go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc v0.54.0 // indirect
+	go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp v0.54.0 // indirect
+	go.opentelemetry.io/otel/metric v1.29.0 // indirect
+	go.opentelemetry.io/otel/sdk/metric v1.29.0 // indirect
+	golang.org/x/crypto v0.27.0 // indirect
+	golang.org/x/mod v0.18.0 // indirect
+	golang.org/x/sync v0.8.0 // indirect
+	golang.org/x/sys v0.25.0 // indirect
+	golang.org/x/time v0.6.0 // indirect
+	golang.org/x/tools v0.22.0 // indirect
+	golang.org/x/xerrors v0.0.0-20240716161551-93cc26a95ae9 // indirect
+	google.golang.org/appengine/v2 v2.0.2 // indirect
+)
+
 // https://github.com/jstemmer/go-junit-report/issues/107
 replace github.com/jstemmer/go-junit-report => github.com/tbpg/go-junit-report v0.9.2-0.20200506144438-50086c54f894
@@ -36,14 +36,14 @@ func TestDataset(t *testing.T) {
 
 	// Delete test datasets if they already exist.
 	if err := getDataset(buf, tc.ProjectID, location, datasetID); err == nil {
-		testutil.Retry(t, 10, time.Second, func(r *testutil.R) {
+		testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
 			if err := deleteDataset(ioutil.Discard, tc.ProjectID, location, datasetID); err != nil {
 				r.Errorf("deleteDataset got err: %v", err)
 			}
 		})
 	}
 	if err := getDataset(buf, tc.ProjectID, location, deidentifiedDatasetID); err == nil {
-		testutil.Retry(t, 10, time.Second, func(r *testutil.R) {
+		testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
 			if err := deleteDataset(ioutil.Discard, tc.ProjectID, location, deidentifiedDatasetID); err != nil {
 				r.Errorf("deleteDataset got err: %v", err)
 			}
@@ -54,7 +54,7 @@ func TestDataset(t *testing.T) {
 		t.Fatalf("createDataset got err: %v", err)
 	}
 	name := fmt.Sprintf("projects/%s/locations/%s/datasets/%s", tc.ProjectID, location, datasetID)
-	testutil.Retry(t, 10, 2*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 2 * time.Second, Max: 4 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		buf.Reset()
 		if err := listDatasets(buf, tc.ProjectID, location); err != nil {
 			r.Errorf("listDatasets got err: %v", err)
@@ -65,7 +65,7 @@ func TestDataset(t *testing.T) {
 		}
 	})
 
-	testutil.Retry(t, 10, 2*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 2 * time.Second, Max: 4 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		buf.Reset()
 		if err := getDataset(buf, tc.ProjectID, location, datasetID); err != nil {
 			r.Errorf("getDataset got err: %v", err)
@@ -76,25 +76,25 @@ func TestDataset(t *testing.T) {
 		}
 	})
 
-	testutil.Retry(t, 10, 2*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 2 * time.Second, Max: 4 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		if err := deidentifyDataset(ioutil.Discard, tc.ProjectID, location, datasetID, deidentifiedDatasetID); err != nil {
 			r.Errorf("deidentifyDataset got err: %v", err)
 		}
 	})
 
-	testutil.Retry(t, 10, 2*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 2 * time.Second, Max: 4 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		if err := patchDataset(ioutil.Discard, tc.ProjectID, location, datasetID, "UTC"); err != nil {
 			r.Errorf("patchDataset got err: %v", err)
 		}
 	})
 
-	testutil.Retry(t, 10, 2*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 2 * time.Second, Max: 4 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		if err := deleteDataset(ioutil.Discard, tc.ProjectID, location, datasetID); err != nil {
 			r.Errorf("deleteDataset got err: %v", err)
 		}
 	})
 
-	testutil.Retry(t, 10, 2*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 2 * time.Second, Max: 4 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		if err := deleteDataset(ioutil.Discard, tc.ProjectID, location, deidentifiedDatasetID); err != nil {
 			r.Errorf("deleteDataset (deidentified) got err: %v", err)
 		}
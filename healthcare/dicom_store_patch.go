@@ -29,7 +29,7 @@ func patchDICOMStore(w io.Writer, projectID, location, datasetID, dicomStoreID,
 
 	healthcareService, err := healthcare.NewService(ctx)
 	if err != nil {
-		return fmt.Errorf("healthcare.NewService: %v", err)
+		return fmt.Errorf("healthcare.NewService: %w", err)
 	}
 
 	storesService := healthcareService.Projects.Locations.Datasets.DicomStores
@@ -41,7 +41,7 @@ func patchDICOMStore(w io.Writer, projectID, location, datasetID, dicomStoreID,
 			PubsubTopic: topicName, // format is "projects/*/locations/*/topics/*"
 		},
 	}).UpdateMask("notificationConfig").Do(); err != nil {
-		return fmt.Errorf("Patch: %v", err)
+		return fmt.Errorf("Patch: %w", err)
 	}
 
 	fmt.Fprintf(w, "Patched DICOM store %s with Pub/sub topic %s\n", datasetID, topicName)
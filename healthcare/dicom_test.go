@@ -51,7 +51,7 @@ func TestDICOMStore(t *testing.T) {
 
 	// Delete test dataset if it already exists.
 	if err := getDataset(buf, tc.ProjectID, location, datasetID); err == nil {
-		testutil.Retry(t, 10, time.Second, func(r *testutil.R) {
+		testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
 			if err := deleteDataset(ioutil.Discard, tc.ProjectID, location, datasetID); err != nil {
 				r.Errorf("deleteDataset got err: %v", err)
 			}
@@ -68,7 +68,7 @@ func TestDICOMStore(t *testing.T) {
 	}
 
 	dicomStoreName := fmt.Sprintf("projects/%s/locations/%s/datasets/%s/dicomStores/%s", tc.ProjectID, location, datasetID, dicomStoreID)
-	testutil.Retry(t, 10, 2*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 2 * time.Second, Max: 4 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		if err := listDICOMStores(buf, tc.ProjectID, location, datasetID); err != nil {
 			r.Errorf("listDICOMStores got err: %v", err)
 		}
@@ -77,7 +77,7 @@ func TestDICOMStore(t *testing.T) {
 		}
 	})
 
-	testutil.Retry(t, 10, 2*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 2 * time.Second, Max: 4 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		buf.Reset()
 		if err := getDICOMStore(buf, tc.ProjectID, location, datasetID, dicomStoreID); err != nil {
 			r.Errorf("getDICOMStore got err: %v", err)
@@ -87,13 +87,13 @@ func TestDICOMStore(t *testing.T) {
 		}
 	})
 
-	testutil.Retry(t, 10, 2*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 2 * time.Second, Max: 4 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		if err := dicomWebStoreInstance(ioutil.Discard, tc.ProjectID, location, datasetID, dicomStoreID, studyUID, "./testdata/dicom_00000001_000.dcm"); err != nil {
 			r.Errorf("dicomStoreInstance got err: %v", err)
 		}
 	})
 
-	testutil.Retry(t, 10, 2*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 2 * time.Second, Max: 4 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		// Remove the output file if it already exists.
 		os.Remove(studyOutputFile)
 		buf.Reset()
@@ -121,7 +121,7 @@ func TestDICOMStore(t *testing.T) {
 		os.Remove(studyOutputFile)
 	})
 
-	testutil.Retry(t, 10, 2*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 2 * time.Second, Max: 4 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		// Remove the output file if it already exists.
 		os.Remove(instanceOutputFile)
 		buf.Reset()
@@ -150,19 +150,19 @@ func TestDICOMStore(t *testing.T) {
 		os.Remove(instanceOutputFile)
 	})
 
-	testutil.Retry(t, 10, 2*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 2 * time.Second, Max: 4 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		if err := dicomWebSearchStudies(ioutil.Discard, tc.ProjectID, location, datasetID, dicomStoreID, studyPath); err != nil {
 			r.Errorf("dicomWebSearchStudies got err: %v", err)
 		}
 	})
 
-	testutil.Retry(t, 10, 2*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 2 * time.Second, Max: 4 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		if err := dicomWebSearchInstances(ioutil.Discard, tc.ProjectID, location, datasetID, dicomStoreID); err != nil {
 			r.Errorf("dicomWebSearchInstances got err: %v", err)
 		}
 	})
 
-	testutil.Retry(t, 10, 2*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 2 * time.Second, Max: 4 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		// Remove the output file if it already exists.
 		os.Remove(renderedOutputFile)
 		buf.Reset()
@@ -190,19 +190,19 @@ func TestDICOMStore(t *testing.T) {
 		os.Remove(renderedOutputFile)
 	})
 
-	testutil.Retry(t, 10, 2*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 2 * time.Second, Max: 4 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		if err := dicomWebDeleteStudy(ioutil.Discard, tc.ProjectID, location, datasetID, dicomStoreID, studyUID); err != nil {
 			r.Errorf("dicomWebDeleteStudy got err: %v", err)
 		}
 	})
 
-	testutil.Retry(t, 10, 2*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 2 * time.Second, Max: 4 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		if err := deleteDICOMStore(ioutil.Discard, tc.ProjectID, location, datasetID, dicomStoreID); err != nil {
 			r.Errorf("deleteDICOMStore got err: %v", err)
 		}
 	})
 
-	testutil.Retry(t, 10, 2*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 2 * time.Second, Max: 4 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		if err := deleteDataset(ioutil.Discard, tc.ProjectID, location, datasetID); err != nil {
 			r.Errorf("deleteDataset got err: %v", err)
 		}
@@ -37,7 +37,7 @@ func dicomWebRetrieveStudy(w io.Writer, projectID, location, datasetID, dicomSto
 
 	healthcareService, err := healthcare.NewService(ctx)
 	if err != nil {
-		return fmt.Errorf("healthcare.NewService: %v", err)
+		return fmt.Errorf("healthcare.NewService: %w", err)
 	}
 
 	storesService := healthcareService.Projects.Locations.Datasets.DicomStores.Studies
@@ -46,7 +46,7 @@ func dicomWebRetrieveStudy(w io.Writer, projectID, location, datasetID, dicomSto
 
 	resp, err := storesService.RetrieveStudy(parent, dicomWebPath).Do()
 	if err != nil {
-		return fmt.Errorf("RetrieveStudy: %v", err)
+		return fmt.Errorf("RetrieveStudy: %w", err)
 	}
 
 	defer resp.Body.Close()
@@ -57,11 +57,11 @@ func dicomWebRetrieveStudy(w io.Writer, projectID, location, datasetID, dicomSto
 
 	file, err := os.Create(outputFile)
 	if err != nil {
-		return fmt.Errorf("os.Create: %v", err)
+		return fmt.Errorf("os.Create: %w", err)
 	}
 	defer file.Close()
 	if _, err := io.Copy(file, resp.Body); err != nil {
-		return fmt.Errorf("io.Copy: %v", err)
+		return fmt.Errorf("io.Copy: %w", err)
 	}
 
 	// When specifying the output file, use an extension like ".multipart".
@@ -32,7 +32,7 @@ func fhirExecuteBundle(w io.Writer, projectID, location, datasetID, fhirStoreID
 
 	healthcareService, err := healthcare.NewService(ctx)
 	if err != nil {
-		return fmt.Errorf("healthcare.NewService: %v", err)
+		return fmt.Errorf("healthcare.NewService: %w", err)
 	}
 
 	fhirService := healthcareService.Projects.Locations.Datasets.FhirStores.Fhir
@@ -51,7 +51,7 @@ func fhirExecuteBundle(w io.Writer, projectID, location, datasetID, fhirStoreID
 	}
 	jsonPayload, err := json.Marshal(payload)
 	if err != nil {
-		return fmt.Errorf("json.Encode: %v", err)
+		return fmt.Errorf("json.Encode: %w", err)
 	}
 
 	parent := fmt.Sprintf("projects/%s/locations/%s/datasets/%s/fhirStores/%s", projectID, location, datasetID, fhirStoreID)
@@ -60,13 +60,13 @@ func fhirExecuteBundle(w io.Writer, projectID, location, datasetID, fhirStoreID
 	call.Header().Set("Content-Type", "application/fhir+json;charset=utf-8")
 	resp, err := call.Do()
 	if err != nil {
-		return fmt.Errorf("ExecuteBundle: %v", err)
+		return fmt.Errorf("ExecuteBundle: %w", err)
 	}
 	defer resp.Body.Close()
 
 	respBytes, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return fmt.Errorf("could not read response: %v", err)
+		return fmt.Errorf("could not read response: %w", err)
 	}
 
 	if resp.StatusCode > 299 {
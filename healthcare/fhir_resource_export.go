@@ -30,7 +30,7 @@ func exportFHIRResource(w io.Writer, projectID, location, datasetID, fhirStoreID
 
 	healthcareService, err := healthcare.NewService(ctx)
 	if err != nil {
-		return fmt.Errorf("healthcare.NewService: %v", err)
+		return fmt.Errorf("healthcare.NewService: %w", err)
 	}
 
 	storesService := healthcareService.Projects.Locations.Datasets.FhirStores
@@ -44,7 +44,7 @@ func exportFHIRResource(w io.Writer, projectID, location, datasetID, fhirStoreID
 
 	op, err := storesService.Export(name, req).Do()
 	if err != nil {
-		return fmt.Errorf("Export: %v", err)
+		return fmt.Errorf("Export: %w", err)
 	}
 
 	operationsService := healthcareService.Projects.Locations.Datasets.Operations
@@ -57,7 +57,7 @@ func exportFHIRResource(w io.Writer, projectID, location, datasetID, fhirStoreID
 		case <-ticker.C:
 			newOp, err := operationsService.Get(op.Name).Do()
 			if err != nil {
-				return fmt.Errorf("operationsService.Get(%q): %v", op.Name, err)
+				return fmt.Errorf("operationsService.Get(%q): %w", op.Name, err)
 			}
 			if newOp.Done {
 				if newOp.Error != nil {
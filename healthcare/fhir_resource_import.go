@@ -30,7 +30,7 @@ func importFHIRResource(w io.Writer, projectID, location, datasetID, fhirStoreID
 
 	healthcareService, err := healthcare.NewService(ctx)
 	if err != nil {
-		return fmt.Errorf("healthcare.NewService: %v", err)
+		return fmt.Errorf("healthcare.NewService: %w", err)
 	}
 
 	storesService := healthcareService.Projects.Locations.Datasets.FhirStores
@@ -45,7 +45,7 @@ func importFHIRResource(w io.Writer, projectID, location, datasetID, fhirStoreID
 
 	op, err := storesService.Import(name, req).Do()
 	if err != nil {
-		return fmt.Errorf("Import: %v", err)
+		return fmt.Errorf("Import: %w", err)
 	}
 
 	operationsService := healthcareService.Projects.Locations.Datasets.Operations
@@ -58,7 +58,7 @@ func importFHIRResource(w io.Writer, projectID, location, datasetID, fhirStoreID
 		case <-ticker.C:
 			newOp, err := operationsService.Get(op.Name).Do()
 			if err != nil {
-				return fmt.Errorf("operationsService.Get(%q): %v", op.Name, err)
+				return fmt.Errorf("operationsService.Get(%q): %w", op.Name, err)
 			}
 			if newOp.Done {
 				if newOp.Error != nil {
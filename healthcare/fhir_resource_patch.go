@@ -32,7 +32,7 @@ func patchFHIRResource(w io.Writer, projectID, location, datasetID, fhirStoreID,
 
 	healthcareService, err := healthcare.NewService(ctx)
 	if err != nil {
-		return fmt.Errorf("healthcare.NewService: %v", err)
+		return fmt.Errorf("healthcare.NewService: %w", err)
 	}
 
 	fhirService := healthcareService.Projects.Locations.Datasets.FhirStores.Fhir
@@ -49,7 +49,7 @@ func patchFHIRResource(w io.Writer, projectID, location, datasetID, fhirStoreID,
 	}
 	jsonPayload, err := json.Marshal(payload)
 	if err != nil {
-		return fmt.Errorf("json.Encode: %v", err)
+		return fmt.Errorf("json.Encode: %w", err)
 	}
 
 	name := fmt.Sprintf("projects/%s/locations/%s/datasets/%s/fhirStores/%s/fhir/%s/%s", projectID, location, datasetID, fhirStoreID, resourceType, fhirResourceID)
@@ -58,13 +58,13 @@ func patchFHIRResource(w io.Writer, projectID, location, datasetID, fhirStoreID,
 	call.Header().Set("Content-Type", "application/json-patch+json")
 	resp, err := call.Do()
 	if err != nil {
-		return fmt.Errorf("Patch: %v", err)
+		return fmt.Errorf("Patch: %w", err)
 	}
 	defer resp.Body.Close()
 
 	respBytes, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return fmt.Errorf("could not read response: %v", err)
+		return fmt.Errorf("could not read response: %w", err)
 	}
 
 	if resp.StatusCode > 299 {
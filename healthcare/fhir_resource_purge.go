@@ -29,7 +29,7 @@ func purgeFHIRResource(w io.Writer, projectID, location, datasetID, fhirStoreID,
 
 	healthcareService, err := healthcare.NewService(ctx)
 	if err != nil {
-		return fmt.Errorf("healthcare.NewService: %v", err)
+		return fmt.Errorf("healthcare.NewService: %w", err)
 	}
 
 	fhirService := healthcareService.Projects.Locations.Datasets.FhirStores.Fhir
@@ -37,7 +37,7 @@ func purgeFHIRResource(w io.Writer, projectID, location, datasetID, fhirStoreID,
 	name := fmt.Sprintf("projects/%s/locations/%s/datasets/%s/fhirStores/%s/fhir/%s/%s", projectID, location, datasetID, fhirStoreID, resourceType, fhirResourceID)
 
 	if _, err := fhirService.ResourcePurge(name).Do(); err != nil {
-		return fmt.Errorf("ResourcePurge: %v", err)
+		return fmt.Errorf("ResourcePurge: %w", err)
 	}
 
 	fmt.Fprintf(w, "Resource Purged: %q", name)
@@ -29,7 +29,7 @@ func getFHIRStore(w io.Writer, projectID, location, datasetID, fhirStoreID strin
 
 	healthcareService, err := healthcare.NewService(ctx)
 	if err != nil {
-		return fmt.Errorf("healthcare.NewService: %v", err)
+		return fmt.Errorf("healthcare.NewService: %w", err)
 	}
 
 	storesService := healthcareService.Projects.Locations.Datasets.FhirStores
@@ -38,7 +38,7 @@ func getFHIRStore(w io.Writer, projectID, location, datasetID, fhirStoreID strin
 
 	store, err := storesService.Get(name).Do()
 	if err != nil {
-		return fmt.Errorf("Get: %v", err)
+		return fmt.Errorf("Get: %w", err)
 	}
 
 	fmt.Fprintf(w, "Got FHIR store: %q\n", store.Name)
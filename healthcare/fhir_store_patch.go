@@ -29,7 +29,7 @@ func patchFHIRStore(w io.Writer, projectID, location, datasetID, fhirStoreID, to
 
 	healthcareService, err := healthcare.NewService(ctx)
 	if err != nil {
-		return fmt.Errorf("healthcare.NewService: %v", err)
+		return fmt.Errorf("healthcare.NewService: %w", err)
 	}
 
 	storesService := healthcareService.Projects.Locations.Datasets.FhirStores
@@ -41,7 +41,7 @@ func patchFHIRStore(w io.Writer, projectID, location, datasetID, fhirStoreID, to
 			PubsubTopic: topicName, // format is "projects/*/locations/*/topics/*"
 		},
 	}).UpdateMask("notificationConfig").Do(); err != nil {
-		return fmt.Errorf("Patch: %v", err)
+		return fmt.Errorf("Patch: %w", err)
 	}
 
 	fmt.Fprintf(w, "Patched FHIR store %s with Pub/sub topic %s\n", datasetID, topicName)
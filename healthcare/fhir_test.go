@@ -43,7 +43,7 @@ func TestFHIRStore(t *testing.T) {
 
 	// Delete test dataset if it already exists.
 	if err := getDataset(buf, tc.ProjectID, location, datasetID); err == nil {
-		testutil.Retry(t, 10, time.Second, func(r *testutil.R) {
+		testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
 			if err := deleteDataset(ioutil.Discard, tc.ProjectID, location, datasetID); err != nil {
 				r.Errorf("deleteDataset got err: %v", err)
 			}
@@ -59,7 +59,7 @@ func TestFHIRStore(t *testing.T) {
 		t.Errorf("createFHIRStore got err: %v", err)
 	}
 
-	testutil.Retry(t, 10, 2*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 2 * time.Second, Max: 4 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		fhirStoreName := fmt.Sprintf("projects/%s/locations/%s/datasets/%s/fhirStores/%s", tc.ProjectID, location, datasetID, fhirStoreID)
 		if err := listFHIRStores(buf, tc.ProjectID, location, datasetID); err != nil {
 			r.Errorf("listFHIRStores got err: %v", err)
@@ -69,7 +69,7 @@ func TestFHIRStore(t *testing.T) {
 		}
 	})
 
-	testutil.Retry(t, 10, 2*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 2 * time.Second, Max: 4 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		buf.Reset()
 		if err := createFHIRResource(buf, tc.ProjectID, location, datasetID, fhirStoreID, resourceType); err != nil {
 			r.Errorf("createFHIRResource got err: %v", err)
@@ -88,7 +88,7 @@ func TestFHIRStore(t *testing.T) {
 		t.Errorf("json.Unmarshal createFHIRResource output: %v", err)
 	}
 
-	testutil.Retry(t, 10, 2*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 2 * time.Second, Max: 4 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		buf.Reset()
 		if err := getFHIRMetadata(buf, tc.ProjectID, location, datasetID, fhirStoreID); err != nil {
 			r.Errorf("getFHIRMetadata got err: %v", err)
@@ -99,7 +99,7 @@ func TestFHIRStore(t *testing.T) {
 	})
 
 	buf.Reset()
-	testutil.Retry(t, 10, 2*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 2 * time.Second, Max: 4 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		if err := getFHIRResource(buf, tc.ProjectID, location, datasetID, fhirStoreID, resourceType, res.ID); err != nil {
 			r.Errorf("getFHIRResource got err: %v", err)
 		}
@@ -108,7 +108,7 @@ func TestFHIRStore(t *testing.T) {
 		}
 	})
 
-	testutil.Retry(t, 10, 2*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 2 * time.Second, Max: 4 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		buf.Reset()
 		if err := searchFHIRResources(buf, tc.ProjectID, location, datasetID, fhirStoreID, resourceType); err != nil {
 			r.Errorf("searchFHIRResources got err: %v", err)
@@ -118,7 +118,7 @@ func TestFHIRStore(t *testing.T) {
 		}
 	})
 
-	testutil.Retry(t, 10, 2*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 2 * time.Second, Max: 4 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		buf.Reset()
 		if err := updateFHIRResource(buf, tc.ProjectID, location, datasetID, fhirStoreID, resourceType, res.ID, false); err != nil {
 			r.Errorf("updateFHIRResource got err: %v", err)
@@ -148,7 +148,7 @@ func TestFHIRStore(t *testing.T) {
 		}
 	})
 
-	testutil.Retry(t, 10, 2*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 2 * time.Second, Max: 4 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		buf.Reset()
 		if err := patchFHIRResource(buf, tc.ProjectID, location, datasetID, fhirStoreID, resourceType, res.ID, false); err != nil {
 			r.Errorf("patchFHIRResource got err: %v", err)
@@ -178,7 +178,7 @@ func TestFHIRStore(t *testing.T) {
 		}
 	})
 
-	testutil.Retry(t, 10, 2*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 2 * time.Second, Max: 4 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		buf.Reset()
 		patchedRes := resource{}
 		if err := conditionalpatch.ConditionalPatchFHIRResource(buf, tc.ProjectID, location, datasetID, fhirStoreID, resourceType, false); err != nil {
@@ -216,7 +216,7 @@ func TestFHIRStore(t *testing.T) {
 		}
 	})
 
-	testutil.Retry(t, 10, 2*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 2 * time.Second, Max: 4 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		buf.Reset()
 		if err := fhirGetPatientEverything(buf, tc.ProjectID, location, datasetID, fhirStoreID, res.ID); err != nil {
 			r.Errorf("fhirGetPatientEverything got err: %v", err)
@@ -226,7 +226,7 @@ func TestFHIRStore(t *testing.T) {
 		}
 	})
 
-	testutil.Retry(t, 10, 2*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 2 * time.Second, Max: 4 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		buf.Reset()
 		if err := listFHIRResourceHistory(buf, tc.ProjectID, location, datasetID, fhirStoreID, resourceType, res.ID); err != nil {
 			r.Errorf("listFHIRResourceHistory got err: %v", err)
@@ -236,7 +236,7 @@ func TestFHIRStore(t *testing.T) {
 		}
 	})
 
-	testutil.Retry(t, 10, 2*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 2 * time.Second, Max: 4 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		buf.Reset()
 		if err := getFHIRResourceHistory(buf, tc.ProjectID, location, datasetID, fhirStoreID, resourceType, res.ID, res.Meta.VersionID); err != nil {
 			r.Errorf("getFHIRResourceHistory got err: %v", err)
@@ -247,7 +247,7 @@ func TestFHIRStore(t *testing.T) {
 	})
 
 	// Longer retry time to avoid bucket create/delete API quota issues.
-	testutil.Retry(t, 10, 10*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 10 * time.Second, Max: 20 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		buf.Reset()
 		// Delete the bucket (if it exists) then recreate it, optimistically
 		// ignoring errors.
@@ -267,19 +267,19 @@ func TestFHIRStore(t *testing.T) {
 		}
 	})
 
-	testutil.Retry(t, 10, 2*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 2 * time.Second, Max: 4 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		if err := conditionaldelete.ConditionalDeleteFHIRResource(ioutil.Discard, tc.ProjectID, location, datasetID, fhirStoreID, resourceType); err != nil {
 			r.Errorf("ConditionalDeleteFHIRResource got err: %v", err)
 		}
 	})
 
-	testutil.Retry(t, 10, 2*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 2 * time.Second, Max: 4 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		if err := deleteFHIRResource(ioutil.Discard, tc.ProjectID, location, datasetID, fhirStoreID, resourceType, res.ID); err != nil {
 			r.Errorf("deleteFHIRResource got err: %v", err)
 		}
 	})
 
-	testutil.Retry(t, 10, 2*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 2 * time.Second, Max: 4 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		buf.Reset()
 		if err := purgeFHIRResource(ioutil.Discard, tc.ProjectID, location, datasetID, fhirStoreID, resourceType, res.ID); err != nil {
 			r.Errorf("purgeFHIRResource got err: %v", err)
@@ -290,7 +290,7 @@ func TestFHIRStore(t *testing.T) {
 		}
 	})
 
-	testutil.Retry(t, 10, 2*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 2 * time.Second, Max: 4 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		buf.Reset()
 		if err := fhirExecuteBundle(buf, tc.ProjectID, location, datasetID, fhirStoreID); err != nil {
 			r.Errorf("fhirExecuteBundle got err: %v", err)
@@ -300,13 +300,13 @@ func TestFHIRStore(t *testing.T) {
 		}
 	})
 
-	testutil.Retry(t, 10, 2*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 2 * time.Second, Max: 4 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		if err := deleteFHIRStore(ioutil.Discard, tc.ProjectID, location, datasetID, fhirStoreID); err != nil {
 			r.Errorf("deleteFHIRStore got err: %v", err)
 		}
 	})
 
-	testutil.Retry(t, 10, 2*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 2 * time.Second, Max: 4 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		if err := deleteDataset(ioutil.Discard, tc.ProjectID, location, datasetID); err != nil {
 			r.Errorf("deleteDataset got err: %v", err)
 		}
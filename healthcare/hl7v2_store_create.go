@@ -29,7 +29,7 @@ func createHL7V2Store(w io.Writer, projectID, location, datasetID, hl7V2StoreID
 
 	healthcareService, err := healthcare.NewService(ctx)
 	if err != nil {
-		return fmt.Errorf("healthcare.NewService: %v", err)
+		return fmt.Errorf("healthcare.NewService: %w", err)
 	}
 
 	storesService := healthcareService.Projects.Locations.Datasets.Hl7V2Stores
@@ -39,7 +39,7 @@ func createHL7V2Store(w io.Writer, projectID, location, datasetID, hl7V2StoreID
 
 	resp, err := storesService.Create(parent, store).Hl7V2StoreId(hl7V2StoreID).Do()
 	if err != nil {
-		return fmt.Errorf("Create: %v", err)
+		return fmt.Errorf("Create: %w", err)
 	}
 
 	fmt.Fprintf(w, "Created HL7V2 store: %q\n", resp.Name)
@@ -20,7 +20,6 @@ import (
 	"io/ioutil"
 	"strings"
 	"testing"
-	"time"
 
 	"github.com/GoogleCloudPlatform/golang-samples/internal/testutil"
 )
@@ -37,26 +36,26 @@ func TestHL7V2Store(t *testing.T) {
 
 	// Delete test dataset if it already exists.
 	if err := getDataset(buf, tc.ProjectID, location, datasetID); err == nil {
-		testutil.Retry(t, 10, time.Second, func(r *testutil.R) {
+		testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
 			if err := deleteDataset(ioutil.Discard, tc.ProjectID, location, datasetID); err != nil {
 				r.Errorf("deleteDataset got err: %v", err)
 			}
 		})
 	}
 
-	testutil.Retry(t, 10, time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
 		if err := createDataset(ioutil.Discard, tc.ProjectID, location, datasetID); err != nil {
 			r.Errorf("Unable to create test dataset: %v", err)
 		}
 	})
 
-	testutil.Retry(t, 10, time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
 		if err := createHL7V2Store(ioutil.Discard, tc.ProjectID, location, datasetID, hl7V2StoreID); err != nil {
 			r.Errorf("createHL7V2Store got err: %v", err)
 		}
 	})
 
-	testutil.Retry(t, 10, time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
 		hl7V2StoreName := fmt.Sprintf("projects/%s/locations/%s/datasets/%s/hl7V2Stores/%s", tc.ProjectID, location, datasetID, hl7V2StoreID)
 		if err := listHL7V2Stores(buf, tc.ProjectID, location, datasetID); err != nil {
 			r.Errorf("listHL7V2Stores got err: %v", err)
@@ -70,7 +69,7 @@ func TestHL7V2Store(t *testing.T) {
 
 	dataFile := "testdata/hl7v2message.dat" // size = 167 bytes
 
-	testutil.Retry(t, 10, time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
 		buf.Reset()
 		if err := createHL7V2Message(buf, tc.ProjectID, location, datasetID, hl7V2StoreID, dataFile); err != nil {
 			r.Errorf("createHL7V2Message got err: %v", err)
@@ -80,7 +79,7 @@ func TestHL7V2Store(t *testing.T) {
 		}
 	})
 
-	testutil.Retry(t, 10, time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
 		buf.Reset()
 		if err := ingestHL7V2Message(buf, tc.ProjectID, location, datasetID, hl7V2StoreID, dataFile); err != nil {
 			r.Errorf("ingestHL7V2Message got err: %v", err)
@@ -90,7 +89,7 @@ func TestHL7V2Store(t *testing.T) {
 		}
 	})
 
-	testutil.Retry(t, 10, time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
 		buf.Reset()
 		if err := getHL7V2Message(buf, tc.ProjectID, location, datasetID, hl7V2StoreID, messageID); err != nil {
 			r.Errorf("getHL7V2Message got err: %v", err)
@@ -100,7 +99,7 @@ func TestHL7V2Store(t *testing.T) {
 		}
 	})
 
-	testutil.Retry(t, 10, time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
 		buf.Reset()
 		if err := listHL7V2Messages(buf, tc.ProjectID, location, datasetID, hl7V2StoreID); err != nil {
 			r.Errorf("listHL7V2Messages got err: %v", err)
@@ -110,25 +109,25 @@ func TestHL7V2Store(t *testing.T) {
 		}
 	})
 
-	testutil.Retry(t, 10, time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
 		if err := patchHL7V2Message(ioutil.Discard, tc.ProjectID, location, datasetID, hl7V2StoreID, messageID, dataFile); err != nil {
 			r.Errorf("patchHL7V2Message got err: %v", err)
 		}
 	})
 
-	testutil.Retry(t, 10, time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
 		if err := deleteHL7V2Message(ioutil.Discard, tc.ProjectID, location, datasetID, hl7V2StoreID, messageID); err != nil {
 			r.Errorf("deleteHL7V2Message got err: %v", err)
 		}
 	})
 
-	testutil.Retry(t, 10, time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
 		if err := deleteHL7V2Store(ioutil.Discard, tc.ProjectID, location, datasetID, hl7V2StoreID); err != nil {
 			r.Errorf("deleteHL7V2Store got err: %v", err)
 		}
 	})
 
-	testutil.Retry(t, 10, time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
 		if err := deleteDataset(ioutil.Discard, tc.ProjectID, location, datasetID); err != nil {
 			r.Errorf("deleteDataset got err: %v", err)
 		}
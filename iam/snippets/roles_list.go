@@ -28,12 +28,12 @@ func listRoles(w io.Writer, projectID string) ([]*iam.Role, error) {
 	ctx := context.Background()
 	service, err := iam.NewService(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("iam.NewService: %v", err)
+		return nil, fmt.Errorf("iam.NewService: %w", err)
 	}
 
 	response, err := service.Projects.Roles.List("projects/" + projectID).Do()
 	if err != nil {
-		return nil, fmt.Errorf("Projects.Roles.List: %v", err)
+		return nil, fmt.Errorf("Projects.Roles.List: %w", err)
 	}
 	for _, role := range response.Roles {
 		fmt.Fprintf(w, "Listing role: %v\n", role.Name)
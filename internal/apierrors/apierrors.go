@@ -0,0 +1,231 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package apierrors classifies the errors returned by Google Cloud client
+// libraries, whether the client talks HTTP (*googleapi.Error) or gRPC
+// (*status.Status). It looks through wrapped errors, so it works on the
+// errors the samples return, which wrap the client's error with %w.
+//
+// Code gives one canonical code for both transports, so callers can write
+//
+//	if apierrors.Code(err) == codes.NotFound {
+//
+// without caring which API the client used, and without matching on the
+// error message. Decide and Retryable hold the one retry policy the samples
+// and their tests share.
+package apierrors
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+
+	"github.com/googleapis/gax-go/v2/apierror"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Info describes a Google API error.
+type Info struct {
+	// HTTPCode is the HTTP status code, or 0 if the error came over gRPC.
+	HTTPCode int
+	// Code is the canonical code of the error. For HTTP errors it is
+	// derived from HTTPCode.
+	Code codes.Code
+	// Reason is the machine-readable reason the service gave, such as
+	// "RATE_LIMIT_EXCEEDED" or "rateLimitExceeded". It is empty if the
+	// service gave none.
+	Reason string
+}
+
+// Retryable reports whether the request that failed with the error is worth
+// retrying with backoff: HTTP 408, 429, 500, 502, 503 and 504, and gRPC
+// Unavailable, ResourceExhausted, Aborted and DeadlineExceeded.
+func (i Info) Retryable() bool {
+	if i.HTTPCode != 0 {
+		switch i.HTTPCode {
+		case http.StatusRequestTimeout, http.StatusTooManyRequests, http.StatusInternalServerError,
+			http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true
+		}
+		return false
+	}
+	switch i.Code {
+	case codes.Unavailable, codes.ResourceExhausted, codes.Aborted, codes.DeadlineExceeded:
+		return true
+	}
+	return false
+}
+
+// Classify returns what err says about the API call that failed. It returns
+// false if err does not wrap a Google API error.
+func Classify(err error) (Info, bool) {
+	if err == nil {
+		return Info{}, false
+	}
+	var gerr *googleapi.Error
+	if errors.As(err, &gerr) {
+		info := Info{HTTPCode: gerr.Code, Code: httpCodes[gerr.Code]}
+		if info.Code == codes.OK {
+			info.Code = codes.Unknown
+		}
+		if ae, ok := apierror.ParseError(gerr, false); ok && ae.Reason() != "" {
+			info.Reason = ae.Reason()
+		} else if len(gerr.Errors) > 0 {
+			info.Reason = gerr.Errors[0].Reason
+		}
+		return info, true
+	}
+	s, ok := status.FromError(err)
+	if !ok {
+		return Info{}, false
+	}
+	info := Info{Code: s.Code()}
+	if ae, ok := apierror.ParseError(err, false); ok {
+		info.Reason = ae.Reason()
+	}
+	return info, true
+}
+
+// Code returns the canonical code of err: codes.OK for nil, the code of the
+// Google API error err wraps, codes.Canceled or codes.DeadlineExceeded if
+// err wraps a context error, and codes.Unknown otherwise.
+func Code(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	if info, ok := Classify(err); ok {
+		return info.Code
+	}
+	switch {
+	case errors.Is(err, context.Canceled):
+		return codes.Canceled
+	case errors.Is(err, context.DeadlineExceeded):
+		return codes.DeadlineExceeded
+	}
+	return codes.Unknown
+}
+
+// Reason returns the reason err gives for failing, or "" if it gives none.
+func Reason(err error) string {
+	info, _ := Classify(err)
+	return info.Reason
+}
+
+// Retryable reports whether err wraps a Google API error that is worth
+// retrying, or says the connection broke mid-request. The caller's own
+// canceled or expired context is not.
+func Retryable(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if info, ok := Classify(err); ok {
+		return info.Retryable()
+	}
+	var nerr net.Error
+	return errors.As(err, &nerr) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// Action is what a caller should do about an error.
+type Action int
+
+const (
+	// ActionNone means there was no error.
+	ActionNone Action = iota
+	// ActionNotFound means the resource does not exist. Retrying will not
+	// help; create it or report it to the user.
+	ActionNotFound
+	// ActionPermissionDenied means the caller lacks access. Retrying will
+	// not help; fix IAM or the credentials instead.
+	ActionPermissionDenied
+	// ActionPreconditionFailed means a condition on the request, such as a
+	// generation match, did not hold. Re-read the resource and decide again
+	// instead of blindly retrying the same request.
+	ActionPreconditionFailed
+	// ActionRetry means the failure is transient. Retry with exponential
+	// backoff.
+	ActionRetry
+	// ActionFail means the error is terminal: a bad request, a server bug,
+	// or the caller's own context was canceled or timed out.
+	ActionFail
+)
+
+func (a Action) String() string {
+	switch a {
+	case ActionNone:
+		return "none"
+	case ActionNotFound:
+		return "not found"
+	case ActionPermissionDenied:
+		return "permission denied"
+	case ActionPreconditionFailed:
+		return "precondition failed"
+	case ActionRetry:
+		return "retry"
+	default:
+		return "fail"
+	}
+}
+
+// Decide returns what to do about err, going by its code and never by its
+// message.
+func Decide(err error) Action {
+	if err == nil {
+		return ActionNone
+	}
+	if Retryable(err) {
+		return ActionRetry
+	}
+	switch Code(err) {
+	case codes.NotFound:
+		return ActionNotFound
+	case codes.PermissionDenied, codes.Unauthenticated:
+		return ActionPermissionDenied
+	case codes.FailedPrecondition:
+		return ActionPreconditionFailed
+	}
+	return ActionFail
+}
+
+// IsNotFound reports whether err says the resource does not exist.
+func IsNotFound(err error) bool {
+	return Code(err) == codes.NotFound
+}
+
+// IsAlreadyExists reports whether err says the resource already exists.
+func IsAlreadyExists(err error) bool {
+	return Code(err) == codes.AlreadyExists
+}
+
+// httpCodes maps HTTP status codes to canonical codes, as in
+// https://github.com/googleapis/googleapis/blob/master/google/rpc/code.proto.
+var httpCodes = map[int]codes.Code{
+	http.StatusBadRequest:          codes.InvalidArgument,
+	http.StatusUnauthorized:        codes.Unauthenticated,
+	http.StatusForbidden:           codes.PermissionDenied,
+	http.StatusNotFound:            codes.NotFound,
+	http.StatusRequestTimeout:      codes.DeadlineExceeded,
+	http.StatusConflict:            codes.AlreadyExists,
+	http.StatusPreconditionFailed:  codes.FailedPrecondition,
+	http.StatusTooManyRequests:     codes.ResourceExhausted,
+	499:                            codes.Canceled,
+	http.StatusInternalServerError: codes.Internal,
+	http.StatusNotImplemented:      codes.Unimplemented,
+	http.StatusBadGateway:          codes.Unavailable,
+	http.StatusServiceUnavailable:  codes.Unavailable,
+	http.StatusGatewayTimeout:      codes.DeadlineExceeded,
+}
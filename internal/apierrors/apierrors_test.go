@@ -0,0 +1,152 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apierrors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"testing"
+
+	"google.golang.org/api/googleapi"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestClassify(t *testing.T) {
+	withReason, err := status.New(codes.ResourceExhausted, "quota").WithDetails(&errdetails.ErrorInfo{Reason: "RATE_LIMIT_EXCEEDED"})
+	if err != nil {
+		t.Fatalf("WithDetails: %v", err)
+	}
+	tests := []struct {
+		err       error
+		want      Info
+		wantOK    bool
+		retryable bool
+	}{
+		{err: nil},
+		{err: errors.New("boom")},
+		{
+			err:    &googleapi.Error{Code: 404},
+			want:   Info{HTTPCode: 404, Code: codes.NotFound},
+			wantOK: true,
+		},
+		{
+			err:       fmt.Errorf("Writer.Close: %w", &googleapi.Error{Code: 429, Errors: []googleapi.ErrorItem{{Reason: "rateLimitExceeded"}}}),
+			want:      Info{HTTPCode: 429, Code: codes.ResourceExhausted, Reason: "rateLimitExceeded"},
+			wantOK:    true,
+			retryable: true,
+		},
+		{
+			err:       &googleapi.Error{Code: 500},
+			want:      Info{HTTPCode: 500, Code: codes.Internal},
+			wantOK:    true,
+			retryable: true,
+		},
+		{
+			err:    &googleapi.Error{Code: 418},
+			want:   Info{HTTPCode: 418, Code: codes.Unknown},
+			wantOK: true,
+		},
+		{
+			err:    fmt.Errorf("Get: %w", status.Error(codes.FailedPrecondition, "needs an index")),
+			want:   Info{Code: codes.FailedPrecondition},
+			wantOK: true,
+		},
+		{
+			err:       withReason.Err(),
+			want:      Info{Code: codes.ResourceExhausted, Reason: "RATE_LIMIT_EXCEEDED"},
+			wantOK:    true,
+			retryable: true,
+		},
+		{
+			// gRPC Internal is a server bug, not a transient failure.
+			err:    status.Error(codes.Internal, "oops"),
+			want:   Info{Code: codes.Internal},
+			wantOK: true,
+		},
+	}
+	for _, tc := range tests {
+		got, ok := Classify(tc.err)
+		if got != tc.want || ok != tc.wantOK {
+			t.Errorf("Classify(%v) got %+v, %v, want %+v, %v", tc.err, got, ok, tc.want, tc.wantOK)
+		}
+		if got := Retryable(tc.err); got != tc.retryable {
+			t.Errorf("Retryable(%v) got %v, want %v", tc.err, got, tc.retryable)
+		}
+	}
+}
+
+func TestCode(t *testing.T) {
+	tests := []struct {
+		err  error
+		want codes.Code
+	}{
+		{nil, codes.OK},
+		{errors.New("boom"), codes.Unknown},
+		{fmt.Errorf("Attrs: %w", &googleapi.Error{Code: 409}), codes.AlreadyExists},
+		{status.Error(codes.NotFound, "gone"), codes.NotFound},
+		{fmt.Errorf("Receive: %w", context.Canceled), codes.Canceled},
+		{fmt.Errorf("Publish: %w", context.DeadlineExceeded), codes.DeadlineExceeded},
+	}
+	for _, tc := range tests {
+		if got := Code(tc.err); got != tc.want {
+			t.Errorf("Code(%v) got %v, want %v", tc.err, got, tc.want)
+		}
+	}
+	if !IsNotFound(fmt.Errorf("Attrs: %w", &googleapi.Error{Code: 404})) {
+		t.Errorf("IsNotFound(wrapped 404) got false, want true")
+	}
+	if !IsAlreadyExists(status.Error(codes.AlreadyExists, "exists")) {
+		t.Errorf("IsAlreadyExists(AlreadyExists) got false, want true")
+	}
+	if got, want := Reason(&googleapi.Error{Code: 403, Errors: []googleapi.ErrorItem{{Reason: "forbidden"}}}), "forbidden"; got != want {
+		t.Errorf("Reason got %q, want %q", got, want)
+	}
+}
+
+func TestDecide(t *testing.T) {
+	tests := []struct {
+		err  error
+		want Action
+	}{
+		{nil, ActionNone},
+		{&googleapi.Error{Code: 401}, ActionPermissionDenied},
+		{&googleapi.Error{Code: 403}, ActionPermissionDenied},
+		{&googleapi.Error{Code: 404}, ActionNotFound},
+		{&googleapi.Error{Code: 412}, ActionPreconditionFailed},
+		{fmt.Errorf("Writer.Close: %w", &googleapi.Error{Code: 429}), ActionRetry},
+		{&googleapi.Error{Code: 500}, ActionRetry},
+		{&googleapi.Error{Code: 400}, ActionFail},
+		{status.Error(codes.Unavailable, "unavailable"), ActionRetry},
+		{status.Error(codes.Aborted, "contention"), ActionRetry},
+		{status.Error(codes.Internal, "oops"), ActionFail},
+		{status.Error(codes.PermissionDenied, "denied"), ActionPermissionDenied},
+		{status.Error(codes.InvalidArgument, "bad"), ActionFail},
+		{fmt.Errorf("Read: %w", io.ErrUnexpectedEOF), ActionRetry},
+		{&net.OpError{Op: "read", Err: errors.New("connection reset by peer")}, ActionRetry},
+		{context.Canceled, ActionFail},
+		{fmt.Errorf("Attrs: %w", context.DeadlineExceeded), ActionFail},
+		{errors.New("boom"), ActionFail},
+	}
+	for _, tc := range tests {
+		if got := Decide(tc.err); got != tc.want {
+			t.Errorf("Decide(%v) got %v, want %v", tc.err, got, tc.want)
+		}
+	}
+}
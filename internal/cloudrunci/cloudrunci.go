@@ -102,7 +102,7 @@ func (s *Service) NewRequest(method, path string) (*http.Request, error) {
 	}
 	url, err := s.URL(path)
 	if err != nil {
-		return nil, fmt.Errorf("service.URL: %v", err)
+		return nil, fmt.Errorf("service.URL: %w", err)
 	}
 	return s.Platform.NewRequest(method, url)
 }
@@ -112,7 +112,7 @@ func (s *Service) NewRequest(method, path string) (*http.Request, error) {
 func (s *Service) URL(p string) (string, error) {
 	u, err := s.ParsedURL()
 	if err != nil {
-		return "", fmt.Errorf("service.ParsedURL: %v", err)
+		return "", fmt.Errorf("service.ParsedURL: %w", err)
 	}
 	modified := &url.URL{}
 	*modified = *u
@@ -125,7 +125,7 @@ func (s *Service) URL(p string) (string, error) {
 func (s *Service) Host() (string, error) {
 	u, err := s.ParsedURL()
 	if err != nil {
-		return "", fmt.Errorf("service.ParsedURL: %v", err)
+		return "", fmt.Errorf("service.ParsedURL: %w", err)
 	}
 	return u.Host + ":443", nil
 }
@@ -145,7 +145,7 @@ func (s *Service) ParsedURL() (*url.URL, error) {
 		sURL := string(out)
 		u, err := url.Parse(sURL)
 		if err != nil {
-			return nil, fmt.Errorf("url.Parse: %v", err)
+			return nil, fmt.Errorf("url.Parse: %w", err)
 		}
 
 		s.url = u
@@ -52,7 +52,7 @@ func bodyShouldContain(t *testing.T, p *aeintegrate.App, path, shouldContain str
 	url, _ := p.URL("")
 	log.Printf("(%s) Deployed to %s", p.Name, url)
 
-	testutil.Retry(t, 20, 10*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 10 * time.Second, Max: 20 * time.Second, Multiplier: 2, MaxAttempts: 20}, func(r *testutil.R) {
 		resp, err := p.Get(path)
 		if err != nil {
 			r.Errorf("Get: %v", err)
@@ -0,0 +1,71 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package endpoint reads the API endpoints of the Cloud Storage, Pub/Sub and
+// Firestore clients from the environment, so the samples, the tools that run
+// them and their tests work against Private Google Access, regional and
+// emulator endpoints without code changes.
+//
+// The client libraries already connect to an emulator when
+// STORAGE_EMULATOR_HOST, PUBSUB_EMULATOR_HOST or FIRESTORE_EMULATOR_HOST is
+// set. For any other endpoint, set
+//
+//	GOLANG_SAMPLES_STORAGE_ENDPOINT=https://storage-myendpoint.p.googleapis.com/storage/v1/
+//	GOLANG_SAMPLES_PUBSUB_ENDPOINT=us-east1-pubsub.googleapis.com:443
+//	GOLANG_SAMPLES_FIRESTORE_ENDPOINT=firestore-myendpoint.p.googleapis.com:443
+//
+// and pass the options to the client constructor or to the sample:
+//
+//	client, err := pubsub.NewClient(ctx, projectID, endpoint.PubSub()...)
+package endpoint
+
+import (
+	"os"
+
+	"google.golang.org/api/option"
+)
+
+// Storage returns the options for a Cloud Storage client. The endpoint is a
+// URL such as "https://storage.googleapis.com/storage/v1/".
+func Storage() []option.ClientOption {
+	return options("GOLANG_SAMPLES_STORAGE_ENDPOINT", "STORAGE_EMULATOR_HOST")
+}
+
+// PubSub returns the options for a Pub/Sub client, including a
+// pubsub.SchemaClient. The endpoint is a host and port, such as
+// "us-east1-pubsub.googleapis.com:443".
+func PubSub() []option.ClientOption {
+	return options("GOLANG_SAMPLES_PUBSUB_ENDPOINT", "PUBSUB_EMULATOR_HOST")
+}
+
+// Firestore returns the options for a Firestore client, including the
+// Firestore admin client. The endpoint is a host and port, such as
+// "firestore.googleapis.com:443".
+func Firestore() []option.ClientOption {
+	return options("GOLANG_SAMPLES_FIRESTORE_ENDPOINT", "FIRESTORE_EMULATOR_HOST")
+}
+
+// options returns option.WithEndpoint for the endpoint in env, if any. It
+// returns no options when emulatorEnv is set: the client library already
+// connects to the emulator, and an endpoint option would override it.
+func options(env, emulatorEnv string) []option.ClientOption {
+	if os.Getenv(emulatorEnv) != "" {
+		return nil
+	}
+	e := os.Getenv(env)
+	if e == "" {
+		return nil
+	}
+	return []option.ClientOption{option.WithEndpoint(e)}
+}
@@ -0,0 +1,42 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package endpoint
+
+import (
+	"os"
+	"testing"
+)
+
+func TestOptions(t *testing.T) {
+	const env, emulatorEnv = "GOLANG_SAMPLES_TEST_ENDPOINT", "GOLANG_SAMPLES_TEST_EMULATOR_HOST"
+	defer os.Unsetenv(env)
+	defer os.Unsetenv(emulatorEnv)
+	tests := []struct {
+		endpoint, emulator string
+		want               int
+	}{
+		{"", "", 0},
+		{"us-east1-pubsub.googleapis.com:443", "", 1},
+		// The client library connects to the emulator itself.
+		{"us-east1-pubsub.googleapis.com:443", "localhost:8085", 0},
+	}
+	for _, tc := range tests {
+		os.Setenv(env, tc.endpoint)
+		os.Setenv(emulatorEnv, tc.emulator)
+		if got := options(env, emulatorEnv); len(got) != tc.want {
+			t.Errorf("options with endpoint %q and emulator %q got %d options, want %d", tc.endpoint, tc.emulator, len(got), tc.want)
+		}
+	}
+}
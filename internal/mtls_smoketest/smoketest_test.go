@@ -22,12 +22,12 @@ import (
 
 	bqstorage "cloud.google.com/go/bigquery/storage/apiv1"
 	gaming "cloud.google.com/go/gaming/apiv1beta"
+	gamingpb "cloud.google.com/go/gaming/apiv1beta/gamingpb"
 	vision "cloud.google.com/go/vision/apiv1"
 	"github.com/GoogleCloudPlatform/golang-samples/internal/testutil"
 	"google.golang.org/api/iterator"
 	"google.golang.org/api/option"
 	bqstoragepb "google.golang.org/genproto/googleapis/cloud/bigquery/storage/v1"
-	gamingpb "google.golang.org/genproto/googleapis/cloud/gaming/v1beta"
 )
 
 var shouldFail = os.Getenv("GOOGLE_API_USE_MTLS") == "always"
@@ -0,0 +1,54 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testutil
+
+import (
+	"os"
+	"testing"
+)
+
+// BenchmarkEnabled reports whether GOLANG_SAMPLES_BENCHMARK is set. Sample
+// benchmarks send real requests, many of them, so `go test -bench` skips
+// them unless they are asked for.
+func BenchmarkEnabled() bool {
+	return os.Getenv("GOLANG_SAMPLES_BENCHMARK") != ""
+}
+
+// BenchmarkTest gets the test context for a benchmark of samples. The
+// benchmark is skipped unless GOLANG_SAMPLES_BENCHMARK is set, and then
+// behaves like SystemTest, except that it also runs without
+// GOLANG_SAMPLES_PROJECT_ID against an emulator:
+//
+//	STORAGE_EMULATOR_HOST=localhost:4443 GOLANG_SAMPLES_BENCHMARK=1 \
+//	  go test -run '^$' -bench . ./storage/objects/
+//
+// Benchmarks against an emulator measure the cost of the sample code and
+// the client library, such as creating a client per call, rather than the
+// network.
+func BenchmarkTest(b *testing.B) Context {
+	if !BenchmarkEnabled() {
+		b.Skip("GOLANG_SAMPLES_BENCHMARK not set")
+	}
+	if StorageEmulatorEnabled() || PubsubEmulatorEnabled() || os.Getenv("FIRESTORE_EMULATOR_HOST") != "" {
+		return emulatorContext(b)
+	}
+	tc, err := testContext()
+	if err == errNoProjectID {
+		b.Skip(err)
+	} else if err != nil {
+		b.Fatal(err)
+	}
+	return tc
+}
@@ -0,0 +1,51 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testutil
+
+import "testing"
+
+var datastoreEmulator = emulator{hostEnv: "DATASTORE_EMULATOR_HOST", name: "Datastore emulator"}
+
+// DatastoreEmulatorEnabled reports whether Datastore clients will talk to the
+// Datastore emulator instead of Datastore.
+func DatastoreEmulatorEnabled() bool {
+	return datastoreEmulator.enabled()
+}
+
+// DatastoreEmulatorTest gets the test context for a Datastore test that can
+// run against the emulator. If DATASTORE_EMULATOR_HOST is set, the test runs
+// even if GOLANG_SAMPLES_PROJECT_ID is not set. Otherwise it behaves like
+// SystemTest.
+//
+// To run these tests without a Google Cloud project:
+//
+//	gcloud beta emulators datastore start --host-port=localhost:8081 --no-store-on-disk
+//	DATASTORE_EMULATOR_HOST=localhost:8081 go test ./datastore/...
+//
+// The emulator does not enforce composite indexes, so queries that need an
+// index succeed even if it was never created.
+func DatastoreEmulatorTest(t *testing.T) Context {
+	return datastoreEmulator.test(t)
+}
+
+// SkipIfDatastoreEmulator skips the test when running against the Datastore
+// emulator. Use it for features the emulator does not implement, like the
+// Datastore Admin API for indexes, operations and exports. Admin clients
+// ignore DATASTORE_EMULATOR_HOST, so without the skip those tests would reach
+// a real project.
+func SkipIfDatastoreEmulator(t *testing.T, feature string) {
+	t.Helper()
+	datastoreEmulator.skip(t, feature)
+}
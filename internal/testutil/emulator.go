@@ -0,0 +1,75 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testutil
+
+import (
+	"os"
+	"testing"
+)
+
+// emulatorProjectID is the project used against an emulator when
+// GOLANG_SAMPLES_PROJECT_ID is not set. Emulators accept any project.
+const emulatorProjectID = "golang-samples-emulator"
+
+// An emulator is a local stand-in for a service. The service's client
+// library connects to the address in hostEnv, without credentials, when it
+// is set, so samples need no changes to run against the emulator.
+type emulator struct {
+	// hostEnv is the environment variable that holds the emulator's
+	// address, such as "PUBSUB_EMULATOR_HOST".
+	hostEnv string
+	// name is used in skip messages, such as "Pub/Sub emulator".
+	name string
+}
+
+// enabled reports whether the service's clients will talk to the emulator.
+func (e emulator) enabled() bool {
+	return os.Getenv(e.hostEnv) != ""
+}
+
+// test gets the test context for a test that can run against the emulator.
+// When the emulator is enabled the test runs even if
+// GOLANG_SAMPLES_PROJECT_ID is not set. Otherwise it behaves like
+// SystemTest.
+func (e emulator) test(t *testing.T) Context {
+	if !e.enabled() {
+		return SystemTest(t)
+	}
+	return emulatorContext(t)
+}
+
+// skip skips the test when running against the emulator, which does not
+// implement feature.
+func (e emulator) skip(t *testing.T, feature string) {
+	t.Helper()
+	if e.enabled() {
+		t.Skipf("%s is not supported by the %s", feature, e.name)
+	}
+}
+
+// emulatorContext gets the test context for a test that runs against an
+// emulator, which does not need a real project.
+func emulatorContext(t testing.TB) Context {
+	tc := Context{ProjectID: os.Getenv("GOLANG_SAMPLES_PROJECT_ID")}
+	if tc.ProjectID == "" {
+		tc.ProjectID = emulatorProjectID
+	}
+	dir, err := repoDir()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tc.Dir = dir
+	return tc
+}
@@ -0,0 +1,46 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testutil
+
+import "testing"
+
+func TestEmulatorTest(t *testing.T) {
+	tests := []struct {
+		hostEnv string
+		enabled func() bool
+		test    func(*testing.T) Context
+	}{
+		{"STORAGE_EMULATOR_HOST", StorageEmulatorEnabled, StorageEmulatorTest},
+		{"PUBSUB_EMULATOR_HOST", PubsubEmulatorEnabled, PubsubEmulatorTest},
+		{"DATASTORE_EMULATOR_HOST", DatastoreEmulatorEnabled, DatastoreEmulatorTest},
+	}
+	for _, tc := range tests {
+		t.Run(tc.hostEnv, func(t *testing.T) {
+			t.Setenv(tc.hostEnv, "localhost:8080")
+			t.Setenv("GOLANG_SAMPLES_PROJECT_ID", "")
+			if !tc.enabled() {
+				t.Errorf("emulator not enabled with %v set", tc.hostEnv)
+			}
+			if got := tc.test(t); got.ProjectID != emulatorProjectID {
+				t.Errorf("got project %q, want %q", got.ProjectID, emulatorProjectID)
+			}
+
+			t.Setenv("GOLANG_SAMPLES_PROJECT_ID", "my-project")
+			if got := tc.test(t); got.ProjectID != "my-project" {
+				t.Errorf("got project %q, want %q", got.ProjectID, "my-project")
+			}
+		})
+	}
+}
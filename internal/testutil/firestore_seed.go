@@ -0,0 +1,205 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testutil
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/api/iterator"
+)
+
+// firestoreData holds the documents SeedCollection loads, one JSON file per
+// dataset.
+//
+//go:embed testdata/firestore/*.json
+var firestoreData embed.FS
+
+// SeedCollection replaces the contents of collection, in projectID and
+// databaseID, with the documents of dataset, and deletes them when t
+// finishes. Datasets are JSON files in testdata/firestore that map document
+// IDs to their fields; "cities" holds the cities the query samples use.
+// An ID may also be a path below the collection, such as
+// "SF/neighborhoods/mission", to seed a document in a subcollection.
+//
+// Documents already in the collection, such as those left by an earlier run
+// that was interrupted, are deleted first, so tests see only the dataset.
+// Subcollections are not touched, except for the documents the dataset
+// seeds in them.
+func SeedCollection(ctx context.Context, t *testing.T, projectID, databaseID, collection, dataset string) {
+	t.Helper()
+	docs, err := loadDataset(dataset)
+	if err != nil {
+		t.Fatalf("SeedCollection: %v", err)
+	}
+	client, err := firestore.NewClientWithDatabase(ctx, projectID, databaseID)
+	if err != nil {
+		t.Fatalf("firestore.NewClientWithDatabase: %v", err)
+	}
+	defer client.Close()
+	if err := clearSeeded(ctx, client, collection, docs); err != nil {
+		t.Fatalf("SeedCollection: %v", err)
+	}
+	// The cleanup gets its own client: the test's clients are closed by
+	// the time it runs.
+	t.Cleanup(func() {
+		ctx := context.Background()
+		client, err := firestore.NewClientWithDatabase(ctx, projectID, databaseID)
+		if err != nil {
+			t.Errorf("firestore.NewClientWithDatabase: %v", err)
+			return
+		}
+		defer client.Close()
+		if err := clearSeeded(ctx, client, collection, docs); err != nil {
+			t.Errorf("SeedCollection cleanup: %v", err)
+		}
+	})
+
+	bw := client.BulkWriter(ctx)
+	jobs := make(map[string]*firestore.BulkWriterJob, len(docs))
+	for id, fields := range docs {
+		job, err := bw.Set(client.Doc(collection+"/"+id), fields)
+		if err != nil {
+			bw.End()
+			t.Fatalf("SeedCollection: BulkWriter.Set(%q): %v", id, err)
+		}
+		jobs[id] = job
+	}
+	bw.End()
+	for id, job := range jobs {
+		if _, err := job.Results(); err != nil {
+			t.Fatalf("SeedCollection: writing %q: %v", id, err)
+		}
+	}
+}
+
+// Dataset returns the documents of dataset, as SeedCollection writes them,
+// for tests that need the data without a Firestore database.
+func Dataset(t *testing.T, dataset string) map[string]map[string]interface{} {
+	t.Helper()
+	docs, err := loadDataset(dataset)
+	if err != nil {
+		t.Fatalf("Dataset: %v", err)
+	}
+	return docs
+}
+
+// loadDataset reads the documents of a dataset. Whole numbers are loaded as
+// int64 and other numbers as float64, as Firestore stores them.
+func loadDataset(dataset string) (map[string]map[string]interface{}, error) {
+	b, err := firestoreData.ReadFile("testdata/firestore/" + dataset + ".json")
+	if err != nil {
+		return nil, fmt.Errorf("unknown dataset %q: %w", dataset, err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var docs map[string]map[string]interface{}
+	if err := dec.Decode(&docs); err != nil {
+		return nil, fmt.Errorf("decoding dataset %q: %w", dataset, err)
+	}
+	for _, fields := range docs {
+		for k, v := range fields {
+			fields[k] = convertNumbers(v)
+		}
+	}
+	return docs, nil
+}
+
+// convertNumbers replaces the json.Numbers in v with int64 or float64.
+func convertNumbers(v interface{}) interface{} {
+	switch v := v.(type) {
+	case json.Number:
+		if n, err := v.Int64(); err == nil {
+			return n
+		}
+		f, _ := v.Float64()
+		return f
+	case []interface{}:
+		for i := range v {
+			v[i] = convertNumbers(v[i])
+		}
+	case map[string]interface{}:
+		for k := range v {
+			v[k] = convertNumbers(v[k])
+		}
+	}
+	return v
+}
+
+// clearSeeded deletes every document in collection and the documents docs
+// seeds in its subcollections.
+func clearSeeded(ctx context.Context, client *firestore.Client, collection string, docs map[string]map[string]interface{}) error {
+	if err := clearCollection(ctx, client, collection); err != nil {
+		return err
+	}
+	bw := client.BulkWriter(ctx)
+	var jobs []*firestore.BulkWriterJob
+	for id := range docs {
+		if !strings.Contains(id, "/") {
+			continue
+		}
+		job, err := bw.Delete(client.Doc(collection + "/" + id))
+		if err != nil {
+			bw.End()
+			return fmt.Errorf("BulkWriter.Delete(%q): %w", id, err)
+		}
+		jobs = append(jobs, job)
+	}
+	bw.End()
+	return jobErrors(jobs)
+}
+
+// clearCollection deletes every document in collection with a BulkWriter.
+func clearCollection(ctx context.Context, client *firestore.Client, collection string) error {
+	bw := client.BulkWriter(ctx)
+	var jobs []*firestore.BulkWriterJob
+	it := client.Collection(collection).DocumentRefs(ctx)
+	for {
+		ref, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			bw.End()
+			return fmt.Errorf("Collection(%q).DocumentRefs: %w", collection, err)
+		}
+		job, err := bw.Delete(ref)
+		if err != nil {
+			bw.End()
+			return fmt.Errorf("BulkWriter.Delete(%q): %w", ref.ID, err)
+		}
+		jobs = append(jobs, job)
+	}
+	bw.End()
+	return jobErrors(jobs)
+}
+
+// jobErrors returns the errors of the finished jobs, joined.
+func jobErrors(jobs []*firestore.BulkWriterJob) error {
+	var errs []error
+	for _, job := range jobs {
+		if _, err := job.Results(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
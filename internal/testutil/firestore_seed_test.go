@@ -0,0 +1,70 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testutil
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLoadDataset(t *testing.T) {
+	docs, err := loadDataset("cities")
+	if err != nil {
+		t.Fatalf("loadDataset: %v", err)
+	}
+	if got := len(docs); got != 5 {
+		t.Errorf("loadDataset got %d documents, want 5", got)
+	}
+	want := map[string]interface{}{
+		"name":       "Tokyo",
+		"country":    "Japan",
+		"capital":    true,
+		"population": int64(9000000),
+		"regions":    []interface{}{"kanto", "honshu"},
+	}
+	if diff := cmp.Diff(want, docs["TOK"]); diff != "" {
+		t.Errorf("loadDataset TOK mismatch (-want +got):\n%s", diff)
+	}
+
+	tree, err := loadDataset("city_tree")
+	if err != nil {
+		t.Fatalf("loadDataset(city_tree): %v", err)
+	}
+	if _, ok := tree["SF/neighborhoods/mission"]; !ok {
+		t.Errorf("loadDataset(city_tree) is missing SF/neighborhoods/mission")
+	}
+
+	if _, err := loadDataset("no-such-dataset"); err == nil {
+		t.Errorf("loadDataset(no-such-dataset) got nil error, want an error")
+	}
+}
+
+func TestConvertNumbers(t *testing.T) {
+	in := map[string]interface{}{
+		"int":    json.Number("42"),
+		"float":  json.Number("1.5"),
+		"nested": []interface{}{json.Number("-7"), map[string]interface{}{"x": json.Number("1e3")}},
+	}
+	want := map[string]interface{}{
+		"int":    int64(42),
+		"float":  1.5,
+		"nested": []interface{}{int64(-7), map[string]interface{}{"x": 1000.0}},
+	}
+	if diff := cmp.Diff(want, convertNumbers(in)); diff != "" {
+		t.Errorf("convertNumbers mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,211 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testutil
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"cloud.google.com/go/firestore"
+	"cloud.google.com/go/pubsub"
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/iterator"
+)
+
+// Fixtures shares resources that are slow to create, such as buckets, among
+// the tests of a package, so that tests can run in parallel against one
+// resource instead of each creating its own.
+//
+// A resource is created by the first test that acquires it and torn down
+// when the last test holding it finishes. Acquire a resource before calling
+// t.Parallel, so the test holds it while it waits for the serial tests to
+// finish; a resource nobody holds is torn down and created again by the next
+// test that asks for it.
+//
+// The zero value is ready to use. SharedBucket, SharedTopic and
+// SharedCollection use a Fixtures shared by the whole package.
+type Fixtures struct {
+	mu     sync.Mutex
+	shared map[string]*fixture
+}
+
+type fixture struct {
+	refs     int
+	ready    chan struct{} // closed when name and err are set
+	name     string
+	err      error
+	teardown func(t *testing.T)
+}
+
+// Acquire returns the name of the resource for key, calling create to create
+// it if no test holds it. It releases the resource when t finishes, and the
+// last test to release it calls the teardown func returned by create.
+// If create fails, every test waiting for the resource fails.
+func (f *Fixtures) Acquire(t *testing.T, key string, create func() (name string, teardown func(t *testing.T), err error)) string {
+	t.Helper()
+
+	f.mu.Lock()
+	if f.shared == nil {
+		f.shared = make(map[string]*fixture)
+	}
+	fx, ok := f.shared[key]
+	if !ok {
+		fx = &fixture{ready: make(chan struct{})}
+		f.shared[key] = fx
+	}
+	fx.refs++
+	f.mu.Unlock()
+
+	t.Cleanup(func() { f.release(t, key, fx) })
+
+	if !ok {
+		// create may stop the test with t.Fatal; the waiting tests must
+		// still wake up.
+		fx.err = errCreateFailed
+		defer func() {
+			if fx.err == errCreateFailed {
+				close(fx.ready)
+			}
+		}()
+		fx.name, fx.teardown, fx.err = create()
+		close(fx.ready)
+	}
+	<-fx.ready
+
+	if fx.err != nil {
+		t.Fatalf("creating shared %s: %v", key, fx.err)
+	}
+	return fx.name
+}
+
+func (f *Fixtures) release(t *testing.T, key string, fx *fixture) {
+	f.mu.Lock()
+	fx.refs--
+	last := fx.refs == 0
+	if last {
+		delete(f.shared, key)
+	}
+	f.mu.Unlock()
+
+	if last && fx.err == nil && fx.teardown != nil {
+		fx.teardown(t)
+	}
+}
+
+// errCreateFailed is the error of a resource whose create func stopped its
+// test instead of returning.
+var errCreateFailed = errors.New("create stopped the test")
+
+// fixtures is the Fixtures of the package under test.
+var fixtures Fixtures
+
+// Prefix returns an object name or document ID prefix that only t uses, such
+// as "TestObjects-" for TestObjects. Tests that share a resource keep their
+// objects under their own prefix so they do not see each other's.
+func Prefix(t *testing.T) string {
+	return strings.ReplaceAll(t.Name(), "/", "-") + "-"
+}
+
+// SharedBucket returns a bucket in projectID shared by the tests of the
+// package that run at the same time. Put objects under Prefix(t), and
+// do not change the bucket's settings; tests that need versioning, a
+// retention policy or similar should create their own bucket.
+func SharedBucket(ctx context.Context, t *testing.T, projectID string) string {
+	t.Helper()
+	return fixtures.Acquire(t, "bucket "+projectID, func() (string, func(t *testing.T), error) {
+		name := UniqueName("shared")
+		CleanBucket(ctx, t, projectID, name)
+		return name, func(t *testing.T) {
+			ctx := context.Background()
+			client, err := storage.NewClient(ctx)
+			if err != nil {
+				t.Errorf("storage.NewClient: %v", err)
+				return
+			}
+			defer client.Close()
+			deleteBucketIfExists(ctx, t, client, name)
+		}, nil
+	})
+}
+
+// SharedTopic returns the ID of a topic in projectID shared by the tests of
+// the package that run at the same time. Each test should create its own
+// subscriptions to it.
+func SharedTopic(ctx context.Context, t *testing.T, projectID string) string {
+	t.Helper()
+	return fixtures.Acquire(t, "topic "+projectID, func() (string, func(t *testing.T), error) {
+		client, err := pubsub.NewClient(ctx, projectID)
+		if err != nil {
+			return "", nil, fmt.Errorf("pubsub.NewClient: %w", err)
+		}
+		defer client.Close()
+		id := UniqueName("shared")
+		if _, err := client.CreateTopic(ctx, id); err != nil {
+			return "", nil, fmt.Errorf("CreateTopic(%q): %w", id, err)
+		}
+		return id, func(t *testing.T) {
+			ctx := context.Background()
+			client, err := pubsub.NewClient(ctx, projectID)
+			if err != nil {
+				t.Errorf("pubsub.NewClient: %v", err)
+				return
+			}
+			defer client.Close()
+			if err := client.Topic(id).Delete(ctx); err != nil {
+				t.Errorf("Topic(%q).Delete: %v", id, err)
+			}
+		}, nil
+	})
+}
+
+// SharedCollection returns the name of a Firestore collection in projectID
+// and databaseID shared by the tests of the package that run at the same
+// time. Use document IDs that start with Prefix(t). The documents are
+// deleted when the last test finishes.
+func SharedCollection(ctx context.Context, t *testing.T, projectID, databaseID string) string {
+	t.Helper()
+	return fixtures.Acquire(t, "collection "+projectID+" "+databaseID, func() (string, func(t *testing.T), error) {
+		// Collections exist as long as they hold documents, so there is
+		// nothing to create.
+		name := UniqueName("shared")
+		return name, func(t *testing.T) {
+			ctx := context.Background()
+			client, err := firestore.NewClientWithDatabase(ctx, projectID, databaseID)
+			if err != nil {
+				t.Errorf("firestore.NewClientWithDatabase: %v", err)
+				return
+			}
+			defer client.Close()
+			it := client.Collection(name).DocumentRefs(ctx)
+			for {
+				doc, err := it.Next()
+				if err == iterator.Done {
+					break
+				}
+				if err != nil {
+					t.Errorf("Collection(%q).DocumentRefs: %v", name, err)
+					return
+				}
+				if _, err := doc.Delete(ctx); err != nil {
+					t.Errorf("Delete(%q): %v", doc.Path, err)
+				}
+			}
+		}, nil
+	})
+}
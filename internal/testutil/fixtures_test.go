@@ -0,0 +1,69 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testutil
+
+import (
+	"fmt"
+	"sync/atomic"
+	"testing"
+)
+
+func TestFixtures(t *testing.T) {
+	var (
+		f                  Fixtures
+		creates, teardowns int32
+	)
+	create := func() (string, func(t *testing.T), error) {
+		n := atomic.AddInt32(&creates, 1)
+		return fmt.Sprintf("resource-%d", n), func(t *testing.T) {
+			atomic.AddInt32(&teardowns, 1)
+		}, nil
+	}
+
+	t.Run("parallel", func(t *testing.T) {
+		for i := 0; i < 5; i++ {
+			t.Run(fmt.Sprint(i), func(t *testing.T) {
+				got := f.Acquire(t, "bucket", create)
+				t.Parallel()
+				if got != "resource-1" {
+					t.Errorf("Acquire got %q, want resource-1", got)
+				}
+				if n := atomic.LoadInt32(&teardowns); n != 0 {
+					t.Errorf("resource torn down %d times while in use", n)
+				}
+			})
+		}
+	})
+	if creates != 1 || teardowns != 1 {
+		t.Errorf("after parallel tests got %d creates and %d teardowns, want 1 and 1", creates, teardowns)
+	}
+
+	t.Run("again", func(t *testing.T) {
+		if got := f.Acquire(t, "bucket", create); got != "resource-2" {
+			t.Errorf("Acquire after release got %q, want resource-2", got)
+		}
+	})
+	if creates != 2 || teardowns != 2 {
+		t.Errorf("after second test got %d creates and %d teardowns, want 2 and 2", creates, teardowns)
+	}
+}
+
+func TestPrefix(t *testing.T) {
+	t.Run("sub/test", func(t *testing.T) {
+		if got, want := Prefix(t), "TestPrefix-sub-test-"; got != want {
+			t.Errorf("Prefix got %q, want %q", got, want)
+		}
+	})
+}
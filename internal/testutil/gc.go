@@ -0,0 +1,133 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testutil
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/iterator"
+)
+
+// isStale reports whether name was returned by UniqueName, or derived from
+// such a name, more than maxAge before now.
+func isStale(name string, now time.Time, maxAge time.Duration) bool {
+	created, ok := nameTime(name)
+	return ok && now.Sub(created) > maxAge
+}
+
+// DeleteStaleResources deletes the buckets, subscriptions and topics of
+// projectID that were named with UniqueName more than maxAge ago. Tests that
+// fail before their cleanup leave their resources behind; run this on a
+// schedule to remove them. Pick maxAge well above the longest test run, so
+// resources of tests that are still running are kept.
+func DeleteStaleResources(ctx context.Context, t *testing.T, projectID string, maxAge time.Duration) {
+	t.Helper()
+	now := time.Now()
+	deleteStaleBuckets(ctx, t, projectID, now, maxAge)
+	// Delete subscriptions first: deleting a topic detaches its
+	// subscriptions but does not delete them.
+	deleteStaleSubscriptions(ctx, t, projectID, now, maxAge)
+	deleteStaleTopics(ctx, t, projectID, now, maxAge)
+}
+
+func deleteStaleBuckets(ctx context.Context, t *testing.T, projectID string, now time.Time, maxAge time.Duration) {
+	t.Helper()
+
+	client, err := storage.NewClient(ctx)
+	if err != nil {
+		t.Fatalf("storage.NewClient: %v", err)
+	}
+	defer client.Close()
+
+	it := client.Buckets(ctx, projectID)
+	it.Prefix = ResourcePrefix
+	for {
+		attrs, err := it.Next()
+		if err == iterator.Done {
+			return
+		}
+		if err != nil {
+			t.Errorf("Buckets(%q): %v", projectID, err)
+			return
+		}
+		if !isStale(attrs.Name, now, maxAge) {
+			continue
+		}
+		t.Logf("Deleting stale bucket %v", attrs.Name)
+		deleteBucketIfExists(ctx, t, client, attrs.Name)
+	}
+}
+
+func deleteStaleSubscriptions(ctx context.Context, t *testing.T, projectID string, now time.Time, maxAge time.Duration) {
+	t.Helper()
+
+	client, err := pubsub.NewClient(ctx, projectID)
+	if err != nil {
+		t.Fatalf("pubsub.NewClient: %v", err)
+	}
+	defer client.Close()
+
+	it := client.Subscriptions(ctx)
+	for {
+		sub, err := it.Next()
+		if err == iterator.Done {
+			return
+		}
+		if err != nil {
+			t.Errorf("Subscriptions: %v", err)
+			return
+		}
+		if !isStale(sub.ID(), now, maxAge) {
+			continue
+		}
+		t.Logf("Deleting stale subscription %v", sub.ID())
+		if err := sub.Delete(ctx); err != nil {
+			t.Errorf("Subscription(%q).Delete: %v", sub.ID(), err)
+		}
+	}
+}
+
+func deleteStaleTopics(ctx context.Context, t *testing.T, projectID string, now time.Time, maxAge time.Duration) {
+	t.Helper()
+
+	client, err := pubsub.NewClient(ctx, projectID)
+	if err != nil {
+		t.Fatalf("pubsub.NewClient: %v", err)
+	}
+	defer client.Close()
+
+	it := client.Topics(ctx)
+	for {
+		topic, err := it.Next()
+		if err == iterator.Done {
+			return
+		}
+		if err != nil {
+			t.Errorf("Topics: %v", err)
+			return
+		}
+		if !isStale(topic.ID(), now, maxAge) {
+			continue
+		}
+		t.Logf("Deleting stale topic %v", topic.ID())
+		if err := topic.Delete(ctx); err != nil {
+			t.Errorf("Topic(%q).Delete: %v", topic.ID(), err)
+		}
+	}
+}
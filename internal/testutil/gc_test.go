@@ -0,0 +1,62 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testutil
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestIsStale(t *testing.T) {
+	now := time.Now()
+	at := func(d time.Duration) string {
+		return fmt.Sprintf("%sreplay-src-%d-0a1b2c", ResourcePrefix, now.Add(-d).Unix())
+	}
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{name: at(25 * time.Hour), want: true},
+		{name: at(time.Hour), want: false},
+		{name: at(25*time.Hour) + "-retry", want: true},
+		{name: at(time.Hour) + "-dead-letter-sink", want: false},
+		{name: "other-prefix-1", want: false},
+		{name: ResourcePrefix + "fixed-name", want: false},
+		{name: ResourcePrefix + "object-bucket-1", want: false},
+	}
+	for _, tc := range tests {
+		if got := isStale(tc.name, now, 24*time.Hour); got != tc.want {
+			t.Errorf("isStale(%q) got %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+// TestDeleteStaleResources deletes leftovers of earlier test runs. The
+// scheduled system test builds set GOLANG_SAMPLES_GC_MAX_AGE to run it.
+func TestDeleteStaleResources(t *testing.T) {
+	tc := SystemTest(t)
+	v := os.Getenv("GOLANG_SAMPLES_GC_MAX_AGE")
+	if v == "" {
+		t.Skip("GOLANG_SAMPLES_GC_MAX_AGE not set")
+	}
+	maxAge, err := time.ParseDuration(v)
+	if err != nil {
+		t.Fatalf("GOLANG_SAMPLES_GC_MAX_AGE: %v", err)
+	}
+	DeleteStaleResources(context.Background(), t, tc.ProjectID, maxAge)
+}
@@ -0,0 +1,145 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testutil
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+
+	"cloud.google.com/go/iam"
+	kms "cloud.google.com/go/kms/apiv1"
+	"cloud.google.com/go/storage"
+	"github.com/GoogleCloudPlatform/golang-samples/internal/apierrors"
+	kmspb "google.golang.org/genproto/googleapis/cloud/kms/v1"
+	"google.golang.org/grpc/codes"
+)
+
+// The key ring and key KMSKeyName provisions. Key rings and keys can't be
+// deleted, so they are created once per project and reused by every run.
+const (
+	testKeyRing   = "golang-samples-tests"
+	testCryptoKey = "storage"
+)
+
+// encrypterDecrypter is the role the Cloud Storage service agent needs on a
+// key to encrypt objects with it.
+const encrypterDecrypter iam.RoleName = "roles/cloudkms.cryptoKeyEncrypterDecrypter"
+
+var (
+	kmsMu sync.Mutex
+	// kmsKeys caches the provisioned key of every project, so that the
+	// tests of a package provision it once.
+	kmsKeys = make(map[string]string)
+)
+
+// KMSKeyName returns the name of a symmetric Cloud KMS key in the global
+// location of projectID, for tests of customer-managed encryption keys.
+//
+// The key is the one named by GOLANG_SAMPLES_KMS_KEYRING and
+// GOLANG_SAMPLES_KMS_CRYPTOKEY, if they are set. Otherwise a key ring and key
+// are created in projectID on first use, and the project's Cloud Storage
+// service agent is allowed to encrypt and decrypt with the key. The test is
+// skipped, saying why, only if the caller may not manage keys in the project.
+func KMSKeyName(ctx context.Context, t *testing.T, projectID string) string {
+	t.Helper()
+	keyRing := os.Getenv("GOLANG_SAMPLES_KMS_KEYRING")
+	cryptoKey := os.Getenv("GOLANG_SAMPLES_KMS_CRYPTOKEY")
+	if keyRing != "" && cryptoKey != "" {
+		return fmt.Sprintf("projects/%s/locations/global/keyRings/%s/cryptoKeys/%s", projectID, keyRing, cryptoKey)
+	}
+
+	kmsMu.Lock()
+	defer kmsMu.Unlock()
+	if name, ok := kmsKeys[projectID]; ok {
+		return name
+	}
+	name, err := ensureKMSKey(ctx, t, projectID, testKeyRing, testCryptoKey)
+	if apierrors.Code(err) == codes.PermissionDenied {
+		t.Skipf("KMSKeyName: can't provision a test key, set GOLANG_SAMPLES_KMS_KEYRING and GOLANG_SAMPLES_KMS_CRYPTOKEY instead: %v", err)
+	}
+	if err != nil {
+		t.Fatalf("KMSKeyName: %v", err)
+	}
+	kmsKeys[projectID] = name
+	return name
+}
+
+// ensureKMSKey creates the key ring and symmetric key in the global location
+// of projectID unless they exist, grants the Cloud Storage service agent
+// encrypterDecrypter on the key unless it has it, and returns the name of
+// the key.
+func ensureKMSKey(ctx context.Context, t *testing.T, projectID, keyRing, cryptoKey string) (string, error) {
+	client, err := kms.NewKeyManagementClient(ctx)
+	if err != nil {
+		return "", fmt.Errorf("kms.NewKeyManagementClient: %w", err)
+	}
+	defer client.Close()
+
+	parent := fmt.Sprintf("projects/%s/locations/global", projectID)
+	ringName := parent + "/keyRings/" + keyRing
+	_, err = client.CreateKeyRing(ctx, &kmspb.CreateKeyRingRequest{
+		Parent:    parent,
+		KeyRingId: keyRing,
+	})
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		return "", fmt.Errorf("CreateKeyRing(%q): %w", ringName, err)
+	}
+
+	keyName := ringName + "/cryptoKeys/" + cryptoKey
+	_, err = client.CreateCryptoKey(ctx, &kmspb.CreateCryptoKeyRequest{
+		Parent:      ringName,
+		CryptoKeyId: cryptoKey,
+		CryptoKey: &kmspb.CryptoKey{
+			Purpose: kmspb.CryptoKey_ENCRYPT_DECRYPT,
+			VersionTemplate: &kmspb.CryptoKeyVersionTemplate{
+				Algorithm: kmspb.CryptoKeyVersion_GOOGLE_SYMMETRIC_ENCRYPTION,
+			},
+		},
+	})
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		return "", fmt.Errorf("CreateCryptoKey(%q): %w", keyName, err)
+	}
+
+	sc, err := storage.NewClient(ctx)
+	if err != nil {
+		return "", fmt.Errorf("storage.NewClient: %w", err)
+	}
+	defer sc.Close()
+	agent, err := sc.ServiceAccount(ctx, projectID)
+	if err != nil {
+		return "", fmt.Errorf("ServiceAccount(%q): %w", projectID, err)
+	}
+	member := "serviceAccount:" + agent
+
+	handle := client.ResourceIAM(keyName)
+	policy, err := handle.Policy(ctx)
+	if err != nil {
+		return "", fmt.Errorf("Policy(%q): %w", keyName, err)
+	}
+	if policy.HasRole(member, encrypterDecrypter) {
+		return keyName, nil
+	}
+	policy.Add(member, encrypterDecrypter)
+	IAMLimiter.Take(t)
+	if err := handle.SetPolicy(ctx, policy); err != nil {
+		return "", fmt.Errorf("SetPolicy(%q): %w", keyName, err)
+	}
+	// IAM changes take a while to apply; callers retry their first
+	// requests with the key.
+	return keyName, nil
+}
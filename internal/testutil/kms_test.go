@@ -0,0 +1,29 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testutil
+
+import (
+	"context"
+	"testing"
+)
+
+func TestKMSKeyNameFromEnv(t *testing.T) {
+	t.Setenv("GOLANG_SAMPLES_KMS_KEYRING", "ring1")
+	t.Setenv("GOLANG_SAMPLES_KMS_CRYPTOKEY", "key1")
+	got := KMSKeyName(context.Background(), t, "my-project")
+	if want := "projects/my-project/locations/global/keyRings/ring1/cryptoKeys/key1"; got != want {
+		t.Errorf("KMSKeyName got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testutil
+
+import "testing"
+
+var pubsubEmulator = emulator{hostEnv: "PUBSUB_EMULATOR_HOST", name: "Pub/Sub emulator"}
+
+// PubsubEmulatorEnabled reports whether Pub/Sub clients will talk to the
+// Pub/Sub emulator instead of Pub/Sub.
+func PubsubEmulatorEnabled() bool {
+	return pubsubEmulator.enabled()
+}
+
+// PubsubEmulatorTest gets the test context for a Pub/Sub test that can run
+// against the emulator. If PUBSUB_EMULATOR_HOST is set, the test runs even if
+// GOLANG_SAMPLES_PROJECT_ID is not set. Otherwise it behaves like SystemTest.
+//
+// To run these tests without a Google Cloud project:
+//
+//	gcloud beta emulators pubsub start --host-port=localhost:8085
+//	PUBSUB_EMULATOR_HOST=localhost:8085 go test ./pubsub/...
+func PubsubEmulatorTest(t *testing.T) Context {
+	return pubsubEmulator.test(t)
+}
+
+// SkipIfPubsubEmulator skips the test when running against the Pub/Sub
+// emulator. Use it for features the emulator does not implement, like IAM,
+// schemas or import topics.
+func SkipIfPubsubEmulator(t *testing.T, feature string) {
+	t.Helper()
+	pubsubEmulator.skip(t, feature)
+}
@@ -0,0 +1,147 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testutil
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// The limiters below keep the tests of one package under the per-project
+// quotas of the operations tests do most. Wait on them before each
+// operation in a loop, instead of letting the requests fail with 429 and
+// retrying. Tests in different packages run in different processes, so set
+// the rates lower when running packages in parallel against one project.
+var (
+	// BucketLimiter limits creating and deleting buckets. Cloud Storage
+	// allows about one per two seconds per project. Set
+	// GOLANG_SAMPLES_BUCKET_RATE to change it. It is off when
+	// STORAGE_EMULATOR_HOST is set: emulators and replays have no quota.
+	BucketLimiter = &Limiter{env: "GOLANG_SAMPLES_BUCKET_RATE", perSecond: 0.5, burst: 1, off: StorageEmulatorEnabled}
+	// IAMLimiter limits setting IAM policies. Set GOLANG_SAMPLES_IAM_RATE
+	// to change it.
+	IAMLimiter = NewLimiter("GOLANG_SAMPLES_IAM_RATE", 1, 2)
+)
+
+// A Limiter is a token bucket: it allows bursts of up to burst operations,
+// then perSecond operations per second on average.
+//
+// The rate and burst can be overridden with the environment variable env,
+// set to "RATE" or "RATE,BURST", such as "0.2,1" for one operation every
+// five seconds. A rate of 0 turns the limiter off.
+type Limiter struct {
+	env       string
+	perSecond float64
+	burst     int
+	off       func() bool // reports whether to skip limiting
+
+	once sync.Once
+	err  error // from parsing env
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+// NewLimiter returns a Limiter with the given default rate and burst, which
+// env overrides when it is set.
+func NewLimiter(env string, perSecond float64, burst int) *Limiter {
+	return &Limiter{env: env, perSecond: perSecond, burst: burst}
+}
+
+// Wait blocks until the limiter allows one more operation, or ctx is done.
+func (l *Limiter) Wait(ctx context.Context) error {
+	l.once.Do(l.configure)
+	if l.err != nil {
+		return l.err
+	}
+	if l.off != nil && l.off() {
+		return nil
+	}
+	d := l.reserve(time.Now())
+	if d <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Take is Wait for tests: it fails t if the limiter is misconfigured.
+func (l *Limiter) Take(t testing.TB) {
+	t.Helper()
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatalf("Limiter.Wait: %v", err)
+	}
+}
+
+func (l *Limiter) configure() {
+	v := os.Getenv(l.env)
+	if v == "" {
+		return
+	}
+	rate, burst := v, ""
+	if i := strings.Index(v, ","); i >= 0 {
+		rate, burst = v[:i], v[i+1:]
+	}
+	perSecond, err := strconv.ParseFloat(rate, 64)
+	if err != nil || perSecond < 0 {
+		l.err = fmt.Errorf("%s=%q: want RATE or RATE,BURST with RATE >= 0", l.env, v)
+		return
+	}
+	l.perSecond = perSecond
+	if burst != "" {
+		n, err := strconv.Atoi(burst)
+		if err != nil || n < 1 {
+			l.err = fmt.Errorf("%s=%q: want BURST >= 1", l.env, v)
+			return
+		}
+		l.burst = n
+	}
+}
+
+// reserve takes a token and returns how long to wait before using it.
+// Tokens are handed out in order, so waiters are served first come, first
+// served.
+func (l *Limiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.perSecond == 0 {
+		return 0
+	}
+	if l.last.IsZero() {
+		l.tokens = float64(l.burst)
+	} else if now.After(l.last) {
+		l.tokens = math.Min(float64(l.burst), l.tokens+now.Sub(l.last).Seconds()*l.perSecond)
+	}
+	l.last = now
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.perSecond * float64(time.Second))
+}
@@ -0,0 +1,90 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testutil
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestLimiterReserve(t *testing.T) {
+	l := NewLimiter("GOLANG_SAMPLES_TEST_RATE", 2, 2)
+	start := time.Unix(1760536800, 0)
+	steps := []struct {
+		after time.Duration
+		want  time.Duration
+	}{
+		// The burst goes through at once.
+		{0, 0},
+		{0, 0},
+		// Then one operation every half second, in order.
+		{0, 500 * time.Millisecond},
+		{0, time.Second},
+		// Waiting refills the bucket, up to the burst.
+		{10 * time.Second, 0},
+		{10 * time.Second, 0},
+		{10 * time.Second, 500 * time.Millisecond},
+	}
+	for i, s := range steps {
+		if got := l.reserve(start.Add(s.after)); got != s.want {
+			t.Errorf("step %d: reserve(+%v) got %v, want %v", i, s.after, got, s.want)
+		}
+	}
+}
+
+func TestLimiterEnv(t *testing.T) {
+	const env = "GOLANG_SAMPLES_TEST_RATE"
+	defer os.Unsetenv(env)
+	tests := []struct {
+		value     string
+		perSecond float64
+		burst     int
+		wantErr   bool
+	}{
+		{value: "", perSecond: 1, burst: 2},
+		{value: "0.2", perSecond: 0.2, burst: 2},
+		{value: "5,10", perSecond: 5, burst: 10},
+		{value: "0", perSecond: 0, burst: 2},
+		{value: "fast", wantErr: true},
+		{value: "1,0", wantErr: true},
+	}
+	for _, tc := range tests {
+		os.Setenv(env, tc.value)
+		l := NewLimiter(env, 1, 2)
+		err := l.Wait(context.Background())
+		if (err != nil) != tc.wantErr {
+			t.Errorf("%s=%q: Wait got err %v, want err %v", env, tc.value, err, tc.wantErr)
+			continue
+		}
+		if !tc.wantErr && (l.perSecond != tc.perSecond || l.burst != tc.burst) {
+			t.Errorf("%s=%q: got rate %v burst %d, want %v and %d", env, tc.value, l.perSecond, l.burst, tc.perSecond, tc.burst)
+		}
+	}
+}
+
+func TestLimiterOff(t *testing.T) {
+	os.Setenv("GOLANG_SAMPLES_TEST_RATE", "0")
+	defer os.Unsetenv("GOLANG_SAMPLES_TEST_RATE")
+	l := NewLimiter("GOLANG_SAMPLES_TEST_RATE", 0.001, 1)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	for i := 0; i < 100; i++ {
+		if err := l.Wait(ctx); err != nil {
+			t.Fatalf("Wait %d with the limiter off: %v", i, err)
+		}
+	}
+}
@@ -27,6 +27,7 @@ import (
 // Retry runs function f for up to maxAttempts times until f returns successfully, and reports whether f was run successfully.
 // It will sleep for the given period between invocations of f.
 // Use the provided *testutil.R instead of a *testing.T from the function.
+// Tests in this repository use RetryBackoff instead.
 func Retry(t *testing.T, maxAttempts int, sleep time.Duration, f func(r *R)) bool {
 	for attempt := 1; attempt <= maxAttempts; attempt++ {
 		r := &R{Attempt: attempt, log: &bytes.Buffer{}}
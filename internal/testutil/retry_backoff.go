@@ -0,0 +1,166 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testutil
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/rand"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/GoogleCloudPlatform/golang-samples/internal/apierrors"
+	"google.golang.org/api/googleapi"
+)
+
+// Backoff configures how long RetryContext and RetryOn wait between
+// attempts. The wait starts at Initial and is multiplied by Multiplier after
+// every attempt, up to Max. Each wait is randomized between half and all of
+// that value, so tests running in parallel against the same project do not
+// retry in lockstep.
+type Backoff struct {
+	Initial    time.Duration
+	Max        time.Duration
+	Multiplier float64
+	// MaxAttempts is the number of attempts. Zero means retry until the
+	// context is done.
+	MaxAttempts int
+}
+
+// DefaultBackoff suits most eventually consistent checks: up to 10 attempts
+// over about half a minute.
+var DefaultBackoff = Backoff{
+	Initial:     500 * time.Millisecond,
+	Max:         8 * time.Second,
+	Multiplier:  2,
+	MaxAttempts: 10,
+}
+
+var (
+	jitterMu   sync.Mutex
+	jitterRand = rand.New(rand.NewSource(time.Now().UnixNano()))
+)
+
+// pauses returns a function that returns the wait before each retry.
+func (bo Backoff) pauses() func() time.Duration {
+	cur := bo.Initial
+	if cur <= 0 {
+		cur = time.Second
+	}
+	mult := bo.Multiplier
+	if mult < 1 {
+		mult = 1
+	}
+	return func() time.Duration {
+		d := cur
+		if bo.Max > 0 && d > bo.Max {
+			d = bo.Max
+		}
+		cur = time.Duration(float64(cur) * mult)
+		jitterMu.Lock()
+		defer jitterMu.Unlock()
+		return d/2 + time.Duration(jitterRand.Int63n(int64(d/2)+1))
+	}
+}
+
+// sleep waits for d or until ctx is done, and reports whether it waited for
+// all of d.
+func sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// RetryContext is like Retry, but waits between attempts according to bo
+// and stops early when ctx is done, for example at the test deadline. It
+// fails the test if f never succeeds.
+func RetryContext(ctx context.Context, t testing.TB, bo Backoff, f func(r *R)) bool {
+	t.Helper()
+	pause := bo.pauses()
+	for attempt := 1; ; attempt++ {
+		r := &R{Attempt: attempt, log: &bytes.Buffer{}}
+
+		f(r)
+
+		if !r.failed {
+			if r.log.Len() != 0 {
+				t.Logf("Success after %d attempts:%s", attempt, r.log.String())
+			}
+			return true
+		}
+
+		if attempt == bo.MaxAttempts || ctx.Err() != nil || !sleep(ctx, pause()) {
+			t.Logf("FAILED after %d attempts:%s", attempt, r.log.String())
+			t.Fail()
+			return false
+		}
+	}
+}
+
+// RetryBackoff is RetryContext without a context.
+func RetryBackoff(t testing.TB, bo Backoff, f func(r *R)) bool {
+	t.Helper()
+	return RetryContext(context.Background(), t, bo, f)
+}
+
+// RetryOn calls f until it returns nil or an error for which retryable is
+// false, waiting between attempts according to bo, and returns the last
+// error. Unlike RetryContext it does not fail the test, so callers decide
+// what an error means. Use it to retry only errors that are known to be
+// transient, such as with Transient, instead of masking real failures.
+func RetryOn(ctx context.Context, bo Backoff, retryable func(error) bool, f func() error) error {
+	pause := bo.pauses()
+	for attempt := 1; ; attempt++ {
+		err := f()
+		if err == nil || !retryable(err) {
+			return err
+		}
+		if attempt == bo.MaxAttempts || ctx.Err() != nil || !sleep(ctx, pause()) {
+			return err
+		}
+	}
+}
+
+// Transient reports whether err, or an error it wraps, is an HTTP 408, 429,
+// 500, 502, 503 or 504 error, or a gRPC Unavailable, ResourceExhausted,
+// Aborted or DeadlineExceeded error, or a broken connection. See
+// apierrors.Retryable.
+func Transient(err error) bool {
+	return apierrors.Retryable(err)
+}
+
+// HTTPStatus returns a retryable function for RetryOn that matches
+// *googleapi.Error errors with one of statuses.
+func HTTPStatus(statuses ...int) func(error) bool {
+	return func(err error) bool {
+		var gerr *googleapi.Error
+		if !errors.As(err, &gerr) {
+			return false
+		}
+		for _, s := range statuses {
+			if gerr.Code == s {
+				return true
+			}
+		}
+		return false
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testutil
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/api/googleapi"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var testBackoff = Backoff{Initial: time.Millisecond, Max: 4 * time.Millisecond, Multiplier: 2, MaxAttempts: 5}
+
+func TestRetryContext(t *testing.T) {
+	var attempts int
+	ok := RetryContext(context.Background(), t, testBackoff, func(r *R) {
+		attempts = r.Attempt
+		if r.Attempt < 3 {
+			r.Errorf("attempt %d", r.Attempt)
+		}
+	})
+	if !ok || attempts != 3 {
+		t.Errorf("RetryContext got ok=%v after %d attempts, want true after 3", ok, attempts)
+	}
+}
+
+func TestRetryOnDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var calls int
+	err := RetryOn(ctx, Backoff{Initial: time.Hour}, Transient, func() error {
+		calls++
+		return &googleapi.Error{Code: 503}
+	})
+	if err == nil || calls != 1 {
+		t.Errorf("RetryOn with done context got %v after %d calls, want an error after 1", err, calls)
+	}
+}
+
+func TestPauses(t *testing.T) {
+	pause := Backoff{Initial: 100 * time.Millisecond, Max: 400 * time.Millisecond, Multiplier: 2}.pauses()
+	for _, max := range []time.Duration{100, 200, 400, 400} {
+		max *= time.Millisecond
+		if got := pause(); got < max/2 || got > max {
+			t.Errorf("pause got %v, want between %v and %v", got, max/2, max)
+		}
+	}
+}
+
+func TestRetryOn(t *testing.T) {
+	unavailable := &googleapi.Error{Code: 503}
+	var calls int
+	err := RetryOn(context.Background(), testBackoff, Transient, func() error {
+		calls++
+		if calls < 3 {
+			return unavailable
+		}
+		return nil
+	})
+	if err != nil || calls != 3 {
+		t.Errorf("RetryOn got %v after %d calls, want nil after 3", err, calls)
+	}
+
+	calls = 0
+	notFound := &googleapi.Error{Code: 404}
+	err = RetryOn(context.Background(), testBackoff, Transient, func() error {
+		calls++
+		return notFound
+	})
+	if err != notFound || calls != 1 {
+		t.Errorf("RetryOn got %v after %d calls, want %v after 1", err, calls, notFound)
+	}
+
+	calls = 0
+	err = RetryOn(context.Background(), testBackoff, HTTPStatus(429), func() error {
+		calls++
+		return &googleapi.Error{Code: 429}
+	})
+	if err == nil || calls != testBackoff.MaxAttempts {
+		t.Errorf("RetryOn got %v after %d calls, want an error after %d", err, calls, testBackoff.MaxAttempts)
+	}
+}
+
+func TestTransient(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{nil, false},
+		{errors.New("boom"), false},
+		{&googleapi.Error{Code: 429}, true},
+		{&googleapi.Error{Code: 503}, true},
+		{&googleapi.Error{Code: 404}, false},
+		{wrapped{&googleapi.Error{Code: 503}}, true},
+		{status.Error(codes.Unavailable, "unavailable"), true},
+		{status.Error(codes.NotFound, "not found"), false},
+	}
+	for _, tc := range tests {
+		if got := Transient(tc.err); got != tc.want {
+			t.Errorf("Transient(%v) got %v, want %v", tc.err, got, tc.want)
+		}
+	}
+}
+
+type wrapped struct{ err error }
+
+func (w wrapped) Error() string { return "wrapped: " + w.err.Error() }
+func (w wrapped) Unwrap() error { return w.err }
@@ -27,8 +27,8 @@ import (
 )
 
 // BuildMain builds the main package in the current working directory.
-// If it doesn't build, t.Fatal is called.
-// Test methods calling BuildMain should run Runner.Cleanup.
+// If it doesn't build, t.Error is called and Runner.Built reports false.
+// The binary is removed when t finishes; Runner.Cleanup removes it sooner.
 func BuildMain(t *testing.T) *Runner {
 	wd, err := os.Getwd()
 	if err != nil {
@@ -40,6 +40,7 @@ func BuildMain(t *testing.T) *Runner {
 	}
 
 	r := &Runner{t: t, tmp: tmp}
+	t.Cleanup(r.Cleanup)
 
 	bin := filepath.Join(tmp, "a.out")
 	cmd := exec.Command("go", "build", "-o", bin)
@@ -94,7 +95,7 @@ func (r *Runner) Run(env map[string]string, timeout time.Duration, args ...strin
 	cmd.Stderr = &bufErr
 
 	if err := cmd.Start(); err != nil {
-		return nil, nil, fmt.Errorf("could not execute binary: %v", err)
+		return nil, nil, fmt.Errorf("could not execute binary: %w", err)
 	}
 
 	if err := cmd.Wait(); err != nil {
@@ -102,3 +103,24 @@ func (r *Runner) Run(env map[string]string, timeout time.Duration, args ...strin
 	}
 	return bufOut.Bytes(), bufErr.Bytes(), nil
 }
+
+// RunMain builds the main package in the current working directory, runs it
+// with env added to the environment and with args, and returns what it wrote
+// to stdout. It fails t if the package does not build, or if the program
+// does not exit successfully within timeout, showing what it wrote to
+// stderr. Use it to test samples that are a main package and print their
+// results, instead of only checking that they build:
+//
+//	out := testutil.RunMain(t, time.Minute, map[string]string{"GCLOUD_PROJECT": projectID}, "-v")
+func RunMain(t *testing.T, timeout time.Duration, env map[string]string, args ...string) string {
+	t.Helper()
+	r := BuildMain(t)
+	if !r.Built() {
+		t.FailNow()
+	}
+	stdout, stderr, err := r.Run(env, timeout, args...)
+	if err != nil {
+		t.Fatalf("running the main package with args %q: %v\nstderr:\n%s", args, err, stderr)
+	}
+	return string(stdout)
+}
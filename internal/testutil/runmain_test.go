@@ -0,0 +1,47 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testutil
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRunMain(t *testing.T) {
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir("testdata/greet"); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd)
+
+	got := RunMain(t, time.Minute, map[string]string{"GREETING": "Hello"}, "Ada", "Alan")
+	if want := "Hello, Ada and Alan!\n"; got != want {
+		t.Errorf("RunMain got %q, want %q", got, want)
+	}
+
+	r := BuildMain(t)
+	_, stderr, err := r.Run(map[string]string{"GREET_FAIL": "1"}, time.Minute)
+	if err == nil {
+		t.Errorf("Run with GREET_FAIL set got nil error, want the exit status")
+	}
+	if !strings.Contains(string(stderr), "asked to fail") {
+		t.Errorf("Run with GREET_FAIL set got stderr %q, want it to contain %q", stderr, "asked to fail")
+	}
+}
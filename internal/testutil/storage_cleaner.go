@@ -25,7 +25,7 @@ import (
 
 // CleanBucket creates a new bucket. If the bucket already exists, it will be
 // deleted and recreated.
-func CleanBucket(ctx context.Context, t *testing.T, projectID, bucket string) error {
+func CleanBucket(ctx context.Context, t testing.TB, projectID, bucket string) error {
 	t.Helper()
 
 	client, err := storage.NewClient(ctx)
@@ -40,7 +40,8 @@ func CleanBucket(ctx context.Context, t *testing.T, projectID, bucket string) er
 
 	// Now create the bucket.
 	// Retry because the bucket can take time to fully delete.
-	Retry(t, 10, 10*time.Second, func(r *R) {
+	RetryBackoff(t, Backoff{Initial: 10 * time.Second, Max: 20 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *R) {
+		BucketLimiter.Take(t)
 		if err := b.Create(ctx, projectID, nil); err != nil {
 			r.Errorf("Bucket.Create(%q): %v", bucket, err)
 		}
@@ -48,7 +49,22 @@ func CleanBucket(ctx context.Context, t *testing.T, projectID, bucket string) er
 	return nil
 }
 
-func deleteBucketIfExists(ctx context.Context, t *testing.T, client *storage.Client, bucket string) {
+// DeleteBucket deletes bucket, and every object in it, if it exists. Tests
+// that create a bucket with CleanBucket call it from t.Cleanup, so that the
+// bucket does not outlive the test.
+func DeleteBucket(ctx context.Context, t testing.TB, bucket string) {
+	t.Helper()
+
+	client, err := storage.NewClient(ctx)
+	if err != nil {
+		t.Errorf("storage.NewClient: %v", err)
+		return
+	}
+	defer client.Close()
+	deleteBucketIfExists(ctx, t, client, bucket)
+}
+
+func deleteBucketIfExists(ctx context.Context, t testing.TB, client *storage.Client, bucket string) {
 	t.Helper()
 
 	b := client.Bucket(bucket)
@@ -87,6 +103,7 @@ func deleteBucketIfExists(ctx context.Context, t *testing.T, client *storage.Cli
 	}
 
 	// Then delete the bucket itself.
+	BucketLimiter.Take(t)
 	if err := b.Delete(ctx); err != nil {
 		t.Errorf("Bucket.Delete(%q): %v", bucket, err)
 	}
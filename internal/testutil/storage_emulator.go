@@ -0,0 +1,45 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testutil
+
+import "testing"
+
+// storageEmulator is a Cloud Storage emulator, such as fake-gcs-server.
+var storageEmulator = emulator{hostEnv: "STORAGE_EMULATOR_HOST", name: "storage emulator"}
+
+// StorageEmulatorEnabled reports whether Cloud Storage clients will talk to
+// an emulator instead of Cloud Storage.
+func StorageEmulatorEnabled() bool {
+	return storageEmulator.enabled()
+}
+
+// StorageEmulatorTest gets the test context for a storage test that can run
+// against an emulator. If STORAGE_EMULATOR_HOST is set, the test runs even if
+// GOLANG_SAMPLES_PROJECT_ID is not set. Otherwise it behaves like SystemTest.
+//
+// To run these tests without a Google Cloud project:
+//
+//	docker run -d -p 4443:4443 fsouza/fake-gcs-server -scheme http
+//	STORAGE_EMULATOR_HOST=localhost:4443 go test ./storage/...
+func StorageEmulatorTest(t *testing.T) Context {
+	return storageEmulator.test(t)
+}
+
+// SkipIfStorageEmulator skips the test when running against a storage
+// emulator. Use it for features emulators do not implement, like IAM or KMS.
+func SkipIfStorageEmulator(t *testing.T, feature string) {
+	t.Helper()
+	storageEmulator.skip(t, feature)
+}
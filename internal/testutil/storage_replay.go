@@ -0,0 +1,275 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testutil
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"cloud.google.com/go/storage"
+	"golang.org/x/oauth2/google"
+)
+
+// storageReplayEnv selects the mode of StorageReplayTest: "record" or
+// "replay". Tests run normally when it is not set.
+const storageReplayEnv = "GOLANG_SAMPLES_STORAGE_REPLAY"
+
+// storageUpstreamEnv overrides storageHost when recording, e.g. with the
+// address of a storage emulator. Requests to it are not authenticated.
+const storageUpstreamEnv = "GOLANG_SAMPLES_STORAGE_REPLAY_UPSTREAM"
+
+// storageHost is the Cloud Storage endpoint recorded requests are sent to,
+// unless storageUpstreamEnv is set. Recordings store it as hostPlaceholder,
+// since the proxy address changes from run to run.
+const (
+	storageHost     = "https://storage.googleapis.com"
+	hostPlaceholder = "{{host}}"
+)
+
+// StorageReplay records the Cloud Storage traffic of a test, or replays a
+// recording of it, through a local proxy.
+//
+// Samples create their own storage clients, so there is no HTTP client to
+// swap for one from cloud.google.com/go/httpreplay. Instead the proxy is set
+// as STORAGE_EMULATOR_HOST, which every storage.NewClient honors.
+//
+// To record, run the test once against a real project:
+//
+//	GOLANG_SAMPLES_STORAGE_REPLAY=record GOLANG_SAMPLES_PROJECT_ID=my-project go test -run TestX
+//
+// and check in the recording. To record without a project, set
+// GOLANG_SAMPLES_STORAGE_REPLAY_UPSTREAM to the address of a storage emulator
+// such as fake-gcs-server, and GOLANG_SAMPLES_PROJECT_ID to any name. With GOLANG_SAMPLES_STORAGE_REPLAY=replay the
+// test runs from the recording, without a project or credentials. Requests
+// are matched by method and URL, in order; bodies are not compared, so a
+// test that changes what it uploads must be recorded again.
+type StorageReplay struct {
+	Context
+
+	t        *testing.T
+	mode     string
+	file     string
+	upstream string
+	srv      *httptest.Server
+
+	mu   sync.Mutex
+	rec  storageRecording
+	used []bool
+	name int
+}
+
+type storageRecording struct {
+	ProjectID    string
+	Names        []string
+	Interactions []storageInteraction
+}
+
+type storageInteraction struct {
+	Method string
+	URL    string
+	Status int
+	Header http.Header
+	Body   []byte
+}
+
+// StorageReplayTest gets a StorageReplay for a storage test. file is the
+// recording, e.g. testdata/replay/TestX.json. Without
+// GOLANG_SAMPLES_STORAGE_REPLAY it behaves like StorageEmulatorTest. In
+// replay mode the test is skipped if file does not exist. Call Close when
+// the test is done.
+//
+// The proxy is set with t.Setenv, since STORAGE_EMULATOR_HOST is
+// process-wide, so a replay test cannot call t.Parallel; t.Setenv panics if
+// it does. STORAGE_EMULATOR_HOST is restored when the test finishes.
+func StorageReplayTest(t *testing.T, file string) *StorageReplay {
+	t.Helper()
+	r := &StorageReplay{t: t, mode: os.Getenv(storageReplayEnv), file: file}
+	switch r.mode {
+	case "":
+		r.Context = StorageEmulatorTest(t)
+		return r
+	case "record":
+		r.Context = SystemTest(t)
+		if StorageEmulatorEnabled() {
+			t.Fatalf("%s=record cannot record against STORAGE_EMULATOR_HOST", storageReplayEnv)
+		}
+		client := http.DefaultClient
+		r.upstream = os.Getenv(storageUpstreamEnv)
+		if r.upstream == "" {
+			r.upstream = storageHost
+			c, err := google.DefaultClient(context.Background(), storage.ScopeFullControl)
+			if err != nil {
+				t.Fatalf("google.DefaultClient: %v", err)
+			}
+			client = c
+		}
+		r.rec.ProjectID = r.ProjectID
+		r.srv = httptest.NewServer(r.recordHandler(client))
+	case "replay":
+		data, err := ioutil.ReadFile(file)
+		if os.IsNotExist(err) {
+			t.Skipf("no recording in %s; run with %s=record to create it", file, storageReplayEnv)
+		}
+		if err != nil {
+			t.Fatalf("ReadFile: %v", err)
+		}
+		if err := json.Unmarshal(data, &r.rec); err != nil {
+			t.Fatalf("json.Unmarshal(%s): %v", file, err)
+		}
+		r.Context = emulatorContext(t)
+		r.ProjectID = r.rec.ProjectID
+		r.used = make([]bool, len(r.rec.Interactions))
+		r.srv = httptest.NewServer(http.HandlerFunc(r.replay))
+	default:
+		t.Fatalf("%s=%q, want record or replay", storageReplayEnv, r.mode)
+	}
+	t.Setenv("STORAGE_EMULATOR_HOST", r.srv.URL)
+	return r
+}
+
+// UniqueName is like the package-level UniqueName, but returns the recorded
+// names when replaying, so the requests of the test match the recording.
+func (r *StorageReplay) UniqueName(prefix string) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	switch r.mode {
+	case "record":
+		name := UniqueName(prefix)
+		r.rec.Names = append(r.rec.Names, name)
+		return name
+	case "replay":
+		if r.name >= len(r.rec.Names) {
+			r.t.Fatalf("UniqueName(%q): recording %s has only %d names", prefix, r.file, len(r.rec.Names))
+		}
+		name := r.rec.Names[r.name]
+		r.name++
+		return name
+	default:
+		return UniqueName(prefix)
+	}
+}
+
+// Close stops the proxy and, when recording, writes the recording.
+func (r *StorageReplay) Close() {
+	r.t.Helper()
+	if r.srv == nil {
+		return
+	}
+	r.srv.Close()
+	if r.mode != "record" || r.t.Failed() {
+		return
+	}
+	data, err := json.MarshalIndent(r.rec, "", "  ")
+	if err != nil {
+		r.t.Fatalf("json.MarshalIndent: %v", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(r.file), 0755); err != nil {
+		r.t.Fatalf("MkdirAll: %v", err)
+	}
+	if err := ioutil.WriteFile(r.file, data, 0644); err != nil {
+		r.t.Fatalf("WriteFile: %v", err)
+	}
+	r.t.Logf("Recorded %d requests to %s", len(r.rec.Interactions), r.file)
+}
+
+// recordHandler forwards requests to r.upstream with client and records them
+// with their responses.
+func (r *StorageReplay) recordHandler(client *http.Client) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		body, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		out, err := http.NewRequest(req.Method, r.upstream+req.URL.RequestURI(), bytes.NewReader(body))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		out.Header = copyHeader(req.Header)
+		resp, err := client.Do(out.WithContext(req.Context()))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+		defer resp.Body.Close()
+		respBody, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+
+		header := copyHeader(resp.Header)
+		header.Del("Content-Length")
+		// Resumable uploads continue at the Location they are given.
+		if loc := header.Get("Location"); loc != "" {
+			header.Set("Location", strings.Replace(loc, r.upstream, hostPlaceholder, 1))
+		}
+		r.mu.Lock()
+		r.rec.Interactions = append(r.rec.Interactions, storageInteraction{
+			Method: req.Method,
+			URL:    req.URL.RequestURI(),
+			Status: resp.StatusCode,
+			Header: header,
+			Body:   respBody,
+		})
+		r.mu.Unlock()
+		r.writeResponse(w, resp.StatusCode, header, respBody)
+	}
+}
+
+// replay answers a request with the first unused recorded response to the
+// same method and URL.
+func (r *StorageReplay) replay(w http.ResponseWriter, req *http.Request) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, in := range r.rec.Interactions {
+		if r.used[i] || in.Method != req.Method || in.URL != req.URL.RequestURI() {
+			continue
+		}
+		r.used[i] = true
+		r.writeResponse(w, in.Status, in.Header, in.Body)
+		return
+	}
+	r.t.Errorf("%s %s is not in recording %s", req.Method, req.URL.RequestURI(), r.file)
+	http.Error(w, "request not recorded", http.StatusNotImplemented)
+}
+
+func copyHeader(h http.Header) http.Header {
+	c := make(http.Header, len(h))
+	for k, vs := range h {
+		c[k] = append([]string(nil), vs...)
+	}
+	return c
+}
+
+func (r *StorageReplay) writeResponse(w http.ResponseWriter, status int, header http.Header, body []byte) {
+	for k, vs := range header {
+		for _, v := range vs {
+			w.Header().Add(k, strings.Replace(v, hostPlaceholder, r.srv.URL, 1))
+		}
+	}
+	w.WriteHeader(status)
+	w.Write(body)
+}
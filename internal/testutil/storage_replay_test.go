@@ -0,0 +1,101 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testutil
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestStorageReplay(t *testing.T) {
+	t.Setenv(storageReplayEnv, "replay")
+	t.Setenv("STORAGE_EMULATOR_HOST", "")
+
+	dir, err := ioutil.TempDir("", "replay")
+	if err != nil {
+		t.Fatalf("TempDir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "TestX.json")
+	rec := storageRecording{
+		ProjectID: "recorded-project",
+		Names:     []string{"golang-samples-x-1760536800-0a1b2c"},
+		Interactions: []storageInteraction{
+			{Method: "GET", URL: "/storage/v1/b/x?alt=json", Status: 200, Body: []byte("first")},
+			{Method: "GET", URL: "/storage/v1/b/x?alt=json", Status: 404, Body: []byte("second")},
+			{Method: "POST", URL: "/upload", Status: 200, Header: http.Header{"Location": {hostPlaceholder + "/upload?id=1"}}},
+		},
+	}
+	data, err := json.Marshal(rec)
+	if err != nil {
+		t.Fatalf("json.Marshal: %v", err)
+	}
+	if err := ioutil.WriteFile(file, data, 0644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	t.Run("replay", func(t *testing.T) {
+		testReplay(t, file, rec)
+	})
+	if got := os.Getenv("STORAGE_EMULATOR_HOST"); got != "" {
+		t.Errorf("STORAGE_EMULATOR_HOST is %q after the test, want it restored", got)
+	}
+}
+
+// testReplay replays rec, which is stored in file.
+func testReplay(t *testing.T, file string, rec storageRecording) {
+	r := StorageReplayTest(t, file)
+	host := os.Getenv("STORAGE_EMULATOR_HOST")
+	if host == "" {
+		t.Fatalf("StorageReplayTest did not set STORAGE_EMULATOR_HOST")
+	}
+	if r.ProjectID != rec.ProjectID {
+		t.Errorf("StorageReplayTest got project %q, want %q", r.ProjectID, rec.ProjectID)
+	}
+	if got := r.UniqueName("x"); got != rec.Names[0] {
+		t.Errorf("UniqueName got %q, want recorded %q", got, rec.Names[0])
+	}
+
+	// Identical requests get the recorded responses in order.
+	for _, want := range []struct {
+		status int
+		body   string
+	}{{200, "first"}, {404, "second"}} {
+		resp, err := http.Get(host + "/storage/v1/b/x?alt=json")
+		if err != nil {
+			t.Fatalf("http.Get: %v", err)
+		}
+		body, _ := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if resp.StatusCode != want.status || string(body) != want.body {
+			t.Errorf("GET got %d %q, want %d %q", resp.StatusCode, body, want.status, want.body)
+		}
+	}
+
+	resp, err := http.Post(host+"/upload", "text/plain", nil)
+	if err != nil {
+		t.Fatalf("http.Post: %v", err)
+	}
+	resp.Body.Close()
+	if got, want := resp.Header.Get("Location"), host+"/upload?id=1"; got != want {
+		t.Errorf("POST got Location %q, want %q", got, want)
+	}
+
+	r.Close()
+}
@@ -0,0 +1,31 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command greet is run by the RunMain tests. It greets its arguments, or
+// fails when GREET_FAIL is set.
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strings"
+)
+
+func main() {
+	if os.Getenv("GREET_FAIL") != "" {
+		log.Fatal("asked to fail")
+	}
+	fmt.Printf("%s, %s!\n", os.Getenv("GREETING"), strings.Join(os.Args[1:], " and "))
+}
@@ -31,6 +31,14 @@ var errNoProjectID = errors.New("GOLANG_SAMPLES_PROJECT_ID not set")
 type Context struct {
 	ProjectID string
 	Dir       string
+
+	// SecondProjectID and SecondServiceAccount are set by SecondProjectTest.
+	// SecondProjectID is a project other than ProjectID that the test
+	// credentials can bill, for requester-pays tests. SecondServiceAccount
+	// is the email of a service account in that project, for cross-project
+	// IAM tests.
+	SecondProjectID      string
+	SecondServiceAccount string
 }
 
 func (tc Context) Path(p ...string) string {
@@ -64,6 +72,23 @@ func SystemTest(t *testing.T) Context {
 	return tc
 }
 
+// SecondProjectTest gets the test context of a test that needs a second
+// project. The test is skipped unless GOLANG_SAMPLES_PROJECT_ID,
+// GOLANG_SAMPLES_SECOND_PROJECT_ID and GOLANG_SAMPLES_SECOND_SERVICE_ACCOUNT
+// are set.
+func SecondProjectTest(t *testing.T) Context {
+	tc := SystemTest(t)
+	tc.SecondProjectID = os.Getenv("GOLANG_SAMPLES_SECOND_PROJECT_ID")
+	tc.SecondServiceAccount = os.Getenv("GOLANG_SAMPLES_SECOND_SERVICE_ACCOUNT")
+	if tc.SecondProjectID == "" || tc.SecondServiceAccount == "" {
+		t.Skip("GOLANG_SAMPLES_SECOND_PROJECT_ID and GOLANG_SAMPLES_SECOND_SERVICE_ACCOUNT must be set")
+	}
+	if tc.SecondProjectID == tc.ProjectID {
+		t.Fatalf("GOLANG_SAMPLES_SECOND_PROJECT_ID is %q, the same as GOLANG_SAMPLES_PROJECT_ID", tc.ProjectID)
+	}
+	return tc
+}
+
 // EndToEndTest gets the test context, and sets the test as Parallel.
 // The test is skipped if the GOLANG_SAMPLES_E2E_TEST environment variable is not set.
 func EndToEndTest(t *testing.T) Context {
@@ -89,18 +114,23 @@ func testContext() (Context, error) {
 		return tc, errNoProjectID
 	}
 
+	dir, err := repoDir()
+	if err != nil {
+		return tc, err
+	}
+	tc.Dir = dir
+
+	return tc, nil
+}
+
+// repoDir returns the root of the golang-samples checkout the test runs in.
+func repoDir() (string, error) {
 	dir, err := os.Getwd()
 	if err != nil {
-		return tc, fmt.Errorf("could not find current directory")
+		return "", fmt.Errorf("could not find current directory")
 	}
 	if !strings.Contains(dir, "golang-samples") {
-		return tc, fmt.Errorf("could not find golang-samples directory")
+		return "", fmt.Errorf("could not find golang-samples directory")
 	}
-	tc.Dir = dir[:strings.Index(dir, "golang-samples")+len("golang-samples")]
-
-	if tc.Dir == "" {
-		return tc, fmt.Errorf("could not find golang-samples directory")
-	}
-
-	return tc, nil
+	return dir[:strings.Index(dir, "golang-samples")+len("golang-samples")], nil
 }
@@ -0,0 +1,46 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testutil
+
+import (
+	"os"
+	"testing"
+)
+
+func TestSecondProjectTest(t *testing.T) {
+	for _, env := range []string{"GOLANG_SAMPLES_PROJECT_ID", "GOLANG_SAMPLES_SECOND_PROJECT_ID", "GOLANG_SAMPLES_SECOND_SERVICE_ACCOUNT"} {
+		old, ok := os.LookupEnv(env)
+		if ok {
+			defer os.Setenv(env, old)
+		} else {
+			defer os.Unsetenv(env)
+		}
+	}
+
+	os.Setenv("GOLANG_SAMPLES_PROJECT_ID", "my-project")
+	os.Unsetenv("GOLANG_SAMPLES_SECOND_PROJECT_ID")
+	os.Unsetenv("GOLANG_SAMPLES_SECOND_SERVICE_ACCOUNT")
+	t.Run("unset", func(t *testing.T) {
+		SecondProjectTest(t)
+		t.Errorf("SecondProjectTest did not skip without a second project")
+	})
+
+	os.Setenv("GOLANG_SAMPLES_SECOND_PROJECT_ID", "other-project")
+	os.Setenv("GOLANG_SAMPLES_SECOND_SERVICE_ACCOUNT", "sa@other-project.iam.gserviceaccount.com")
+	tc := SecondProjectTest(t)
+	if tc.ProjectID != "my-project" || tc.SecondProjectID != "other-project" || tc.SecondServiceAccount != "sa@other-project.iam.gserviceaccount.com" {
+		t.Errorf("SecondProjectTest got %+v, want the projects and service account from the environment", tc)
+	}
+}
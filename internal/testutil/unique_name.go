@@ -0,0 +1,67 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testutil
+
+import (
+	"crypto/rand"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ResourcePrefix starts the names returned by UniqueName. Only resources
+// with this prefix are deleted by DeleteStaleResources.
+const ResourcePrefix = "golang-samples-"
+
+// UniqueName returns a name for a bucket, topic or subscription created by a
+// test, such as golang-samples-object-bucket-1760536800-3fa9c2. The name
+// holds its creation time, so DeleteStaleResources can tell how old the
+// resource is, and a random suffix, so parallel runs of a test, even in
+// other projects, do not collide on globally unique bucket names. Names
+// derived from it by appending "-" and a suffix, like
+// UniqueName("topic")+"-retry", are still recognized.
+//
+// Keep prefix short, lowercase and dash-separated: bucket names are at most
+// 63 characters, of which the name adds 33.
+func UniqueName(prefix string) string {
+	b := make([]byte, 3)
+	if _, err := rand.Read(b); err != nil {
+		panic(fmt.Sprintf("rand.Read: %v", err))
+	}
+	return fmt.Sprintf("%s%s-%d-%x", ResourcePrefix, prefix, time.Now().Unix(), b)
+}
+
+// nameTime returns the creation time held by a name returned by UniqueName,
+// or derived from one. ok is false for other names.
+func nameTime(name string) (created time.Time, ok bool) {
+	if !strings.HasPrefix(name, ResourcePrefix) {
+		return time.Time{}, false
+	}
+	// The creation time is the last 10-digit part followed by the random
+	// suffix.
+	parts := strings.Split(strings.TrimPrefix(name, ResourcePrefix), "-")
+	for i := len(parts) - 2; i >= 0; i-- {
+		if len(parts[i]) != 10 || len(parts[i+1]) != 6 {
+			continue
+		}
+		sec, err := strconv.ParseInt(parts[i], 10, 64)
+		if err != nil {
+			continue
+		}
+		return time.Unix(sec, 0), true
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,54 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testutil
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestUniqueName(t *testing.T) {
+	a, b := UniqueName("gc"), UniqueName("gc")
+	if a == b {
+		t.Errorf("UniqueName returned %q twice", a)
+	}
+	if !strings.HasPrefix(a, ResourcePrefix+"gc-") {
+		t.Errorf("UniqueName(%q) got %q, want prefix %q", "gc", a, ResourcePrefix+"gc-")
+	}
+	created, ok := nameTime(a)
+	if !ok || time.Since(created) > time.Minute {
+		t.Errorf("nameTime(UniqueName(%q)) got %v, %v; want about now", "gc", created, ok)
+	}
+}
+
+func TestNameTime(t *testing.T) {
+	created := time.Unix(1760536800, 0)
+	tests := []struct {
+		name   string
+		wantOK bool
+	}{
+		{name: ResourcePrefix + "object-bucket-1760536800-3fa9c2", wantOK: true},
+		{name: ResourcePrefix + "topic-1760536800-3fa9c2-retry", wantOK: true},
+		{name: ResourcePrefix + "object-bucket-1", wantOK: false},
+		{name: "other-prefix-1760536800-3fa9c2", wantOK: false},
+	}
+	for _, tc := range tests {
+		got, ok := nameTime(tc.name)
+		if ok != tc.wantOK || (ok && !got.Equal(created)) {
+			t.Errorf("nameTime(%q) got %v, %v; want %v, %v", tc.name, got, ok, created, tc.wantOK)
+		}
+	}
+}
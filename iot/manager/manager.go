@@ -842,7 +842,7 @@ func listGateways(w io.Writer, projectID string, region string, registryID strin
 	response, err := client.Projects.Locations.Registries.Devices.List(parent).GatewayListOptionsGatewayType("GATEWAY").Do()
 
 	if err != nil {
-		return nil, fmt.Errorf("ListGateways: %v", err)
+		return nil, fmt.Errorf("ListGateways: %w", err)
 	}
 
 	if len(response.Devices) == 0 {
@@ -885,7 +885,7 @@ func bindDeviceToGateway(w io.Writer, projectID string, region string, registryI
 	response, err := client.Projects.Locations.Registries.BindDeviceToGateway(parent, bindRequest).Do()
 
 	if err != nil {
-		return nil, fmt.Errorf("BindDeviceToGateway: %v", err)
+		return nil, fmt.Errorf("BindDeviceToGateway: %w", err)
 	}
 
 	if response.HTTPStatusCode/100 != 2 {
@@ -923,7 +923,7 @@ func unbindDeviceFromGateway(w io.Writer, projectID string, region string, regis
 	response, err := client.Projects.Locations.Registries.UnbindDeviceFromGateway(parent, unbindRequest).Do()
 
 	if err != nil {
-		return nil, fmt.Errorf("UnbindDeviceFromGateway error: %v", err)
+		return nil, fmt.Errorf("UnbindDeviceFromGateway error: %w", err)
 	}
 
 	if response.HTTPStatusCode/100 != 2 {
@@ -957,7 +957,7 @@ func listDevicesForGateway(w io.Writer, projectID string, region string, registr
 	response, err := client.Projects.Locations.Registries.Devices.List(parent).GatewayListOptionsAssociationsGatewayId(gatewayID).Do()
 
 	if err != nil {
-		return nil, fmt.Errorf("ListDevicesForGateway: %v", err)
+		return nil, fmt.Errorf("ListDevicesForGateway: %w", err)
 	}
 
 	if len(response.Devices) == 0 {
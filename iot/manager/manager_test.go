@@ -114,7 +114,7 @@ func shutdown() {
 func TestCreateRegistry(t *testing.T) {
 	testRegistryID := createIDForTest("registry")
 
-	testutil.Retry(t, 10, 10*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 10 * time.Second, Max: 20 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		buf := new(bytes.Buffer)
 		registry, err := createRegistry(buf, projectID, region, testRegistryID, topicName)
 		if err != nil {
@@ -139,7 +139,7 @@ func TestCreateRegistry(t *testing.T) {
 func TestGetRegistry(t *testing.T) {
 	testRegistryID := createIDForTest("registry")
 
-	testutil.Retry(t, 10, 10*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 10 * time.Second, Max: 20 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		buf := new(bytes.Buffer)
 		if _, err := createRegistry(ioutil.Discard, projectID, region, testRegistryID, topicName); err != nil {
 			r.Errorf("Could not create registry: %v\n", err)
@@ -162,7 +162,7 @@ func TestGetRegistry(t *testing.T) {
 func TestListRegistries(t *testing.T) {
 	testRegistryID := createIDForTest("registry")
 
-	testutil.Retry(t, 10, 10*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 10 * time.Second, Max: 20 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		buf := new(bytes.Buffer)
 		if _, err := createRegistry(ioutil.Discard, projectID, region, testRegistryID, topicName); err != nil {
 			r.Errorf("Could not create registry 1: %v\n", err)
@@ -184,7 +184,7 @@ func TestListRegistries(t *testing.T) {
 func TestDeleteRegistry(t *testing.T) {
 	testRegistryID := createIDForTest("registry")
 
-	testutil.Retry(t, 10, 10*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 10 * time.Second, Max: 20 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		buf := new(bytes.Buffer)
 		if _, err := createRegistry(ioutil.Discard, projectID, region, testRegistryID, topicName); err != nil {
 			r.Errorf("Could not create registry: %v\n", err)
@@ -211,7 +211,7 @@ func TestSendCommand(t *testing.T) {
 
 	commandToSend := "test"
 
-	testutil.Retry(t, 10, 10*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 10 * time.Second, Max: 20 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		buf := new(bytes.Buffer)
 		_, err := sendCommand(buf, projectID, region, registryID, deviceID, commandToSend)
 
@@ -235,7 +235,7 @@ func TestCreateGateway(t *testing.T) {
 
 	gatewayID := createIDForTest("gateway")
 
-	testutil.Retry(t, 1, 10*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 10 * time.Second, Max: 20 * time.Second, Multiplier: 2, MaxAttempts: 1}, func(r *testutil.R) {
 		buf := new(bytes.Buffer)
 		if _, err := createGateway(buf, projectID, region, registryID, gatewayID, "ASSOCIATION_ONLY", pubKeyRSA); err != nil {
 			r.Errorf("Could not create gateway: %v\n", err)
@@ -257,7 +257,7 @@ func TestListGateways(t *testing.T) {
 	}
 
 	// list zero gateways for initial registry
-	testutil.Retry(t, 10, 10*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 10 * time.Second, Max: 20 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		buf := new(bytes.Buffer)
 		if _, err := listGateways(buf, projectID, region, registryID); err != nil {
 			r.Errorf("Could not list gateways: %v\v", err)
@@ -272,7 +272,7 @@ func TestListGateways(t *testing.T) {
 
 	// create and list gateway
 	gatewayID := createIDForTest("gateway")
-	testutil.Retry(t, 10, 10*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 10 * time.Second, Max: 20 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		buf := new(bytes.Buffer)
 
 		if _, err := createGateway(ioutil.Discard, projectID, region, registryID, gatewayID, "ASSOCIATION_ONLY", pubKeyRSA); err != nil {
@@ -301,7 +301,7 @@ func TestBindDeviceToGateway(t *testing.T) {
 	gatewayID := createIDForTest("gateway")
 	deviceID := createIDForTest("device")
 
-	testutil.Retry(t, 10, 10*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 10 * time.Second, Max: 20 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		buf := new(bytes.Buffer)
 		if _, err := createGateway(ioutil.Discard, projectID, region, registryID, gatewayID, "ASSOCIATION_ONLY", pubKeyRSA); err != nil {
 			r.Errorf("Could not create gateway: %v\n", err)
@@ -335,7 +335,7 @@ func TestUnbindDeviceFromGateway(t *testing.T) {
 	gatewayID := createIDForTest("gateway")
 	deviceID := createIDForTest("device")
 
-	testutil.Retry(t, 10, 10*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 10 * time.Second, Max: 20 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		buf := new(bytes.Buffer)
 		_, err := createGateway(ioutil.Discard, projectID, region, registryID, gatewayID, "ASSOCIATION_ONLY", pubKeyRSA)
 		if err != nil {
@@ -374,7 +374,7 @@ func TestListDevicesForGateway(t *testing.T) {
 	gatewayID := createIDForTest("gateway")
 	deviceID := createIDForTest("device")
 
-	testutil.Retry(t, 10, 10*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 10 * time.Second, Max: 20 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		buf := new(bytes.Buffer)
 
 		if _, err := createGateway(ioutil.Discard, projectID, region, registryID, gatewayID, "ASSOCIATION_ONLY", pubKeyRSA); err != nil {
@@ -213,7 +213,7 @@ func bindDeviceToGateway(w io.Writer, projectID string, region string, registryI
 	response, err := client.Projects.Locations.Registries.BindDeviceToGateway(parent, bindRequest).Do()
 
 	if err != nil {
-		return nil, fmt.Errorf("BindDeviceToGateway: %v", err)
+		return nil, fmt.Errorf("BindDeviceToGateway: %w", err)
 	}
 
 	if response.HTTPStatusCode/100 != 2 {
@@ -241,7 +241,7 @@ func unbindDeviceFromGateway(w io.Writer, projectID string, region string, regis
 	response, err := client.Projects.Locations.Registries.UnbindDeviceFromGateway(parent, unbindRequest).Do()
 
 	if err != nil {
-		return nil, fmt.Errorf("UnbindDeviceFromGateway error: %v", err)
+		return nil, fmt.Errorf("UnbindDeviceFromGateway error: %w", err)
 	}
 
 	if response.HTTPStatusCode/100 != 2 {
@@ -264,28 +264,28 @@ func TestSendDataFromBoundDevice(t *testing.T) {
 	gatewayID := createID("gateway")
 	deviceID := createID("device")
 
-	testutil.Retry(t, 10, 5*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 5 * time.Second, Max: 10 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		if _, err := createGateway(ioutil.Discard, projectID, region, registryID, gatewayID, "ASSOCIATION_ONLY", pubKeyRSA); err != nil {
 			r.Errorf("Could not create gateway: %v\n", err)
 			return
 		}
 	})
 
-	testutil.Retry(t, 10, 5*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 5 * time.Second, Max: 10 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		if _, err := createDevice(ioutil.Discard, projectID, region, registryID, deviceID, "RSA_X509_PEM", pubKeyRSA); err != nil {
 			r.Errorf("Could not create device: %v\n", err)
 			return
 		}
 	})
 
-	testutil.Retry(t, 10, 5*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 5 * time.Second, Max: 10 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		if _, err := bindDeviceToGateway(ioutil.Discard, projectID, region, registryID, gatewayID, deviceID); err != nil {
 			r.Errorf("Could not bind device to gateway: %v\n", err)
 			return
 		}
 	})
 
-	testutil.Retry(t, 2, 10*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 10 * time.Second, Max: 20 * time.Second, Multiplier: 2, MaxAttempts: 2}, func(r *testutil.R) {
 		buf := new(bytes.Buffer)
 		if err := sendDataFromBoundDevice(buf, projectID, region, registryID, gatewayID, deviceID, privateKeyRSA, "RS256", 2, "test"); err != nil {
 			r.Errorf("Error in sendDataFromBoundDevice: %v\n", err)
@@ -298,19 +298,19 @@ func TestSendDataFromBoundDevice(t *testing.T) {
 	})
 
 	// cleanup
-	testutil.Retry(t, 10, 5*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 5 * time.Second, Max: 10 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		if _, err := unbindDeviceFromGateway(ioutil.Discard, projectID, region, registryID, gatewayID, deviceID); err != nil {
 			r.Errorf("Could not unbind device: %v\n", err)
 		}
 	})
 
-	testutil.Retry(t, 10, 5*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 5 * time.Second, Max: 10 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		if _, err := deleteDevice(ioutil.Discard, projectID, region, registryID, deviceID); err != nil {
 			r.Errorf("Could not unbind device: %v\n", err)
 		}
 	})
 
-	testutil.Retry(t, 10, 5*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 5 * time.Second, Max: 10 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		if _, err := deleteDevice(ioutil.Discard, projectID, region, registryID, gatewayID); err != nil {
 			r.Errorf("Could not unbind device: %v\n", err)
 		}
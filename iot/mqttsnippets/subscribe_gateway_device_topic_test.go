@@ -61,7 +61,7 @@ func TestSubscribeGatewayToDeviceTopic(t *testing.T) {
 	gatewayID := createID("gateway")
 	deviceID := createID("device")
 
-	testutil.Retry(t, 10, 5*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 5 * time.Second, Max: 10 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		if _, err := createGateway(ioutil.Discard, projectID, region, registryID, gatewayID, "ASSOCIATION_ONLY", pubKeyRSA); err != nil {
 			r.Errorf("Could not create gateway: %v\n", err)
 		}
@@ -70,7 +70,7 @@ func TestSubscribeGatewayToDeviceTopic(t *testing.T) {
 		return
 	}
 
-	testutil.Retry(t, 10, 5*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 5 * time.Second, Max: 10 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		if _, err := createDevice(ioutil.Discard, projectID, region, registryID, deviceID, "RSA_X509_PEM", pubKeyRSA); err != nil {
 			r.Errorf("Could not create device: %v\n", err)
 		}
@@ -79,7 +79,7 @@ func TestSubscribeGatewayToDeviceTopic(t *testing.T) {
 		return
 	}
 
-	testutil.Retry(t, 10, 5*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 5 * time.Second, Max: 10 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		if _, err := bindDeviceToGateway(ioutil.Discard, projectID, region, registryID, gatewayID, deviceID); err != nil {
 			r.Errorf("Could not bind device to gateway: %v\n", err)
 		}
@@ -88,7 +88,7 @@ func TestSubscribeGatewayToDeviceTopic(t *testing.T) {
 		return
 	}
 
-	testutil.Retry(t, 10, 5*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 5 * time.Second, Max: 10 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		// sample test config message.
 		message := "{'threshold':'high'}"
 
@@ -107,19 +107,19 @@ func TestSubscribeGatewayToDeviceTopic(t *testing.T) {
 	})
 
 	// cleanup
-	testutil.Retry(t, 10, 5*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 5 * time.Second, Max: 10 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		if _, err := unbindDeviceFromGateway(ioutil.Discard, projectID, region, registryID, gatewayID, deviceID); err != nil {
 			r.Errorf("Could not unbind device: %v\n", err)
 		}
 	})
 
-	testutil.Retry(t, 10, 5*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 5 * time.Second, Max: 10 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		if _, err := deleteDevice(ioutil.Discard, projectID, region, registryID, deviceID); err != nil {
 			r.Errorf("Could not unbind device: %v\n", err)
 		}
 	})
 
-	testutil.Retry(t, 10, 5*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 5 * time.Second, Max: 10 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		if _, err := deleteDevice(ioutil.Discard, projectID, region, registryID, gatewayID); err != nil {
 			r.Errorf("Could not unbind device: %v\n", err)
 		}
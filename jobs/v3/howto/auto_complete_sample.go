@@ -32,12 +32,12 @@ func jobTitleAutoComplete(w io.Writer, projectID, companyName, query string) (*t
 
 	client, err := google.DefaultClient(ctx, talent.CloudPlatformScope)
 	if err != nil {
-		return nil, fmt.Errorf("google.DefaultClient: %v", err)
+		return nil, fmt.Errorf("google.DefaultClient: %w", err)
 	}
 	// Create the jobs service client.
 	service, err := talent.New(client)
 	if err != nil {
-		return nil, fmt.Errorf("talent.New: %v", err)
+		return nil, fmt.Errorf("talent.New: %w", err)
 	}
 
 	parent := "projects/" + projectID
@@ -47,7 +47,7 @@ func jobTitleAutoComplete(w io.Writer, projectID, companyName, query string) (*t
 	}
 	resp, err := complete.Do()
 	if err != nil {
-		return nil, fmt.Errorf("failed to auto complete with query %s in company %s: %v", query, companyName, err)
+		return nil, fmt.Errorf("failed to auto complete with query %s in company %s: %w", query, companyName, err)
 	}
 
 	fmt.Fprintf(w, "Auto complete results:")
@@ -70,12 +70,12 @@ func defaultAutoComplete(w io.Writer, projectID, companyName, query string) (*ta
 
 	client, err := google.DefaultClient(ctx, talent.CloudPlatformScope)
 	if err != nil {
-		return nil, fmt.Errorf("google.DefaultClient: %v", err)
+		return nil, fmt.Errorf("google.DefaultClient: %w", err)
 	}
 	// Create the jobs service client.
 	service, err := talent.New(client)
 	if err != nil {
-		return nil, fmt.Errorf("talent.New: %v", err)
+		return nil, fmt.Errorf("talent.New: %w", err)
 	}
 
 	complete := service.Projects.Complete(parent).Query(query).LanguageCode("en-US").Type("COMBINED").PageSize(10)
@@ -84,7 +84,7 @@ func defaultAutoComplete(w io.Writer, projectID, companyName, query string) (*ta
 	}
 	resp, err := complete.Do()
 	if err != nil {
-		return nil, fmt.Errorf("failed to auto complete with query %s in company %s: %v", query, companyName, err)
+		return nil, fmt.Errorf("failed to auto complete with query %s in company %s: %w", query, companyName, err)
 	}
 
 	fmt.Fprintf(w, "Auto complete results:")
@@ -31,12 +31,12 @@ func commuteSearch(w io.Writer, projectID, companyName string) (*talent.SearchJo
 
 	client, err := google.DefaultClient(ctx, talent.CloudPlatformScope)
 	if err != nil {
-		return nil, fmt.Errorf("google.DefaultClient: %v", err)
+		return nil, fmt.Errorf("google.DefaultClient: %w", err)
 	}
 	// Create the jobs service client.
 	service, err := talent.New(client)
 	if err != nil {
-		return nil, fmt.Errorf("talent.New: %v", err)
+		return nil, fmt.Errorf("talent.New: %w", err)
 	}
 
 	jobQuery := &talent.JobQuery{
@@ -74,7 +74,7 @@ func commuteSearch(w io.Writer, projectID, companyName string) (*talent.SearchJo
 	}
 	resp, err := service.Projects.Jobs.Search(parent, req).Do()
 	if err != nil {
-		return nil, fmt.Errorf("failed to search for jobs with commute filter: %v", err)
+		return nil, fmt.Errorf("failed to search for jobs with commute filter: %w", err)
 	}
 	return resp, nil
 }
@@ -18,7 +18,6 @@ import (
 	"bytes"
 	"strings"
 	"testing"
-	"time"
 
 	"github.com/GoogleCloudPlatform/golang-samples/internal/testutil"
 )
@@ -27,7 +26,7 @@ func TestFilterOnStringValueCustomAttribute(t *testing.T) {
 	t.Skip("Flaky. https://github.com/GoogleCloudPlatform/golang-samples/issues/1061.")
 
 	tc := testutil.SystemTest(t)
-	testutil.Retry(t, 10, 1*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
 		buf := &bytes.Buffer{}
 		if _, err := filterOnStringValueCustomAttribute(buf, tc.ProjectID); err != nil {
 			r.Errorf("filterOnStringValueCustomAttribute: %v", err)
@@ -43,7 +42,7 @@ func TestFilterOnLongValueCustomAttribute(t *testing.T) {
 	t.Skip("Flaky. https://github.com/GoogleCloudPlatform/golang-samples/issues/1061.")
 
 	tc := testutil.SystemTest(t)
-	testutil.Retry(t, 10, 1*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
 		buf := &bytes.Buffer{}
 		if _, err := filterOnLongValueCustomAttribute(buf, tc.ProjectID); err != nil {
 			r.Errorf("filterOnLongValueCustomAttribute: %v", err)
@@ -59,7 +58,7 @@ func TestFilterOnMultiCustomAttributes(t *testing.T) {
 	t.Skip("Flaky. https://github.com/GoogleCloudPlatform/golang-samples/issues/1061.")
 
 	tc := testutil.SystemTest(t)
-	testutil.Retry(t, 10, 1*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
 		buf := &bytes.Buffer{}
 		if _, err := filterOnMultiCustomAttributes(buf, tc.ProjectID); err != nil {
 			r.Errorf("filterOnMultiCustomAttributes: %v", err)
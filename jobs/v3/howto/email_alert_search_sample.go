@@ -32,12 +32,12 @@ func searchForAlerts(w io.Writer, projectID, companyName string) (*talent.Search
 
 	client, err := google.DefaultClient(ctx, talent.CloudPlatformScope)
 	if err != nil {
-		return nil, fmt.Errorf("google.DefaultClient: %v", err)
+		return nil, fmt.Errorf("google.DefaultClient: %w", err)
 	}
 	// Create the jobs service client.
 	service, err := talent.New(client)
 	if err != nil {
-		return nil, fmt.Errorf("talent.New: %v", err)
+		return nil, fmt.Errorf("talent.New: %w", err)
 	}
 
 	parent := "projects/" + projectID
@@ -63,7 +63,7 @@ func searchForAlerts(w io.Writer, projectID, companyName string) (*talent.Search
 
 	resp, err := service.Projects.Jobs.SearchForAlert(parent, req).Do()
 	if err != nil {
-		return nil, fmt.Errorf("failed to search for jobs with alerts: %v", err)
+		return nil, fmt.Errorf("failed to search for jobs with alerts: %w", err)
 	}
 
 	fmt.Fprintln(w, "Jobs:")
@@ -18,7 +18,6 @@ import (
 	"bytes"
 	"strings"
 	"testing"
-	"time"
 
 	"github.com/GoogleCloudPlatform/golang-samples/internal/testutil"
 )
@@ -28,7 +27,7 @@ func TestSearchForAlerts(t *testing.T) {
 
 	tc := testutil.SystemTest(t)
 
-	testutil.Retry(t, 10, 1*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
 		buf := &bytes.Buffer{}
 		if _, err := searchForAlerts(buf, tc.ProjectID, testCompany.Name); err != nil {
 			r.Errorf("searchForAlerts: %v", err)
@@ -18,7 +18,6 @@ import (
 	"bytes"
 	"strings"
 	"testing"
-	"time"
 
 	"github.com/GoogleCloudPlatform/golang-samples/internal/testutil"
 )
@@ -27,7 +26,7 @@ func TestSearchFeaturedJobs(t *testing.T) {
 	t.Skip("Flaky. https://github.com/GoogleCloudPlatform/golang-samples/issues/1061.")
 
 	tc := testutil.SystemTest(t)
-	testutil.Retry(t, 10, 1*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
 		buf := &bytes.Buffer{}
 		if _, err := searchFeaturedJobs(buf, tc.ProjectID, testCompany.Name, "SWE"); err != nil {
 			r.Errorf("searchFeaturedJobs: %v", err)
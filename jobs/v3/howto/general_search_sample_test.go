@@ -18,7 +18,6 @@ import (
 	"bytes"
 	"strings"
 	"testing"
-	"time"
 
 	"github.com/GoogleCloudPlatform/golang-samples/internal/testutil"
 )
@@ -28,7 +27,7 @@ func TestBasicJobSearch(t *testing.T) {
 
 	tc := testutil.SystemTest(t)
 
-	testutil.Retry(t, 10, 1*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
 		buf := &bytes.Buffer{}
 		if _, err := basicJobSearch(buf, tc.ProjectID, testCompany.Name, "SWE"); err != nil {
 			r.Errorf("basicJobSearch: %v", err)
@@ -45,7 +44,7 @@ func TestCategoryFilterSearch(t *testing.T) {
 
 	tc := testutil.SystemTest(t)
 
-	testutil.Retry(t, 10, 1*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
 		buf := &bytes.Buffer{}
 		if _, err := categoryFilterSearch(buf, tc.ProjectID, testCompany.Name, []string{"COMPUTER_AND_IT"}); err != nil {
 			r.Errorf("categoryFilterSearch: %v", err)
@@ -62,7 +61,7 @@ func TestEmploymentTypesSearch(t *testing.T) {
 
 	tc := testutil.SystemTest(t)
 
-	testutil.Retry(t, 10, 1*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
 		buf := &bytes.Buffer{}
 		if _, err := employmentTypesSearch(buf, tc.ProjectID, testCompany.Name, []string{"FULL_TIME"}); err != nil {
 			r.Errorf("employmentTypesSearch: %v", err)
@@ -79,7 +78,7 @@ func TestDateRangeSearch(t *testing.T) {
 
 	tc := testutil.SystemTest(t)
 
-	testutil.Retry(t, 10, 1*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
 		buf := &bytes.Buffer{}
 		if _, err := dateRangeSearch(buf, tc.ProjectID, testCompany.Name, "2000-01-01T00:00:00.01Z", "2099-01-01T00:00:00.01Z"); err != nil {
 			r.Errorf("dateRangeSearch: %v", err)
@@ -96,7 +95,7 @@ func TestLanguageCodeSearch(t *testing.T) {
 
 	tc := testutil.SystemTest(t)
 
-	testutil.Retry(t, 10, 1*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
 		buf := &bytes.Buffer{}
 		if _, err := languageCodeSearch(buf, tc.ProjectID, testCompany.Name, []string{"en-US"}); err != nil {
 			r.Errorf("languageCodeSearch: %v", err)
@@ -113,7 +112,7 @@ func TestCompanyDisplayNameSearch(t *testing.T) {
 
 	tc := testutil.SystemTest(t)
 
-	testutil.Retry(t, 10, 1*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
 		buf := &bytes.Buffer{}
 		if _, err := companyDisplayNameSearch(buf, tc.ProjectID, testCompany.Name, []string{"Google Sample"}); err != nil {
 			r.Errorf("companyDisplayNameSearch: %v", err)
@@ -130,7 +129,7 @@ func TestCompensationSearch(t *testing.T) {
 
 	tc := testutil.SystemTest(t)
 
-	testutil.Retry(t, 10, 1*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
 		buf := &bytes.Buffer{}
 		if _, err := compensationSearch(buf, tc.ProjectID, testCompany.Name); err != nil {
 			r.Errorf("compensationSearch: %v", err)
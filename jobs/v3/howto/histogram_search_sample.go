@@ -31,12 +31,12 @@ func histogramSearch(w io.Writer, projectID, companyName string) (*talent.Search
 
 	client, err := google.DefaultClient(ctx, talent.CloudPlatformScope)
 	if err != nil {
-		return nil, fmt.Errorf("google.DefaultClient: %v", err)
+		return nil, fmt.Errorf("google.DefaultClient: %w", err)
 	}
 	// Create the jobs service client.
 	service, err := talent.New(client)
 	if err != nil {
-		return nil, fmt.Errorf("talent.New: %v", err)
+		return nil, fmt.Errorf("talent.New: %w", err)
 	}
 
 	parent := "projects/" + projectID
@@ -72,7 +72,7 @@ func histogramSearch(w io.Writer, projectID, companyName string) (*talent.Search
 
 	resp, err := service.Projects.Jobs.Search(parent, req).Do()
 	if err != nil {
-		return nil, fmt.Errorf("failed to search for jobs with Historgram Facets: %v", err)
+		return nil, fmt.Errorf("failed to search for jobs with Historgram Facets: %w", err)
 	}
 
 	fmt.Fprintln(w, "Jobs:")
@@ -18,7 +18,6 @@ import (
 	"bytes"
 	"strings"
 	"testing"
-	"time"
 
 	"github.com/GoogleCloudPlatform/golang-samples/internal/testutil"
 )
@@ -28,7 +27,7 @@ func TestBasicLocationSearch(t *testing.T) {
 
 	tc := testutil.SystemTest(t)
 
-	testutil.Retry(t, 10, 1*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
 		buf := &bytes.Buffer{}
 		if _, err := basicLocationSearch(buf, tc.ProjectID, testCompany.Name, "Mountain View, CA", .5); err != nil {
 			r.Errorf("basicLocationSearch: %v", err)
@@ -45,7 +44,7 @@ func TestCityLocationSearch(t *testing.T) {
 
 	tc := testutil.SystemTest(t)
 
-	testutil.Retry(t, 10, 1*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
 		buf := &bytes.Buffer{}
 		if _, err := cityLocationSearch(buf, tc.ProjectID, testCompany.Name, "Mountain View, CA"); err != nil {
 			r.Errorf("cityLocationSearch: %v", err)
@@ -62,7 +61,7 @@ func TestBroadeningLocationSearch(t *testing.T) {
 
 	tc := testutil.SystemTest(t)
 
-	testutil.Retry(t, 10, 1*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
 		buf := &bytes.Buffer{}
 		if _, err := broadeningLocationSearch(buf, tc.ProjectID, testCompany.Name, "Bay Area"); err != nil {
 			r.Errorf("broadeningLocationSearch: %v", err)
@@ -79,7 +78,7 @@ func TestKeywordLocationSearch(t *testing.T) {
 
 	tc := testutil.SystemTest(t)
 
-	testutil.Retry(t, 10, 1*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
 		buf := &bytes.Buffer{}
 		if _, err := keywordLocationSearch(buf, tc.ProjectID, testCompany.Name, "Mountain View, CA", .5, "SWE"); err != nil {
 			r.Errorf("keywordLocationSearch: %v", err)
@@ -96,7 +95,7 @@ func TestMultiLocationsSearch(t *testing.T) {
 
 	tc := testutil.SystemTest(t)
 
-	testutil.Retry(t, 10, 1*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
 		buf := &bytes.Buffer{}
 		if _, err := multiLocationsSearch(buf, tc.ProjectID, testCompany.Name, "New York, NY", "Sunnyvale, CA", .5); err != nil {
 			r.Errorf("multiLocationsSearch: %v", err)
@@ -33,7 +33,7 @@ func commuteSearch(w io.Writer, projectID, companyID string) error {
 	// Initialize a jobService client.
 	c, err := talent.NewJobClient(ctx)
 	if err != nil {
-		return fmt.Errorf("talent.NewJobClient: %v", err)
+		return fmt.Errorf("talent.NewJobClient: %w", err)
 	}
 
 	// Construct a jobQuery request with a commute filter.
@@ -70,7 +70,7 @@ func commuteSearch(w io.Writer, projectID, companyID string) error {
 
 	resp, err := c.SearchJobs(ctx, req)
 	if err != nil {
-		return fmt.Errorf("SearchJobs: %v", err)
+		return fmt.Errorf("SearchJobs: %w", err)
 	}
 
 	for _, job := range resp.GetMatchingJobs() {
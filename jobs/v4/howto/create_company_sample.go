@@ -31,7 +31,7 @@ func createCompany(w io.Writer, projectID, externalID, displayName string) (*tal
 	// Initializes a companyService client.
 	c, err := talent.NewCompanyClient(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("talent.NewCompanyClient: %v", err)
+		return nil, fmt.Errorf("talent.NewCompanyClient: %w", err)
 	}
 
 	// Construct a createCompany request.
@@ -45,7 +45,7 @@ func createCompany(w io.Writer, projectID, externalID, displayName string) (*tal
 
 	resp, err := c.CreateCompany(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("CreateCompany: %v", err)
+		return nil, fmt.Errorf("CreateCompany: %w", err)
 	}
 
 	fmt.Fprintf(w, "Created company: %q\n", resp.GetName())
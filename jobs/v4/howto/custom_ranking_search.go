@@ -31,7 +31,7 @@ func customRankingSearch(w io.Writer, projectID, companyID string) error {
 	// Initialize a jobService client.
 	c, err := talent.NewJobClient(ctx)
 	if err != nil {
-		return fmt.Errorf("taleng.NewJobClient: %v", err)
+		return fmt.Errorf("taleng.NewJobClient: %w", err)
 	}
 
 	// Construct a searchJobs request.
@@ -61,7 +61,7 @@ func customRankingSearch(w io.Writer, projectID, companyID string) error {
 
 	resp, err := c.SearchJobs(ctx, req)
 	if err != nil {
-		return fmt.Errorf("SearchJobs: %v", err)
+		return fmt.Errorf("SearchJobs: %w", err)
 	}
 
 	for _, job := range resp.GetMatchingJobs() {
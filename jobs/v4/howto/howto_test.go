@@ -128,7 +128,7 @@ func TestBasicUsage(t *testing.T) {
 	}
 
 	// Commute search is problematic, wrapped in a retry to reduce flakiness.
-	testutil.Retry(t, 20, 2*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 2 * time.Second, Max: 4 * time.Second, Multiplier: 2, MaxAttempts: 20}, func(r *testutil.R) {
 		buf.Reset()
 		if err := commuteSearch(buf, tc.ProjectID, companyID); err != nil {
 			r.Errorf("commuteSearch: %v", err)
@@ -141,7 +141,7 @@ func TestBasicUsage(t *testing.T) {
 	})
 
 	// Histogram search.
-	testutil.Retry(t, 10, 1*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
 		buf.Reset()
 		if err := histogramSearch(buf, tc.ProjectID, companyID); err != nil {
 			r.Errorf("histogramSearch: %v", err)
@@ -163,7 +163,7 @@ func TestBasicUsage(t *testing.T) {
 }
 
 func TestBatchDeleteJobs(t *testing.T) {
-	testutil.Retry(t, 10, 1*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
 		tc := testutil.SystemTest(t)
 
 		requisitionID := fmt.Sprintf("job-%s", uuid.Must(uuid.NewV4()).String())
@@ -225,7 +225,7 @@ func TestCreateJobWithCustomAttributes(t *testing.T) {
 		t.Fatalf("createJobWithCustomAttributes got %q, want to contain %q", got, want)
 	}
 
-	testutil.Retry(t, 20, 2*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 2 * time.Second, Max: 4 * time.Second, Multiplier: 2, MaxAttempts: 20}, func(r *testutil.R) {
 		// Custom ranking search.
 		buf.Reset()
 		if err := customRankingSearch(buf, tc.ProjectID, companyID); err != nil {
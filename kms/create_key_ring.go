@@ -33,7 +33,7 @@ func createKeyRing(w io.Writer, parent, id string) error {
 	ctx := context.Background()
 	client, err := kms.NewKeyManagementClient(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to create kms client: %v", err)
+		return fmt.Errorf("failed to create kms client: %w", err)
 	}
 
 	// Build the request.
@@ -45,7 +45,7 @@ func createKeyRing(w io.Writer, parent, id string) error {
 	// Call the API.
 	result, err := client.CreateKeyRing(ctx, req)
 	if err != nil {
-		return fmt.Errorf("failed to create key ring: %v", err)
+		return fmt.Errorf("failed to create key ring: %w", err)
 	}
 	fmt.Fprintf(w, "Created key ring: %s\n", result.Name)
 	return nil
@@ -32,7 +32,7 @@ func createKeyVersion(w io.Writer, parent string) error {
 	ctx := context.Background()
 	client, err := kms.NewKeyManagementClient(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to create kms client: %v", err)
+		return fmt.Errorf("failed to create kms client: %w", err)
 	}
 
 	// Build the request.
@@ -43,7 +43,7 @@ func createKeyVersion(w io.Writer, parent string) error {
 	// Call the API.
 	result, err := client.CreateCryptoKeyVersion(ctx, req)
 	if err != nil {
-		return fmt.Errorf("failed to create key version: %v", err)
+		return fmt.Errorf("failed to create key version: %w", err)
 	}
 	fmt.Fprintf(w, "Created key version: %s\n", result.Name)
 	return nil
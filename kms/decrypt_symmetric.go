@@ -35,7 +35,7 @@ func decryptSymmetric(w io.Writer, name string, ciphertext []byte) error {
 	ctx := context.Background()
 	client, err := kms.NewKeyManagementClient(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to create kms client: %v", err)
+		return fmt.Errorf("failed to create kms client: %w", err)
 	}
 
 	// Optional, but recommended: Compute ciphertext's CRC32C.
@@ -55,7 +55,7 @@ func decryptSymmetric(w io.Writer, name string, ciphertext []byte) error {
 	// Call the API.
 	result, err := client.Decrypt(ctx, req)
 	if err != nil {
-		return fmt.Errorf("failed to decrypt ciphertext: %v", err)
+		return fmt.Errorf("failed to decrypt ciphertext: %w", err)
 	}
 
 	// Optional, but recommended: perform integrity verification on result.
@@ -17,6 +17,7 @@ package kms
 // [START kms_encrypt_symmetric]
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"hash/crc32"
 	"io"
@@ -36,7 +37,7 @@ func encryptSymmetric(w io.Writer, name string, message string) error {
 	ctx := context.Background()
 	client, err := kms.NewKeyManagementClient(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to create kms client: %v", err)
+		return fmt.Errorf("failed to create kms client: %w", err)
 	}
 
 	// Convert the message into bytes. Cryptographic plaintexts and
@@ -60,7 +61,7 @@ func encryptSymmetric(w io.Writer, name string, message string) error {
 	// Call the API.
 	result, err := client.Encrypt(ctx, req)
 	if err != nil {
-		return fmt.Errorf("failed to encrypt: %v", err)
+		return fmt.Errorf("failed to encrypt: %w", err)
 	}
 
 	// Optional, but recommended: perform integrity verification on result.
@@ -73,7 +74,8 @@ func encryptSymmetric(w io.Writer, name string, message string) error {
 		return fmt.Errorf("Encrypt: response corrupted in-transit")
 	}
 
-	fmt.Fprintf(w, "Encrypted ciphertext: %s", result.Ciphertext)
+	// Ciphertexts are binary; encode them to print or store them as text.
+	fmt.Fprintf(w, "Encrypted ciphertext: %s\n", base64.StdEncoding.EncodeToString(result.Ciphertext))
 	return nil
 }
 
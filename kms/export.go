@@ -0,0 +1,46 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kms
+
+import "io"
+
+// The functions below export the samples that cmd/samplectl is built from,
+// so that the tool runs the same code as the documentation.
+
+// CreateKeyRing runs the kms_create_key_ring sample.
+func CreateKeyRing(w io.Writer, parent, id string) error {
+	return createKeyRing(w, parent, id)
+}
+
+// CreateKeySymmetricEncryptDecrypt runs the
+// kms_create_key_symmetric_encrypt_decrypt sample.
+func CreateKeySymmetricEncryptDecrypt(w io.Writer, parent, id string) error {
+	return createKeySymmetricEncryptDecrypt(w, parent, id)
+}
+
+// DecryptSymmetric runs the kms_decrypt_symmetric sample.
+func DecryptSymmetric(w io.Writer, name string, ciphertext []byte) error {
+	return decryptSymmetric(w, name, ciphertext)
+}
+
+// EncryptSymmetric runs the kms_encrypt_symmetric sample.
+func EncryptSymmetric(w io.Writer, name, message string) error {
+	return encryptSymmetric(w, name, message)
+}
+
+// RotateKey runs the kms_rotate_key sample.
+func RotateKey(w io.Writer, name string) error {
+	return rotateKey(w, name)
+}
@@ -425,6 +425,7 @@ func TestIAMGetPolicy(t *testing.T) {
 	}
 	policy.Add("group:test@google.com", "roles/cloudkms.cryptoKeyEncrypterDecrypter")
 
+	testutil.IAMLimiter.Take(t)
 	if err := handle.SetPolicy(ctx, policy); err != nil {
 		t.Fatal(err)
 	}
@@ -454,6 +455,21 @@ func TestIAMRemoveMember(t *testing.T) {
 	}
 }
 
+func TestRotateKey(t *testing.T) {
+	testutil.SystemTest(t)
+
+	name := fixture.SymmetricKeyName
+
+	var b bytes.Buffer
+	if err := rotateKey(&b, name); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := b.String(), "primary version is now "+name+"/cryptoKeyVersions/"; !strings.Contains(got, want) {
+		t.Errorf("rotateKey: expected %q to contain %q", got, want)
+	}
+}
+
 func TestSignAsymmetric(t *testing.T) {
 	testutil.SystemTest(t)
 
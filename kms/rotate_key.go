@@ -0,0 +1,65 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kms
+
+// [START kms_rotate_key]
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+
+	kms "cloud.google.com/go/kms/apiv1"
+	kmspb "google.golang.org/genproto/googleapis/cloud/kms/v1"
+)
+
+// rotateKey rotates a symmetric Cloud KMS key by hand: it creates a new key
+// version and makes it the primary version. New data is encrypted with the
+// new version; data encrypted with older versions can still be decrypted
+// while those versions are enabled.
+func rotateKey(w io.Writer, name string) error {
+	// name := "projects/my-project/locations/us-east1/keyRings/my-key-ring/cryptoKeys/my-key"
+
+	// Create the client.
+	ctx := context.Background()
+	client, err := kms.NewKeyManagementClient(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create kms client: %w", err)
+	}
+	defer client.Close()
+
+	// Create the new key version. Software-protected symmetric versions
+	// are enabled as soon as they are created.
+	version, err := client.CreateCryptoKeyVersion(ctx, &kmspb.CreateCryptoKeyVersionRequest{
+		Parent: name,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create key version: %w", err)
+	}
+
+	// Make it the primary version. The request takes the version ID, the
+	// last element of its name.
+	key, err := client.UpdateCryptoKeyPrimaryVersion(ctx, &kmspb.UpdateCryptoKeyPrimaryVersionRequest{
+		Name:               name,
+		CryptoKeyVersionId: path.Base(version.Name),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update primary version: %w", err)
+	}
+	fmt.Fprintf(w, "Rotated key %s, primary version is now %s\n", key.Name, key.Primary.Name)
+	return nil
+}
+
+// [END kms_rotate_key]
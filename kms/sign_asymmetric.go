@@ -37,7 +37,7 @@ func signAsymmetric(w io.Writer, name string, message string) error {
 	ctx := context.Background()
 	client, err := kms.NewKeyManagementClient(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to create kms client: %v", err)
+		return fmt.Errorf("failed to create kms client: %w", err)
 	}
 
 	// Convert the message into bytes. Cryptographic plaintexts and
@@ -47,7 +47,7 @@ func signAsymmetric(w io.Writer, name string, message string) error {
 	// Calculate the digest of the message.
 	digest := sha256.New()
 	if _, err := digest.Write(plaintext); err != nil {
-		return fmt.Errorf("failed to create digest: %v", err)
+		return fmt.Errorf("failed to create digest: %w", err)
 	}
 
 	// Optional but recommended: Compute digest's CRC32C.
@@ -75,7 +75,7 @@ func signAsymmetric(w io.Writer, name string, message string) error {
 	// Call the API.
 	result, err := client.AsymmetricSign(ctx, req)
 	if err != nil {
-		return fmt.Errorf("failed to sign digest: %v", err)
+		return fmt.Errorf("failed to sign digest: %w", err)
 	}
 
 	// Optional, but recommended: perform integrity verification on result.
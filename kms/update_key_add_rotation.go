@@ -37,7 +37,7 @@ func addRotationSchedule(w io.Writer, name string) error {
 	ctx := context.Background()
 	client, err := kms.NewKeyManagementClient(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to create kms client: %v", err)
+		return fmt.Errorf("failed to create kms client: %w", err)
 	}
 
 	// Build the request.
@@ -66,7 +66,7 @@ func addRotationSchedule(w io.Writer, name string) error {
 	// Call the API.
 	result, err := client.UpdateCryptoKey(ctx, req)
 	if err != nil {
-		return fmt.Errorf("failed to update key: %v", err)
+		return fmt.Errorf("failed to update key: %w", err)
 	}
 	fmt.Fprintf(w, "Updated key: %s\n", result.Name)
 	return nil
@@ -33,7 +33,7 @@ func updateKeyUpdateLabels(w io.Writer, name string) error {
 	ctx := context.Background()
 	client, err := kms.NewKeyManagementClient(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to create kms client: %v", err)
+		return fmt.Errorf("failed to create kms client: %w", err)
 	}
 
 	//
@@ -48,7 +48,7 @@ func updateKeyUpdateLabels(w io.Writer, name string) error {
 	// Call the API.
 	result, err := client.GetCryptoKey(ctx, getReq)
 	if err != nil {
-		return fmt.Errorf("failed to get key: %v", err)
+		return fmt.Errorf("failed to get key: %w", err)
 	}
 
 	//
@@ -72,7 +72,7 @@ func updateKeyUpdateLabels(w io.Writer, name string) error {
 	// Call the API.
 	result, err = client.UpdateCryptoKey(ctx, updateReq)
 	if err != nil {
-		return fmt.Errorf("failed to update key: %v", err)
+		return fmt.Errorf("failed to update key: %w", err)
 	}
 
 	// Print the labels.
@@ -40,13 +40,13 @@ func verifyAsymmetricSignatureRSA(w io.Writer, name string, message, signature [
 	ctx := context.Background()
 	client, err := kms.NewKeyManagementClient(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to create kms client: %v", err)
+		return fmt.Errorf("failed to create kms client: %w", err)
 	}
 
 	// Retrieve the public key from KMS.
 	response, err := client.GetPublicKey(ctx, &kmspb.GetPublicKeyRequest{Name: name})
 	if err != nil {
-		return fmt.Errorf("failed to get public key: %v", err)
+		return fmt.Errorf("failed to get public key: %w", err)
 	}
 
 	// Parse the public key. Note, this example assumes the public key is in the
@@ -54,7 +54,7 @@ func verifyAsymmetricSignatureRSA(w io.Writer, name string, message, signature [
 	block, _ := pem.Decode([]byte(response.Pem))
 	publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
 	if err != nil {
-		return fmt.Errorf("failed to parse public key: %v", err)
+		return fmt.Errorf("failed to parse public key: %w", err)
 	}
 	rsaKey, ok := publicKey.(*rsa.PublicKey)
 	if !ok {
@@ -67,7 +67,7 @@ func verifyAsymmetricSignatureRSA(w io.Writer, name string, message, signature [
 		SaltLength: len(digest),
 		Hash:       crypto.SHA256,
 	}); err != nil {
-		return fmt.Errorf("failed to verify signature: %v", err)
+		return fmt.Errorf("failed to verify signature: %w", err)
 	}
 
 	fmt.Fprint(w, "Verified signature!\n")
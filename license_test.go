@@ -49,6 +49,7 @@ var skip = map[string]bool{
 	"profiler/shakesapp/shakesapp/shakesapp.pb.go":           true,
 	"run/grpc-ping/pkg/api/v1/message.pb.go":                 true,
 	"run/grpc-server-streaming/pkg/api/v1/timeservice.pb.go": true,
+	"pubsub/schemas/statepb/us-states.pb.go":                 true,
 }
 
 func TestLicense(t *testing.T) {
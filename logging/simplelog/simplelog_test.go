@@ -46,7 +46,7 @@ func TestSimplelog(t *testing.T) {
 	}()
 
 	defer func() {
-		testutil.Retry(t, 10, 5*time.Second, func(r *testutil.R) {
+		testutil.RetryBackoff(t, testutil.Backoff{Initial: 5 * time.Second, Max: 10 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 			if err := deleteLog(adminClient); err != nil {
 				r.Errorf("deleteLog: %v", err)
 			}
@@ -60,7 +60,7 @@ func TestSimplelog(t *testing.T) {
 	writeEntry(client)
 	structuredWrite(client)
 
-	testutil.Retry(t, 20, 10*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 10 * time.Second, Max: 20 * time.Second, Multiplier: 2, MaxAttempts: 20}, func(r *testutil.R) {
 		entries, err := getEntries(adminClient, tc.ProjectID)
 		if err != nil {
 			r.Errorf("getEntries: %v", err)
@@ -20,8 +20,8 @@ import (
 	"fmt"
 	"io"
 
-	transcoder "cloud.google.com/go/video/transcoder/apiv1beta1"
-	transcoderpb "google.golang.org/genproto/googleapis/cloud/video/transcoder/v1beta1"
+	transcoder "cloud.google.com/go/video/transcoder/apiv1"
+	"cloud.google.com/go/video/transcoder/apiv1/transcoderpb"
 )
 
 // createJobTemplate creates a template for a job. See
@@ -34,7 +34,7 @@ func createJobTemplate(w io.Writer, projectID string, location string, templateI
 	ctx := context.Background()
 	client, err := transcoder.NewClient(ctx)
 	if err != nil {
-		return fmt.Errorf("NewClient: %v", err)
+		return fmt.Errorf("NewClient: %w", err)
 	}
 	defer client.Close()
 
@@ -48,11 +48,14 @@ func createJobTemplate(w io.Writer, projectID string, location string, templateI
 						Key: "video_stream0",
 						ElementaryStream: &transcoderpb.ElementaryStream_VideoStream{
 							VideoStream: &transcoderpb.VideoStream{
-								Codec:        "h264",
-								BitrateBps:   550000,
-								FrameRate:    60,
-								HeightPixels: 360,
-								WidthPixels:  640,
+								CodecSettings: &transcoderpb.VideoStream_H264{
+									H264: &transcoderpb.VideoStream_H264CodecSettings{
+										BitrateBps:   550000,
+										FrameRate:    60,
+										HeightPixels: 360,
+										WidthPixels:  640,
+									},
+								},
 							},
 						},
 					},
@@ -60,11 +63,14 @@ func createJobTemplate(w io.Writer, projectID string, location string, templateI
 						Key: "video_stream1",
 						ElementaryStream: &transcoderpb.ElementaryStream_VideoStream{
 							VideoStream: &transcoderpb.VideoStream{
-								Codec:        "h264",
-								BitrateBps:   2500000,
-								FrameRate:    60,
-								HeightPixels: 720,
-								WidthPixels:  1280,
+								CodecSettings: &transcoderpb.VideoStream_H264{
+									H264: &transcoderpb.VideoStream_H264CodecSettings{
+										BitrateBps:   2500000,
+										FrameRate:    60,
+										HeightPixels: 720,
+										WidthPixels:  1280,
+									},
+								},
 							},
 						},
 					},
@@ -96,7 +102,7 @@ func createJobTemplate(w io.Writer, projectID string, location string, templateI
 
 	response, err := client.CreateJobTemplate(ctx, req)
 	if err != nil {
-		return fmt.Errorf("CreateJobTemplate: %v", err)
+		return fmt.Errorf("CreateJobTemplate: %w", err)
 	}
 
 	fmt.Fprintf(w, "Job template: %v", response.GetName())
@@ -20,8 +20,8 @@ import (
 	"fmt"
 	"io"
 
-	transcoder "cloud.google.com/go/video/transcoder/apiv1beta1"
-	transcoderpb "google.golang.org/genproto/googleapis/cloud/video/transcoder/v1beta1"
+	transcoder "cloud.google.com/go/video/transcoder/apiv1"
+	"cloud.google.com/go/video/transcoder/apiv1/transcoderpb"
 )
 
 // deleteJobTemplate deletes a previously-created template for a job. See
@@ -34,7 +34,7 @@ func deleteJobTemplate(w io.Writer, projectID string, location string, templateI
 	ctx := context.Background()
 	client, err := transcoder.NewClient(ctx)
 	if err != nil {
-		return fmt.Errorf("NewClient: %v", err)
+		return fmt.Errorf("NewClient: %w", err)
 	}
 	defer client.Close()
 
@@ -44,7 +44,7 @@ func deleteJobTemplate(w io.Writer, projectID string, location string, templateI
 
 	err = client.DeleteJobTemplate(ctx, req)
 	if err != nil {
-		return fmt.Errorf("DeleteJobTemplate: %v", err)
+		return fmt.Errorf("DeleteJobTemplate: %w", err)
 	}
 
 	fmt.Fprintf(w, "Deleted job template")
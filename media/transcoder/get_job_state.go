@@ -20,8 +20,8 @@ import (
 	"fmt"
 	"io"
 
-	transcoder "cloud.google.com/go/video/transcoder/apiv1beta1"
-	transcoderpb "google.golang.org/genproto/googleapis/cloud/video/transcoder/v1beta1"
+	transcoder "cloud.google.com/go/video/transcoder/apiv1"
+	"cloud.google.com/go/video/transcoder/apiv1/transcoderpb"
 )
 
 // getJobState gets the state for a previously-created job. See
@@ -34,7 +34,7 @@ func getJobState(w io.Writer, projectID string, location string, jobID string) e
 	ctx := context.Background()
 	client, err := transcoder.NewClient(ctx)
 	if err != nil {
-		return fmt.Errorf("NewClient: %v", err)
+		return fmt.Errorf("NewClient: %w", err)
 	}
 	defer client.Close()
 
@@ -44,9 +44,9 @@ func getJobState(w io.Writer, projectID string, location string, jobID string) e
 
 	response, err := client.GetJob(ctx, req)
 	if err != nil {
-		return fmt.Errorf("GetJob: %v", err)
+		return fmt.Errorf("GetJob: %w", err)
 	}
-	fmt.Fprintf(w, "Job state: %v\n----\nJob failure reason:%v\n----\nJob failure details:%v\n", response.State, response.FailureReason, response.FailureDetails)
+	fmt.Fprintf(w, "Job state: %v\n----\nJob error:%v\n", response.State, response.Error)
 	return nil
 }
 
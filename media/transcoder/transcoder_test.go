@@ -93,7 +93,7 @@ func testJobTemplates(t *testing.T, projectNumber string) {
 
 	// Remove the default template if it exists
 	if err := getJobTemplate(buf, tc.ProjectID, location, templateID); err == nil {
-		testutil.Retry(t, 10, time.Second, func(r *testutil.R) {
+		testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
 			if err := deleteJobTemplate(buf, tc.ProjectID, location, templateID); err != nil {
 				r.Errorf("deleteJobTemplate got err: %v", err)
 			}
@@ -101,7 +101,7 @@ func testJobTemplates(t *testing.T, projectNumber string) {
 	}
 
 	// Create a new job template.
-	testutil.Retry(t, 3, 2*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 2 * time.Second, Max: 4 * time.Second, Multiplier: 2, MaxAttempts: 3}, func(r *testutil.R) {
 		templateName := fmt.Sprintf("projects/%s/locations/%s/jobTemplates/%s", projectNumber, location, templateID)
 		if err := createJobTemplate(buf, tc.ProjectID, location, templateID); err != nil {
 			r.Errorf("createJobTemplate got err: %v", err)
@@ -112,7 +112,7 @@ func testJobTemplates(t *testing.T, projectNumber string) {
 	})
 
 	// Get the new job template.
-	testutil.Retry(t, 3, 2*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 2 * time.Second, Max: 4 * time.Second, Multiplier: 2, MaxAttempts: 3}, func(r *testutil.R) {
 		templateName := fmt.Sprintf("projects/%s/locations/%s/jobTemplates/%s", projectNumber, location, templateID)
 		if err := getJobTemplate(buf, tc.ProjectID, location, templateID); err != nil {
 			r.Errorf("getJobTemplate got err: %v", err)
@@ -123,7 +123,7 @@ func testJobTemplates(t *testing.T, projectNumber string) {
 	})
 
 	// List the job templates for a given location.
-	testutil.Retry(t, 3, 2*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 2 * time.Second, Max: 4 * time.Second, Multiplier: 2, MaxAttempts: 3}, func(r *testutil.R) {
 		templateName := fmt.Sprintf("projects/%s/locations/%s/jobTemplates/%s", projectNumber, location, templateID)
 		if err := listJobTemplates(buf, tc.ProjectID, location); err != nil {
 			r.Errorf("listJobTemplates got err: %v", err)
@@ -134,7 +134,7 @@ func testJobTemplates(t *testing.T, projectNumber string) {
 	})
 
 	// Delete the job template.
-	testutil.Retry(t, 3, 2*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 2 * time.Second, Max: 4 * time.Second, Multiplier: 2, MaxAttempts: 3}, func(r *testutil.R) {
 		if err := deleteJobTemplate(buf, tc.ProjectID, location, templateID); err != nil {
 			r.Errorf("deleteJobTemplate got err: %v", err)
 		}
@@ -197,7 +197,7 @@ func testJobFromPreset(t *testing.T, projectNumber string, inputURI string, outp
 	jobID = strSlice[len(strSlice)-1]
 
 	// Get the job by job ID.
-	testutil.Retry(t, 3, 5*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 5 * time.Second, Max: 10 * time.Second, Multiplier: 2, MaxAttempts: 3}, func(r *testutil.R) {
 		jobName := fmt.Sprintf("projects/%s/locations/%s/jobs/%s", projectNumber, location, jobID)
 		if err := getJob(buf, tc.ProjectID, location, jobID); err != nil {
 			r.Errorf("getJob got err: %v", err)
@@ -208,7 +208,7 @@ func testJobFromPreset(t *testing.T, projectNumber string, inputURI string, outp
 	})
 
 	// Get the job state (should be succeeded).
-	testutil.Retry(t, 3, 30*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 30 * time.Second, Max: 60 * time.Second, Multiplier: 2, MaxAttempts: 3}, func(r *testutil.R) {
 		if err := getJobState(buf, tc.ProjectID, location, jobID); err != nil {
 			r.Errorf("getJobState got err: %v", err)
 		}
@@ -218,7 +218,7 @@ func testJobFromPreset(t *testing.T, projectNumber string, inputURI string, outp
 	})
 
 	// List the jobs for a given location.
-	testutil.Retry(t, 3, 5*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 5 * time.Second, Max: 10 * time.Second, Multiplier: 2, MaxAttempts: 3}, func(r *testutil.R) {
 		jobName := fmt.Sprintf("projects/%s/locations/%s/jobs/%s", projectNumber, location, jobID)
 		if err := listJobs(buf, tc.ProjectID, location); err != nil {
 			r.Errorf("listJobs got err: %v", err)
@@ -229,7 +229,7 @@ func testJobFromPreset(t *testing.T, projectNumber string, inputURI string, outp
 	})
 
 	// Delete the job.
-	testutil.Retry(t, 3, 5*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 5 * time.Second, Max: 10 * time.Second, Multiplier: 2, MaxAttempts: 3}, func(r *testutil.R) {
 		if err := deleteJob(buf, tc.ProjectID, location, jobID); err != nil {
 			r.Errorf("deleteJob got err: %v", err)
 		}
@@ -247,7 +247,7 @@ func testJobFromTemplate(t *testing.T, projectNumber string, inputURI string, ou
 	jobID := ""
 
 	// Create a job template.
-	testutil.Retry(t, 3, 2*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 2 * time.Second, Max: 4 * time.Second, Multiplier: 2, MaxAttempts: 3}, func(r *testutil.R) {
 		templateName := fmt.Sprintf("projects/%s/locations/%s/jobTemplates/%s", projectNumber, location, templateID)
 		if err := createJobTemplate(buf, tc.ProjectID, location, templateID); err != nil {
 			r.Errorf("createJobTemplate got err: %v", err)
@@ -270,7 +270,7 @@ func testJobFromTemplate(t *testing.T, projectNumber string, inputURI string, ou
 	jobID = strSlice[len(strSlice)-1]
 
 	// Get the job state (should be succeeded).
-	testutil.Retry(t, 3, 30*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 30 * time.Second, Max: 60 * time.Second, Multiplier: 2, MaxAttempts: 3}, func(r *testutil.R) {
 		if err := getJobState(buf, tc.ProjectID, location, jobID); err != nil {
 			r.Errorf("getJobState got err: %v", err)
 		}
@@ -280,7 +280,7 @@ func testJobFromTemplate(t *testing.T, projectNumber string, inputURI string, ou
 	})
 
 	// Delete the job.
-	testutil.Retry(t, 3, 5*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 5 * time.Second, Max: 10 * time.Second, Multiplier: 2, MaxAttempts: 3}, func(r *testutil.R) {
 		if err := deleteJob(buf, tc.ProjectID, location, jobID); err != nil {
 			r.Errorf("deleteJob got err: %v", err)
 		}
@@ -290,7 +290,7 @@ func testJobFromTemplate(t *testing.T, projectNumber string, inputURI string, ou
 	})
 
 	// Delete the job template
-	testutil.Retry(t, 3, 2*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 2 * time.Second, Max: 4 * time.Second, Multiplier: 2, MaxAttempts: 3}, func(r *testutil.R) {
 		if err := deleteJobTemplate(buf, tc.ProjectID, location, templateID); err != nil {
 			r.Errorf("deleteJobTemplate got err: %v", err)
 		}
@@ -321,7 +321,7 @@ func testJobFromAdHoc(t *testing.T, projectNumber string, inputURI string, outpu
 	jobID = strSlice[len(strSlice)-1]
 
 	// Get the job.
-	testutil.Retry(t, 3, 5*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 5 * time.Second, Max: 10 * time.Second, Multiplier: 2, MaxAttempts: 3}, func(r *testutil.R) {
 		jobName := fmt.Sprintf("projects/%s/locations/%s/jobs/%s", projectNumber, location, jobID)
 		if err := getJob(buf, tc.ProjectID, location, jobID); err != nil {
 			r.Errorf("getJob got err: %v", err)
@@ -332,7 +332,7 @@ func testJobFromAdHoc(t *testing.T, projectNumber string, inputURI string, outpu
 	})
 
 	// Get the job state (should be succeeded).
-	testutil.Retry(t, 3, 30*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 30 * time.Second, Max: 60 * time.Second, Multiplier: 2, MaxAttempts: 3}, func(r *testutil.R) {
 		if err := getJobState(buf, tc.ProjectID, location, jobID); err != nil {
 			r.Errorf("getJobState got err: %v", err)
 		}
@@ -342,7 +342,7 @@ func testJobFromAdHoc(t *testing.T, projectNumber string, inputURI string, outpu
 	})
 
 	// Delete the job.
-	testutil.Retry(t, 3, 5*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 5 * time.Second, Max: 10 * time.Second, Multiplier: 2, MaxAttempts: 3}, func(r *testutil.R) {
 		if err := deleteJob(buf, tc.ProjectID, location, jobID); err != nil {
 			r.Errorf("deleteJob got err: %v", err)
 		}
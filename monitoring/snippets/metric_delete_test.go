@@ -33,7 +33,7 @@ func TestDeleteMetric(t *testing.T) {
 		t.Fatalf("createCustomMetric: %v", err)
 	}
 
-	testutil.Retry(t, 20, 10*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 10 * time.Second, Max: 20 * time.Second, Multiplier: 2, MaxAttempts: 20}, func(r *testutil.R) {
 		buf := &bytes.Buffer{}
 		if err := deleteMetric(buf, m.GetName()); err != nil {
 			r.Errorf("deleteMetric: %v", err)
@@ -33,14 +33,14 @@ func getMetricDescriptor(w io.Writer, projectID, metricType string) error {
 	ctx := context.Background()
 	c, err := monitoring.NewMetricClient(ctx)
 	if err != nil {
-		return fmt.Errorf("NewMetricClient: %v", err)
+		return fmt.Errorf("NewMetricClient: %w", err)
 	}
 	req := &monitoringpb.GetMetricDescriptorRequest{
 		Name: fmt.Sprintf("projects/%s/metricDescriptors/%s", projectID, metricType),
 	}
 	resp, err := c.GetMetricDescriptor(ctx, req)
 	if err != nil {
-		return fmt.Errorf("could not get custom metric: %v", err)
+		return fmt.Errorf("could not get custom metric: %w", err)
 	}
 
 	fmt.Fprintf(w, "Name: %v\n", resp.GetName())
@@ -34,7 +34,7 @@ func TestGetMetricDescriptor(t *testing.T) {
 	}
 	defer deleteMetric(ioutil.Discard, m.GetName())
 
-	testutil.Retry(t, 20, 10*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 10 * time.Second, Max: 20 * time.Second, Multiplier: 2, MaxAttempts: 20}, func(r *testutil.R) {
 		buf := &bytes.Buffer{}
 		if err := getMetricDescriptor(buf, tc.ProjectID, metricType); err != nil {
 			r.Errorf("getMetricDescriptor: %v", err)
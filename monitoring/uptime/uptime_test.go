@@ -26,7 +26,7 @@ import (
 
 func TestCreateGet(t *testing.T) {
 	c := testutil.SystemTest(t)
-	testutil.Retry(t, 5, 5*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 5 * time.Second, Max: 10 * time.Second, Multiplier: 2, MaxAttempts: 5}, func(r *testutil.R) {
 		buf := new(bytes.Buffer)
 		config, err := createGet(buf, c.ProjectID)
 		if err != nil {
@@ -43,7 +43,7 @@ func TestCreateGet(t *testing.T) {
 
 func TestCreatePost(t *testing.T) {
 	c := testutil.SystemTest(t)
-	testutil.Retry(t, 5, 5*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 5 * time.Second, Max: 10 * time.Second, Multiplier: 2, MaxAttempts: 5}, func(r *testutil.R) {
 		buf := new(bytes.Buffer)
 		config, err := createPost(buf, c.ProjectID)
 		if err != nil {
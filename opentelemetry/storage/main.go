@@ -0,0 +1,210 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// The storage command uploads and downloads a Cloud Storage object with
+// OpenTelemetry instrumentation and exports the traces to Google Cloud Trace.
+//
+// Every HTTP request the storage client makes gets a span from otelhttp, and
+// each chunk the command reads or writes gets its own span and a latency
+// measurement, so slow transfers can be broken down chunk by chunk:
+//
+//	GOOGLE_CLOUD_PROJECT=my-project go run . -bucket my-bucket -file big.bin
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"time"
+
+	"cloud.google.com/go/storage"
+	texporter "github.com/GoogleCloudPlatform/opentelemetry-operations-go/exporter/trace"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/api/global"
+	"go.opentelemetry.io/otel/api/metric"
+	"go.opentelemetry.io/otel/label"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/unit"
+	"google.golang.org/api/option"
+	htransport "google.golang.org/api/transport/http"
+)
+
+const instrumentationName = "github.com/GoogleCloudPlatform/golang-samples/opentelemetry/storage"
+
+func main() {
+	bucket := flag.String("bucket", "", "bucket to upload to and download from")
+	object := flag.String("object", "otel-sample-object", "name of the object to write")
+	file := flag.String("file", "", "local file to upload")
+	chunkSize := flag.Int("chunk_size", 8<<20, "size in bytes of each chunk read or written")
+	flag.Parse()
+	if *bucket == "" || *file == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	ctx := context.Background()
+	projectID := os.Getenv("GOOGLE_CLOUD_PROJECT")
+	exporter, err := texporter.NewExporter(texporter.WithProjectID(projectID))
+	if err != nil {
+		log.Fatalf("texporter.NewExporter: %v", err)
+	}
+	defer exporter.Shutdown(ctx) // flushes any pending spans
+
+	// Sampling every trace is fine for a one-off command. A long running
+	// service should use a ProbabilitySampler instead.
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	global.SetTracerProvider(tp)
+
+	client, err := newTracedClient(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer client.Close()
+
+	f, err := os.Open(*file)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	if err := uploadObject(ctx, os.Stdout, client, *bucket, *object, f, *chunkSize); err != nil {
+		log.Fatal(err)
+	}
+	if err := downloadObject(ctx, os.Stdout, client, *bucket, *object, ioutil.Discard, *chunkSize); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// [START storage_opentelemetry_client]
+
+// newTracedClient returns a storage client whose HTTP requests are traced.
+// The otelhttp transport sits below the authenticating transport so that each
+// span covers exactly one request to Cloud Storage.
+func newTracedClient(ctx context.Context) (*storage.Client, error) {
+	base := otelhttp.NewTransport(http.DefaultTransport)
+	trans, err := htransport.NewTransport(ctx, base, option.WithScopes(storage.ScopeReadWrite))
+	if err != nil {
+		return nil, fmt.Errorf("htransport.NewTransport: %v", err)
+	}
+	client, err := storage.NewClient(ctx, option.WithHTTPClient(&http.Client{Transport: trans}))
+	if err != nil {
+		return nil, fmt.Errorf("storage.NewClient: %v", err)
+	}
+	return client, nil
+}
+
+// [END storage_opentelemetry_client]
+
+// [START storage_opentelemetry_upload]
+
+// uploadObject writes r to the object chunk by chunk. Each chunk gets its own
+// span under a parent span for the whole upload.
+func uploadObject(ctx context.Context, w io.Writer, client *storage.Client, bucket, object string, r io.Reader, chunkSize int) error {
+	tracer := global.Tracer(instrumentationName)
+	latency := metric.Must(global.Meter(instrumentationName)).NewInt64ValueRecorder(
+		"storage/chunk_latency", metric.WithUnit(unit.Milliseconds))
+
+	ctx, span := tracer.Start(ctx, "uploadObject")
+	defer span.End()
+	span.SetAttributes(label.String("bucket", bucket), label.String("object", object))
+
+	wc := client.Bucket(bucket).Object(object).NewWriter(ctx)
+	// Matching the writer's chunk size to ours means every chunk span
+	// includes the request that sends it.
+	wc.ChunkSize = chunkSize
+
+	buf := make([]byte, chunkSize)
+	var total int64
+	for i := 0; ; i++ {
+		n, err := io.ReadFull(r, buf)
+		if n > 0 {
+			start := time.Now()
+			_, chunkSpan := tracer.Start(ctx, "uploadChunk")
+			chunkSpan.SetAttributes(label.Int("chunk", i), label.Int("bytes", n))
+			_, werr := wc.Write(buf[:n])
+			chunkSpan.End()
+			latency.Record(ctx, time.Since(start).Milliseconds(), label.String("op", "upload"))
+			if werr != nil {
+				wc.Close()
+				return fmt.Errorf("Writer.Write: %v", werr)
+			}
+			total += int64(n)
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+		if err != nil {
+			wc.Close()
+			return fmt.Errorf("Read: %v", err)
+		}
+	}
+	if err := wc.Close(); err != nil {
+		return fmt.Errorf("Writer.Close: %v", err)
+	}
+	fmt.Fprintf(w, "Uploaded %d bytes to gs://%s/%s\n", total, bucket, object)
+	return nil
+}
+
+// [END storage_opentelemetry_upload]
+
+// [START storage_opentelemetry_download]
+
+// downloadObject copies the object to dst chunk by chunk. Each chunk is read
+// with a ranged reader, so each chunk span covers its own request.
+func downloadObject(ctx context.Context, w io.Writer, client *storage.Client, bucket, object string, dst io.Writer, chunkSize int) error {
+	tracer := global.Tracer(instrumentationName)
+	latency := metric.Must(global.Meter(instrumentationName)).NewInt64ValueRecorder(
+		"storage/chunk_latency", metric.WithUnit(unit.Milliseconds))
+
+	ctx, span := tracer.Start(ctx, "downloadObject")
+	defer span.End()
+	span.SetAttributes(label.String("bucket", bucket), label.String("object", object))
+
+	o := client.Bucket(bucket).Object(object)
+	attrs, err := o.Attrs(ctx)
+	if err != nil {
+		return fmt.Errorf("Object(%q).Attrs: %v", object, err)
+	}
+
+	for i, offset := 0, int64(0); offset < attrs.Size; i, offset = i+1, offset+int64(chunkSize) {
+		start := time.Now()
+		chunkCtx, chunkSpan := tracer.Start(ctx, "downloadChunk")
+		chunkSpan.SetAttributes(label.Int("chunk", i), label.Int64("offset", offset))
+		err := func() error {
+			rc, err := o.Generation(attrs.Generation).NewRangeReader(chunkCtx, offset, int64(chunkSize))
+			if err != nil {
+				return fmt.Errorf("Object(%q).NewRangeReader: %v", object, err)
+			}
+			defer rc.Close()
+			if _, err := io.Copy(dst, rc); err != nil {
+				return fmt.Errorf("io.Copy: %v", err)
+			}
+			return nil
+		}()
+		chunkSpan.End()
+		latency.Record(ctx, time.Since(start).Milliseconds(), label.String("op", "download"))
+		if err != nil {
+			return err
+		}
+	}
+	fmt.Fprintf(w, "Downloaded %d bytes from gs://%s/%s\n", attrs.Size, bucket, object)
+	return nil
+}
+
+// [END storage_opentelemetry_download]
@@ -0,0 +1,206 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+)
+
+// writeFunc writes an archive object.
+type writeFunc func(ctx context.Context, name, contentType string, data []byte) error
+
+// acker settles a message once its batch has been written or has failed.
+// *pubsub.Message implements it.
+type acker interface {
+	Ack()
+	Nack()
+}
+
+// record is the archived form of a message.
+type record struct {
+	ID          string            `json:"id"`
+	PublishTime time.Time         `json:"publish_time"`
+	Attributes  map[string]string `json:"attributes,omitempty"`
+	Data        []byte            `json:"data"`
+}
+
+// batch is a set of messages that are archived together.
+type batch struct {
+	start   time.Time
+	records []record
+	ackers  []acker
+	size    int
+}
+
+// archiver collects messages into batches and writes each batch once it
+// is full or too old.
+type archiver struct {
+	write       writeFunc
+	prefix      string
+	enc         encoder
+	maxMessages int
+	maxBytes    int
+	maxAge      time.Duration
+	now         func() time.Time
+
+	mu     sync.Mutex
+	cur    *batch
+	closed bool
+}
+
+// add adds msg to the current batch, and writes the batch if that filled it.
+func (a *archiver) add(ctx context.Context, msg *pubsub.Message) {
+	a.addRecord(ctx, record{
+		ID:          msg.ID,
+		PublishTime: msg.PublishTime,
+		Attributes:  msg.Attributes,
+		Data:        msg.Data,
+	}, msg)
+}
+
+func (a *archiver) addRecord(ctx context.Context, r record, ack acker) {
+	a.mu.Lock()
+	if a.closed {
+		// The last batch has been written; let Pub/Sub redeliver the
+		// message to another instance.
+		a.mu.Unlock()
+		ack.Nack()
+		return
+	}
+	if a.cur == nil {
+		a.cur = &batch{start: a.now()}
+	}
+	b := a.cur
+	b.records = append(b.records, r)
+	b.ackers = append(b.ackers, ack)
+	b.size += len(r.Data)
+	full := len(b.records) >= a.maxMessages || b.size >= a.maxBytes
+	if full {
+		a.cur = nil
+	}
+	a.mu.Unlock()
+
+	if full {
+		a.flush(ctx, b)
+	}
+}
+
+// take removes and returns the current batch, if any.
+func (a *archiver) take() *batch {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	b := a.cur
+	a.cur = nil
+	return b
+}
+
+// takeExpired removes and returns the current batch if it is older than
+// maxAge.
+func (a *archiver) takeExpired() *batch {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.cur == nil || a.now().Sub(a.cur.start) < a.maxAge {
+		return nil
+	}
+	b := a.cur
+	a.cur = nil
+	return b
+}
+
+// flushLoop writes batches that reach maxAge without filling up, until ctx
+// is done.
+func (a *archiver) flushLoop(ctx context.Context) {
+	ticker := time.NewTicker(a.maxAge / 4)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if b := a.takeExpired(); b != nil {
+				a.flush(ctx, b)
+			}
+		}
+	}
+}
+
+// drain runs flushLoop until ctx is done, then writes the open batch and
+// calls stopReceive. Receive only returns once its callbacks have, and the
+// acks of a batch written after that are dropped, so the last batch must be
+// written while Receive is still running. Messages that arrive in the
+// meantime are nacked.
+func (a *archiver) drain(ctx context.Context, stopReceive func()) {
+	a.flushLoop(ctx)
+
+	a.mu.Lock()
+	a.closed = true
+	b := a.cur
+	a.cur = nil
+	a.mu.Unlock()
+
+	// ctx is done, so use a fresh one for the last write.
+	flushCtx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	a.flush(flushCtx, b)
+	stopReceive()
+}
+
+// flush writes b and then acks its messages. If the write fails, the
+// messages are nacked so that Pub/Sub redelivers them.
+func (a *archiver) flush(ctx context.Context, b *batch) {
+	if b == nil || len(b.records) == 0 {
+		return
+	}
+	name := a.objectName(b.start, a.now(), b.records[0].ID)
+	err := a.writeBatch(ctx, name, b)
+	for _, ack := range b.ackers {
+		if err != nil {
+			ack.Nack()
+		} else {
+			ack.Ack()
+		}
+	}
+	if err != nil {
+		log.Printf("Archiving %d messages to %v failed: %v", len(b.records), name, err)
+		return
+	}
+	log.Printf("Archived %d messages to %v", len(b.records), name)
+}
+
+func (a *archiver) writeBatch(ctx context.Context, name string, b *batch) error {
+	data, err := a.enc.encode(b.records)
+	if err != nil {
+		return fmt.Errorf("encode: %w", err)
+	}
+	return a.write(ctx, name, a.enc.contentType, data)
+}
+
+// objectName names the archive of a batch open from start to end. Names sort
+// by time and are grouped in hourly folders; the ID of the first message
+// keeps names from colliding when several instances archive the same
+// subscription.
+func (a *archiver) objectName(start, end time.Time, firstID string) string {
+	const stamp = "20060102T150405Z"
+	start, end = start.UTC(), end.UTC()
+	return path.Join(a.prefix, start.Format("2006/01/02/15"),
+		fmt.Sprintf("%s-%s-%s%s", start.Format(stamp), end.Format(stamp), firstID, a.enc.ext))
+}
@@ -0,0 +1,211 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/linkedin/goavro/v2"
+)
+
+type fakeAcker struct {
+	acked, nacked bool
+}
+
+func (f *fakeAcker) Ack()  { f.acked = true }
+func (f *fakeAcker) Nack() { f.nacked = true }
+
+type fakeBucket struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	err     error
+}
+
+func (f *fakeBucket) write(_ context.Context, name, _ string, data []byte) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.err != nil {
+		return f.err
+	}
+	f.objects[name] = data
+	return nil
+}
+
+func newTestArchiver(bucket *fakeBucket, now *time.Time) *archiver {
+	return &archiver{
+		write:       bucket.write,
+		prefix:      "archive",
+		enc:         encoders["json"],
+		maxMessages: 3,
+		maxBytes:    100,
+		maxAge:      time.Minute,
+		now:         func() time.Time { return *now },
+	}
+}
+
+func TestObjectName(t *testing.T) {
+	a := &archiver{prefix: "archive", enc: encoders["avro"]}
+	start := time.Date(2026, 10, 15, 14, 5, 0, 0, time.UTC)
+	got := a.objectName(start, start.Add(time.Minute), "123")
+	want := "archive/2026/10/15/14/20261015T140500Z-20261015T140600Z-123.avro"
+	if got != want {
+		t.Errorf("objectName got %q, want %q", got, want)
+	}
+}
+
+func TestBatching(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 15, 14, 5, 0, 0, time.UTC)
+
+	t.Run("count", func(t *testing.T) {
+		bucket := &fakeBucket{objects: map[string][]byte{}}
+		a := newTestArchiver(bucket, &now)
+		var ackers []*fakeAcker
+		for _, id := range []string{"1", "2", "3", "4"} {
+			ack := &fakeAcker{}
+			ackers = append(ackers, ack)
+			a.addRecord(ctx, record{ID: id, Data: []byte("x")}, ack)
+		}
+		if len(bucket.objects) != 1 {
+			t.Fatalf("got %d objects after 4 messages with maxMessages 3, want 1", len(bucket.objects))
+		}
+		for i, ack := range ackers {
+			if want := i < 3; ack.acked != want {
+				t.Errorf("message %d acked = %v, want %v", i, ack.acked, want)
+			}
+		}
+	})
+
+	t.Run("size", func(t *testing.T) {
+		bucket := &fakeBucket{objects: map[string][]byte{}}
+		a := newTestArchiver(bucket, &now)
+		a.addRecord(ctx, record{ID: "1", Data: make([]byte, 100)}, &fakeAcker{})
+		if len(bucket.objects) != 1 {
+			t.Errorf("got %d objects after a message of maxBytes, want 1", len(bucket.objects))
+		}
+	})
+
+	t.Run("age", func(t *testing.T) {
+		bucket := &fakeBucket{objects: map[string][]byte{}}
+		start := now
+		a := newTestArchiver(bucket, &start)
+		a.addRecord(ctx, record{ID: "1", Data: []byte("x")}, &fakeAcker{})
+		if b := a.takeExpired(); b != nil {
+			t.Errorf("takeExpired returned a batch younger than maxAge")
+		}
+		start = start.Add(time.Minute)
+		b := a.takeExpired()
+		if b == nil {
+			t.Fatalf("takeExpired returned nil for a batch of maxAge")
+		}
+		a.flush(ctx, b)
+		want := "archive/2026/10/15/14/20261015T140500Z-20261015T140600Z-1.json"
+		if _, ok := bucket.objects[want]; !ok {
+			t.Errorf("got objects %v, want %q", bucket.objects, want)
+		}
+	})
+
+	t.Run("write error", func(t *testing.T) {
+		bucket := &fakeBucket{objects: map[string][]byte{}, err: errors.New("unavailable")}
+		a := newTestArchiver(bucket, &now)
+		ack := &fakeAcker{}
+		a.addRecord(ctx, record{ID: "1", Data: []byte("x")}, ack)
+		a.flush(ctx, a.take())
+		if ack.acked || !ack.nacked {
+			t.Errorf("after a failed write got acked = %v, nacked = %v; want a nack", ack.acked, ack.nacked)
+		}
+	})
+}
+
+func TestEncode(t *testing.T) {
+	records := []record{
+		{ID: "1", PublishTime: time.Date(2026, 10, 15, 14, 5, 0, 0, time.UTC), Attributes: map[string]string{"k": "v"}, Data: []byte("hello")},
+		{ID: "2", PublishTime: time.Date(2026, 10, 15, 14, 5, 1, 0, time.UTC), Data: []byte("world")},
+	}
+
+	data, err := encodeJSON(records)
+	if err != nil {
+		t.Fatalf("encodeJSON: %v", err)
+	}
+	var got []record
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	for sc.Scan() {
+		var r record
+		if err := json.Unmarshal(sc.Bytes(), &r); err != nil {
+			t.Fatalf("json.Unmarshal(%q): %v", sc.Text(), err)
+		}
+		got = append(got, r)
+	}
+	if diff := cmp.Diff(records, got); diff != "" {
+		t.Errorf("encodeJSON round trip mismatch (-want +got):\n%s", diff)
+	}
+
+	data, err = encodeAvro(records)
+	if err != nil {
+		t.Fatalf("encodeAvro: %v", err)
+	}
+	r, err := goavro.NewOCFReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("goavro.NewOCFReader: %v", err)
+	}
+	var ids []string
+	for r.Scan() {
+		v, err := r.Read()
+		if err != nil {
+			t.Fatalf("Read: %v", err)
+		}
+		ids = append(ids, v.(map[string]interface{})["id"].(string))
+	}
+	if got, want := strings.Join(ids, ","), "1,2"; got != want {
+		t.Errorf("encodeAvro got records %q, want %q", got, want)
+	}
+}
+
+func TestDrain(t *testing.T) {
+	now := time.Date(2026, 10, 15, 14, 5, 0, 0, time.UTC)
+	bucket := &fakeBucket{objects: map[string][]byte{}}
+	a := newTestArchiver(bucket, &now)
+	open := &fakeAcker{}
+	a.addRecord(context.Background(), record{ID: "1", Data: []byte("x")}, open)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var writtenBeforeStop, ackedBeforeStop bool
+	a.drain(ctx, func() {
+		writtenBeforeStop = len(bucket.objects) == 1
+		ackedBeforeStop = open.acked
+	})
+	if !writtenBeforeStop || !ackedBeforeStop {
+		t.Errorf("before stopping Receive got written = %v, acked = %v; want the open batch written and acked", writtenBeforeStop, ackedBeforeStop)
+	}
+
+	late := &fakeAcker{}
+	a.addRecord(context.Background(), record{ID: "2", Data: []byte("x")}, late)
+	if !late.nacked {
+		t.Errorf("message added after drain was not nacked")
+	}
+	if b := a.take(); b != nil {
+		t.Errorf("message added after drain was batched: %v", b.records)
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/linkedin/goavro/v2"
+)
+
+// encoder turns a batch of records into the contents of an archive object.
+type encoder struct {
+	ext         string
+	contentType string
+	encode      func(records []record) ([]byte, error)
+}
+
+var encoders = map[string]encoder{
+	"json": {".json", "application/x-ndjson", encodeJSON},
+	"avro": {".avro", "application/avro", encodeAvro},
+}
+
+// encodeJSON writes one JSON object per line. Message data is base64
+// encoded, as encoding/json does for []byte.
+func encodeJSON(records []record) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, r := range records {
+		if err := enc.Encode(r); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// recordSchema is the Avro schema of archived messages.
+const recordSchema = `{
+	"type": "record",
+	"name": "PubsubMessage",
+	"fields": [
+		{"name": "id", "type": "string"},
+		{"name": "publish_time", "type": {"type": "long", "logicalType": "timestamp-micros"}},
+		{"name": "attributes", "type": {"type": "map", "values": "string"}},
+		{"name": "data", "type": "bytes"}
+	]
+}`
+
+// encodeAvro writes an Avro object container file, which embeds the schema
+// so readers such as BigQuery can load it directly.
+func encodeAvro(records []record) ([]byte, error) {
+	var buf bytes.Buffer
+	w, err := goavro.NewOCFWriter(goavro.OCFConfig{
+		W:      &buf,
+		Schema: recordSchema,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("goavro.NewOCFWriter: %w", err)
+	}
+	natives := make([]interface{}, len(records))
+	for i, r := range records {
+		attrs := make(map[string]interface{}, len(r.Attributes))
+		for k, v := range r.Attributes {
+			attrs[k] = v
+		}
+		natives[i] = map[string]interface{}{
+			"id":           r.ID,
+			"publish_time": r.PublishTime,
+			"attributes":   attrs,
+			"data":         r.Data,
+		}
+	}
+	if err := w.Append(natives); err != nil {
+		return nil, fmt.Errorf("Append: %w", err)
+	}
+	return buf.Bytes(), nil
+}
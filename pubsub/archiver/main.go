@@ -0,0 +1,150 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command archiver is a sample service that archives the messages of a
+// Pub/Sub subscription to Cloud Storage.
+//
+// It batches messages by count, size and age, and writes each batch as one
+// object of newline-delimited JSON or an Avro container file, named after
+// the time window the batch covers:
+//
+//	PREFIX/2026/10/15/14/20261015T140500Z-20261015T140600Z-MESSAGEID.json
+//
+// Messages are acked only once the object holding them has been written, so
+// nothing is lost if the service stops; a message may be archived twice if
+// the service stops right after writing an object.
+//
+// Cloud Storage subscriptions archive messages without running any code.
+// Use a service like this one when you need a format, naming scheme or
+// filtering those subscriptions do not offer.
+//
+// Usage:
+//
+//	archiver -project my-project -subscription my-sub -bucket my-bucket [-format json|avro]
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"cloud.google.com/go/storage"
+	"github.com/GoogleCloudPlatform/golang-samples/internal/endpoint"
+)
+
+func main() {
+	var (
+		projectID   = flag.String("project", os.Getenv("GOOGLE_CLOUD_PROJECT"), "Project of the subscription.")
+		subID       = flag.String("subscription", "", "Subscription to archive.")
+		bucket      = flag.String("bucket", "", "Bucket to write archives to.")
+		prefix      = flag.String("prefix", "archive", "Prefix of archive object names.")
+		format      = flag.String("format", "json", `Archive format: "json" or "avro".`)
+		maxMessages = flag.Int("max-messages", 1000, "Maximum number of messages per archive.")
+		maxBytes    = flag.Int("max-bytes", 10*1024*1024, "Maximum message data bytes per archive.")
+		maxAge      = flag.Duration("max-age", time.Minute, "Maximum time a batch stays open.")
+	)
+	flag.Parse()
+	if *projectID == "" || *subID == "" || *bucket == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	enc, ok := encoders[*format]
+	if !ok {
+		log.Fatalf("unknown format %q", *format)
+	}
+
+	// Stop receiving on SIGTERM, which Cloud Run and GKE send before
+	// shutting an instance down, and write the open batch.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-sig
+		cancel()
+	}()
+
+	if err := run(ctx, *projectID, *subID, *bucket, *prefix, enc, *maxMessages, *maxBytes, *maxAge); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(ctx context.Context, projectID, subID, bucket, prefix string, enc encoder, maxMessages, maxBytes int, maxAge time.Duration) error {
+	psClient, err := pubsub.NewClient(ctx, projectID, endpoint.PubSub()...)
+	if err != nil {
+		return fmt.Errorf("pubsub.NewClient: %w", err)
+	}
+	defer psClient.Close()
+	gcsClient, err := storage.NewClient(ctx, endpoint.Storage()...)
+	if err != nil {
+		return fmt.Errorf("storage.NewClient: %w", err)
+	}
+	defer gcsClient.Close()
+
+	a := &archiver{
+		write:       gcsWriter(gcsClient.Bucket(bucket)),
+		prefix:      prefix,
+		enc:         enc,
+		maxMessages: maxMessages,
+		maxBytes:    maxBytes,
+		maxAge:      maxAge,
+		now:         time.Now,
+	}
+
+	sub := psClient.Subscription(subID)
+	// Messages wait in a batch for up to maxAge before they are acked, so
+	// the client must hold at least a full batch and keep extending the
+	// leases of its messages for that long.
+	sub.ReceiveSettings.MaxOutstandingMessages = 2 * maxMessages
+	sub.ReceiveSettings.MaxOutstandingBytes = 2 * maxBytes
+	sub.ReceiveSettings.MaxExtension = maxAge + 10*time.Minute
+
+	// Receive keeps running after ctx is done until drain has written the
+	// open batch, so that the acks of that batch still reach Pub/Sub.
+	recvCtx, stopReceive := context.WithCancel(context.Background())
+	defer stopReceive()
+	go a.drain(ctx, stopReceive)
+	log.Printf("Archiving %v to gs://%v/%v", sub, bucket, prefix)
+	err = sub.Receive(recvCtx, func(ctx context.Context, msg *pubsub.Message) {
+		a.add(ctx, msg)
+	})
+	if err != nil {
+		return fmt.Errorf("Receive: %w", err)
+	}
+	return nil
+}
+
+// gcsWriter returns a writeFunc that writes objects to bucket. Objects are
+// only created if they do not exist yet, so a retried write never
+// overwrites an archive.
+func gcsWriter(bucket *storage.BucketHandle) writeFunc {
+	return func(ctx context.Context, name, contentType string, data []byte) error {
+		w := bucket.Object(name).If(storage.Conditions{DoesNotExist: true}).NewWriter(ctx)
+		w.ContentType = contentType
+		if _, err := w.Write(data); err != nil {
+			w.Close()
+			return fmt.Errorf("Writer.Write(%q): %w", name, err)
+		}
+		if err := w.Close(); err != nil {
+			return fmt.Errorf("Writer.Close(%q): %w", name, err)
+		}
+		return nil
+	}
+}
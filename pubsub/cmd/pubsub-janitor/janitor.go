@@ -0,0 +1,248 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	monitoring "cloud.google.com/go/monitoring/apiv3/v2"
+	"cloud.google.com/go/monitoring/apiv3/v2/monitoringpb"
+	"cloud.google.com/go/pubsub"
+	"google.golang.org/api/iterator"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Reasons a resource is reported.
+const (
+	reasonDetached     = "detached"
+	reasonTopicDeleted = "topic deleted"
+	reasonNoSubs       = "no subscriptions"
+	reasonIdle         = "idle"
+)
+
+// deletedTopic is the topic name of a subscription whose topic was deleted.
+// It is not a full topic name, so Topic.ID panics on it.
+const deletedTopic = "_deleted-topic_"
+
+// kind is a type of Pub/Sub resource. Subscriptions sort before topics, the
+// order they are deleted in.
+type kind int
+
+const (
+	kindSubscription kind = iota
+	kindTopic
+)
+
+func (k kind) String() string {
+	if k == kindTopic {
+		return "topic"
+	}
+	return "subscription"
+}
+
+// finding is a resource that looks unused, and why.
+type finding struct {
+	Kind    kind
+	ID      string
+	Reasons []string
+}
+
+// activity holds the IDs of the topics that were published to, and of the
+// subscriptions that were sent messages, in a recent window.
+type activity struct {
+	Topics        map[string]bool
+	Subscriptions map[string]bool
+}
+
+// readActivity reads from Cloud Monitoring which topics and subscriptions of
+// projectID were active since the given time.
+func readActivity(ctx context.Context, mc *monitoring.MetricClient, projectID string, since time.Time) (*activity, error) {
+	topics, err := activeIDs(ctx, mc, projectID, since, "pubsub.googleapis.com/topic/send_message_operation_count", "topic_id")
+	if err != nil {
+		return nil, err
+	}
+	subs, err := activeIDs(ctx, mc, projectID, since, "pubsub.googleapis.com/subscription/sent_message_count", "subscription_id")
+	if err != nil {
+		return nil, err
+	}
+	return &activity{Topics: topics, Subscriptions: subs}, nil
+}
+
+// activeIDs returns the values of the resource label of every time series of
+// metricType with data since the given time. Pub/Sub only writes these
+// metrics when there is traffic, so a resource without a time series was
+// idle.
+func activeIDs(ctx context.Context, mc *monitoring.MetricClient, projectID string, since time.Time, metricType, label string) (map[string]bool, error) {
+	req := &monitoringpb.ListTimeSeriesRequest{
+		Name:   "projects/" + projectID,
+		Filter: fmt.Sprintf("metric.type = %q", metricType),
+		Interval: &monitoringpb.TimeInterval{
+			StartTime: timestamppb.New(since),
+			EndTime:   timestamppb.Now(),
+		},
+		// Only the labels are needed, not the points.
+		View: monitoringpb.ListTimeSeriesRequest_HEADERS,
+	}
+	ids := make(map[string]bool)
+	it := mc.ListTimeSeries(ctx, req)
+	for {
+		ts, err := it.Next()
+		if err == iterator.Done {
+			return ids, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("ListTimeSeries(%s): %w", metricType, err)
+		}
+		ids[ts.GetResource().GetLabels()[label]] = true
+	}
+}
+
+// inventory lists the topics and subscriptions whose IDs start with prefix
+// and returns those that look unused. act is nil when activity is not
+// checked.
+func inventory(ctx context.Context, client *pubsub.Client, prefix string, act *activity) ([]finding, error) {
+	var found []finding
+	// subsPerTopic counts the subscriptions of every topic by full name,
+	// whatever their ID.
+	subsPerTopic := make(map[string]int)
+	subs := client.Subscriptions(ctx)
+	for {
+		sub, err := subs.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("Subscriptions: %w", err)
+		}
+		cfg, err := sub.Config(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("Subscription(%q).Config: %w", sub.ID(), err)
+		}
+		var topic string
+		if cfg.Topic != nil {
+			topic = cfg.Topic.String()
+			subsPerTopic[topic]++
+		}
+		if !strings.HasPrefix(sub.ID(), prefix) {
+			continue
+		}
+		if r := subscriptionReasons(sub.ID(), topic, cfg.Detached, act); len(r) > 0 {
+			found = append(found, finding{Kind: kindSubscription, ID: sub.ID(), Reasons: r})
+		}
+	}
+
+	topics := client.Topics(ctx)
+	for {
+		topic, err := topics.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("Topics: %w", err)
+		}
+		if !strings.HasPrefix(topic.ID(), prefix) {
+			continue
+		}
+		if r := topicReasons(topic.ID(), subsPerTopic[topic.String()], act); len(r) > 0 {
+			found = append(found, finding{Kind: kindTopic, ID: topic.ID(), Reasons: r})
+		}
+	}
+
+	sort.Slice(found, func(i, j int) bool {
+		if found[i].Kind != found[j].Kind {
+			return found[i].Kind < found[j].Kind
+		}
+		return found[i].ID < found[j].ID
+	})
+	return found, nil
+}
+
+// subscriptionReasons returns why the subscription subID of the topic with
+// the given full name looks unused; none means it is in use.
+func subscriptionReasons(subID, topic string, detached bool, act *activity) []string {
+	var reasons []string
+	if detached {
+		reasons = append(reasons, reasonDetached)
+	}
+	if topic == deletedTopic {
+		reasons = append(reasons, reasonTopicDeleted)
+	}
+	if act != nil && !act.Subscriptions[subID] {
+		reasons = append(reasons, reasonIdle)
+	}
+	return reasons
+}
+
+// topicReasons returns why a topic with numSubs subscriptions looks unused;
+// none means it is in use. A topic that is still published to is kept: its
+// publishers would start failing. So is a topic with subscriptions, even
+// idle ones, since they may belong to others and deleting the topic would
+// detach them.
+func topicReasons(topicID string, numSubs int, act *activity) []string {
+	if numSubs > 0 {
+		return nil
+	}
+	if act != nil {
+		if act.Topics[topicID] {
+			return nil
+		}
+		return []string{reasonIdle, reasonNoSubs}
+	}
+	return []string{reasonNoSubs}
+}
+
+// report prints the findings as a table.
+func report(w io.Writer, found []finding, idle time.Duration) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "KIND\tID\tREASONS")
+	for _, f := range found {
+		reasons := strings.Join(f.Reasons, ", ")
+		reasons = strings.Replace(reasons, reasonIdle, fmt.Sprintf("no activity in %v", idle), 1)
+		fmt.Fprintf(tw, "%v\t%s\t%s\n", f.Kind, f.ID, reasons)
+	}
+	if err := tw.Flush(); err != nil {
+		return fmt.Errorf("Flush: %w", err)
+	}
+	fmt.Fprintf(w, "Found %d unused resource(s).\n", len(found))
+	return nil
+}
+
+// sweep deletes the findings, in order. It keeps going after a failure, and
+// returns all the errors.
+func sweep(ctx context.Context, w io.Writer, client *pubsub.Client, found []finding) error {
+	var errs []error
+	for _, f := range found {
+		var err error
+		switch f.Kind {
+		case kindSubscription:
+			err = client.Subscription(f.ID).Delete(ctx)
+		case kindTopic:
+			err = client.Topic(f.ID).Delete(ctx)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("deleting %v %q: %w", f.Kind, f.ID, err))
+			continue
+		}
+		fmt.Fprintf(w, "Deleted %v %s\n", f.Kind, f.ID)
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,177 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"cloud.google.com/go/pubsub"
+	"github.com/GoogleCloudPlatform/golang-samples/internal/testutil"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestReasons(t *testing.T) {
+	act := &activity{
+		Topics:        map[string]bool{"busy": true},
+		Subscriptions: map[string]bool{"busy-sub": true},
+	}
+
+	const busyTopic = "projects/my-project/topics/busy"
+	subTests := []struct {
+		subID    string
+		topic    string
+		detached bool
+		act      *activity
+		want     []string
+	}{
+		{"quiet-sub", busyTopic, false, nil, nil},
+		{"quiet-sub", busyTopic, true, nil, []string{reasonDetached}},
+		{"quiet-sub", deletedTopic, false, nil, []string{reasonTopicDeleted}},
+		{"busy-sub", busyTopic, false, act, nil},
+		{"quiet-sub", busyTopic, false, act, []string{reasonIdle}},
+	}
+	for _, tc := range subTests {
+		if diff := cmp.Diff(tc.want, subscriptionReasons(tc.subID, tc.topic, tc.detached, tc.act)); diff != "" {
+			t.Errorf("subscriptionReasons(%q, %q, %v) mismatch (-want +got):\n%s", tc.subID, tc.topic, tc.detached, diff)
+		}
+	}
+
+	topicTests := []struct {
+		topicID string
+		numSubs int
+		act     *activity
+		want    []string
+	}{
+		{"quiet", 1, nil, nil},
+		{"quiet", 0, nil, []string{reasonNoSubs}},
+		{"busy", 0, act, nil},
+		{"quiet", 2, act, nil},
+		{"quiet", 0, act, []string{reasonIdle, reasonNoSubs}},
+	}
+	for _, tc := range topicTests {
+		if diff := cmp.Diff(tc.want, topicReasons(tc.topicID, tc.numSubs, tc.act)); diff != "" {
+			t.Errorf("topicReasons(%q, %d) mismatch (-want +got):\n%s", tc.topicID, tc.numSubs, diff)
+		}
+	}
+}
+
+func TestRunArgs(t *testing.T) {
+	for _, args := range [][]string{
+		{},
+		{"-prefix", ""},
+		{"-prefix", "test-", "-idle", "-1h"},
+		{"-prefix", "test-", "extra"},
+	} {
+		if err := run(context.Background(), new(bytes.Buffer), args); err == nil {
+			t.Errorf("run(%q) got nil error, want an error", args)
+		}
+	}
+}
+
+func TestInventoryAndSweep(t *testing.T) {
+	tc := testutil.PubsubEmulatorTest(t)
+	ctx := context.Background()
+	client, err := pubsub.NewClient(ctx, tc.ProjectID)
+	if err != nil {
+		t.Fatalf("pubsub.NewClient: %v", err)
+	}
+	defer client.Close()
+
+	prefix := testutil.UniqueName("janitor") + "-"
+	used, err := client.CreateTopic(ctx, prefix+"used")
+	if err != nil {
+		t.Fatalf("CreateTopic: %v", err)
+	}
+	defer used.Delete(ctx)
+	sub, err := client.CreateSubscription(ctx, prefix+"used-sub", pubsub.SubscriptionConfig{Topic: used})
+	if err != nil {
+		t.Fatalf("CreateSubscription: %v", err)
+	}
+	defer sub.Delete(ctx)
+	if _, err := client.CreateTopic(ctx, prefix+"orphan"); err != nil {
+		t.Fatalf("CreateTopic: %v", err)
+	}
+	gone, err := client.CreateTopic(ctx, prefix+"gone")
+	if err != nil {
+		t.Fatalf("CreateTopic: %v", err)
+	}
+	if _, err := client.CreateSubscription(ctx, prefix+"gone-sub", pubsub.SubscriptionConfig{Topic: gone}); err != nil {
+		t.Fatalf("CreateSubscription: %v", err)
+	}
+	if err := gone.Delete(ctx); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+
+	found, err := inventory(ctx, client, prefix, nil)
+	if err != nil {
+		t.Fatalf("inventory: %v", err)
+	}
+	want := []finding{
+		{Kind: kindSubscription, ID: prefix + "gone-sub", Reasons: []string{reasonTopicDeleted}},
+		{Kind: kindTopic, ID: prefix + "orphan", Reasons: []string{reasonNoSubs}},
+	}
+	if diff := cmp.Diff(want, found); diff != "" {
+		t.Fatalf("inventory mismatch (-want +got):\n%s", diff)
+	}
+
+	buf := new(bytes.Buffer)
+	if err := sweep(ctx, buf, client, found); err != nil {
+		t.Fatalf("sweep: %v", err)
+	}
+	if got, want := buf.String(), "Deleted topic "+prefix+"orphan"; !strings.Contains(got, want) {
+		t.Errorf("sweep got %q, want to contain %q", got, want)
+	}
+	if found, err = inventory(ctx, client, prefix, nil); err != nil {
+		t.Fatalf("inventory: %v", err)
+	}
+	if len(found) != 0 {
+		t.Errorf("inventory after sweep got %+v, want none", found)
+	}
+}
+
+func TestInventoryKeepsSubscribedTopics(t *testing.T) {
+	tc := testutil.PubsubEmulatorTest(t)
+	ctx := context.Background()
+	client, err := pubsub.NewClient(ctx, tc.ProjectID)
+	if err != nil {
+		t.Fatalf("pubsub.NewClient: %v", err)
+	}
+	defer client.Close()
+
+	// The topic matches the prefix but its subscription, which belongs to
+	// someone else, does not. Neither saw any traffic.
+	prefix := testutil.UniqueName("janitor") + "-"
+	topic, err := client.CreateTopic(ctx, prefix+"shared")
+	if err != nil {
+		t.Fatalf("CreateTopic: %v", err)
+	}
+	defer topic.Delete(ctx)
+	sub, err := client.CreateSubscription(ctx, testutil.UniqueName("janitor-other"), pubsub.SubscriptionConfig{Topic: topic})
+	if err != nil {
+		t.Fatalf("CreateSubscription: %v", err)
+	}
+	defer sub.Delete(ctx)
+
+	found, err := inventory(ctx, client, prefix, &activity{})
+	if err != nil {
+		t.Fatalf("inventory: %v", err)
+	}
+	if len(found) != 0 {
+		t.Errorf("inventory got %+v, want none: the topic still has a subscription", found)
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command pubsub-janitor finds Pub/Sub topics and subscriptions that look
+// unused, such as those left behind by tests, and deletes them.
+//
+// Usage:
+//
+//	pubsub-janitor -prefix PREFIX [-idle 168h] [-delete]
+//
+// Only topics and subscriptions whose IDs start with PREFIX are considered.
+// A subscription is reported when it is detached, when its topic was
+// deleted, or when Pub/Sub sent it no messages in the last -idle. A topic is
+// reported when it has no subscriptions, whatever their IDs, and nothing was
+// published to it in the last -idle. Activity is read from Cloud Monitoring;
+// -idle 0 skips that check. A topic whose subscriptions are deleted by one
+// run is reported by the next.
+//
+// Without -delete the tool only prints what it would delete. With -delete
+// it deletes the subscriptions first and then the topics, since deleting a
+// topic does not delete its subscriptions.
+//
+// The project is read from GOOGLE_CLOUD_PROJECT.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	monitoring "cloud.google.com/go/monitoring/apiv3/v2"
+	"cloud.google.com/go/pubsub"
+	"github.com/GoogleCloudPlatform/golang-samples/internal/endpoint"
+)
+
+func main() {
+	if err := run(context.Background(), os.Stdout, os.Args[1:]); err != nil {
+		fmt.Fprintf(os.Stderr, "pubsub-janitor: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, w io.Writer, args []string) error {
+	fs := flag.NewFlagSet("pubsub-janitor", flag.ContinueOnError)
+	prefix := fs.String("prefix", "", "only consider topics and subscriptions whose IDs start with this (required)")
+	idle := fs.Duration("idle", 7*24*time.Hour, "report resources with no activity for this long; 0 skips the activity check")
+	del := fs.Bool("delete", false, "delete the reported resources instead of only listing them")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 0 {
+		return errors.New("want no arguments")
+	}
+	// Requiring a prefix keeps a typo from sweeping the whole project.
+	if *prefix == "" {
+		return errors.New("-prefix must be set")
+	}
+	if *idle < 0 {
+		return errors.New("-idle must not be negative")
+	}
+
+	projectID := os.Getenv("GOOGLE_CLOUD_PROJECT")
+	if projectID == "" {
+		return errors.New("GOOGLE_CLOUD_PROJECT must be set")
+	}
+	client, err := pubsub.NewClient(ctx, projectID, endpoint.PubSub()...)
+	if err != nil {
+		return fmt.Errorf("pubsub.NewClient: %w", err)
+	}
+	defer client.Close()
+
+	var act *activity
+	if *idle > 0 {
+		mc, err := monitoring.NewMetricClient(ctx)
+		if err != nil {
+			return fmt.Errorf("monitoring.NewMetricClient: %w", err)
+		}
+		defer mc.Close()
+		if act, err = readActivity(ctx, mc, projectID, time.Now().Add(-*idle)); err != nil {
+			return err
+		}
+	}
+
+	found, err := inventory(ctx, client, *prefix, act)
+	if err != nil {
+		return err
+	}
+	if err := report(w, found, *idle); err != nil {
+		return err
+	}
+	if !*del {
+		if len(found) > 0 {
+			fmt.Fprintln(w, "Dry run: nothing was deleted. Run again with -delete to delete these.")
+		}
+		return nil
+	}
+	return sweep(ctx, w, client, found)
+}
@@ -0,0 +1,170 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"google.golang.org/api/iterator"
+)
+
+func snapshot(ctx context.Context, w io.Writer, client *pubsub.Client, args []string) error {
+	if len(args) != 2 {
+		return errors.New("want SUBSCRIPTION and SNAPSHOT")
+	}
+	cfg, err := client.Subscription(args[0]).CreateSnapshot(ctx, args[1])
+	if err != nil {
+		return fmt.Errorf("CreateSnapshot: %w", err)
+	}
+	fmt.Fprintf(w, "Created snapshot %v of %v, expires %v\n", cfg.ID(), args[0], cfg.Expiration.Format(time.RFC3339))
+	return nil
+}
+
+func snapshots(ctx context.Context, w io.Writer, client *pubsub.Client, args []string) error {
+	if len(args) != 0 {
+		return errors.New("want no arguments")
+	}
+	it := client.Snapshots(ctx)
+	for {
+		cfg, err := it.Next()
+		if err == iterator.Done {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("Snapshots: %w", err)
+		}
+		fmt.Fprintf(w, "%v\ttopic=%v\texpires=%v\n", cfg.ID(), cfg.Topic.ID(), cfg.Expiration.Format(time.RFC3339))
+	}
+}
+
+func seek(ctx context.Context, w io.Writer, client *pubsub.Client, args []string) error {
+	fs := flag.NewFlagSet("seek", flag.ContinueOnError)
+	at := fs.String("time", "", "RFC 3339 time, or a duration meaning that long ago, to seek to")
+	snap := fs.String("snapshot", "", "snapshot to seek to")
+	republishTo := fs.String("republish-to", "", "topic to republish replayed messages to")
+	max := fs.Int("max", 100, "maximum number of messages to republish")
+	timeout := fs.Duration("timeout", time.Minute, "how long to wait for messages to republish")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return errors.New("want SUBSCRIPTION")
+	}
+	if (*at == "") == (*snap == "") {
+		return errors.New("want exactly one of -time and -snapshot")
+	}
+
+	sub := client.Subscription(fs.Arg(0))
+	if *snap != "" {
+		if err := sub.SeekToSnapshot(ctx, client.Snapshot(*snap)); err != nil {
+			return fmt.Errorf("SeekToSnapshot: %w", err)
+		}
+		fmt.Fprintf(w, "Seeked %v to snapshot %v\n", sub.ID(), *snap)
+	} else {
+		t, err := parseTime(*at, time.Now())
+		if err != nil {
+			return err
+		}
+		if err := sub.SeekToTime(ctx, t); err != nil {
+			return fmt.Errorf("SeekToTime: %w", err)
+		}
+		fmt.Fprintf(w, "Seeked %v to %v\n", sub.ID(), t.Format(time.RFC3339))
+	}
+
+	if *republishTo == "" {
+		return nil
+	}
+	n, err := republish(ctx, sub, client.Topic(*republishTo), *max, *timeout)
+	fmt.Fprintf(w, "Republished %d messages to %v\n", n, *republishTo)
+	return err
+}
+
+// parseTime parses an RFC 3339 time, or a duration that is subtracted from
+// now.
+func parseTime(s string, now time.Time) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil || d < 0 {
+		return time.Time{}, fmt.Errorf("time %q is neither an RFC 3339 time nor a positive duration", s)
+	}
+	return now.Add(-d), nil
+}
+
+// republish receives up to max messages from sub and publishes copies to
+// topic, acking each message once its copy is published. It stops after
+// timeout even if fewer messages arrived.
+func republish(ctx context.Context, sub *pubsub.Subscription, topic *pubsub.Topic, max int, timeout time.Duration) (int, error) {
+	// Keep ordering keys, so ordered consumers of topic see the replayed
+	// messages in their original order.
+	topic.EnableMessageOrdering = true
+	defer topic.Stop()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var (
+		mu         sync.Mutex
+		reserved   int
+		published  int
+		publishErr error
+	)
+	err := sub.Receive(ctx, func(ctx context.Context, msg *pubsub.Message) {
+		mu.Lock()
+		if reserved >= max {
+			mu.Unlock()
+			msg.Nack()
+			return
+		}
+		reserved++
+		mu.Unlock()
+
+		_, err := topic.Publish(ctx, &pubsub.Message{
+			Data:        msg.Data,
+			Attributes:  msg.Attributes,
+			OrderingKey: msg.OrderingKey,
+		}).Get(ctx)
+
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			reserved--
+			if publishErr == nil && ctx.Err() == nil {
+				publishErr = err
+			}
+			msg.Nack()
+			return
+		}
+		published++
+		msg.Ack()
+		if published >= max {
+			cancel()
+		}
+	})
+	if err != nil {
+		return published, fmt.Errorf("Receive: %w", err)
+	}
+	if publishErr != nil {
+		return published, fmt.Errorf("Publish: %w", publishErr)
+	}
+	return published, nil
+}
@@ -0,0 +1,101 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command pubsub-replay snapshots Pub/Sub subscriptions and replays their
+// messages by seeking them back in time.
+//
+// Usage:
+//
+//	pubsub-replay snapshot SUBSCRIPTION SNAPSHOT
+//	pubsub-replay snapshots
+//	pubsub-replay seek (-time TIME | -snapshot SNAPSHOT) [-republish-to TOPIC] [-max 100] [-timeout 1m] SUBSCRIPTION
+//
+// TIME is either an RFC 3339 timestamp or a duration such as 2h, meaning
+// that long ago. Seeking to a time only replays acked messages if the
+// subscription retains them (RetainAckedMessages); seeking to a snapshot
+// replays the messages that were unacked when the snapshot was taken.
+//
+// With -republish-to, seek then receives up to -max messages from the
+// subscription and publishes copies to TOPIC, for example to replay them
+// into a staging environment. Each message is acked only once its copy has
+// been published.
+//
+// The project is read from GOOGLE_CLOUD_PROJECT.
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"cloud.google.com/go/pubsub"
+	"github.com/GoogleCloudPlatform/golang-samples/internal/endpoint"
+)
+
+// command is a pubsub-replay subcommand.
+type command struct {
+	usage string
+	run   func(ctx context.Context, w io.Writer, client *pubsub.Client, args []string) error
+}
+
+var commands = map[string]command{
+	"snapshot":  {"snapshot SUBSCRIPTION SNAPSHOT", snapshot},
+	"snapshots": {"snapshots", snapshots},
+	"seek":      {"seek (-time TIME | -snapshot SNAPSHOT) [-republish-to TOPIC] [-max 100] [-timeout 1m] SUBSCRIPTION", seek},
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage(os.Stderr)
+		os.Exit(2)
+	}
+	cmd, ok := commands[os.Args[1]]
+	if !ok {
+		usage(os.Stderr)
+		os.Exit(2)
+	}
+
+	if err := run(context.Background(), os.Stdout, cmd, os.Args[2:]); err != nil {
+		fmt.Fprintf(os.Stderr, "pubsub-replay %s: %v\n", os.Args[1], err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, w io.Writer, cmd command, args []string) error {
+	projectID := os.Getenv("GOOGLE_CLOUD_PROJECT")
+	if projectID == "" {
+		return errors.New("GOOGLE_CLOUD_PROJECT must be set")
+	}
+	client, err := pubsub.NewClient(ctx, projectID, endpoint.PubSub()...)
+	if err != nil {
+		return fmt.Errorf("pubsub.NewClient: %w", err)
+	}
+	defer client.Close()
+	return cmd.run(ctx, w, client, args)
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "usage:")
+	var names []string
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(w, "\tpubsub-replay %s\n", commands[name].usage)
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"github.com/GoogleCloudPlatform/golang-samples/internal/testutil"
+)
+
+func TestParseTime(t *testing.T) {
+	now := time.Date(2026, 10, 15, 14, 0, 0, 0, time.UTC)
+	tests := []struct {
+		in      string
+		want    time.Time
+		wantErr bool
+	}{
+		{in: "2026-10-15T12:30:00Z", want: time.Date(2026, 10, 15, 12, 30, 0, 0, time.UTC)},
+		{in: "2h", want: now.Add(-2 * time.Hour)},
+		{in: "90m", want: now.Add(-90 * time.Minute)},
+		{in: "-1h", wantErr: true},
+		{in: "yesterday", wantErr: true},
+	}
+	for _, tc := range tests {
+		got, err := parseTime(tc.in, now)
+		if (err != nil) != tc.wantErr {
+			t.Errorf("parseTime(%q) got err %v, want err %v", tc.in, err, tc.wantErr)
+			continue
+		}
+		if !got.Equal(tc.want) {
+			t.Errorf("parseTime(%q) got %v, want %v", tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestSeekArgs(t *testing.T) {
+	for _, args := range [][]string{
+		{"my-sub"},
+		{"-time", "1h", "-snapshot", "snap", "my-sub"},
+		{"-time", "1h"},
+	} {
+		if err := seek(context.Background(), new(bytes.Buffer), nil, args); err == nil {
+			t.Errorf("seek(%q) got nil error, want an error", args)
+		}
+	}
+}
+
+func TestCommands(t *testing.T) {
+	tc := testutil.SystemTest(t)
+	ctx := context.Background()
+	client, err := pubsub.NewClient(ctx, tc.ProjectID)
+	if err != nil {
+		t.Fatalf("pubsub.NewClient: %v", err)
+	}
+	defer client.Close()
+
+	srcTopicID := testutil.UniqueName("replay-src")
+	dstTopicID := testutil.UniqueName("replay-dst")
+	srcSubID := testutil.UniqueName("replay-src-sub")
+	dstSubID := testutil.UniqueName("replay-dst-sub")
+	snapID := testutil.UniqueName("replay-snap")
+
+	srcTopic, err := client.CreateTopic(ctx, srcTopicID)
+	if err != nil {
+		t.Fatalf("CreateTopic: %v", err)
+	}
+	defer srcTopic.Delete(ctx)
+	defer srcTopic.Stop()
+	dstTopic, err := client.CreateTopic(ctx, dstTopicID)
+	if err != nil {
+		t.Fatalf("CreateTopic: %v", err)
+	}
+	defer dstTopic.Delete(ctx)
+	srcSub, err := client.CreateSubscription(ctx, srcSubID, pubsub.SubscriptionConfig{
+		Topic:               srcTopic,
+		RetainAckedMessages: true,
+	})
+	if err != nil {
+		t.Fatalf("CreateSubscription: %v", err)
+	}
+	defer srcSub.Delete(ctx)
+	dstSub, err := client.CreateSubscription(ctx, dstSubID, pubsub.SubscriptionConfig{Topic: dstTopic})
+	if err != nil {
+		t.Fatalf("CreateSubscription: %v", err)
+	}
+	defer dstSub.Delete(ctx)
+
+	const numMsgs = 3
+	for i := 0; i < numMsgs; i++ {
+		if _, err := srcTopic.Publish(ctx, &pubsub.Message{Data: []byte(fmt.Sprintf("message#%d", i))}).Get(ctx); err != nil {
+			t.Fatalf("Publish: %v", err)
+		}
+	}
+
+	buf := new(bytes.Buffer)
+	if err := snapshot(ctx, buf, client, []string{srcSubID, snapID}); err != nil {
+		t.Fatalf("snapshot: %v", err)
+	}
+	defer client.Snapshot(snapID).Delete(ctx)
+
+	buf.Reset()
+	if err := snapshots(ctx, buf, client, nil); err != nil {
+		t.Fatalf("snapshots: %v", err)
+	}
+	if got := buf.String(); !strings.Contains(got, snapID) {
+		t.Errorf("snapshots got %q, want to contain %q", got, snapID)
+	}
+
+	buf.Reset()
+	args := []string{"-snapshot", snapID, "-republish-to", dstTopicID, "-max", fmt.Sprint(numMsgs), "-timeout", "30s", srcSubID}
+	if err := seek(ctx, buf, client, args); err != nil {
+		t.Fatalf("seek: %v", err)
+	}
+	if got, want := buf.String(), fmt.Sprintf("Republished %d messages", numMsgs); !strings.Contains(got, want) {
+		t.Errorf("seek got %q, want to contain %q", got, want)
+	}
+
+	// The messages were acked while republishing; seeking back in time
+	// brings them back because the subscription retains acked messages.
+	buf.Reset()
+	if err := seek(ctx, buf, client, []string{"-time", "1h", srcSubID}); err != nil {
+		t.Fatalf("seek: %v", err)
+	}
+	if got, want := buf.String(), "Seeked "+srcSubID; !strings.Contains(got, want) {
+		t.Errorf("seek got %q, want to contain %q", got, want)
+	}
+}
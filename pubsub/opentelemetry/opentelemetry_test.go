@@ -0,0 +1,78 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opentelemetry
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"github.com/GoogleCloudPlatform/golang-samples/internal/testutil"
+)
+
+func TestTracing(t *testing.T) {
+	tc := testutil.SystemTest(t)
+	ctx := context.Background()
+	client, err := pubsub.NewClient(ctx, tc.ProjectID)
+	if err != nil {
+		t.Fatalf("pubsub.NewClient: %v", err)
+	}
+	defer client.Close()
+
+	topicID := testutil.UniqueName("otel-topic")
+	subID := testutil.UniqueName("otel-sub")
+	topic := client.Topic(topicID)
+	if ok, err := topic.Exists(ctx); err != nil {
+		t.Fatalf("Exists: %v", err)
+	} else if !ok {
+		if topic, err = client.CreateTopic(ctx, topicID); err != nil {
+			t.Fatalf("CreateTopic: %v", err)
+		}
+	}
+	defer topic.Delete(ctx)
+	sub := client.Subscription(subID)
+	if ok, err := sub.Exists(ctx); err != nil {
+		t.Fatalf("Exists: %v", err)
+	} else if ok {
+		sub.Delete(ctx)
+	}
+	if sub, err = client.CreateSubscription(ctx, subID, pubsub.SubscriptionConfig{Topic: topic}); err != nil {
+		t.Fatalf("CreateSubscription: %v", err)
+	}
+	defer sub.Delete(ctx)
+
+	buf := new(bytes.Buffer)
+	traceID, err := publishWithTracing(buf, tc.ProjectID, topicID, "traced")
+	if err != nil {
+		t.Fatalf("publishWithTracing: %v", err)
+	}
+
+	buf.Reset()
+	traces, err := subscribeWithTracing(buf, tc.ProjectID, subID, 20*time.Second)
+	if err != nil {
+		t.Fatalf("subscribeWithTracing: %v", err)
+	}
+	var found bool
+	for _, id := range traces {
+		if id == traceID {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("subscribeWithTracing got traces %v, want one in publisher trace %v", traces, traceID)
+	}
+}
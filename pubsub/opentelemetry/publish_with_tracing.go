@@ -0,0 +1,85 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opentelemetry
+
+// [START pubsub_publish_otel_tracing]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	texporter "github.com/GoogleCloudPlatform/opentelemetry-operations-go/exporter/trace"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// publishWithTracing publishes a message with OpenTelemetry tracing enabled
+// and exports the spans to Cloud Trace. The client records spans for
+// batching and sending the message and adds the trace context to the
+// message attributes, so subscribers can continue the same trace.
+func publishWithTracing(w io.Writer, projectID, topicID, msg string) (traceID string, err error) {
+	// projectID := "my-project-id"
+	// topicID := "my-topic"
+	// msg := "Hello World"
+	const timeout = time.Minute
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	exporter, err := texporter.New(texporter.WithProjectID(projectID))
+	if err != nil {
+		return "", fmt.Errorf("texporter.New: %w", err)
+	}
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		// Sample every trace. Use a lower ratio in production.
+		sdktrace.WithSampler(sdktrace.TraceIDRatioBased(1.0)),
+	)
+	// Shutdown flushes the spans that have not been exported yet.
+	defer tp.Shutdown(ctx)
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	client, err := pubsub.NewClientWithConfig(ctx, projectID, &pubsub.ClientConfig{
+		EnableOpenTelemetryTracing: true,
+	})
+	if err != nil {
+		return "", fmt.Errorf("pubsub.NewClientWithConfig: %w", err)
+	}
+	defer client.Close()
+
+	// Spans the client creates become children of the span in ctx, so the
+	// publish shows up as part of the operation that triggered it.
+	ctx, span := otel.Tracer("pubsub-publisher").Start(ctx, "handle-order")
+	defer span.End()
+
+	t := client.Topic(topicID)
+	defer t.Stop()
+	result := t.Publish(ctx, &pubsub.Message{
+		Data: []byte(msg),
+	})
+	id, err := result.Get(ctx)
+	if err != nil {
+		return "", fmt.Errorf("Get: %w", err)
+	}
+	traceID = trace.SpanContextFromContext(ctx).TraceID().String()
+	fmt.Fprintf(w, "Published message %v in trace %v\n", id, traceID)
+	return traceID, nil
+}
+
+// [END pubsub_publish_otel_tracing]
@@ -0,0 +1,88 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opentelemetry
+
+// [START pubsub_subscribe_otel_tracing]
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	texporter "github.com/GoogleCloudPlatform/opentelemetry-operations-go/exporter/trace"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// subscribeWithTracing receives messages with OpenTelemetry tracing enabled
+// and exports the spans to Cloud Trace. For messages published with tracing
+// enabled, the client reads the trace context from the message attributes,
+// so the subscribe spans join the publisher's trace even when the publisher
+// runs in another service. It returns the trace ID of each message.
+func subscribeWithTracing(w io.Writer, projectID, subID string, duration time.Duration) (map[string]string, error) {
+	// projectID := "my-project-id"
+	// subID := "my-sub"
+	// duration := 30 * time.Second
+	ctx := context.Background()
+
+	exporter, err := texporter.New(texporter.WithProjectID(projectID))
+	if err != nil {
+		return nil, fmt.Errorf("texporter.New: %w", err)
+	}
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.TraceIDRatioBased(1.0)),
+	)
+	defer tp.Shutdown(ctx)
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	client, err := pubsub.NewClientWithConfig(ctx, projectID, &pubsub.ClientConfig{
+		EnableOpenTelemetryTracing: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("pubsub.NewClientWithConfig: %w", err)
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(ctx, duration)
+	defer cancel()
+
+	var mu sync.Mutex
+	traces := make(map[string]string)
+	err = client.Subscription(subID).Receive(ctx, func(ctx context.Context, msg *pubsub.Message) {
+		// ctx carries the subscribe span, so spans started from it are
+		// part of the message's trace.
+		ctx, span := otel.Tracer("pubsub-subscriber").Start(ctx, "process-order")
+		defer span.End()
+
+		traceID := trace.SpanContextFromContext(ctx).TraceID().String()
+		mu.Lock()
+		traces[msg.ID] = traceID
+		fmt.Fprintf(w, "Received message %v in trace %v\n", msg.ID, traceID)
+		mu.Unlock()
+		msg.Ack()
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Receive: %w", err)
+	}
+	return traces, nil
+}
+
+// [END pubsub_subscribe_otel_tracing]
@@ -0,0 +1,121 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+// [START pubsub_push_handler]
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"google.golang.org/api/idtoken"
+)
+
+// pushRequest is the body of a push delivery. Pub/Sub wraps each message in
+// a JSON envelope.
+type pushRequest struct {
+	Message struct {
+		// Data is base64 encoded in the JSON, and decoded by
+		// encoding/json into []byte.
+		Data        []byte            `json:"data,omitempty"`
+		Attributes  map[string]string `json:"attributes,omitempty"`
+		MessageID   string            `json:"messageId"`
+		PublishTime time.Time         `json:"publishTime"`
+		OrderingKey string            `json:"orderingKey,omitempty"`
+	} `json:"message"`
+	Subscription string `json:"subscription"`
+	// DeliveryAttempt is only set if the subscription has a dead letter
+	// policy.
+	DeliveryAttempt int `json:"deliveryAttempt,omitempty"`
+}
+
+// pushHandler verifies and handles push deliveries.
+//
+// Pub/Sub treats a 2xx response as an ack and anything else as a nack, and
+// retries nacked messages according to the subscription's retry policy.
+type pushHandler struct {
+	// audience is the audience set on the push subscription.
+	audience string
+	// serviceAccount, if set, is the only service account allowed to push.
+	serviceAccount string
+	// validate verifies a token; it is idtoken.Validate outside tests.
+	validate func(ctx context.Context, token, audience string) (*idtoken.Payload, error)
+	// process handles a message. Returning an error makes Pub/Sub retry it.
+	process func(ctx context.Context, req *pushRequest) error
+}
+
+func (h *pushHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Pub/Sub sends a Google-signed OIDC token for the push service
+	// account. Check that the token is valid, was issued by Google for
+	// this endpoint, and belongs to the expected account.
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if token == "" || token == r.Header.Get("Authorization") {
+		http.Error(w, "Missing bearer token", http.StatusUnauthorized)
+		return
+	}
+	payload, err := h.validate(r.Context(), token, h.audience)
+	if err != nil {
+		log.Printf("Invalid token: %v", err)
+		http.Error(w, "Invalid token", http.StatusUnauthorized)
+		return
+	}
+	if payload.Issuer != "accounts.google.com" && payload.Issuer != "https://accounts.google.com" {
+		http.Error(w, "Wrong issuer", http.StatusUnauthorized)
+		return
+	}
+	if h.serviceAccount != "" {
+		email, _ := payload.Claims["email"].(string)
+		verified, _ := payload.Claims["email_verified"].(bool)
+		if email != h.serviceAccount || !verified {
+			http.Error(w, "Forbidden", http.StatusForbidden)
+			return
+		}
+	}
+
+	var req pushRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Printf("json.Decode: %v", err)
+		http.Error(w, "Bad request", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.process(r.Context(), &req); err != nil {
+		// A 5xx response nacks the message, so Pub/Sub delivers it again.
+		log.Printf("Processing message %v failed: %v", req.Message.MessageID, err)
+		http.Error(w, "Processing failed", http.StatusServiceUnavailable)
+		return
+	}
+	// Any 2xx response acks the message.
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// process handles a message. Replace it with your own processing. Return an
+// error only for failures that are worth retrying; a message that can never
+// be processed should be logged and acked, or it is retried until it
+// expires or is dead-lettered.
+func process(ctx context.Context, req *pushRequest) error {
+	log.Printf("Received message %v from %v: %s", req.Message.MessageID, req.Subscription, req.Message.Data)
+	return nil
+}
+
+// [END pubsub_push_handler]
@@ -0,0 +1,62 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command push is a sample HTTP server that receives messages from a
+// Pub/Sub push subscription.
+//
+// Create the push subscription with authentication, so Pub/Sub sends an
+// OIDC token the server can verify:
+//
+//	gcloud pubsub subscriptions create my-push-sub --topic=my-topic \
+//	    --push-endpoint=https://my-service.run.app/push \
+//	    --push-auth-service-account=push-invoker@my-project.iam.gserviceaccount.com \
+//	    --push-auth-token-audience=https://my-service.run.app/push
+//
+// and run the server with the same audience and service account:
+//
+//	PUSH_AUDIENCE=https://my-service.run.app/push \
+//	PUSH_SERVICE_ACCOUNT=push-invoker@my-project.iam.gserviceaccount.com \
+//	go run .
+package main
+
+import (
+	"log"
+	"net/http"
+	"os"
+
+	"google.golang.org/api/idtoken"
+)
+
+func main() {
+	h := &pushHandler{
+		audience:       os.Getenv("PUSH_AUDIENCE"),
+		serviceAccount: os.Getenv("PUSH_SERVICE_ACCOUNT"),
+		validate:       idtoken.Validate,
+		process:        process,
+	}
+	if h.audience == "" {
+		log.Fatal("PUSH_AUDIENCE must be set")
+	}
+	http.Handle("/push", h)
+
+	port := os.Getenv("PORT")
+	if port == "" {
+		port = "8080"
+		log.Printf("Defaulting to port %s", port)
+	}
+	log.Printf("Listening on port %s", port)
+	if err := http.ListenAndServe(":"+port, nil); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/idtoken"
+)
+
+const (
+	testAudience       = "https://example.com/push"
+	testServiceAccount = "push@my-project.iam.gserviceaccount.com"
+)
+
+// fakeValidate accepts the token "good" for testAudience, and "other" as a
+// token for another service account.
+func fakeValidate(_ context.Context, token, audience string) (*idtoken.Payload, error) {
+	if audience != testAudience {
+		return nil, fmt.Errorf("audience %q does not match", audience)
+	}
+	email := testServiceAccount
+	switch token {
+	case "good":
+	case "other":
+		email = "someone@example.com"
+	default:
+		return nil, errors.New("invalid token")
+	}
+	return &idtoken.Payload{
+		Issuer:   "https://accounts.google.com",
+		Audience: audience,
+		Claims:   map[string]interface{}{"email": email, "email_verified": true},
+	}, nil
+}
+
+func TestPushHandler(t *testing.T) {
+	data := base64.StdEncoding.EncodeToString([]byte("hello"))
+	body := fmt.Sprintf(`{"message":{"data":%q,"messageId":"123","attributes":{"k":"v"},"publishTime":"2026-10-15T14:00:00Z"},"subscription":"projects/p/subscriptions/s"}`, data)
+
+	var got *pushRequest
+	h := &pushHandler{
+		audience:       testAudience,
+		serviceAccount: testServiceAccount,
+		validate:       fakeValidate,
+		process: func(_ context.Context, req *pushRequest) error {
+			got = req
+			if string(req.Message.Data) == "fail" {
+				return errors.New("transient failure")
+			}
+			return nil
+		},
+	}
+
+	tests := []struct {
+		name   string
+		method string
+		auth   string
+		body   string
+		want   int
+	}{
+		{name: "ok", auth: "Bearer good", body: body, want: http.StatusNoContent},
+		{name: "get", method: http.MethodGet, auth: "Bearer good", body: body, want: http.StatusMethodNotAllowed},
+		{name: "no token", body: body, want: http.StatusUnauthorized},
+		{name: "not bearer", auth: "good", body: body, want: http.StatusUnauthorized},
+		{name: "bad token", auth: "Bearer bad", body: body, want: http.StatusUnauthorized},
+		{name: "other account", auth: "Bearer other", body: body, want: http.StatusForbidden},
+		{name: "bad body", auth: "Bearer good", body: "{", want: http.StatusBadRequest},
+		{
+			name: "processing fails",
+			auth: "Bearer good",
+			body: fmt.Sprintf(`{"message":{"data":%q,"messageId":"456"}}`, base64.StdEncoding.EncodeToString([]byte("fail"))),
+			want: http.StatusServiceUnavailable,
+		},
+	}
+	for _, tc := range tests {
+		method := tc.method
+		if method == "" {
+			method = http.MethodPost
+		}
+		req := httptest.NewRequest(method, "/push", strings.NewReader(tc.body))
+		if tc.auth != "" {
+			req.Header.Set("Authorization", tc.auth)
+		}
+		rr := httptest.NewRecorder()
+		h.ServeHTTP(rr, req)
+		if rr.Code != tc.want {
+			t.Errorf("%s: got status %d, want %d", tc.name, rr.Code, tc.want)
+		}
+	}
+
+	// Check that the envelope is decoded for process.
+	req := httptest.NewRequest(http.MethodPost, "/push", strings.NewReader(body))
+	req.Header.Set("Authorization", "Bearer good")
+	h.ServeHTTP(httptest.NewRecorder(), req)
+	if got == nil || string(got.Message.Data) != "hello" || got.Message.MessageID != "123" || got.Message.Attributes["k"] != "v" {
+		t.Errorf("process got %+v, want the decoded message", got)
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schemas
+
+// [START pubsub_create_topic_with_schema]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"google.golang.org/api/option"
+)
+
+// createTopicWithSchema creates a topic whose messages must conform to the
+// schema. Messages are validated when published, and rejected if they do not
+// match the schema in the given encoding.
+func createTopicWithSchema(w io.Writer, projectID, topicID, schemaID string, encoding pubsub.SchemaEncoding, opts ...option.ClientOption) error {
+	// projectID := "my-project-id"
+	// topicID := "my-topic"
+	// schemaID := "my-schema"
+	// encoding := pubsub.EncodingJSON
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	client, err := pubsub.NewClient(ctx, projectID, opts...)
+	if err != nil {
+		return fmt.Errorf("pubsub.NewClient: %w", err)
+	}
+	defer client.Close()
+
+	tc := &pubsub.TopicConfig{
+		SchemaSettings: &pubsub.SchemaSettings{
+			Schema:   fmt.Sprintf("projects/%s/schemas/%s", projectID, schemaID),
+			Encoding: encoding,
+		},
+	}
+	t, err := client.CreateTopicWithConfig(ctx, topicID, tc)
+	if err != nil {
+		return fmt.Errorf("CreateTopicWithConfig: %w", err)
+	}
+	fmt.Fprintf(w, "Created topic with schema: %#v\n", t)
+	return nil
+}
+
+// [END pubsub_create_topic_with_schema]
@@ -0,0 +1,49 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schemas
+
+// [START pubsub_delete_schema]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"google.golang.org/api/option"
+)
+
+// deleteSchema deletes a schema. Topics that used the schema keep existing,
+// but publishing to them fails until they are bound to another schema.
+func deleteSchema(w io.Writer, projectID, schemaID string, opts ...option.ClientOption) error {
+	// projectID := "my-project-id"
+	// schemaID := "my-schema"
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	client, err := pubsub.NewSchemaClient(ctx, projectID, opts...)
+	if err != nil {
+		return fmt.Errorf("pubsub.NewSchemaClient: %w", err)
+	}
+	defer client.Close()
+
+	if err := client.DeleteSchema(ctx, schemaID); err != nil {
+		return fmt.Errorf("client.DeleteSchema: %w", err)
+	}
+	fmt.Fprintf(w, "Deleted schema: %s\n", schemaID)
+	return nil
+}
+
+// [END pubsub_delete_schema]
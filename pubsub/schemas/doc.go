@@ -0,0 +1,20 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package schemas contains samples for creating Pub/Sub schemas, binding them
+// to topics, and publishing and receiving messages that conform to them.
+// See more about Pub/Sub schemas at https://cloud.google.com/pubsub/docs/schemas.
+package schemas
+
+//go:generate protoc --proto_path=testdata --go_out=statepb --go_opt=paths=source_relative us-states.proto
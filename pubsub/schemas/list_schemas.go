@@ -0,0 +1,60 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schemas
+
+// [START pubsub_list_schemas]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+)
+
+// listSchemas lists the schemas in the project.
+func listSchemas(w io.Writer, projectID string, opts ...option.ClientOption) ([]*pubsub.SchemaConfig, error) {
+	// projectID := "my-project-id"
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	client, err := pubsub.NewSchemaClient(ctx, projectID, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("pubsub.NewSchemaClient: %w", err)
+	}
+	defer client.Close()
+
+	var schemas []*pubsub.SchemaConfig
+
+	schemaIter := client.Schemas(ctx, pubsub.SchemaViewFull)
+	for {
+		sc, err := schemaIter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("schemaIter.Next: %w", err)
+		}
+		fmt.Fprintf(w, "Got Schema: %#v\n", sc)
+		schemas = append(schemas, sc)
+	}
+
+	fmt.Fprintf(w, "Got %d schemas\n", len(schemas))
+	return schemas, nil
+}
+
+// [END pubsub_list_schemas]
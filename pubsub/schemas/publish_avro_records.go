@@ -0,0 +1,92 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schemas
+
+// [START pubsub_publish_avro_records]
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"github.com/linkedin/goavro/v2"
+	"google.golang.org/api/option"
+)
+
+// publishAvroRecords publishes a record encoded with the topic's Avro schema,
+// in whichever encoding the topic expects.
+func publishAvroRecords(w io.Writer, projectID, topicID, avscFile string, opts ...option.ClientOption) error {
+	// projectID := "my-project-id"
+	// topicID := "my-topic"
+	// avscFile := "path/to/an/avro/schema/file(.avsc)/formatted/in/json"
+	const timeout = time.Minute
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	client, err := pubsub.NewClient(ctx, projectID, opts...)
+	if err != nil {
+		return fmt.Errorf("pubsub.NewClient: %w", err)
+	}
+	defer client.Close()
+
+	avroSource, err := ioutil.ReadFile(avscFile)
+	if err != nil {
+		return fmt.Errorf("ioutil.ReadFile err: %w", err)
+	}
+	codec, err := goavro.NewCodec(string(avroSource))
+	if err != nil {
+		return fmt.Errorf("goavro.NewCodec err: %w", err)
+	}
+	record := map[string]interface{}{"name": "Alaska", "post_abbr": "AK"}
+
+	// Get the topic encoding type.
+	t := client.Topic(topicID)
+	cfg, err := t.Config(ctx)
+	if err != nil {
+		return fmt.Errorf("topic.Config err: %w", err)
+	}
+	if cfg.SchemaSettings == nil {
+		return fmt.Errorf("topic %q has no schema", topicID)
+	}
+
+	var msg []byte
+	switch cfg.SchemaSettings.Encoding {
+	case pubsub.EncodingBinary:
+		msg, err = codec.BinaryFromNative(nil, record)
+		if err != nil {
+			return fmt.Errorf("codec.BinaryFromNative err: %w", err)
+		}
+	case pubsub.EncodingJSON:
+		msg, err = codec.TextualFromNative(nil, record)
+		if err != nil {
+			return fmt.Errorf("codec.TextualFromNative err: %w", err)
+		}
+	default:
+		return fmt.Errorf("invalid encoding: %v", cfg.SchemaSettings.Encoding)
+	}
+
+	result := t.Publish(ctx, &pubsub.Message{
+		Data: msg,
+	})
+	_, err = result.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("result.Get: %w", err)
+	}
+	fmt.Fprintf(w, "Published avro record: %s\n", string(msg))
+	return nil
+}
+
+// [END pubsub_publish_avro_records]
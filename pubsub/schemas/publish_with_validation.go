@@ -0,0 +1,169 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schemas
+
+// [START pubsub_publish_with_schema_validation]
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"github.com/linkedin/goavro/v2"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errInvalidMessage is returned by schemaValidator.validate for a message
+// that does not conform to the schema.
+var errInvalidMessage = errors.New("message does not match the schema")
+
+// schemaValidator checks messages against the schema of a topic before they
+// are published.
+type schemaValidator struct {
+	schemas  *pubsub.SchemaClient
+	schemaID string
+	encoding pubsub.SchemaEncoding
+	// codec, if set, validates Avro messages locally with a cached copy of
+	// the schema, instead of calling the service for every message.
+	codec *goavro.Codec
+}
+
+// newSchemaValidator looks up the schema and encoding of the topic. With
+// cache set, an Avro schema is downloaded once and messages are validated
+// locally; otherwise, and for Protocol Buffer schemas, every message is sent
+// to the service's ValidateMessage method.
+func newSchemaValidator(ctx context.Context, client *pubsub.Client, schemas *pubsub.SchemaClient, topicID string, cache bool) (*schemaValidator, error) {
+	cfg, err := client.Topic(topicID).Config(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("topic.Config: %w", err)
+	}
+	if cfg.SchemaSettings == nil {
+		return nil, fmt.Errorf("topic %q has no schema", topicID)
+	}
+	// The topic names its schema as "projects/PROJECT/schemas/SCHEMA".
+	name := cfg.SchemaSettings.Schema
+	v := &schemaValidator{
+		schemas:  schemas,
+		schemaID: name[strings.LastIndex(name, "/")+1:],
+		encoding: cfg.SchemaSettings.Encoding,
+	}
+	if !cache {
+		return v, nil
+	}
+	// The cache holds the latest revision. Refresh it by creating a new
+	// validator after committing a new revision of the schema.
+	s, err := schemas.Schema(ctx, v.schemaID, pubsub.SchemaViewFull)
+	if err != nil {
+		return nil, fmt.Errorf("schemas.Schema: %w", err)
+	}
+	if s.Type == pubsub.SchemaAvro {
+		if v.codec, err = goavro.NewCodec(s.Definition); err != nil {
+			return nil, fmt.Errorf("goavro.NewCodec: %w", err)
+		}
+	}
+	return v, nil
+}
+
+// validate returns an error wrapping errInvalidMessage if data does not
+// conform to the schema. Any other error means the message could not be
+// checked, not that it is invalid.
+func (v *schemaValidator) validate(ctx context.Context, data []byte) error {
+	if v.codec != nil {
+		var rest []byte
+		var err error
+		switch v.encoding {
+		case pubsub.EncodingBinary:
+			_, rest, err = v.codec.NativeFromBinary(data)
+		case pubsub.EncodingJSON:
+			_, rest, err = v.codec.NativeFromTextual(data)
+		default:
+			return fmt.Errorf("invalid encoding: %v", v.encoding)
+		}
+		if err == nil && len(strings.TrimSpace(string(rest))) > 0 {
+			err = fmt.Errorf("%d trailing bytes", len(rest))
+		}
+		if err != nil {
+			return fmt.Errorf("%w: %v", errInvalidMessage, err)
+		}
+		return nil
+	}
+	if _, err := v.schemas.ValidateMessageWithID(ctx, data, v.encoding, v.schemaID); err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			return fmt.Errorf("%w: %v", errInvalidMessage, err)
+		}
+		return fmt.Errorf("ValidateMessageWithID: %w", err)
+	}
+	return nil
+}
+
+// publishWithValidation publishes only the messages that conform to the
+// topic's schema. Pub/Sub rejects a whole publish batch if one message in it
+// is invalid, so checking each message first keeps a single bad message from
+// failing its neighbors, and says which message was wrong.
+func publishWithValidation(w io.Writer, projectID, topicID string, msgs [][]byte, cache bool, opts ...option.ClientOption) error {
+	// projectID := "my-project-id"
+	// topicID := "my-topic"
+	// msgs := [][]byte{[]byte(`{"name":"Alaska","post_abbr":"AK"}`)}
+	// cache := true
+	const timeout = time.Minute
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	client, err := pubsub.NewClient(ctx, projectID, opts...)
+	if err != nil {
+		return fmt.Errorf("pubsub.NewClient: %w", err)
+	}
+	defer client.Close()
+	schemas, err := pubsub.NewSchemaClient(ctx, projectID, opts...)
+	if err != nil {
+		return fmt.Errorf("pubsub.NewSchemaClient: %w", err)
+	}
+	defer schemas.Close()
+
+	v, err := newSchemaValidator(ctx, client, schemas, topicID, cache)
+	if err != nil {
+		return err
+	}
+
+	t := client.Topic(topicID)
+	defer t.Stop()
+	var results []*pubsub.PublishResult
+	for i, data := range msgs {
+		if err := v.validate(ctx, data); err != nil {
+			if !errors.Is(err, errInvalidMessage) {
+				return err
+			}
+			// Send invalid messages to a dead-letter store or log
+			// instead of the topic.
+			fmt.Fprintf(w, "Rejected message %d: %v\n", i, err)
+			continue
+		}
+		results = append(results, t.Publish(ctx, &pubsub.Message{Data: data}))
+	}
+	for _, r := range results {
+		id, err := r.Get(ctx)
+		if err != nil {
+			return fmt.Errorf("Get: %w", err)
+		}
+		fmt.Fprintf(w, "Published a message; msg ID: %v\n", id)
+	}
+	return nil
+}
+
+// [END pubsub_publish_with_schema_validation]
@@ -0,0 +1,290 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schemas
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"github.com/GoogleCloudPlatform/golang-samples/internal/testutil"
+	"github.com/linkedin/goavro/v2"
+)
+
+const (
+	avroFile  = "./testdata/us-states.avsc"
+	protoFile = "./testdata/us-states.proto"
+)
+
+// resourceID returns an ID unique to this run, so concurrent runs of the
+// tests do not collide. Topics and subscriptions left behind by a failed run
+// are deleted by testutil.DeleteStaleResources.
+func resourceID(prefix string) string {
+	return testutil.UniqueName(prefix)
+}
+
+func TestAvroSchema(t *testing.T) {
+	tc := testutil.SystemTest(t)
+	ctx := context.Background()
+	client, err := pubsub.NewClient(ctx, tc.ProjectID)
+	if err != nil {
+		t.Fatalf("pubsub.NewClient: %v", err)
+	}
+	defer client.Close()
+	schemaClient, err := pubsub.NewSchemaClient(ctx, tc.ProjectID)
+	if err != nil {
+		t.Fatalf("pubsub.NewSchemaClient: %v", err)
+	}
+	defer schemaClient.Close()
+
+	schemaID := resourceID("go-avro-schema")
+	buf := new(bytes.Buffer)
+	if err := createAvroSchema(buf, tc.ProjectID, schemaID, avroFile); err != nil {
+		t.Fatalf("createAvroSchema: %v", err)
+	}
+	defer schemaClient.DeleteSchema(ctx, schemaID)
+	if got, want := buf.String(), "Schema created"; !strings.Contains(got, want) {
+		t.Errorf("createAvroSchema got %q, want to contain %q", got, want)
+	}
+
+	for _, encoding := range []pubsub.SchemaEncoding{pubsub.EncodingJSON, pubsub.EncodingBinary} {
+		topicID, subID, cleanup := createTopicAndSub(ctx, t, client, schemaID, encoding)
+		defer cleanup()
+
+		if err := publishAvroRecords(buf, tc.ProjectID, topicID, avroFile); err != nil {
+			t.Fatalf("publishAvroRecords(%v): %v", encoding, err)
+		}
+
+		testutil.RetryBackoff(t, testutil.Backoff{Initial: time.Second, Max: 2 * time.Second, Multiplier: 2, MaxAttempts: 5}, func(r *testutil.R) {
+			buf.Reset()
+			if err := subscribeWithAvroSchema(buf, tc.ProjectID, subID, avroFile); err != nil {
+				r.Errorf("subscribeWithAvroSchema: %v", err)
+				return
+			}
+			if got, want := buf.String(), "Alaska is abbreviated as AK"; !strings.Contains(got, want) {
+				r.Errorf("subscribeWithAvroSchema(%v) got %q, want to contain %q", encoding, got, want)
+			}
+		})
+	}
+}
+
+func TestProtoSchema(t *testing.T) {
+	tc := testutil.SystemTest(t)
+	ctx := context.Background()
+	client, err := pubsub.NewClient(ctx, tc.ProjectID)
+	if err != nil {
+		t.Fatalf("pubsub.NewClient: %v", err)
+	}
+	defer client.Close()
+	schemaClient, err := pubsub.NewSchemaClient(ctx, tc.ProjectID)
+	if err != nil {
+		t.Fatalf("pubsub.NewSchemaClient: %v", err)
+	}
+	defer schemaClient.Close()
+
+	schemaID := resourceID("go-proto-schema")
+	buf := new(bytes.Buffer)
+	if err := createProtoSchema(buf, tc.ProjectID, schemaID, protoFile); err != nil {
+		t.Fatalf("createProtoSchema: %v", err)
+	}
+	defer schemaClient.DeleteSchema(ctx, schemaID)
+	if got, want := buf.String(), "Schema created"; !strings.Contains(got, want) {
+		t.Errorf("createProtoSchema got %q, want to contain %q", got, want)
+	}
+
+	for _, encoding := range []pubsub.SchemaEncoding{pubsub.EncodingJSON, pubsub.EncodingBinary} {
+		topicID, subID, cleanup := createTopicAndSub(ctx, t, client, schemaID, encoding)
+		defer cleanup()
+
+		if err := publishProtoMessages(buf, tc.ProjectID, topicID); err != nil {
+			t.Fatalf("publishProtoMessages(%v): %v", encoding, err)
+		}
+
+		testutil.RetryBackoff(t, testutil.Backoff{Initial: time.Second, Max: 2 * time.Second, Multiplier: 2, MaxAttempts: 5}, func(r *testutil.R) {
+			buf.Reset()
+			if err := subscribeWithProtoSchema(buf, tc.ProjectID, subID); err != nil {
+				r.Errorf("subscribeWithProtoSchema: %v", err)
+				return
+			}
+			if got, want := buf.String(), "Alaska is abbreviated as AK"; !strings.Contains(got, want) {
+				r.Errorf("subscribeWithProtoSchema(%v) got %q, want to contain %q", encoding, got, want)
+			}
+		})
+	}
+}
+
+func TestPublishWithValidation(t *testing.T) {
+	tc := testutil.SystemTest(t)
+	ctx := context.Background()
+	client, err := pubsub.NewClient(ctx, tc.ProjectID)
+	if err != nil {
+		t.Fatalf("pubsub.NewClient: %v", err)
+	}
+	defer client.Close()
+	schemaClient, err := pubsub.NewSchemaClient(ctx, tc.ProjectID)
+	if err != nil {
+		t.Fatalf("pubsub.NewSchemaClient: %v", err)
+	}
+	defer schemaClient.Close()
+
+	schemaID := resourceID("go-validate-schema")
+	if err := createAvroSchema(ioutil.Discard, tc.ProjectID, schemaID, avroFile); err != nil {
+		t.Fatalf("createAvroSchema: %v", err)
+	}
+	defer schemaClient.DeleteSchema(ctx, schemaID)
+	topicID, _, cleanup := createTopicAndSub(ctx, t, client, schemaID, pubsub.EncodingJSON)
+	defer cleanup()
+
+	msgs := [][]byte{
+		[]byte(`{"name":"Alaska","post_abbr":"AK"}`),
+		[]byte(`{"name":"Alaska"}`),
+	}
+	for _, cache := range []bool{false, true} {
+		buf := new(bytes.Buffer)
+		if err := publishWithValidation(buf, tc.ProjectID, topicID, msgs, cache); err != nil {
+			t.Fatalf("publishWithValidation(cache=%v): %v", cache, err)
+		}
+		got := buf.String()
+		if want := "Rejected message 1"; !strings.Contains(got, want) {
+			t.Errorf("publishWithValidation(cache=%v) got %q, want to contain %q", cache, got, want)
+		}
+		if n := strings.Count(got, "Published a message"); n != 1 {
+			t.Errorf("publishWithValidation(cache=%v) published %d messages, want 1", cache, n)
+		}
+	}
+}
+
+func TestSchemaValidatorCache(t *testing.T) {
+	avsc, err := ioutil.ReadFile(avroFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	codec, err := goavro.NewCodec(string(avsc))
+	if err != nil {
+		t.Fatalf("goavro.NewCodec: %v", err)
+	}
+	binary, err := codec.BinaryFromNative(nil, map[string]interface{}{"name": "Alaska", "post_abbr": "AK"})
+	if err != nil {
+		t.Fatalf("BinaryFromNative: %v", err)
+	}
+
+	tests := []struct {
+		encoding pubsub.SchemaEncoding
+		data     []byte
+		valid    bool
+	}{
+		{pubsub.EncodingJSON, []byte(`{"name":"Alaska","post_abbr":"AK"}`), true},
+		{pubsub.EncodingJSON, []byte(`{"name":"Alaska"}`), false},
+		{pubsub.EncodingJSON, []byte(`{"name":"Alaska","post_abbr":7}`), false},
+		{pubsub.EncodingJSON, []byte(`{"name":"Alaska","post_abbr":"AK"} {}`), false},
+		{pubsub.EncodingBinary, binary, true},
+		{pubsub.EncodingBinary, binary[:3], false},
+	}
+	for _, tc := range tests {
+		// No client is needed: the cached codec validates locally.
+		v := &schemaValidator{encoding: tc.encoding, codec: codec}
+		err := v.validate(context.Background(), tc.data)
+		if tc.valid && err != nil {
+			t.Errorf("validate(%q) got %v, want valid", tc.data, err)
+		}
+		if !tc.valid && !errors.Is(err, errInvalidMessage) {
+			t.Errorf("validate(%q) got %v, want errInvalidMessage", tc.data, err)
+		}
+	}
+}
+
+// createTopicAndSub creates a topic bound to the schema with createTopicWithSchema,
+// and a subscription to it. Call cleanup to delete both.
+func createTopicAndSub(ctx context.Context, t *testing.T, client *pubsub.Client, schemaID string, encoding pubsub.SchemaEncoding) (topicID, subID string, cleanup func()) {
+	t.Helper()
+
+	topicID = resourceID("go-schema-topic")
+	if err := createTopicWithSchema(ioutil.Discard, client.Project(), topicID, schemaID, encoding); err != nil {
+		t.Fatalf("createTopicWithSchema: %v", err)
+	}
+	topic := client.Topic(topicID)
+
+	subID = resourceID("go-schema-sub")
+	sub, err := client.CreateSubscription(ctx, subID, pubsub.SubscriptionConfig{Topic: topic})
+	if err != nil {
+		topic.Delete(ctx)
+		t.Fatalf("CreateSubscription: %v", err)
+	}
+	return topicID, subID, func() {
+		sub.Delete(ctx)
+		topic.Delete(ctx)
+	}
+}
+
+func TestSchemaAdmin(t *testing.T) {
+	tc := testutil.SystemTest(t)
+	schemaID := resourceID("go-admin-schema")
+
+	buf := new(bytes.Buffer)
+	if err := validateAvroSchema(buf, tc.ProjectID, avroFile); err != nil {
+		t.Fatalf("validateAvroSchema: %v", err)
+	}
+	if err := validateAvroSchema(buf, tc.ProjectID, protoFile); err == nil {
+		t.Errorf("validateAvroSchema(%q) got nil error, want invalid schema", protoFile)
+	}
+
+	if err := createAvroSchema(ioutil.Discard, tc.ProjectID, schemaID, avroFile); err != nil {
+		t.Fatalf("createAvroSchema: %v", err)
+	}
+
+	buf.Reset()
+	if err := getSchema(buf, tc.ProjectID, schemaID); err != nil {
+		t.Fatalf("getSchema: %v", err)
+	}
+	if got, want := buf.String(), "post_abbr"; !strings.Contains(got, want) {
+		t.Errorf("getSchema got %q, want definition containing %q", got, want)
+	}
+
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: time.Second, Max: 2 * time.Second, Multiplier: 2, MaxAttempts: 5}, func(r *testutil.R) {
+		schemas, err := listSchemas(ioutil.Discard, tc.ProjectID)
+		if err != nil {
+			r.Errorf("listSchemas: %v", err)
+			return
+		}
+		for _, s := range schemas {
+			if strings.HasSuffix(s.Name, "/"+schemaID) {
+				return
+			}
+		}
+		r.Errorf("listSchemas got %d schemas, want one named %q", len(schemas), schemaID)
+	})
+
+	valid := []byte(`{"name":"Alaska","post_abbr":"AK"}`)
+	if err := validateMessage(ioutil.Discard, tc.ProjectID, schemaID, valid, pubsub.EncodingJSON); err != nil {
+		t.Errorf("validateMessage(%s): %v", valid, err)
+	}
+	invalid := []byte(`{"name":"Alaska"}`)
+	if err := validateMessage(ioutil.Discard, tc.ProjectID, schemaID, invalid, pubsub.EncodingJSON); err == nil {
+		t.Errorf("validateMessage(%s) got nil error, want invalid message", invalid)
+	}
+
+	buf.Reset()
+	if err := deleteSchema(buf, tc.ProjectID, schemaID); err != nil {
+		t.Fatalf("deleteSchema: %v", err)
+	}
+	if got, want := buf.String(), "Deleted schema"; !strings.Contains(got, want) {
+		t.Errorf("deleteSchema got %q, want to contain %q", got, want)
+	}
+}
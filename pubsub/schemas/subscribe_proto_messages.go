@@ -0,0 +1,83 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schemas
+
+// [START pubsub_subscribe_proto_messages]
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	statepb "github.com/GoogleCloudPlatform/golang-samples/pubsub/schemas/statepb"
+	"google.golang.org/api/option"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// subscribeWithProtoSchema receives messages published to a topic with a
+// protocol buffer schema and decodes them into the generated Go type.
+func subscribeWithProtoSchema(w io.Writer, projectID, subID string, opts ...option.ClientOption) error {
+	// projectID := "my-project-id"
+	// subID := "my-sub"
+	ctx := context.Background()
+	client, err := pubsub.NewClient(ctx, projectID, opts...)
+	if err != nil {
+		return fmt.Errorf("pubsub.NewClient: %w", err)
+	}
+	defer client.Close()
+
+	sub := client.Subscription(subID)
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	var mu sync.Mutex
+	err = sub.Receive(ctx, func(ctx context.Context, msg *pubsub.Message) {
+		mu.Lock()
+		defer mu.Unlock()
+		encoding := msg.Attributes["googclient_schemaencoding"]
+
+		state := &statepb.StateProto{}
+		if encoding == "BINARY" {
+			if err := proto.Unmarshal(msg.Data, state); err != nil {
+				fmt.Fprintf(w, "proto.Unmarshal err: %v\n", err)
+				msg.Nack()
+				return
+			}
+			fmt.Fprintf(w, "Received a binary-encoded message:\n%v\n", state)
+		} else if encoding == "JSON" {
+			if err := protojson.Unmarshal(msg.Data, state); err != nil {
+				fmt.Fprintf(w, "protojson.Unmarshal err: %v\n", err)
+				msg.Nack()
+				return
+			}
+			fmt.Fprintf(w, "Received a JSON-encoded message:\n%v\n", state)
+		} else {
+			fmt.Fprintf(w, "Unknown message type(%s), nacking\n", encoding)
+			msg.Nack()
+			return
+		}
+		fmt.Fprintf(w, "%s is abbreviated as %s\n", state.Name, state.PostAbbr)
+		msg.Ack()
+	})
+	if err != nil {
+		return fmt.Errorf("sub.Receive: %w", err)
+	}
+	return nil
+}
+
+// [END pubsub_subscribe_proto_messages]
@@ -0,0 +1,54 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schemas
+
+// [START pubsub_validate_message]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"google.golang.org/api/option"
+)
+
+// validateMessage checks that a message conforms to an existing schema
+// without publishing it, for example to test a producer before pointing it
+// at a topic.
+func validateMessage(w io.Writer, projectID, schemaID string, msg []byte, encoding pubsub.SchemaEncoding, opts ...option.ClientOption) error {
+	// projectID := "my-project-id"
+	// schemaID := "my-schema"
+	// msg := []byte(`{"name":"Alaska","post_abbr":"AK"}`)
+	// encoding := pubsub.EncodingJSON
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	client, err := pubsub.NewSchemaClient(ctx, projectID, opts...)
+	if err != nil {
+		return fmt.Errorf("pubsub.NewSchemaClient: %w", err)
+	}
+	defer client.Close()
+
+	// A message that does not match the schema is reported as an
+	// InvalidArgument error.
+	if _, err := client.ValidateMessageWithID(ctx, msg, encoding, schemaID); err != nil {
+		return fmt.Errorf("client.ValidateMessageWithID: %w", err)
+	}
+	fmt.Fprintf(w, "Message is valid for schema %s\n", schemaID)
+	return nil
+}
+
+// [END pubsub_validate_message]
@@ -0,0 +1,60 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schemas
+
+// [START pubsub_validate_schema]
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"google.golang.org/api/option"
+)
+
+// validateAvroSchema checks that an Avro schema definition is valid without
+// creating a schema resource.
+func validateAvroSchema(w io.Writer, projectID, avscFile string, opts ...option.ClientOption) error {
+	// projectID := "my-project-id"
+	// avscFile := "path/to/an/avro/schema/file(.avsc)/formatted/in/json"
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	client, err := pubsub.NewSchemaClient(ctx, projectID, opts...)
+	if err != nil {
+		return fmt.Errorf("pubsub.NewSchemaClient: %w", err)
+	}
+	defer client.Close()
+
+	avscSource, err := ioutil.ReadFile(avscFile)
+	if err != nil {
+		return fmt.Errorf("error reading from file: %s", avscFile)
+	}
+
+	config := pubsub.SchemaConfig{
+		Type:       pubsub.SchemaAvro,
+		Definition: string(avscSource),
+	}
+	// An invalid definition is reported as an InvalidArgument error.
+	if _, err := client.ValidateSchema(ctx, config); err != nil {
+		return fmt.Errorf("client.ValidateSchema: %w", err)
+	}
+	fmt.Fprintf(w, "Schema definition in %s is valid\n", avscFile)
+	return nil
+}
+
+// [END pubsub_validate_schema]
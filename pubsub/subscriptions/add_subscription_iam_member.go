@@ -0,0 +1,63 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package subscriptions
+
+// [START pubsub_add_subscription_iam_member]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"cloud.google.com/go/iam"
+	"cloud.google.com/go/pubsub"
+	"google.golang.org/api/option"
+)
+
+// addSubscriptionIAMMember grants a member a role on the subscription, for
+// example to let a service account receive and ack its messages. Granting
+// the role on the subscription rather than the project keeps the account
+// from reading any other subscription.
+func addSubscriptionIAMMember(w io.Writer, projectID, subID, member string, role iam.RoleName, opts ...option.ClientOption) error {
+	// projectID := "my-project-id"
+	// subID := "my-sub"
+	// member := "serviceAccount:subscriber@my-project-id.iam.gserviceaccount.com"
+	// role := iam.RoleName("roles/pubsub.subscriber")
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	client, err := pubsub.NewClient(ctx, projectID, opts...)
+	if err != nil {
+		return fmt.Errorf("pubsub.NewClient: %w", err)
+	}
+	defer client.Close()
+
+	sub := client.Subscription(subID)
+	policy, err := sub.IAM().Policy(ctx)
+	if err != nil {
+		return fmt.Errorf("Policy: %w", err)
+	}
+	policy.Add(member, role)
+	if err := sub.IAM().SetPolicy(ctx, policy); err != nil {
+		return fmt.Errorf("SetPolicy: %w", err)
+	}
+	// NOTE: It may be necessary to retry this operation if IAM policies are
+	// being modified concurrently. SetPolicy will return an error if the policy
+	// was modified since it was retrieved.
+	fmt.Fprintf(w, "Added %v with role %v to subscription %v\n", member, role, subID)
+	return nil
+}
+
+// [END pubsub_add_subscription_iam_member]
@@ -18,32 +18,36 @@ package subscriptions
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"cloud.google.com/go/iam"
 	"cloud.google.com/go/pubsub"
+	"google.golang.org/api/option"
 )
 
 // addUsers adds all IAM users to a subscription.
-func addUsers(projectID, subID string) error {
+func addUsers(projectID, subID string, opts ...option.ClientOption) error {
 	// projectID := "my-project-id"
 	// subID := "my-sub"
-	ctx := context.Background()
-	client, err := pubsub.NewClient(ctx, projectID)
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	client, err := pubsub.NewClient(ctx, projectID, opts...)
 	if err != nil {
-		return fmt.Errorf("pubsub.NewClient: %v", err)
+		return fmt.Errorf("pubsub.NewClient: %w", err)
 	}
 
 	sub := client.Subscription(subID)
 	policy, err := sub.IAM().Policy(ctx)
 	if err != nil {
-		return fmt.Errorf("Policy: %v", err)
+		return fmt.Errorf("Policy: %w", err)
 	}
 	// Other valid prefixes are "serviceAccount:", "user:"
 	// See the documentation for more values.
 	policy.Add(iam.AllUsers, iam.Viewer)
 	policy.Add("group:cloud-logs@google.com", iam.Editor)
 	if err := sub.IAM().SetPolicy(ctx, policy); err != nil {
-		return fmt.Errorf("SetPolicy: %v", err)
+		return fmt.Errorf("SetPolicy: %w", err)
 	}
 	// NOTE: It may be necessary to retry this operation if IAM policies are
 	// being modified concurrently. SetPolicy will return an error if the policy
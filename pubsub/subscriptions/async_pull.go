@@ -23,15 +23,16 @@ import (
 	"sync"
 
 	"cloud.google.com/go/pubsub"
+	"google.golang.org/api/option"
 )
 
-func pullMsgs(w io.Writer, projectID, subID string) error {
+func pullMsgs(w io.Writer, projectID, subID string, opts ...option.ClientOption) error {
 	// projectID := "my-project-id"
 	// subID := "my-sub"
 	ctx := context.Background()
-	client, err := pubsub.NewClient(ctx, projectID)
+	client, err := pubsub.NewClient(ctx, projectID, opts...)
 	if err != nil {
-		return fmt.Errorf("pubsub.NewClient: %v", err)
+		return fmt.Errorf("pubsub.NewClient: %w", err)
 	}
 
 	// Consume 10 messages.
@@ -50,7 +51,7 @@ func pullMsgs(w io.Writer, projectID, subID string) error {
 		}
 	})
 	if err != nil {
-		return fmt.Errorf("Receive: %v", err)
+		return fmt.Errorf("Receive: %w", err)
 	}
 	return nil
 }
@@ -22,15 +22,16 @@ import (
 	"time"
 
 	"cloud.google.com/go/pubsub"
+	"google.golang.org/api/option"
 )
 
-func pullMsgsCustomAttributes(w io.Writer, projectID, subID string) error {
+func pullMsgsCustomAttributes(w io.Writer, projectID, subID string, opts ...option.ClientOption) error {
 	// projectID := "my-project-id"
 	// subID := "my-sub"
 	ctx := context.Background()
-	client, err := pubsub.NewClient(ctx, projectID)
+	client, err := pubsub.NewClient(ctx, projectID, opts...)
 	if err != nil {
-		return fmt.Errorf("pubsub.NewClient: %v", err)
+		return fmt.Errorf("pubsub.NewClient: %w", err)
 	}
 	defer client.Close()
 
@@ -61,7 +62,7 @@ func pullMsgsCustomAttributes(w io.Writer, projectID, subID string) error {
 		cm <- msg
 	})
 	if err != nil {
-		return fmt.Errorf("Receive: %v", err)
+		return fmt.Errorf("Receive: %w", err)
 	}
 
 	return nil
@@ -22,16 +22,19 @@ import (
 	"time"
 
 	"cloud.google.com/go/pubsub"
+	"google.golang.org/api/option"
 )
 
-func create(w io.Writer, projectID, subID string, topic *pubsub.Topic) error {
+func create(w io.Writer, projectID, subID string, topic *pubsub.Topic, opts ...option.ClientOption) error {
 	// projectID := "my-project-id"
 	// subID := "my-sub"
 	// topic of type https://godoc.org/cloud.google.com/go/pubsub#Topic
-	ctx := context.Background()
-	client, err := pubsub.NewClient(ctx, projectID)
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	client, err := pubsub.NewClient(ctx, projectID, opts...)
 	if err != nil {
-		return fmt.Errorf("pubsub.NewClient: %v", err)
+		return fmt.Errorf("pubsub.NewClient: %w", err)
 	}
 
 	sub, err := client.CreateSubscription(ctx, subID, pubsub.SubscriptionConfig{
@@ -39,7 +42,7 @@ func create(w io.Writer, projectID, subID string, topic *pubsub.Topic) error {
 		AckDeadline: 20 * time.Second,
 	})
 	if err != nil {
-		return fmt.Errorf("CreateSubscription: %v", err)
+		return fmt.Errorf("CreateSubscription: %w", err)
 	}
 	fmt.Fprintf(w, "Created subscription: %v\n", sub)
 	return nil
@@ -22,17 +22,20 @@ import (
 	"time"
 
 	"cloud.google.com/go/pubsub"
+	"google.golang.org/api/option"
 )
 
-func createWithEndpoint(w io.Writer, projectID, subID string, topic *pubsub.Topic, endpoint string) error {
+func createWithEndpoint(w io.Writer, projectID, subID string, topic *pubsub.Topic, endpoint string, opts ...option.ClientOption) error {
 	// projectID := "my-project-id"
 	// subID := "my-sub"
 	// topic of type https://godoc.org/cloud.google.com/go/pubsub#Topic
 	// endpoint := "https://my-test-project.appspot.com/push"
-	ctx := context.Background()
-	client, err := pubsub.NewClient(ctx, projectID)
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	client, err := pubsub.NewClient(ctx, projectID, opts...)
 	if err != nil {
-		return fmt.Errorf("pubsub.NewClient: %v", err)
+		return fmt.Errorf("pubsub.NewClient: %w", err)
 	}
 
 	sub, err := client.CreateSubscription(ctx, subID, pubsub.SubscriptionConfig{
@@ -41,7 +44,7 @@ func createWithEndpoint(w io.Writer, projectID, subID string, topic *pubsub.Topi
 		PushConfig:  pubsub.PushConfig{Endpoint: endpoint},
 	})
 	if err != nil {
-		return fmt.Errorf("CreateSubscription: %v", err)
+		return fmt.Errorf("CreateSubscription: %w", err)
 	}
 	fmt.Fprintf(w, "Created subscription: %v\n", sub)
 	return nil
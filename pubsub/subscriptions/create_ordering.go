@@ -22,16 +22,19 @@ import (
 	"time"
 
 	"cloud.google.com/go/pubsub"
+	"google.golang.org/api/option"
 )
 
-func createWithOrdering(w io.Writer, projectID, subID string, topic *pubsub.Topic) error {
+func createWithOrdering(w io.Writer, projectID, subID string, topic *pubsub.Topic, opts ...option.ClientOption) error {
 	// projectID := "my-project-id"
 	// subID := "my-sub"
 	// topic of type https://godoc.org/cloud.google.com/go/pubsub#Topic
-	ctx := context.Background()
-	client, err := pubsub.NewClient(ctx, projectID)
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	client, err := pubsub.NewClient(ctx, projectID, opts...)
 	if err != nil {
-		return fmt.Errorf("pubsub.NewClient: %v", err)
+		return fmt.Errorf("pubsub.NewClient: %w", err)
 	}
 
 	// Message ordering can only be set when creating a subscription.
@@ -41,7 +44,7 @@ func createWithOrdering(w io.Writer, projectID, subID string, topic *pubsub.Topi
 		EnableMessageOrdering: true,
 	})
 	if err != nil {
-		return fmt.Errorf("CreateSubscription: %v", err)
+		return fmt.Errorf("CreateSubscription: %w", err)
 	}
 	fmt.Fprintf(w, "Created subscription: %v\n", sub)
 	return nil
@@ -0,0 +1,60 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package subscriptions
+
+// [START pubsub_create_subscription_with_retry_policy]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"google.golang.org/api/option"
+)
+
+// createSubWithRetryPolicy creates a subscription whose nacked and expired
+// messages are redelivered with exponential backoff. Without a retry policy,
+// Pub/Sub redelivers them as soon as possible.
+func createSubWithRetryPolicy(w io.Writer, projectID, subID string, topic *pubsub.Topic, opts ...option.ClientOption) error {
+	// projectID := "my-project-id"
+	// subID := "my-sub"
+	// topic of type https://godoc.org/cloud.google.com/go/pubsub#Topic
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	client, err := pubsub.NewClient(ctx, projectID, opts...)
+	if err != nil {
+		return fmt.Errorf("pubsub.NewClient: %w", err)
+	}
+	defer client.Close()
+
+	sub, err := client.CreateSubscription(ctx, subID, pubsub.SubscriptionConfig{
+		Topic: topic,
+		// The first redelivery waits at least MinimumBackoff; each
+		// following one waits longer, up to MaximumBackoff.
+		RetryPolicy: &pubsub.RetryPolicy{
+			MinimumBackoff: 10 * time.Second,
+			MaximumBackoff: 10 * time.Minute,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("CreateSubscription: %w", err)
+	}
+	fmt.Fprintf(w, "Created subscription with retry policy: %v\n", sub)
+	return nil
+}
+
+// [END pubsub_create_subscription_with_retry_policy]
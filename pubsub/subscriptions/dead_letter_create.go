@@ -22,18 +22,21 @@ import (
 	"time"
 
 	"cloud.google.com/go/pubsub"
+	"google.golang.org/api/option"
 )
 
 // createSubWithDeadLetter creates a subscription with a dead letter policy.
-func createSubWithDeadLetter(w io.Writer, projectID, subID string, topicID string, fullyQualifiedDeadLetterTopic string) error {
+func createSubWithDeadLetter(w io.Writer, projectID, subID string, topicID string, fullyQualifiedDeadLetterTopic string, opts ...option.ClientOption) error {
 	// projectID := "my-project-id"
 	// subID := "my-sub"
 	// topicID := "my-topic"
 	// fullyQualifiedDeadLetterTopic := "projects/my-project/topics/my-dead-letter-topic"
-	ctx := context.Background()
-	client, err := pubsub.NewClient(ctx, projectID)
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	client, err := pubsub.NewClient(ctx, projectID, opts...)
 	if err != nil {
-		return fmt.Errorf("pubsub.NewClient: %v", err)
+		return fmt.Errorf("pubsub.NewClient: %w", err)
 	}
 
 	topic := client.Topic(topicID)
@@ -49,7 +52,7 @@ func createSubWithDeadLetter(w io.Writer, projectID, subID string, topicID strin
 
 	sub, err := client.CreateSubscription(ctx, subID, subConfig)
 	if err != nil {
-		return fmt.Errorf("CreateSubscription: %v", err)
+		return fmt.Errorf("CreateSubscription: %w", err)
 	}
 	fmt.Fprintf(w, "Created subscription (%s) with dead letter topic (%s)\n", sub.String(), fullyQualifiedDeadLetterTopic)
 	fmt.Fprintln(w, "To process dead letter messages, remember to add a subscription to your dead letter topic.")
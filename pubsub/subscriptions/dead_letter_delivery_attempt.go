@@ -22,15 +22,16 @@ import (
 	"time"
 
 	"cloud.google.com/go/pubsub"
+	"google.golang.org/api/option"
 )
 
-func pullMsgsDeadLetterDeliveryAttempt(w io.Writer, projectID, subID string) error {
+func pullMsgsDeadLetterDeliveryAttempt(w io.Writer, projectID, subID string, opts ...option.ClientOption) error {
 	// projectID := "my-project-id"
 	// subID := "my-sub"
 	ctx := context.Background()
-	client, err := pubsub.NewClient(ctx, projectID)
+	client, err := pubsub.NewClient(ctx, projectID, opts...)
 	if err != nil {
-		return fmt.Errorf("pubsub.NewClient: %v", err)
+		return fmt.Errorf("pubsub.NewClient: %w", err)
 	}
 
 	// Receive messages for 10 seconds.
@@ -48,7 +49,7 @@ func pullMsgsDeadLetterDeliveryAttempt(w io.Writer, projectID, subID string) err
 		msg.Ack()
 	})
 	if err != nil {
-		return fmt.Errorf("got error in Receive: %v", err)
+		return fmt.Errorf("got error in Receive: %w", err)
 	}
 	return nil
 }
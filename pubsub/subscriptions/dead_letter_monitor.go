@@ -0,0 +1,111 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package subscriptions
+
+// [START pubsub_dead_letter_monitor]
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"google.golang.org/api/option"
+)
+
+// deadLetterKey groups dead-lettered messages for the report.
+type deadLetterKey struct {
+	// Subscription is the subscription the message was dead-lettered from.
+	Subscription string
+	// Error is the value of the error attribute, if the message has one.
+	Error string
+}
+
+// monitorDeadLetters receives from a subscription on a dead letter topic for
+// the given duration and reports how many messages were dead-lettered from
+// each subscription, grouped by the value of errorAttr.
+//
+// Pub/Sub adds the CloudPubSubDeadLetterSourceSubscription attribute when it
+// forwards a message to the dead letter topic. errorAttr is an attribute
+// your publishers or subscribers set themselves, for example to record why
+// processing failed.
+//
+// Messages are acked once counted, so use a subscription dedicated to
+// monitoring, not the one you re-drive messages from.
+func monitorDeadLetters(w io.Writer, projectID, subID, errorAttr string, duration time.Duration, opts ...option.ClientOption) (map[deadLetterKey]int, error) {
+	// projectID := "my-project-id"
+	// subID := "my-dead-letter-monitor-sub"
+	// errorAttr := "error"
+	// duration := time.Minute
+	ctx := context.Background()
+	client, err := pubsub.NewClient(ctx, projectID, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("pubsub.NewClient: %w", err)
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(ctx, duration)
+	defer cancel()
+
+	var mu sync.Mutex
+	counts := make(map[deadLetterKey]int)
+	err = client.Subscription(subID).Receive(ctx, func(_ context.Context, msg *pubsub.Message) {
+		key := deadLetterKey{
+			Subscription: msg.Attributes["CloudPubSubDeadLetterSourceSubscription"],
+			Error:        msg.Attributes[errorAttr],
+		}
+		if key.Subscription == "" {
+			key.Subscription = "(unknown)"
+		}
+		if key.Error == "" {
+			key.Error = "(none)"
+		}
+		mu.Lock()
+		counts[key]++
+		mu.Unlock()
+		msg.Ack()
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Receive: %w", err)
+	}
+
+	keys := make([]deadLetterKey, 0, len(counts))
+	for k := range counts {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if counts[keys[i]] != counts[keys[j]] {
+			return counts[keys[i]] > counts[keys[j]]
+		}
+		if keys[i].Subscription != keys[j].Subscription {
+			return keys[i].Subscription < keys[j].Subscription
+		}
+		return keys[i].Error < keys[j].Error
+	})
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "SUBSCRIPTION\tERROR\tCOUNT")
+	for _, k := range keys {
+		fmt.Fprintf(tw, "%s\t%s\t%d\n", k.Subscription, k.Error, counts[k])
+	}
+	if err := tw.Flush(); err != nil {
+		return nil, fmt.Errorf("Flush: %w", err)
+	}
+	return counts, nil
+}
+
+// [END pubsub_dead_letter_monitor]
@@ -0,0 +1,121 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package subscriptions
+
+// [START pubsub_dead_letter_redrive]
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"google.golang.org/api/option"
+)
+
+// redriveDeadLetters republishes up to max messages that were dead-lettered
+// from sourceSub back to topicID, usually the topic sourceSub is attached
+// to, once the problem that made them fail has been fixed.
+//
+// A message is acked on the dead letter subscription only after it has been
+// republished, so none are lost if republishing fails. Messages from other
+// subscriptions, and any beyond max, are nacked and stay on the dead letter
+// subscription.
+func redriveDeadLetters(w io.Writer, projectID, deadLetterSubID, sourceSub, topicID string, max int, duration time.Duration, opts ...option.ClientOption) (int, error) {
+	// projectID := "my-project-id"
+	// deadLetterSubID := "my-dead-letter-sub"
+	// sourceSub := "projects/my-project-id/subscriptions/my-sub"
+	// topicID := "my-topic"
+	// max := 100
+	// duration := time.Minute
+	ctx := context.Background()
+	client, err := pubsub.NewClient(ctx, projectID, opts...)
+	if err != nil {
+		return 0, fmt.Errorf("pubsub.NewClient: %w", err)
+	}
+	defer client.Close()
+
+	topic := client.Topic(topicID)
+	defer topic.Stop()
+
+	ctx, cancel := context.WithTimeout(ctx, duration)
+	defer cancel()
+
+	var (
+		mu         sync.Mutex
+		reserved   int
+		redriven   int
+		publishErr error
+	)
+	err = client.Subscription(deadLetterSubID).Receive(ctx, func(ctx context.Context, msg *pubsub.Message) {
+		if msg.Attributes["CloudPubSubDeadLetterSourceSubscription"] != sourceSub {
+			msg.Nack()
+			return
+		}
+		mu.Lock()
+		if reserved >= max {
+			mu.Unlock()
+			msg.Nack()
+			return
+		}
+		// Reserve a slot before publishing, so concurrent handlers do
+		// not republish more than max messages.
+		reserved++
+		mu.Unlock()
+
+		// Drop the attributes Pub/Sub added when dead-lettering, so that
+		// a message that fails again is reported from scratch.
+		attrs := make(map[string]string)
+		for k, v := range msg.Attributes {
+			if !strings.HasPrefix(k, "CloudPubSubDeadLetter") {
+				attrs[k] = v
+			}
+		}
+		_, err := topic.Publish(ctx, &pubsub.Message{
+			Data:       msg.Data,
+			Attributes: attrs,
+		}).Get(ctx)
+
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			reserved--
+			// Errors after the deadline only mean time ran out.
+			if publishErr == nil && ctx.Err() == nil {
+				publishErr = err
+			}
+			msg.Nack()
+			return
+		}
+		redriven++
+		fmt.Fprintf(w, "Republished dead-lettered message %v to %v\n", msg.ID, topicID)
+		msg.Ack()
+		if redriven >= max {
+			cancel()
+		}
+	})
+	if err != nil {
+		return redriven, fmt.Errorf("Receive: %w", err)
+	}
+	if publishErr != nil {
+		return redriven, fmt.Errorf("Publish: %w", publishErr)
+	}
+	fmt.Fprintf(w, "Republished %d messages from %v\n", redriven, sourceSub)
+	return redriven, nil
+}
+
+// [END pubsub_dead_letter_redrive]
@@ -19,25 +19,29 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"time"
 
 	"cloud.google.com/go/pubsub"
+	"google.golang.org/api/option"
 )
 
 // removeDeadLetterTopic removes the dead letter policy from a subscription.
-func removeDeadLetterTopic(w io.Writer, projectID, subID string) error {
+func removeDeadLetterTopic(w io.Writer, projectID, subID string, opts ...option.ClientOption) error {
 	// projectID := "my-project-id"
 	// subID := "my-sub"
-	ctx := context.Background()
-	client, err := pubsub.NewClient(ctx, projectID)
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	client, err := pubsub.NewClient(ctx, projectID, opts...)
 	if err != nil {
-		return fmt.Errorf("pubsub.NewClient: %v", err)
+		return fmt.Errorf("pubsub.NewClient: %w", err)
 	}
 
 	subConfig, err := client.Subscription(subID).Update(ctx, pubsub.SubscriptionConfigToUpdate{
 		DeadLetterPolicy: &pubsub.DeadLetterPolicy{},
 	})
 	if err != nil {
-		return fmt.Errorf("Update: %v", err)
+		return fmt.Errorf("Update: %w", err)
 	}
 	fmt.Fprintf(w, "Updated subscription config: %+v\n", subConfig)
 	return nil
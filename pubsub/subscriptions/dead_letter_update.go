@@ -19,19 +19,23 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"time"
 
 	"cloud.google.com/go/pubsub"
+	"google.golang.org/api/option"
 )
 
 // updateDeadLetter updates an existing subscription with a dead letter policy.
-func updateDeadLetter(w io.Writer, projectID, subID string, fullyQualifiedDeadLetterTopic string) error {
+func updateDeadLetter(w io.Writer, projectID, subID string, fullyQualifiedDeadLetterTopic string, opts ...option.ClientOption) error {
 	// projectID := "my-project-id"
 	// subID := "my-sub"
 	// fullyQualifiedDeadLetterTopic := "projects/my-project/topics/my-dead-letter-topic"
-	ctx := context.Background()
-	client, err := pubsub.NewClient(ctx, projectID)
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	client, err := pubsub.NewClient(ctx, projectID, opts...)
 	if err != nil {
-		return fmt.Errorf("pubsub.NewClient: %v", err)
+		return fmt.Errorf("pubsub.NewClient: %w", err)
 	}
 
 	updateConfig := pubsub.SubscriptionConfigToUpdate{
@@ -43,7 +47,7 @@ func updateDeadLetter(w io.Writer, projectID, subID string, fullyQualifiedDeadLe
 
 	subConfig, err := client.Subscription(subID).Update(ctx, updateConfig)
 	if err != nil {
-		return fmt.Errorf("Update: %v", err)
+		return fmt.Errorf("Update: %w", err)
 	}
 	fmt.Fprintf(w, "Updated subscription config: %+v\n", subConfig)
 	return nil
@@ -19,20 +19,24 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"time"
 
 	"cloud.google.com/go/pubsub"
+	"google.golang.org/api/option"
 )
 
-func detachSubscription(w io.Writer, projectID, subName string) error {
+func detachSubscription(w io.Writer, projectID, subName string, opts ...option.ClientOption) error {
 	// projectID is the project which contains the topic you manage.
 	// This might differ from the project which contains the subscription
 	// you wish to detach, which can exist in any GCP project.
 	// projectID := "my-project-id"
 	// subName := "projects/some-project/subscriptions/my-sub"
-	ctx := context.Background()
-	client, err := pubsub.NewClient(ctx, projectID)
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	client, err := pubsub.NewClient(ctx, projectID, opts...)
 	if err != nil {
-		return fmt.Errorf("pubsub.NewClient: %v", err)
+		return fmt.Errorf("pubsub.NewClient: %w", err)
 	}
 	defer client.Close()
 
@@ -41,7 +45,7 @@ func detachSubscription(w io.Writer, projectID, subName string) error {
 	// `pubsub.topics.detachSubscription` role on the topic.
 	_, err = client.DetachSubscription(ctx, subName)
 	if err != nil {
-		return fmt.Errorf("detach subscription failed: %v", err)
+		return fmt.Errorf("detach subscription failed: %w", err)
 	}
 
 	fmt.Fprintf(w, "Detached subscription %s", subName)
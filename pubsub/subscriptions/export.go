@@ -0,0 +1,92 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package subscriptions
+
+import (
+	"io"
+	"time"
+
+	"cloud.google.com/go/iam"
+	"cloud.google.com/go/pubsub"
+	"google.golang.org/api/option"
+)
+
+// The functions below export the samples that cmd/samplectl is built from,
+// so that the tool runs the same code as the documentation.
+
+// CreateSubWithDeadLetter runs the pubsub_dead_letter_create_subscription sample.
+func CreateSubWithDeadLetter(w io.Writer, projectID, subID string, topicID string, fullyQualifiedDeadLetterTopic string, opts ...option.ClientOption) error {
+	return createSubWithDeadLetter(w, projectID, subID, topicID, fullyQualifiedDeadLetterTopic, opts...)
+}
+
+// RemoveDeadLetterTopic runs the pubsub_dead_letter_remove sample.
+func RemoveDeadLetterTopic(w io.Writer, projectID, subID string, opts ...option.ClientOption) error {
+	return removeDeadLetterTopic(w, projectID, subID, opts...)
+}
+
+// UpdateDeadLetter runs the pubsub_dead_letter_update_subscription sample.
+func UpdateDeadLetter(w io.Writer, projectID, subID string, fullyQualifiedDeadLetterTopic string, opts ...option.ClientOption) error {
+	return updateDeadLetter(w, projectID, subID, fullyQualifiedDeadLetterTopic, opts...)
+}
+
+// Delete runs the pubsub_delete_subscription sample.
+func Delete(w io.Writer, projectID, subID string, opts ...option.ClientOption) error {
+	return delete(w, projectID, subID, opts...)
+}
+
+// DetachSubscription runs the pubsub_detach_subscription sample.
+func DetachSubscription(w io.Writer, projectID, subName string, opts ...option.ClientOption) error {
+	return detachSubscription(w, projectID, subName, opts...)
+}
+
+// List runs the pubsub_list_subscriptions sample.
+func List(projectID string, opts ...option.ClientOption) ([]*pubsub.Subscription, error) {
+	return list(projectID, opts...)
+}
+
+// Policy runs the pubsub_get_subscription_policy sample.
+func Policy(w io.Writer, projectID, subID string, opts ...option.ClientOption) (*iam.Policy, error) {
+	return policy(w, projectID, subID, opts...)
+}
+
+// PullMsgsSync runs the pubsub_subscriber_sync_pull sample.
+func PullMsgsSync(w io.Writer, projectID, subID string, opts ...option.ClientOption) error {
+	return pullMsgsSync(w, projectID, subID, opts...)
+}
+
+// TestPermissions runs the pubsub_test_subscription_permissions sample.
+func TestPermissions(w io.Writer, projectID, subID string, opts ...option.ClientOption) ([]string, error) {
+	return testPermissions(w, projectID, subID, opts...)
+}
+
+// UpdateEndpoint runs the pubsub_update_push_configuration sample.
+func UpdateEndpoint(w io.Writer, projectID, subID string, endpoint string, opts ...option.ClientOption) error {
+	return updateEndpoint(w, projectID, subID, endpoint, opts...)
+}
+
+// UpdateAckDeadline runs the pubsub_update_subscription_ack_deadline sample.
+func UpdateAckDeadline(w io.Writer, projectID, subID string, ackDeadline time.Duration, opts ...option.ClientOption) error {
+	return updateAckDeadline(w, projectID, subID, ackDeadline, opts...)
+}
+
+// UpdateExpirationPolicy runs the pubsub_update_subscription_expiration_policy sample.
+func UpdateExpirationPolicy(w io.Writer, projectID, subID string, ttl time.Duration, opts ...option.ClientOption) error {
+	return updateExpirationPolicy(w, projectID, subID, ttl, opts...)
+}
+
+// UpdateMessageRetention runs the pubsub_update_subscription_message_retention sample.
+func UpdateMessageRetention(w io.Writer, projectID, subID string, retention time.Duration, retainAcked bool, opts ...option.ClientOption) error {
+	return updateMessageRetention(w, projectID, subID, retention, retainAcked, opts...)
+}
@@ -19,23 +19,27 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"time"
 
 	"cloud.google.com/go/iam"
 	"cloud.google.com/go/pubsub"
+	"google.golang.org/api/option"
 )
 
-func policy(w io.Writer, projectID, subID string) (*iam.Policy, error) {
+func policy(w io.Writer, projectID, subID string, opts ...option.ClientOption) (*iam.Policy, error) {
 	// projectID := "my-project-id"
 	// subID := "my-sub"
-	ctx := context.Background()
-	client, err := pubsub.NewClient(ctx, projectID)
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	client, err := pubsub.NewClient(ctx, projectID, opts...)
 	if err != nil {
-		return nil, fmt.Errorf("pubsub.NewClient: %v", err)
+		return nil, fmt.Errorf("pubsub.NewClient: %w", err)
 	}
 
 	policy, err := client.Subscription(subID).IAM().Policy(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("Subscription: %v", err)
+		return nil, fmt.Errorf("Subscription: %w", err)
 	}
 	for _, role := range policy.Roles() {
 		fmt.Fprintf(w, "%q: %q\n", role, policy.Members(role))
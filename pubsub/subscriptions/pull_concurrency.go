@@ -24,15 +24,16 @@ import (
 	"time"
 
 	"cloud.google.com/go/pubsub"
+	"google.golang.org/api/option"
 )
 
-func pullMsgsConcurrenyControl(w io.Writer, projectID, subID string) error {
+func pullMsgsConcurrenyControl(w io.Writer, projectID, subID string, opts ...option.ClientOption) error {
 	// projectID := "my-project-id"
 	// subID := "my-sub"
 	ctx := context.Background()
-	client, err := pubsub.NewClient(ctx, projectID)
+	client, err := pubsub.NewClient(ctx, projectID, opts...)
 	if err != nil {
-		return fmt.Errorf("pubsub.NewClient: %v", err)
+		return fmt.Errorf("pubsub.NewClient: %w", err)
 	}
 	defer client.Close()
 
@@ -59,7 +60,7 @@ func pullMsgsConcurrenyControl(w io.Writer, projectID, subID string) error {
 		msg.Ack()
 	})
 	if err != nil {
-		return fmt.Errorf("pubsub: Receive returned error: %v", err)
+		return fmt.Errorf("pubsub: Receive returned error: %w", err)
 	}
 	fmt.Fprintf(w, "Received %d messages\n", counter)
 
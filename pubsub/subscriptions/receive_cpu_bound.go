@@ -0,0 +1,90 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package subscriptions
+
+// [START pubsub_subscriber_concurrency_cpu_bound]
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"runtime"
+	"sync/atomic"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"google.golang.org/api/option"
+)
+
+// receiveCPUBound runs a CPU-bound handler over the messages of a
+// subscription for the given duration and reports the throughput.
+//
+// Two settings control concurrency, and they are easy to mix up:
+//
+//   - NumGoroutines is the number of streams pulling messages from Pub/Sub.
+//     It does not limit how many handlers run at once. One stream can
+//     deliver thousands of messages per second, so raise it only when
+//     pulling, not processing, is the bottleneck.
+//   - MaxOutstandingMessages limits how many messages are being handled at
+//     once. Each message is handled in its own goroutine, so this is the
+//     handler parallelism.
+//
+// For CPU-bound work, running more handlers than there are CPUs only adds
+// scheduling overhead and holds messages longer, so MaxOutstandingMessages
+// is set to runtime.NumCPU().
+func receiveCPUBound(w io.Writer, projectID, subID string, numGoroutines int, duration time.Duration, opts ...option.ClientOption) (processed int64, err error) {
+	// projectID := "my-project-id"
+	// subID := "my-sub"
+	// numGoroutines := 1
+	// duration := 30 * time.Second
+	ctx := context.Background()
+	client, err := pubsub.NewClient(ctx, projectID, opts...)
+	if err != nil {
+		return 0, fmt.Errorf("pubsub.NewClient: %w", err)
+	}
+	defer client.Close()
+
+	sub := client.Subscription(subID)
+	sub.ReceiveSettings.NumGoroutines = numGoroutines
+	sub.ReceiveSettings.MaxOutstandingMessages = runtime.NumCPU()
+
+	ctx, cancel := context.WithTimeout(ctx, duration)
+	defer cancel()
+
+	start := time.Now()
+	err = sub.Receive(ctx, func(_ context.Context, msg *pubsub.Message) {
+		work(msg.Data)
+		atomic.AddInt64(&processed, 1)
+		msg.Ack()
+	})
+	if err != nil {
+		return processed, fmt.Errorf("Receive: %w", err)
+	}
+	elapsed := time.Since(start)
+	fmt.Fprintf(w, "Processed %d messages in %v with %d pulling goroutines and %d handlers: %.1f msgs/s\n",
+		processed, elapsed.Round(time.Millisecond), numGoroutines, runtime.NumCPU(), float64(processed)/elapsed.Seconds())
+	return processed, nil
+}
+
+// work stands in for CPU-bound processing by hashing data repeatedly.
+func work(data []byte) [sha256.Size]byte {
+	sum := sha256.Sum256(data)
+	for i := 0; i < 100000; i++ {
+		sum = sha256.Sum256(sum[:])
+	}
+	return sum
+}
+
+// [END pubsub_subscriber_concurrency_cpu_bound]
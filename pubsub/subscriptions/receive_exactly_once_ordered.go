@@ -0,0 +1,124 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package subscriptions
+
+// [START pubsub_subscriber_exactly_once_ordered]
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"google.golang.org/api/option"
+)
+
+// ledger stands in for the database the side effects are written to. Each
+// commit stores the effect together with the ID of the message that caused
+// it, in one transaction, so a message that is delivered again is recognized
+// and not applied twice.
+type ledger struct {
+	mu sync.Mutex
+	// applied holds the IDs of the messages already committed.
+	applied map[string]bool
+	// entries holds the data committed for each ordering key, in order.
+	entries map[string][]string
+}
+
+// commit applies the message unless it was applied before, and reports
+// whether it did.
+func (l *ledger) commit(orderingKey, msgID, data string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.applied[msgID] {
+		return false
+	}
+	l.applied[msgID] = true
+	l.entries[orderingKey] = append(l.entries[orderingKey], data)
+	return true
+}
+
+// receiveExactlyOnceOrdered receives from a subscription created with both
+// EnableExactlyOnceDelivery and EnableMessageOrdering, and applies each
+// message to a ledger once, in order for each ordering key.
+//
+// The two features work together as follows:
+//
+//   - Ordering makes the client call the handler for one message of a key
+//     at a time. Waiting for the ack result inside the handler keeps the
+//     next message of the key from being processed until this one is known
+//     to be acknowledged.
+//   - Exactly-once delivery means that a message whose ack succeeded is never
+//     delivered again. It does not cover a message whose ack failed, for
+//     example because its ack deadline expired first: that message is
+//     delivered again, and with ordering so is every later message of the
+//     same key, including ones already processed. The side effects must
+//     therefore still be idempotent, which the ledger's message IDs provide.
+//   - Nacking a message also redelivers the later messages of its key, so
+//     nack only to retry, never to skip a message.
+func receiveExactlyOnceOrdered(w io.Writer, projectID, subID string, duration time.Duration, opts ...option.ClientOption) (map[string][]string, error) {
+	// projectID := "my-project-id"
+	// subID := "my-sub"
+	// duration := 30 * time.Second
+	ctx := context.Background()
+	client, err := pubsub.NewClient(ctx, projectID, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("pubsub.NewClient: %w", err)
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(ctx, duration)
+	defer cancel()
+
+	l := &ledger{applied: make(map[string]bool), entries: make(map[string][]string)}
+	// The handlers for different keys run concurrently and share w.
+	var mu sync.Mutex
+	err = client.Subscription(subID).Receive(ctx, func(ctx context.Context, msg *pubsub.Message) {
+		if !l.commit(msg.OrderingKey, msg.ID, string(msg.Data)) {
+			mu.Lock()
+			fmt.Fprintf(w, "Message %v was already applied, acking it again\n", msg.ID)
+			mu.Unlock()
+		}
+		// Get blocks until the service confirms the ack. Only a
+		// successful ack guarantees the message won't come back.
+		status, err := msg.AckWithResult().Get(ctx)
+		if err != nil {
+			// The message, and the later ones for its key, will be
+			// redelivered; the ledger skips those already applied.
+			mu.Lock()
+			fmt.Fprintf(w, "Ack of message %v failed with status %v: %v\n", msg.ID, status, err)
+			mu.Unlock()
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Receive: %w", err)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	keys := make([]string, 0, len(l.entries))
+	for k := range l.entries {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fmt.Fprintf(w, "Ordering key %q: %v\n", k, l.entries[k])
+	}
+	return l.entries, nil
+}
+
+// [END pubsub_subscriber_exactly_once_ordered]
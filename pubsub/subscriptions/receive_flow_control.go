@@ -0,0 +1,85 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package subscriptions
+
+// [START pubsub_subscriber_flow_control_backpressure]
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync/atomic"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"google.golang.org/api/option"
+)
+
+// receiveWithFlowControl receives messages with a slow handler and reports
+// the largest number of messages that were being handled at the same time.
+// Flow control keeps that number at or below maxOutstanding: once the limit
+// is reached, Receive stops taking new messages until a handler acks or
+// nacks, and the rest of the backlog stays in Pub/Sub.
+func receiveWithFlowControl(w io.Writer, projectID, subID string, maxOutstanding int, handlerDelay, duration time.Duration, opts ...option.ClientOption) (peak int32, err error) {
+	// projectID := "my-project-id"
+	// subID := "my-sub"
+	// maxOutstanding := 5
+	// handlerDelay := time.Second
+	// duration := 30 * time.Second
+	ctx := context.Background()
+	client, err := pubsub.NewClient(ctx, projectID, opts...)
+	if err != nil {
+		return 0, fmt.Errorf("pubsub.NewClient: %w", err)
+	}
+	defer client.Close()
+
+	sub := client.Subscription(subID)
+	// Limit outstanding messages by count and by size; whichever is hit
+	// first applies backpressure. A negative value disables a limit.
+	sub.ReceiveSettings.MaxOutstandingMessages = maxOutstanding
+	sub.ReceiveSettings.MaxOutstandingBytes = 1024 * 1024 // 1 MiB
+	// By default the limits are also sent to the server, so the stream
+	// does not deliver more than the client can hold. Environments that do
+	// not support server-side flow control, such as older emulator
+	// versions, can set UseLegacyFlowControl to enforce the limits in the
+	// client only. A large batch can then briefly exceed them, and the
+	// extra messages wait in the client, using up their ack deadline.
+	sub.ReceiveSettings.UseLegacyFlowControl = false
+
+	ctx, cancel := context.WithTimeout(ctx, duration)
+	defer cancel()
+
+	var inFlight, handled int32
+	err = sub.Receive(ctx, func(_ context.Context, msg *pubsub.Message) {
+		n := atomic.AddInt32(&inFlight, 1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		// Simulate a slow handler.
+		time.Sleep(handlerDelay)
+		atomic.AddInt32(&inFlight, -1)
+		atomic.AddInt32(&handled, 1)
+		msg.Ack()
+	})
+	if err != nil {
+		return peak, fmt.Errorf("Receive: %w", err)
+	}
+	fmt.Fprintf(w, "Handled %d messages in %v, at most %d at a time (limit %d)\n", handled, duration, peak, maxOutstanding)
+	return peak, nil
+}
+
+// [END pubsub_subscriber_flow_control_backpressure]
@@ -0,0 +1,95 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package subscriptions
+
+// [START pubsub_subscriber_lease_management]
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"google.golang.org/api/option"
+)
+
+// receiveWithLeaseManagement receives messages whose processing takes
+// processingTime and reports how many times each message was delivered.
+//
+// While a message is being processed, the client keeps extending its ack
+// deadline (its lease) so that Pub/Sub does not redeliver it. It stops once
+// the message has been held for MaxExtension. If processing takes longer
+// than that, the lease expires after the subscription's ack deadline and the
+// message is redelivered, possibly to another subscriber, while the first
+// delivery is still being processed. The late ack of the first delivery is
+// then not guaranteed to have any effect.
+func receiveWithLeaseManagement(w io.Writer, projectID, subID string, maxExtension, processingTime, duration time.Duration, opts ...option.ClientOption) (map[string]int, error) {
+	// projectID := "my-project-id"
+	// subID := "my-sub"
+	// maxExtension := 10 * time.Minute
+	// processingTime := 2 * time.Minute
+	// duration := 5 * time.Minute
+	ctx := context.Background()
+	client, err := pubsub.NewClient(ctx, projectID, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("pubsub.NewClient: %w", err)
+	}
+	defer client.Close()
+
+	sub := client.Subscription(subID)
+	// MaxExtension is the total time the client holds a message before
+	// giving it up. Set it above the longest processing time you expect.
+	sub.ReceiveSettings.MaxExtension = maxExtension
+	// MaxExtensionPeriod caps each individual extension. A shorter period
+	// means a message held by a crashed subscriber is redelivered sooner,
+	// at the cost of more extension requests.
+	sub.ReceiveSettings.MaxExtensionPeriod = 30 * time.Second
+	// MinExtensionPeriod is the shortest extension the client requests.
+	// By default the client adapts it to how quickly messages are acked.
+	sub.ReceiveSettings.MinExtensionPeriod = 10 * time.Second
+
+	ctx, cancel := context.WithTimeout(ctx, duration)
+	defer cancel()
+
+	var mu sync.Mutex
+	deliveries := make(map[string]int)
+	err = sub.Receive(ctx, func(ctx context.Context, msg *pubsub.Message) {
+		mu.Lock()
+		deliveries[msg.ID]++
+		fmt.Fprintf(w, "Processing message %v (delivery %d)\n", msg.ID, deliveries[msg.ID])
+		mu.Unlock()
+
+		// Simulate long-running work. Stop early if Receive is shutting
+		// down; the message will then be redelivered.
+		select {
+		case <-time.After(processingTime):
+			msg.Ack()
+		case <-ctx.Done():
+			msg.Nack()
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Receive: %w", err)
+	}
+	for id, n := range deliveries {
+		if n > 1 {
+			fmt.Fprintf(w, "Message %v was delivered %d times: processing outlasted MaxExtension\n", id, n)
+		}
+	}
+	return deliveries, nil
+}
+
+// [END pubsub_subscriber_lease_management]
@@ -0,0 +1,120 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package subscriptions
+
+// [START pubsub_subscriber_nack_and_ack_patterns]
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"google.golang.org/api/option"
+)
+
+// errTransient marks failures that may succeed if the message is retried,
+// such as a dependency that is briefly unavailable.
+var errTransient = errors.New("transient failure")
+
+// orderStore records which orders have been applied. Pub/Sub delivers
+// messages at least once, so applying an order must be idempotent: a
+// redelivered message for an order that was already applied is acked
+// without applying it again.
+type orderStore struct {
+	mu      sync.Mutex
+	applied map[string]bool
+	// failed tracks orders whose first attempt failed, to simulate a
+	// flaky dependency.
+	failed map[string]bool
+}
+
+// apply applies the order once. The first attempt for every order fails
+// with errTransient.
+func (s *orderStore) apply(orderID string) (applied bool, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.applied[orderID] {
+		return false, nil
+	}
+	if !s.failed[orderID] {
+		s.failed[orderID] = true
+		return false, errTransient
+	}
+	s.applied[orderID] = true
+	return true, nil
+}
+
+// receiveWithNackPatterns shows how a handler should settle each message:
+//
+//   - Nack on a transient failure so that Pub/Sub redelivers the message.
+//     Without a retry policy on the subscription the message comes back
+//     immediately, which can turn an outage into a hot loop; with one,
+//     redelivery waits for the policy's backoff.
+//   - Ack after the side effect is done, never before, so a crash in
+//     between leads to a redelivery rather than a lost message.
+//   - Ack messages that can never be processed, such as malformed ones,
+//     instead of nacking them forever. Log them, or use a dead letter topic
+//     to keep them.
+func receiveWithNackPatterns(w io.Writer, projectID, subID string, duration time.Duration, opts ...option.ClientOption) (applied int, err error) {
+	// projectID := "my-project-id"
+	// subID := "my-sub"
+	// duration := time.Minute
+	ctx := context.Background()
+	client, err := pubsub.NewClient(ctx, projectID, opts...)
+	if err != nil {
+		return 0, fmt.Errorf("pubsub.NewClient: %w", err)
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(ctx, duration)
+	defer cancel()
+
+	store := &orderStore{applied: make(map[string]bool), failed: make(map[string]bool)}
+	var mu sync.Mutex
+	err = client.Subscription(subID).Receive(ctx, func(_ context.Context, msg *pubsub.Message) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		orderID := msg.Attributes["order_id"]
+		if orderID == "" {
+			fmt.Fprintf(w, "Dropping malformed message %v: no order_id\n", msg.ID)
+			msg.Ack()
+			return
+		}
+		ok, err := store.apply(orderID)
+		if err == errTransient {
+			fmt.Fprintf(w, "Nacking message %v for order %v: %v\n", msg.ID, orderID, err)
+			msg.Nack()
+			return
+		}
+		if !ok {
+			fmt.Fprintf(w, "Order %v was already applied, acking duplicate %v\n", orderID, msg.ID)
+			msg.Ack()
+			return
+		}
+		applied++
+		fmt.Fprintf(w, "Applied order %v\n", orderID)
+		msg.Ack()
+	})
+	if err != nil {
+		return applied, fmt.Errorf("Receive: %w", err)
+	}
+	return applied, nil
+}
+
+// [END pubsub_subscriber_nack_and_ack_patterns]
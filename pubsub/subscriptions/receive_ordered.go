@@ -0,0 +1,82 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package subscriptions
+
+// [START pubsub_subscriber_ordered_receive]
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"google.golang.org/api/option"
+)
+
+// receiveOrdered receives from a subscription created with
+// EnableMessageOrdering and records the order messages arrived in for each
+// ordering key.
+//
+// The client calls the handler for one message of a given ordering key at a
+// time, and only after the previous message with that key was handled, so
+// per-key processing is sequential without any locking. Messages with
+// different keys are still handled concurrently, so throughput comes from
+// having many keys: a single hot key is processed one message at a time no
+// matter how many goroutines the subscriber has.
+func receiveOrdered(w io.Writer, projectID, subID string, duration time.Duration, opts ...option.ClientOption) (map[string][]string, error) {
+	// projectID := "my-project-id"
+	// subID := "my-sub"
+	// duration := 30 * time.Second
+	ctx := context.Background()
+	client, err := pubsub.NewClient(ctx, projectID, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("pubsub.NewClient: %w", err)
+	}
+	defer client.Close()
+
+	sub := client.Subscription(subID)
+	ctx, cancel := context.WithTimeout(ctx, duration)
+	defer cancel()
+
+	// The mutex protects the map shared by handlers for different keys;
+	// handlers for the same key never overlap.
+	var mu sync.Mutex
+	received := make(map[string][]string)
+	err = sub.Receive(ctx, func(ctx context.Context, msg *pubsub.Message) {
+		// Process the message. Returning without acking would block
+		// further messages for this key until the ack deadline expires.
+		mu.Lock()
+		received[msg.OrderingKey] = append(received[msg.OrderingKey], string(msg.Data))
+		mu.Unlock()
+		msg.Ack()
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Receive: %w", err)
+	}
+
+	keys := make([]string, 0, len(received))
+	for k := range received {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fmt.Fprintf(w, "Ordering key %q: %v\n", k, received[k])
+	}
+	return received, nil
+}
+
+// [END pubsub_subscriber_ordered_receive]
@@ -0,0 +1,94 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package subscriptions
+
+// [START pubsub_create_and_receive_with_settings]
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync/atomic"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"google.golang.org/api/option"
+)
+
+// receiveWithSettings creates a pull subscription to topic and receives from
+// it for the given duration, with flow control tuned so that the subscriber
+// never holds more than 100 messages or 10 MiB of unprocessed data.
+// Messages that are processed are acked; messages that cannot be processed
+// are nacked so that Pub/Sub redelivers them, possibly to another subscriber.
+func receiveWithSettings(w io.Writer, projectID, subID string, topic *pubsub.Topic, duration time.Duration, opts ...option.ClientOption) (acked int32, err error) {
+	// projectID := "my-project-id"
+	// subID := "my-sub"
+	// topic of type https://godoc.org/cloud.google.com/go/pubsub#Topic
+	// duration := 30 * time.Second
+	ctx := context.Background()
+	client, err := pubsub.NewClient(ctx, projectID, opts...)
+	if err != nil {
+		return 0, fmt.Errorf("pubsub.NewClient: %w", err)
+	}
+	defer client.Close()
+
+	sub, err := client.CreateSubscription(ctx, subID, pubsub.SubscriptionConfig{
+		Topic: topic,
+		// Messages that are not acked or nacked within the deadline are
+		// redelivered. The client extends the deadline automatically
+		// while a message is being processed.
+		AckDeadline: 20 * time.Second,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("CreateSubscription: %w", err)
+	}
+
+	// Receive pauses pulling once either limit is reached, and resumes as
+	// messages are acked or nacked.
+	sub.ReceiveSettings.MaxOutstandingMessages = 100
+	sub.ReceiveSettings.MaxOutstandingBytes = 10 * 1024 * 1024 // 10 MiB
+
+	// Receive runs until its context is done, so bound how long to run.
+	ctx, cancel := context.WithTimeout(ctx, duration)
+	defer cancel()
+
+	var nacked int32
+	// The callback is called concurrently, so only use atomic counters or
+	// otherwise synchronized state in it.
+	err = sub.Receive(ctx, func(ctx context.Context, msg *pubsub.Message) {
+		if err := process(msg); err != nil {
+			atomic.AddInt32(&nacked, 1)
+			msg.Nack()
+			return
+		}
+		atomic.AddInt32(&acked, 1)
+		msg.Ack()
+	})
+	if err != nil {
+		return acked, fmt.Errorf("Receive: %w", err)
+	}
+	fmt.Fprintf(w, "Acked %d messages and nacked %d messages in %v\n", acked, nacked, duration)
+	return acked, nil
+}
+
+// process handles a message. Replace it with your own processing.
+func process(msg *pubsub.Message) error {
+	if len(msg.Data) == 0 {
+		return errors.New("empty message")
+	}
+	return nil
+}
+
+// [END pubsub_create_and_receive_with_settings]
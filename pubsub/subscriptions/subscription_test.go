@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io/ioutil"
 	"strings"
 	"sync"
 	"testing"
@@ -32,8 +33,11 @@ import (
 	"github.com/google/go-cmp/cmp"
 )
 
-var topicID string
-var subID string
+// topicID and subID are shared by the tests, unique to this run.
+var (
+	topicID = testutil.UniqueName("sub-topic")
+	subID   = testutil.UniqueName("sub")
+)
 
 // once guards cleanup related operations in setup. No need to set up and tear
 // down every time, so this speeds things up.
@@ -41,10 +45,8 @@ var once sync.Once
 
 func setup(t *testing.T) *pubsub.Client {
 	ctx := context.Background()
-	tc := testutil.SystemTest(t)
+	tc := testutil.PubsubEmulatorTest(t)
 
-	topicID = "test-sub-topic"
-	subID = "test-sub"
 	var err error
 	client, err := pubsub.NewClient(ctx, tc.ProjectID)
 	if err != nil {
@@ -80,7 +82,7 @@ func setup(t *testing.T) *pubsub.Client {
 
 func TestCreate(t *testing.T) {
 	ctx := context.Background()
-	tc := testutil.SystemTest(t)
+	tc := testutil.PubsubEmulatorTest(t)
 	client := setup(t)
 	topic, err := client.CreateTopic(ctx, topicID)
 	if err != nil {
@@ -100,9 +102,9 @@ func TestCreate(t *testing.T) {
 }
 
 func TestList(t *testing.T) {
-	tc := testutil.SystemTest(t)
+	tc := testutil.PubsubEmulatorTest(t)
 
-	testutil.Retry(t, 10, time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
 		subs, err := list(tc.ProjectID)
 		if err != nil {
 			r.Errorf("failed to list subscriptions: %v", err)
@@ -124,9 +126,10 @@ func TestList(t *testing.T) {
 }
 
 func TestIAM(t *testing.T) {
+	testutil.SkipIfPubsubEmulator(t, "IAM")
 	tc := testutil.SystemTest(t)
 
-	testutil.Retry(t, 10, time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
 		buf := new(bytes.Buffer)
 		perms, err := testPermissions(buf, tc.ProjectID, subID)
 		if err != nil {
@@ -137,13 +140,13 @@ func TestIAM(t *testing.T) {
 		}
 	})
 
-	testutil.Retry(t, 10, time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
 		if err := addUsers(tc.ProjectID, subID); err != nil {
 			r.Errorf("addUsers: %v", err)
 		}
 	})
 
-	testutil.Retry(t, 10, time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
 		buf := new(bytes.Buffer)
 		policy, err := policy(buf, tc.ProjectID, subID)
 		if err != nil {
@@ -156,11 +159,46 @@ func TestIAM(t *testing.T) {
 			r.Errorf("want %q as viewer, policy=%v", member, policy)
 		}
 	})
+
+	const (
+		member = "group:cloud-logs@google.com"
+		role   = iam.RoleName("roles/pubsub.subscriber")
+	)
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
+		if err := addSubscriptionIAMMember(ioutil.Discard, tc.ProjectID, subID, member, role); err != nil {
+			r.Errorf("addSubscriptionIAMMember: %v", err)
+		}
+	})
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
+		policy, err := policy(ioutil.Discard, tc.ProjectID, subID)
+		if err != nil {
+			r.Errorf("policy: %v", err)
+			return
+		}
+		if !policy.HasRole(member, role) {
+			r.Errorf("want %q as %v, policy=%v", member, role, policy)
+		}
+	})
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
+		if err := removeSubscriptionIAMMember(ioutil.Discard, tc.ProjectID, subID, member, role); err != nil {
+			r.Errorf("removeSubscriptionIAMMember: %v", err)
+		}
+	})
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
+		policy, err := policy(ioutil.Discard, tc.ProjectID, subID)
+		if err != nil {
+			r.Errorf("policy: %v", err)
+			return
+		}
+		if policy.HasRole(member, role) {
+			r.Errorf("want %q removed from %v, policy=%v", member, role, policy)
+		}
+	})
 }
 
 func TestDelete(t *testing.T) {
 	ctx := context.Background()
-	tc := testutil.SystemTest(t)
+	tc := testutil.PubsubEmulatorTest(t)
 	client := setup(t)
 
 	topic := client.Topic(topicID)
@@ -198,7 +236,7 @@ func TestDelete(t *testing.T) {
 func TestPullMsgsAsync(t *testing.T) {
 	client := setup(t)
 	ctx := context.Background()
-	tc := testutil.SystemTest(t)
+	tc := testutil.PubsubEmulatorTest(t)
 	asyncTopicID := topicID + "-async"
 	asyncSubID := subID + "-async"
 
@@ -236,7 +274,7 @@ func TestPullMsgsAsync(t *testing.T) {
 func TestPullMsgsSync(t *testing.T) {
 	client := setup(t)
 	ctx := context.Background()
-	tc := testutil.SystemTest(t)
+	tc := testutil.PubsubEmulatorTest(t)
 	topicIDSync := topicID + "-sync"
 	subIDSync := subID + "-sync"
 
@@ -312,7 +350,7 @@ func TestPullMsgsConcurrencyControl(t *testing.T) {
 func TestPullMsgsCustomAttributes(t *testing.T) {
 	client := setup(t)
 	ctx := context.Background()
-	tc := testutil.SystemTest(t)
+	tc := testutil.PubsubEmulatorTest(t)
 	topicIDAttributes := topicID + "-attributes"
 	subIDAttributes := subID + "-attributes"
 
@@ -360,7 +398,7 @@ func TestCreateWithDeadLetterPolicy(t *testing.T) {
 	deadLetterSubID := subID + "-dead-letter-sub"
 	deadLetterSinkID := topicID + "-dead-letter-sink"
 
-	testutil.Retry(t, 10, time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
 		deadLetterSourceTopic, err := getOrCreateTopic(ctx, client, deadLetterSourceID)
 		if err != nil {
 			r.Errorf("getOrCreateTopic: %v", err)
@@ -420,7 +458,7 @@ func TestUpdateDeadLetterPolicy(t *testing.T) {
 	deadLetterSubID := subID + "-update-sub"
 	deadLetterSinkID := topicID + "-update-sink"
 
-	testutil.Retry(t, 10, time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
 		deadLetterSourceTopic, err := getOrCreateTopic(ctx, client, deadLetterSourceID)
 		if err != nil {
 			r.Errorf("getOrCreateTopic: %v", err)
@@ -500,7 +538,7 @@ func TestPullMsgsDeadLetterDeliveryAttempts(t *testing.T) {
 	deadLetterSinkID := topicID + "-delivery-sink"
 	deadLetterSubID := subID + "-delivery-sub"
 
-	testutil.Retry(t, 10, time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
 		deadLetterSourceTopic, err := getOrCreateTopic(ctx, client, deadLetterSourceID)
 		if err != nil {
 			r.Errorf("getOrCreateTopic: %v", err)
@@ -556,10 +594,7 @@ func TestCreateWithOrdering(t *testing.T) {
 	defer client.Close()
 	orderingSubID := subID + "-ordering"
 
-	topic, err := getOrCreateTopic(ctx, client, topicID)
-	if err != nil {
-		t.Fatalf("CreateTopic: %v", err)
-	}
+	topic := client.Topic(testutil.SharedTopic(ctx, t, tc.ProjectID))
 	buf := new(bytes.Buffer)
 	if err := createWithOrdering(buf, tc.ProjectID, orderingSubID, topic); err != nil {
 		t.Fatalf("failed to create a subscription: %v", err)
@@ -588,16 +623,11 @@ func TestDetachSubscription(t *testing.T) {
 	defer client.Close()
 	ctx := context.Background()
 	tc := testutil.SystemTest(t)
-	detachTopicID := topicID + "-detach"
-	detachSubID := "testdetachsubsxyz-" + subID
-
-	topic, err := getOrCreateTopic(ctx, client, detachTopicID)
-	if err != nil {
-		t.Fatalf("getOrCreateTopic: %v", err)
-	}
-	defer topic.Delete(ctx)
-	defer topic.Stop()
+	detachSubID := subID + "-detach"
 
+	// Detaching a subscription leaves its topic alone, so the topic is
+	// shared.
+	topic := client.Topic(testutil.SharedTopic(ctx, t, tc.ProjectID))
 	sub, err := getOrCreateSub(ctx, client, detachSubID, &pubsub.SubscriptionConfig{
 		Topic: topic,
 	})
@@ -625,6 +655,740 @@ func TestDetachSubscription(t *testing.T) {
 	}
 }
 
+func TestCreateWithFilter(t *testing.T) {
+	client := setup(t)
+	defer client.Close()
+	ctx := context.Background()
+	tc := testutil.SystemTest(t)
+	filterSubID := subID + "-filter"
+
+	// The filter drops the messages other tests publish to the shared
+	// topic, since they lack the attributes.
+	topic := client.Topic(testutil.SharedTopic(ctx, t, tc.ProjectID))
+	defer topic.Stop()
+
+	// The filter of an existing subscription cannot be changed, so always
+	// start from a new one.
+	sub := client.Subscription(filterSubID)
+	if ok, err := sub.Exists(ctx); err != nil {
+		t.Fatalf("Exists: %v", err)
+	} else if ok {
+		sub.Delete(ctx)
+	}
+	buf := new(bytes.Buffer)
+	filter := `attributes.region = "us" AND attributes.priority = "high"`
+	if err := createWithFilter(buf, tc.ProjectID, filterSubID, filter, topic); err != nil {
+		t.Fatalf("createWithFilter: %v", err)
+	}
+	defer sub.Delete(ctx)
+
+	msgs := []struct {
+		data, region, priority string
+	}{
+		{"match", "us", "high"},
+		{"wrong-priority", "us", "low"},
+		{"wrong-region", "eu", "high"},
+	}
+	var results []*pubsub.PublishResult
+	for _, m := range msgs {
+		results = append(results, topic.Publish(ctx, &pubsub.Message{
+			Data:       []byte(m.data),
+			Attributes: map[string]string{"region": m.region, "priority": m.priority},
+		}))
+	}
+	for _, r := range results {
+		if _, err := r.Get(ctx); err != nil {
+			t.Fatalf("Get publish result: %v", err)
+		}
+	}
+
+	var mu sync.Mutex
+	var received []string
+	cctx, cancel := context.WithTimeout(ctx, 20*time.Second)
+	defer cancel()
+	err := sub.Receive(cctx, func(ctx context.Context, msg *pubsub.Message) {
+		mu.Lock()
+		defer mu.Unlock()
+		received = append(received, string(msg.Data))
+		msg.Ack()
+	})
+	if err != nil {
+		t.Fatalf("Receive: %v", err)
+	}
+	if want := []string{"match"}; !cmp.Equal(received, want) {
+		t.Errorf("filtered subscription received %v, want %v", received, want)
+	}
+}
+
+func TestReceiveWithSettings(t *testing.T) {
+	client := setup(t)
+	defer client.Close()
+	ctx := context.Background()
+	tc := testutil.SystemTest(t)
+	settingsTopicID := topicID + "-settings"
+	settingsSubID := subID + "-settings"
+
+	topic, err := getOrCreateTopic(ctx, client, settingsTopicID)
+	if err != nil {
+		t.Fatalf("getOrCreateTopic: %v", err)
+	}
+	defer topic.Delete(ctx)
+	defer topic.Stop()
+
+	sub := client.Subscription(settingsSubID)
+	if ok, err := sub.Exists(ctx); err != nil {
+		t.Fatalf("Exists: %v", err)
+	} else if ok {
+		sub.Delete(ctx)
+	}
+	defer sub.Delete(ctx)
+
+	// Publish once the subscription exists, so it receives the messages.
+	const numMsgs = 10
+	go func() {
+		time.Sleep(5 * time.Second)
+		if err := publishMsgs(ctx, topic, numMsgs); err != nil {
+			t.Errorf("publishMsgs: %v", err)
+		}
+	}()
+
+	buf := new(bytes.Buffer)
+	acked, err := receiveWithSettings(buf, tc.ProjectID, settingsSubID, topic, 30*time.Second)
+	if err != nil {
+		t.Fatalf("receiveWithSettings: %v", err)
+	}
+	if acked != numMsgs {
+		t.Errorf("receiveWithSettings acked %d messages, want %d: %q", acked, numMsgs, buf.String())
+	}
+}
+
+func TestReceiveOrdered(t *testing.T) {
+	client := setup(t)
+	defer client.Close()
+	ctx := context.Background()
+	tc := testutil.SystemTest(t)
+	orderedTopicID := topicID + "-ordered"
+	orderedSubID := subID + "-ordered"
+
+	topic, err := getOrCreateTopic(ctx, client, orderedTopicID)
+	if err != nil {
+		t.Fatalf("getOrCreateTopic: %v", err)
+	}
+	defer topic.Delete(ctx)
+	defer topic.Stop()
+	topic.EnableMessageOrdering = true
+
+	sub := client.Subscription(orderedSubID)
+	if ok, err := sub.Exists(ctx); err != nil {
+		t.Fatalf("Exists: %v", err)
+	} else if ok {
+		sub.Delete(ctx)
+	}
+	if err := createWithOrdering(ioutil.Discard, tc.ProjectID, orderedSubID, topic); err != nil {
+		t.Fatalf("createWithOrdering: %v", err)
+	}
+	defer sub.Delete(ctx)
+
+	keys := []string{"key-a", "key-b", "key-c"}
+	const numMsgs = 5
+	var results []*pubsub.PublishResult
+	for i := 0; i < numMsgs; i++ {
+		for _, k := range keys {
+			results = append(results, topic.Publish(ctx, &pubsub.Message{
+				Data:        []byte(fmt.Sprintf("%s#%d", k, i)),
+				OrderingKey: k,
+			}))
+		}
+	}
+	for _, r := range results {
+		if _, err := r.Get(ctx); err != nil {
+			t.Fatalf("Get publish result: %v", err)
+		}
+	}
+
+	buf := new(bytes.Buffer)
+	got, err := receiveOrdered(buf, tc.ProjectID, orderedSubID, 30*time.Second)
+	if err != nil {
+		t.Fatalf("receiveOrdered: %v", err)
+	}
+	for _, k := range keys {
+		var want []string
+		for i := 0; i < numMsgs; i++ {
+			want = append(want, fmt.Sprintf("%s#%d", k, i))
+		}
+		if diff := cmp.Diff(want, got[k]); diff != "" {
+			t.Errorf("receiveOrdered key %q mismatch (-want +got):\n%s", k, diff)
+		}
+	}
+}
+
+func TestReceiveExactlyOnceOrdered(t *testing.T) {
+	client := setup(t)
+	defer client.Close()
+	ctx := context.Background()
+	tc := testutil.SystemTest(t)
+	eosTopicID := topicID + "-eos-ordered"
+	eosSubID := subID + "-eos-ordered"
+
+	topic, err := getOrCreateTopic(ctx, client, eosTopicID)
+	if err != nil {
+		t.Fatalf("getOrCreateTopic: %v", err)
+	}
+	defer topic.Delete(ctx)
+	defer topic.Stop()
+	topic.EnableMessageOrdering = true
+
+	sub, err := client.CreateSubscription(ctx, eosSubID, pubsub.SubscriptionConfig{
+		Topic:                     topic,
+		EnableExactlyOnceDelivery: true,
+		EnableMessageOrdering:     true,
+	})
+	if err != nil {
+		t.Fatalf("CreateSubscription: %v", err)
+	}
+	defer sub.Delete(ctx)
+
+	keys := []string{"key-a", "key-b"}
+	const numMsgs = 5
+	var results []*pubsub.PublishResult
+	for i := 0; i < numMsgs; i++ {
+		for _, k := range keys {
+			results = append(results, topic.Publish(ctx, &pubsub.Message{
+				Data:        []byte(fmt.Sprintf("%s#%d", k, i)),
+				OrderingKey: k,
+			}))
+		}
+	}
+	for _, r := range results {
+		if _, err := r.Get(ctx); err != nil {
+			t.Fatalf("Get publish result: %v", err)
+		}
+	}
+
+	got, err := receiveExactlyOnceOrdered(ioutil.Discard, tc.ProjectID, eosSubID, 30*time.Second)
+	if err != nil {
+		t.Fatalf("receiveExactlyOnceOrdered: %v", err)
+	}
+	for _, k := range keys {
+		var want []string
+		for i := 0; i < numMsgs; i++ {
+			want = append(want, fmt.Sprintf("%s#%d", k, i))
+		}
+		if diff := cmp.Diff(want, got[k]); diff != "" {
+			t.Errorf("receiveExactlyOnceOrdered key %q mismatch (-want +got):\n%s", k, diff)
+		}
+	}
+}
+
+func TestLedger(t *testing.T) {
+	l := &ledger{applied: make(map[string]bool), entries: make(map[string][]string)}
+	// A redelivery of message 1 after message 2 was applied is skipped.
+	for _, m := range []struct{ id, data string }{{"1", "a#0"}, {"2", "a#1"}, {"1", "a#0"}, {"2", "a#1"}, {"3", "a#2"}} {
+		l.commit("a", m.id, m.data)
+	}
+	if diff := cmp.Diff([]string{"a#0", "a#1", "a#2"}, l.entries["a"]); diff != "" {
+		t.Errorf("ledger entries mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestRetryPolicy(t *testing.T) {
+	client := setup(t)
+	defer client.Close()
+	ctx := context.Background()
+	tc := testutil.SystemTest(t)
+	retryTopicID := topicID + "-retry"
+	retrySubID := subID + "-retry-policy"
+	defaultSubID := subID + "-retry-default"
+
+	topic, err := getOrCreateTopic(ctx, client, retryTopicID)
+	if err != nil {
+		t.Fatalf("getOrCreateTopic: %v", err)
+	}
+	defer topic.Delete(ctx)
+	defer topic.Stop()
+
+	// Start from fresh subscriptions so no earlier messages are pending.
+	for _, id := range []string{retrySubID, defaultSubID} {
+		sub := client.Subscription(id)
+		if ok, err := sub.Exists(ctx); err != nil {
+			t.Fatalf("Exists: %v", err)
+		} else if ok {
+			sub.Delete(ctx)
+		}
+		defer sub.Delete(ctx)
+	}
+	defaultSub, err := client.CreateSubscription(ctx, defaultSubID, pubsub.SubscriptionConfig{Topic: topic})
+	if err != nil {
+		t.Fatalf("CreateSubscription: %v", err)
+	}
+
+	buf := new(bytes.Buffer)
+	if err := createSubWithRetryPolicy(buf, tc.ProjectID, retrySubID, topic); err != nil {
+		t.Fatalf("createSubWithRetryPolicy: %v", err)
+	}
+	retrySub := client.Subscription(retrySubID)
+	cfg, err := retrySub.Config(ctx)
+	if err != nil {
+		t.Fatalf("Config: %v", err)
+	}
+	want := &pubsub.RetryPolicy{MinimumBackoff: 10 * time.Second, MaximumBackoff: 10 * time.Minute}
+	if !cmp.Equal(cfg.RetryPolicy, want) {
+		t.Errorf("createSubWithRetryPolicy got policy %+v, want %+v", cfg.RetryPolicy, want)
+	}
+
+	const minBackoff = 20 * time.Second
+	if err := updateRetryPolicy(buf, tc.ProjectID, retrySubID, minBackoff, 5*time.Minute); err != nil {
+		t.Fatalf("updateRetryPolicy: %v", err)
+	}
+	cfg, err = retrySub.Config(ctx)
+	if err != nil {
+		t.Fatalf("Config: %v", err)
+	}
+	want = &pubsub.RetryPolicy{MinimumBackoff: minBackoff, MaximumBackoff: 5 * time.Minute}
+	if !cmp.Equal(cfg.RetryPolicy, want) {
+		t.Errorf("updateRetryPolicy got policy %+v, want %+v", cfg.RetryPolicy, want)
+	}
+
+	if err := publishMsgs(ctx, topic, 1); err != nil {
+		t.Fatalf("publishMsgs: %v", err)
+	}
+
+	// Without a retry policy a nacked message comes back right away. With one,
+	// Pub/Sub holds it back for at least the minimum backoff.
+	defaultDelay, err := redeliveryDelay(ctx, defaultSub)
+	if err != nil {
+		t.Fatalf("redeliveryDelay(%v): %v", defaultSubID, err)
+	}
+	retryDelay, err := redeliveryDelay(ctx, retrySub)
+	if err != nil {
+		t.Fatalf("redeliveryDelay(%v): %v", retrySubID, err)
+	}
+	t.Logf("redelivery after nack: %v without retry policy, %v with a %v minimum backoff", defaultDelay, retryDelay, minBackoff)
+	// The backoff is best effort, so leave some slack.
+	if retryDelay < minBackoff/2 {
+		t.Errorf("redelivery with retry policy took %v, want at least about %v", retryDelay, minBackoff)
+	}
+	if retryDelay <= defaultDelay {
+		t.Errorf("redelivery with retry policy took %v, want longer than the default %v", retryDelay, defaultDelay)
+	}
+}
+
+// redeliveryDelay nacks the first message it receives from sub and returns
+// how long it took for the message to be delivered again.
+func redeliveryDelay(ctx context.Context, sub *pubsub.Subscription) (time.Duration, error) {
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Minute)
+	defer cancel()
+
+	var (
+		mu    sync.Mutex
+		first time.Time
+		delay time.Duration
+	)
+	err := sub.Receive(ctx, func(_ context.Context, msg *pubsub.Message) {
+		mu.Lock()
+		defer mu.Unlock()
+		if first.IsZero() {
+			first = time.Now()
+			msg.Nack()
+			return
+		}
+		delay = time.Since(first)
+		msg.Ack()
+		cancel()
+	})
+	if err != nil {
+		return 0, err
+	}
+	if delay == 0 {
+		return 0, fmt.Errorf("message was not redelivered")
+	}
+	return delay, nil
+}
+
+func TestReceiveWithLeaseManagement(t *testing.T) {
+	client := setup(t)
+	defer client.Close()
+	ctx := context.Background()
+	tc := testutil.SystemTest(t)
+	leaseTopicID := topicID + "-lease"
+	leaseSubID := subID + "-lease"
+
+	topic, err := getOrCreateTopic(ctx, client, leaseTopicID)
+	if err != nil {
+		t.Fatalf("getOrCreateTopic: %v", err)
+	}
+	defer topic.Delete(ctx)
+	defer topic.Stop()
+
+	sub := client.Subscription(leaseSubID)
+	if ok, err := sub.Exists(ctx); err != nil {
+		t.Fatalf("Exists: %v", err)
+	} else if ok {
+		sub.Delete(ctx)
+	}
+	sub, err = client.CreateSubscription(ctx, leaseSubID, pubsub.SubscriptionConfig{
+		Topic:       topic,
+		AckDeadline: 10 * time.Second,
+	})
+	if err != nil {
+		t.Fatalf("CreateSubscription: %v", err)
+	}
+	defer sub.Delete(ctx)
+
+	const numMsgs = 3
+
+	// Processing fits in the extension budget: every message is delivered
+	// once even though it takes longer than the ack deadline.
+	if err := publishMsgs(ctx, topic, numMsgs); err != nil {
+		t.Fatalf("publishMsgs: %v", err)
+	}
+	buf := new(bytes.Buffer)
+	deliveries, err := receiveWithLeaseManagement(buf, tc.ProjectID, leaseSubID, time.Minute, 20*time.Second, 45*time.Second)
+	if err != nil {
+		t.Fatalf("receiveWithLeaseManagement: %v", err)
+	}
+	if len(deliveries) != numMsgs {
+		t.Errorf("receiveWithLeaseManagement got %d messages, want %d: %q", len(deliveries), numMsgs, buf.String())
+	}
+	for id, n := range deliveries {
+		if n != 1 {
+			t.Errorf("message %v delivered %d times within MaxExtension, want 1", id, n)
+		}
+	}
+
+	// Processing outlasts the extension budget: the lease expires and the
+	// messages are redelivered while still being processed.
+	if err := publishMsgs(ctx, topic, numMsgs); err != nil {
+		t.Fatalf("publishMsgs: %v", err)
+	}
+	buf.Reset()
+	deliveries, err = receiveWithLeaseManagement(buf, tc.ProjectID, leaseSubID, 10*time.Second, 45*time.Second, time.Minute)
+	if err != nil {
+		t.Fatalf("receiveWithLeaseManagement: %v", err)
+	}
+	var redelivered int
+	for _, n := range deliveries {
+		if n > 1 {
+			redelivered++
+		}
+	}
+	if redelivered == 0 {
+		t.Errorf("receiveWithLeaseManagement got no redeliveries past MaxExtension: %q", buf.String())
+	}
+}
+
+func TestReceiveCPUBound(t *testing.T) {
+	client := setup(t)
+	defer client.Close()
+	ctx := context.Background()
+	tc := testutil.SystemTest(t)
+	cpuTopicID := topicID + "-cpu"
+	cpuSubID := subID + "-cpu"
+
+	topic, err := getOrCreateTopic(ctx, client, cpuTopicID)
+	if err != nil {
+		t.Fatalf("getOrCreateTopic: %v", err)
+	}
+	defer topic.Delete(ctx)
+	defer topic.Stop()
+
+	sub, err := getOrCreateSub(ctx, client, cpuSubID, &pubsub.SubscriptionConfig{
+		Topic: topic,
+	})
+	if err != nil {
+		t.Fatalf("getOrCreateSub: %v", err)
+	}
+	defer sub.Delete(ctx)
+
+	const numMsgs = 50
+	if err := publishMsgs(ctx, topic, numMsgs); err != nil {
+		t.Fatalf("publishMsgs: %v", err)
+	}
+
+	buf := new(bytes.Buffer)
+	processed, err := receiveCPUBound(buf, tc.ProjectID, cpuSubID, 1, 30*time.Second)
+	if err != nil {
+		t.Fatalf("receiveCPUBound: %v", err)
+	}
+	if processed < numMsgs {
+		t.Errorf("receiveCPUBound processed %d messages, want at least %d", processed, numMsgs)
+	}
+	if got, want := buf.String(), "msgs/s"; !strings.Contains(got, want) {
+		t.Errorf("receiveCPUBound got %q, want to contain %q", got, want)
+	}
+}
+
+func TestReceiveWithFlowControl(t *testing.T) {
+	client := setup(t)
+	defer client.Close()
+	ctx := context.Background()
+	tc := testutil.SystemTest(t)
+	flowTopicID := topicID + "-flow"
+	flowSubID := subID + "-flow"
+
+	topic, err := getOrCreateTopic(ctx, client, flowTopicID)
+	if err != nil {
+		t.Fatalf("getOrCreateTopic: %v", err)
+	}
+	defer topic.Delete(ctx)
+	defer topic.Stop()
+
+	sub, err := getOrCreateSub(ctx, client, flowSubID, &pubsub.SubscriptionConfig{
+		Topic: topic,
+	})
+	if err != nil {
+		t.Fatalf("getOrCreateSub: %v", err)
+	}
+	defer sub.Delete(ctx)
+
+	if err := publishMsgs(ctx, topic, 30); err != nil {
+		t.Fatalf("publishMsgs: %v", err)
+	}
+
+	const maxOutstanding = 5
+	buf := new(bytes.Buffer)
+	peak, err := receiveWithFlowControl(buf, tc.ProjectID, flowSubID, maxOutstanding, time.Second, 20*time.Second)
+	if err != nil {
+		t.Fatalf("receiveWithFlowControl: %v", err)
+	}
+	if peak == 0 || peak > maxOutstanding {
+		t.Errorf("receiveWithFlowControl handled at most %d messages at a time, want between 1 and %d: %q", peak, maxOutstanding, buf.String())
+	}
+}
+
+func TestUpdateSubscription(t *testing.T) {
+	client := setup(t)
+	defer client.Close()
+	ctx := context.Background()
+	tc := testutil.SystemTest(t)
+	updateTopicID := topicID + "-update"
+	updateSubID := subID + "-update"
+
+	topic, err := getOrCreateTopic(ctx, client, updateTopicID)
+	if err != nil {
+		t.Fatalf("getOrCreateTopic: %v", err)
+	}
+	defer topic.Delete(ctx)
+	defer topic.Stop()
+
+	sub, err := getOrCreateSub(ctx, client, updateSubID, &pubsub.SubscriptionConfig{
+		Topic: topic,
+	})
+	if err != nil {
+		t.Fatalf("getOrCreateSub: %v", err)
+	}
+	defer sub.Delete(ctx)
+
+	buf := new(bytes.Buffer)
+	if err := updateAckDeadline(buf, tc.ProjectID, updateSubID, time.Minute); err != nil {
+		t.Fatalf("updateAckDeadline: %v", err)
+	}
+	ttl := 14 * 24 * time.Hour
+	if err := updateExpirationPolicy(buf, tc.ProjectID, updateSubID, ttl); err != nil {
+		t.Fatalf("updateExpirationPolicy: %v", err)
+	}
+	labels := map[string]string{"team": "samples", "env": "test"}
+	if err := updateLabels(buf, tc.ProjectID, updateSubID, labels); err != nil {
+		t.Fatalf("updateLabels: %v", err)
+	}
+	retention := 3 * 24 * time.Hour
+	if err := updateMessageRetention(buf, tc.ProjectID, updateSubID, retention, true); err != nil {
+		t.Fatalf("updateMessageRetention: %v", err)
+	}
+
+	cfg, err := sub.Config(ctx)
+	if err != nil {
+		t.Fatalf("Config: %v", err)
+	}
+	if cfg.AckDeadline != time.Minute {
+		t.Errorf("AckDeadline got %v, want %v", cfg.AckDeadline, time.Minute)
+	}
+	if cfg.ExpirationPolicy != ttl {
+		t.Errorf("ExpirationPolicy got %v, want %v", cfg.ExpirationPolicy, ttl)
+	}
+	if diff := cmp.Diff(labels, cfg.Labels); diff != "" {
+		t.Errorf("Labels mismatch (-want +got):\n%s", diff)
+	}
+	if cfg.RetentionDuration != retention || !cfg.RetainAckedMessages {
+		t.Errorf("got retention %v and RetainAckedMessages %v, want %v and true", cfg.RetentionDuration, cfg.RetainAckedMessages, retention)
+	}
+
+	buf.Reset()
+	if err := updateExpirationPolicy(buf, tc.ProjectID, updateSubID, 0); err != nil {
+		t.Fatalf("updateExpirationPolicy: %v", err)
+	}
+	if got, want := buf.String(), "no longer expires"; !strings.Contains(got, want) {
+		t.Errorf("updateExpirationPolicy got %q, want to contain %q", got, want)
+	}
+}
+
+func TestReceiveWithNackPatterns(t *testing.T) {
+	client := setup(t)
+	defer client.Close()
+	ctx := context.Background()
+	tc := testutil.SystemTest(t)
+	nackTopicID := topicID + "-nack"
+	nackSubID := subID + "-nack"
+
+	topic, err := getOrCreateTopic(ctx, client, nackTopicID)
+	if err != nil {
+		t.Fatalf("getOrCreateTopic: %v", err)
+	}
+	defer topic.Delete(ctx)
+	defer topic.Stop()
+
+	sub := client.Subscription(nackSubID)
+	if ok, err := sub.Exists(ctx); err != nil {
+		t.Fatalf("Exists: %v", err)
+	} else if ok {
+		sub.Delete(ctx)
+	}
+	sub, err = client.CreateSubscription(ctx, nackSubID, pubsub.SubscriptionConfig{
+		Topic: topic,
+		RetryPolicy: &pubsub.RetryPolicy{
+			MinimumBackoff: 10 * time.Second,
+			MaximumBackoff: time.Minute,
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateSubscription: %v", err)
+	}
+	defer sub.Delete(ctx)
+
+	msgs := []*pubsub.Message{
+		{Data: []byte("order"), Attributes: map[string]string{"order_id": "1"}},
+		{Data: []byte("order"), Attributes: map[string]string{"order_id": "2"}},
+		{Data: []byte("malformed")},
+	}
+	for _, m := range msgs {
+		if _, err := topic.Publish(ctx, m).Get(ctx); err != nil {
+			t.Fatalf("Publish: %v", err)
+		}
+	}
+
+	buf := new(bytes.Buffer)
+	applied, err := receiveWithNackPatterns(buf, tc.ProjectID, nackSubID, 45*time.Second)
+	if err != nil {
+		t.Fatalf("receiveWithNackPatterns: %v", err)
+	}
+	got := buf.String()
+	if applied != 2 {
+		t.Errorf("receiveWithNackPatterns applied %d orders, want 2: %q", applied, got)
+	}
+	for _, want := range []string{"Nacking message", "Dropping malformed message"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("receiveWithNackPatterns got %q, want to contain %q", got, want)
+		}
+	}
+}
+
+func TestDeadLetterMonitorAndRedrive(t *testing.T) {
+	client := setup(t)
+	defer client.Close()
+	ctx := context.Background()
+	tc := testutil.SystemTest(t)
+	dlqTopicID := topicID + "-dlq"
+	sourceTopicID := topicID + "-dlq-source"
+
+	dlqTopic, err := getOrCreateTopic(ctx, client, dlqTopicID)
+	if err != nil {
+		t.Fatalf("getOrCreateTopic: %v", err)
+	}
+	defer dlqTopic.Delete(ctx)
+	defer dlqTopic.Stop()
+	sourceTopic, err := getOrCreateTopic(ctx, client, sourceTopicID)
+	if err != nil {
+		t.Fatalf("getOrCreateTopic: %v", err)
+	}
+	defer sourceTopic.Delete(ctx)
+	defer sourceTopic.Stop()
+
+	subs := map[string]*pubsub.Topic{
+		subID + "-dlq-monitor": dlqTopic,
+		subID + "-dlq-redrive": dlqTopic,
+		subID + "-dlq-source":  sourceTopic,
+	}
+	for id, topic := range subs {
+		sub := client.Subscription(id)
+		if ok, err := sub.Exists(ctx); err != nil {
+			t.Fatalf("Exists: %v", err)
+		} else if ok {
+			sub.Delete(ctx)
+		}
+		if _, err := client.CreateSubscription(ctx, id, pubsub.SubscriptionConfig{Topic: topic}); err != nil {
+			t.Fatalf("CreateSubscription(%q): %v", id, err)
+		}
+		defer sub.Delete(ctx)
+	}
+
+	// Publish messages that look like Pub/Sub forwarded them from two
+	// subscriptions, rather than waiting for real deliveries to fail.
+	subA := fmt.Sprintf("projects/%s/subscriptions/orders", tc.ProjectID)
+	subB := fmt.Sprintf("projects/%s/subscriptions/payments", tc.ProjectID)
+	for _, m := range []struct{ sub, error string }{
+		{subA, "timeout"},
+		{subA, "timeout"},
+		{subA, "bad-input"},
+		{subB, "timeout"},
+	} {
+		_, err := dlqTopic.Publish(ctx, &pubsub.Message{
+			Data: []byte("failed message"),
+			Attributes: map[string]string{
+				"CloudPubSubDeadLetterSourceSubscription":        m.sub,
+				"CloudPubSubDeadLetterSourceDeliveryCount":       "5",
+				"CloudPubSubDeadLetterSourceSubscriptionProject": tc.ProjectID,
+				"error": m.error,
+			},
+		}).Get(ctx)
+		if err != nil {
+			t.Fatalf("Publish: %v", err)
+		}
+	}
+
+	buf := new(bytes.Buffer)
+	counts, err := monitorDeadLetters(buf, tc.ProjectID, subID+"-dlq-monitor", "error", 20*time.Second)
+	if err != nil {
+		t.Fatalf("monitorDeadLetters: %v", err)
+	}
+	want := map[deadLetterKey]int{
+		{Subscription: subA, Error: "timeout"}:   2,
+		{Subscription: subA, Error: "bad-input"}: 1,
+		{Subscription: subB, Error: "timeout"}:   1,
+	}
+	if diff := cmp.Diff(want, counts); diff != "" {
+		t.Errorf("monitorDeadLetters mismatch (-want +got):\n%s\n%s", diff, buf.String())
+	}
+
+	buf.Reset()
+	n, err := redriveDeadLetters(buf, tc.ProjectID, subID+"-dlq-redrive", subA, sourceTopicID, 2, 30*time.Second)
+	if err != nil {
+		t.Fatalf("redriveDeadLetters: %v", err)
+	}
+	if n != 2 {
+		t.Errorf("redriveDeadLetters republished %d messages, want 2: %q", n, buf.String())
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx, 20*time.Second)
+	defer cancel()
+	var mu sync.Mutex
+	var received int
+	err = client.Subscription(subID+"-dlq-source").Receive(ctx2, func(_ context.Context, msg *pubsub.Message) {
+		mu.Lock()
+		defer mu.Unlock()
+		received++
+		if _, ok := msg.Attributes["CloudPubSubDeadLetterSourceSubscription"]; ok {
+			t.Errorf("republished message %v kept dead letter attributes: %v", msg.ID, msg.Attributes)
+		}
+		msg.Ack()
+	})
+	if err != nil {
+		t.Fatalf("Receive: %v", err)
+	}
+	if received != 2 {
+		t.Errorf("source topic got %d republished messages, want 2", received)
+	}
+}
+
 func publishMsgs(ctx context.Context, t *pubsub.Topic, numMsgs int) error {
 	var results []*pubsub.PublishResult
 	for i := 0; i < numMsgs; i++ {
@@ -636,7 +1400,7 @@ func publishMsgs(ctx context.Context, t *pubsub.Topic, numMsgs int) error {
 	// Check that all messages were published.
 	for _, r := range results {
 		if _, err := r.Get(ctx); err != nil {
-			return fmt.Errorf("Get publish result: %v", err)
+			return fmt.Errorf("Get publish result: %w", err)
 		}
 	}
 	return nil
@@ -647,12 +1411,12 @@ func getOrCreateTopic(ctx context.Context, client *pubsub.Client, topicID string
 	topic := client.Topic(topicID)
 	ok, err := topic.Exists(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to check if topic exists: %v", err)
+		return nil, fmt.Errorf("failed to check if topic exists: %w", err)
 	}
 	if !ok {
 		topic, err = client.CreateTopic(ctx, topicID)
 		if err != nil {
-			return nil, fmt.Errorf("failed to create topic (%q): %v", topicID, err)
+			return nil, fmt.Errorf("failed to create topic (%q): %w", topicID, err)
 		}
 	}
 	return topic, nil
@@ -663,12 +1427,12 @@ func getOrCreateSub(ctx context.Context, client *pubsub.Client, subID string, cf
 	sub := client.Subscription(subID)
 	ok, err := sub.Exists(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to check if subscription exists: %v", err)
+		return nil, fmt.Errorf("failed to check if subscription exists: %w", err)
 	}
 	if !ok {
 		sub, err = client.CreateSubscription(ctx, subID, *cfg)
 		if err != nil {
-			return nil, fmt.Errorf("failed to create subscription (%q): %v", topicID, err)
+			return nil, fmt.Errorf("failed to create subscription (%q): %w", topicID, err)
 		}
 	}
 	return sub, nil
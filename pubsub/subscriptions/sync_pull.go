@@ -25,15 +25,16 @@ import (
 	"google.golang.org/grpc/status"
 
 	"cloud.google.com/go/pubsub"
+	"google.golang.org/api/option"
 )
 
-func pullMsgsSync(w io.Writer, projectID, subID string) error {
+func pullMsgsSync(w io.Writer, projectID, subID string, opts ...option.ClientOption) error {
 	// projectID := "my-project-id"
 	// subID := "my-sub"
 	ctx := context.Background()
-	client, err := pubsub.NewClient(ctx, projectID)
+	client, err := pubsub.NewClient(ctx, projectID, opts...)
 	if err != nil {
-		return fmt.Errorf("pubsub.NewClient: %v", err)
+		return fmt.Errorf("pubsub.NewClient: %w", err)
 	}
 	defer client.Close()
 
@@ -65,7 +66,7 @@ func pullMsgsSync(w io.Writer, projectID, subID string) error {
 		cm <- msg
 	})
 	if err != nil && status.Code(err) != codes.Canceled {
-		return fmt.Errorf("Receive: %v", err)
+		return fmt.Errorf("Receive: %w", err)
 	}
 
 	return nil
@@ -0,0 +1,55 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package subscriptions
+
+// [START pubsub_update_subscription_ack_deadline]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"google.golang.org/api/option"
+)
+
+// updateAckDeadline changes how long Pub/Sub waits for an ack before it
+// redelivers a message. It must be between 10 seconds and 10 minutes. The
+// Go client extends the deadline while a message is handled, so this mostly
+// matters for how soon messages held by a crashed subscriber come back.
+func updateAckDeadline(w io.Writer, projectID, subID string, ackDeadline time.Duration, opts ...option.ClientOption) error {
+	// projectID := "my-project-id"
+	// subID := "my-sub"
+	// ackDeadline := 60 * time.Second
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	client, err := pubsub.NewClient(ctx, projectID, opts...)
+	if err != nil {
+		return fmt.Errorf("pubsub.NewClient: %w", err)
+	}
+	defer client.Close()
+
+	cfg, err := client.Subscription(subID).Update(ctx, pubsub.SubscriptionConfigToUpdate{
+		AckDeadline: ackDeadline,
+	})
+	if err != nil {
+		return fmt.Errorf("Update: %w", err)
+	}
+	fmt.Fprintf(w, "Updated ack deadline of %v to %v\n", subID, cfg.AckDeadline)
+	return nil
+}
+
+// [END pubsub_update_subscription_ack_deadline]
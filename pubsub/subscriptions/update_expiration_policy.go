@@ -0,0 +1,58 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package subscriptions
+
+// [START pubsub_update_subscription_expiration_policy]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"google.golang.org/api/option"
+)
+
+// updateExpirationPolicy changes how long a subscription may go without
+// subscriber activity before Pub/Sub deletes it. A ttl of 0 means the
+// subscription never expires; otherwise it must be at least one day.
+func updateExpirationPolicy(w io.Writer, projectID, subID string, ttl time.Duration, opts ...option.ClientOption) error {
+	// projectID := "my-project-id"
+	// subID := "my-sub"
+	// ttl := 14 * 24 * time.Hour
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	client, err := pubsub.NewClient(ctx, projectID, opts...)
+	if err != nil {
+		return fmt.Errorf("pubsub.NewClient: %w", err)
+	}
+	defer client.Close()
+
+	cfg, err := client.Subscription(subID).Update(ctx, pubsub.SubscriptionConfigToUpdate{
+		ExpirationPolicy: ttl,
+	})
+	if err != nil {
+		return fmt.Errorf("Update: %w", err)
+	}
+	if ttl == 0 {
+		fmt.Fprintf(w, "Subscription %v no longer expires\n", subID)
+		return nil
+	}
+	fmt.Fprintf(w, "Subscription %v expires after %v of inactivity\n", subID, cfg.ExpirationPolicy)
+	return nil
+}
+
+// [END pubsub_update_subscription_expiration_policy]
@@ -0,0 +1,57 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package subscriptions
+
+// [START pubsub_update_subscription_message_retention]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"google.golang.org/api/option"
+)
+
+// updateMessageRetention changes how long a subscription keeps messages and
+// whether it keeps acked ones too. Retaining acked messages lets you seek
+// the subscription back to an earlier time and replay them, but they count
+// towards storage costs. The retention must be between 10 minutes and 7 days.
+func updateMessageRetention(w io.Writer, projectID, subID string, retention time.Duration, retainAcked bool, opts ...option.ClientOption) error {
+	// projectID := "my-project-id"
+	// subID := "my-sub"
+	// retention := 3 * 24 * time.Hour
+	// retainAcked := true
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	client, err := pubsub.NewClient(ctx, projectID, opts...)
+	if err != nil {
+		return fmt.Errorf("pubsub.NewClient: %w", err)
+	}
+	defer client.Close()
+
+	cfg, err := client.Subscription(subID).Update(ctx, pubsub.SubscriptionConfigToUpdate{
+		RetentionDuration:   retention,
+		RetainAckedMessages: retainAcked,
+	})
+	if err != nil {
+		return fmt.Errorf("Update: %w", err)
+	}
+	fmt.Fprintf(w, "Updated %v to retain messages for %v (retain acked messages: %v)\n", subID, cfg.RetentionDuration, cfg.RetainAckedMessages)
+	return nil
+}
+
+// [END pubsub_update_subscription_message_retention]
@@ -0,0 +1,57 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package subscriptions
+
+// [START pubsub_update_subscription_retry_policy]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"google.golang.org/api/option"
+)
+
+// updateRetryPolicy changes the backoff bounds of a subscription's retry
+// policy. Both bounds must be between 0 and 600 seconds.
+func updateRetryPolicy(w io.Writer, projectID, subID string, minBackoff, maxBackoff time.Duration, opts ...option.ClientOption) error {
+	// projectID := "my-project-id"
+	// subID := "my-sub"
+	// minBackoff := 20 * time.Second
+	// maxBackoff := 5 * time.Minute
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	client, err := pubsub.NewClient(ctx, projectID, opts...)
+	if err != nil {
+		return fmt.Errorf("pubsub.NewClient: %w", err)
+	}
+	defer client.Close()
+
+	cfg, err := client.Subscription(subID).Update(ctx, pubsub.SubscriptionConfigToUpdate{
+		RetryPolicy: &pubsub.RetryPolicy{
+			MinimumBackoff: minBackoff,
+			MaximumBackoff: maxBackoff,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("Update: %w", err)
+	}
+	fmt.Fprintf(w, "Updated retry policy of %v: %+v\n", subID, cfg.RetryPolicy)
+	return nil
+}
+
+// [END pubsub_update_subscription_retry_policy]
@@ -0,0 +1,66 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package topics
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+
+	"cloud.google.com/go/pubsub"
+	"github.com/GoogleCloudPlatform/golang-samples/internal/testutil"
+)
+
+// BenchmarkPublish measures the publish sample, which creates a client for
+// every message, against publishing with one client. Run it with
+//
+//	GOLANG_SAMPLES_BENCHMARK=1 go test -run '^$' -bench Publish ./pubsub/topics/
+func BenchmarkPublish(b *testing.B) {
+	tc := testutil.BenchmarkTest(b)
+	ctx := context.Background()
+	client, err := pubsub.NewClient(ctx, tc.ProjectID)
+	if err != nil {
+		b.Fatalf("pubsub.NewClient: %v", err)
+	}
+	defer client.Close()
+
+	id := testutil.UniqueName("benchmark")
+	topic, err := client.CreateTopic(ctx, id)
+	if err != nil {
+		b.Fatalf("CreateTopic(%q): %v", id, err)
+	}
+	defer func() {
+		if err := topic.Delete(ctx); err != nil {
+			b.Errorf("Topic(%q).Delete: %v", id, err)
+		}
+	}()
+	defer topic.Stop()
+
+	const msg = "benchmark message"
+	b.Run("publish", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if err := publish(ioutil.Discard, tc.ProjectID, id, msg); err != nil {
+				b.Fatalf("publish: %v", err)
+			}
+		}
+	})
+	b.Run("shared client", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := topic.Publish(ctx, &pubsub.Message{Data: []byte(msg)}).Get(ctx); err != nil {
+				b.Fatalf("Publish: %v", err)
+			}
+		}
+	})
+}
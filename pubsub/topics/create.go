@@ -19,22 +19,26 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"time"
 
 	"cloud.google.com/go/pubsub"
+	"google.golang.org/api/option"
 )
 
-func create(w io.Writer, projectID, topicID string) error {
+func create(w io.Writer, projectID, topicID string, opts ...option.ClientOption) error {
 	// projectID := "my-project-id"
 	// topicID := "my-topic"
-	ctx := context.Background()
-	client, err := pubsub.NewClient(ctx, projectID)
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	client, err := pubsub.NewClient(ctx, projectID, opts...)
 	if err != nil {
-		return fmt.Errorf("pubsub.NewClient: %v", err)
+		return fmt.Errorf("pubsub.NewClient: %w", err)
 	}
 
 	t, err := client.CreateTopic(ctx, topicID)
 	if err != nil {
-		return fmt.Errorf("CreateTopic: %v", err)
+		return fmt.Errorf("CreateTopic: %w", err)
 	}
 	fmt.Fprintf(w, "Topic created: %v\n", t)
 	return nil
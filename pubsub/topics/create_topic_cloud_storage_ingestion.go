@@ -0,0 +1,71 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package topics
+
+// [START pubsub_create_topic_with_cloud_storage_ingestion]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"google.golang.org/api/option"
+)
+
+// createTopicWithCloudStorageIngestion creates an import topic that publishes
+// the contents of objects in a Cloud Storage bucket. Each line of a matching
+// text object becomes one message. The Pub/Sub service agent needs read
+// access to the bucket.
+func createTopicWithCloudStorageIngestion(w io.Writer, projectID, topicID, bucket, matchGlob string, minimumObjectCreateTime time.Time, opts ...option.ClientOption) error {
+	// projectID := "my-project-id"
+	// topicID := "my-topic"
+	// bucket := "bucket-name" // without the gs:// prefix
+	// matchGlob := "**.txt"
+	// minimumObjectCreateTime := time.Now().Add(-24 * time.Hour)
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	client, err := pubsub.NewClient(ctx, projectID, opts...)
+	if err != nil {
+		return fmt.Errorf("pubsub.NewClient: %w", err)
+	}
+	defer client.Close()
+
+	cfg := &pubsub.TopicConfig{
+		IngestionDataSourceSettings: &pubsub.IngestionDataSourceSettings{
+			Source: &pubsub.IngestionDataSourceCloudStorage{
+				Bucket: bucket,
+				// Objects can also be read as Avro files, or as
+				// previously exported Pub/Sub messages.
+				InputFormat: &pubsub.IngestionDataSourceCloudStorageTextFormat{
+					Delimiter: "\n",
+				},
+				// Only objects whose names match the glob and that
+				// were created after this time are ingested.
+				MatchGlob:               matchGlob,
+				MinimumObjectCreateTime: minimumObjectCreateTime,
+			},
+		},
+	}
+	t, err := client.CreateTopicWithConfig(ctx, topicID, cfg)
+	if err != nil {
+		return fmt.Errorf("CreateTopicWithConfig: %w", err)
+	}
+	fmt.Fprintf(w, "Cloud Storage import topic created: %v\n", t)
+	return nil
+}
+
+// [END pubsub_create_topic_with_cloud_storage_ingestion]
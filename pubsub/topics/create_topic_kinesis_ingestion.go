@@ -0,0 +1,66 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package topics
+
+// [START pubsub_create_topic_with_kinesis_ingestion]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"google.golang.org/api/option"
+)
+
+// createTopicWithKinesisIngestion creates an import topic that continuously
+// ingests records from an AWS Kinesis data stream. Pub/Sub reads the stream
+// through an enhanced fan-out consumer, assuming the AWS role by federating
+// the Google Cloud service account's identity.
+func createTopicWithKinesisIngestion(w io.Writer, projectID, topicID, streamARN, consumerARN, awsRoleARN, gcpServiceAccount string, opts ...option.ClientOption) error {
+	// projectID := "my-project-id"
+	// topicID := "my-topic"
+	// streamARN := "stream-arn"
+	// consumerARN := "consumer-arn"
+	// awsRoleARN := "aws-role-arn"
+	// gcpServiceAccount := "gcp-service-account"
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	client, err := pubsub.NewClient(ctx, projectID, opts...)
+	if err != nil {
+		return fmt.Errorf("pubsub.NewClient: %w", err)
+	}
+	defer client.Close()
+
+	cfg := &pubsub.TopicConfig{
+		IngestionDataSourceSettings: &pubsub.IngestionDataSourceSettings{
+			Source: &pubsub.IngestionDataSourceAWSKinesis{
+				StreamARN:         streamARN,
+				ConsumerARN:       consumerARN,
+				AWSRoleARN:        awsRoleARN,
+				GCPServiceAccount: gcpServiceAccount,
+			},
+		},
+	}
+	t, err := client.CreateTopicWithConfig(ctx, topicID, cfg)
+	if err != nil {
+		return fmt.Errorf("CreateTopicWithConfig: %w", err)
+	}
+	fmt.Fprintf(w, "Kinesis import topic created: %v\n", t)
+	return nil
+}
+
+// [END pubsub_create_topic_with_kinesis_ingestion]
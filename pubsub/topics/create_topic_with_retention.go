@@ -0,0 +1,55 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package topics
+
+// [START pubsub_create_topic_with_retention]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"google.golang.org/api/option"
+)
+
+// createTopicWithRetention creates a topic that keeps published messages for
+// the retention duration, even after they are acknowledged. Subscriptions to
+// the topic can then seek back in time to replay them.
+func createTopicWithRetention(w io.Writer, projectID, topicID string, retention time.Duration, opts ...option.ClientOption) error {
+	// projectID := "my-project-id"
+	// topicID := "my-topic"
+	// retention := 24 * time.Hour // between 10 minutes and 31 days
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	client, err := pubsub.NewClient(ctx, projectID, opts...)
+	if err != nil {
+		return fmt.Errorf("pubsub.NewClient: %w", err)
+	}
+	defer client.Close()
+
+	cfg := &pubsub.TopicConfig{
+		RetentionDuration: retention,
+	}
+	t, err := client.CreateTopicWithConfig(ctx, topicID, cfg)
+	if err != nil {
+		return fmt.Errorf("CreateTopicWithConfig: %w", err)
+	}
+	fmt.Fprintf(w, "Created topic %v with retention duration %v\n", t.ID(), retention)
+	return nil
+}
+
+// [END pubsub_create_topic_with_retention]
@@ -0,0 +1,74 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package topics
+
+// [START pubsub_detach_topic_subscription]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+)
+
+// detachTopicSubscription cuts a subscription off from a topic. The
+// subscription is kept, and may live in another project, but it stops
+// receiving messages and its backlog is dropped. Detaching requires the
+// pubsub.topics.detachSubscription permission on the topic only, so topic
+// owners can stop delivery to consumers they do not control.
+func detachTopicSubscription(w io.Writer, projectID, topicID, subName string, opts ...option.ClientOption) error {
+	// projectID := "my-project-id"
+	// topicID := "my-topic"
+	// subName := "projects/some-project/subscriptions/my-sub"
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	client, err := pubsub.NewClient(ctx, projectID, opts...)
+	if err != nil {
+		return fmt.Errorf("pubsub.NewClient: %w", err)
+	}
+	defer client.Close()
+
+	// Make sure the subscription is one of the topic's before detaching it.
+	attached := false
+	it := client.Topic(topicID).Subscriptions(ctx)
+	for {
+		sub, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("Next: %w", err)
+		}
+		if sub.String() == subName {
+			attached = true
+			break
+		}
+	}
+	if !attached {
+		return fmt.Errorf("subscription %s is not attached to topic %s", subName, topicID)
+	}
+
+	if _, err := client.DetachSubscription(ctx, subName); err != nil {
+		return fmt.Errorf("DetachSubscription: %w", err)
+	}
+	fmt.Fprintf(w, "Detached subscription %s from topic %s\n", subName, topicID)
+	return nil
+}
+
+// [END pubsub_detach_topic_subscription]
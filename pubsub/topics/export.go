@@ -0,0 +1,102 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package topics
+
+import (
+	"io"
+	"time"
+
+	"cloud.google.com/go/iam"
+	"cloud.google.com/go/pubsub"
+	"google.golang.org/api/option"
+)
+
+// The functions below export the samples that cmd/samplectl is built from,
+// so that the tool runs the same code as the documentation.
+
+// ClearTopicRetention runs the pubsub_clear_topic_retention sample.
+func ClearTopicRetention(w io.Writer, projectID, topicID string, opts ...option.ClientOption) error {
+	return clearTopicRetention(w, projectID, topicID, opts...)
+}
+
+// Create runs the pubsub_create_topic sample.
+func Create(w io.Writer, projectID, topicID string, opts ...option.ClientOption) error {
+	return create(w, projectID, topicID, opts...)
+}
+
+// CreateTopicWithRetention runs the pubsub_create_topic_with_retention sample.
+func CreateTopicWithRetention(w io.Writer, projectID, topicID string, retention time.Duration, opts ...option.ClientOption) error {
+	return createTopicWithRetention(w, projectID, topicID, retention, opts...)
+}
+
+// Delete runs the pubsub_delete_topic sample.
+func Delete(w io.Writer, projectID, topicID string, opts ...option.ClientOption) error {
+	return delete(w, projectID, topicID, opts...)
+}
+
+// DetachTopicSubscription runs the pubsub_detach_topic_subscription sample.
+func DetachTopicSubscription(w io.Writer, projectID, topicID, subName string, opts ...option.ClientOption) error {
+	return detachTopicSubscription(w, projectID, topicID, subName, opts...)
+}
+
+// GetTopicIngestionState runs the pubsub_get_topic_ingestion_state sample.
+func GetTopicIngestionState(w io.Writer, projectID, topicID string, opts ...option.ClientOption) error {
+	return getTopicIngestionState(w, projectID, topicID, opts...)
+}
+
+// ListSubscriptions runs the pubsub_list_topic_subscriptions sample.
+func ListSubscriptions(projectID, topicID string, opts ...option.ClientOption) ([]*pubsub.Subscription, error) {
+	return listSubscriptions(projectID, topicID, opts...)
+}
+
+// List runs the pubsub_list_topics sample.
+func List(projectID string, opts ...option.ClientOption) ([]*pubsub.Topic, error) {
+	return list(projectID, opts...)
+}
+
+// Policy runs the pubsub_get_topic_policy sample.
+func Policy(w io.Writer, projectID, topicID string, opts ...option.ClientOption) (*iam.Policy, error) {
+	return policy(w, projectID, topicID, opts...)
+}
+
+// Publish runs the pubsub_quickstart_publisher sample.
+func Publish(w io.Writer, projectID, topicID, msg string, opts ...option.ClientOption) error {
+	return publish(w, projectID, topicID, msg, opts...)
+}
+
+// PublishCustomAttributes runs the pubsub_publish_custom_attributes sample.
+func PublishCustomAttributes(w io.Writer, projectID, topicID string, opts ...option.ClientOption) error {
+	return publishCustomAttributes(w, projectID, topicID, opts...)
+}
+
+// PublishWithAttributes runs the pubsub_publish_with_attributes sample.
+func PublishWithAttributes(w io.Writer, projectID, topicID string, opts ...option.ClientOption) error {
+	return publishWithAttributes(w, projectID, topicID, opts...)
+}
+
+// TestPermissions runs the pubsub_test_topic_permissions sample.
+func TestPermissions(w io.Writer, projectID, topicID string, opts ...option.ClientOption) ([]string, error) {
+	return testPermissions(w, projectID, topicID, opts...)
+}
+
+// UpdateTopic runs the pubsub_update_topic sample.
+func UpdateTopic(w io.Writer, projectID, topicID, schemaName string, opts ...option.ClientOption) error {
+	return updateTopic(w, projectID, topicID, schemaName, opts...)
+}
+
+// UpdateTopicRetention runs the pubsub_update_topic_retention sample.
+func UpdateTopicRetention(w io.Writer, projectID, topicID string, retention time.Duration, opts ...option.ClientOption) error {
+	return updateTopicRetention(w, projectID, topicID, retention, opts...)
+}
@@ -0,0 +1,76 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package topics
+
+// [START pubsub_get_topic_ingestion_state]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"google.golang.org/api/option"
+)
+
+// getTopicIngestionState reports whether an import topic is ingesting. When
+// ingestion stops, the state names the cause, such as missing permissions on
+// the source.
+func getTopicIngestionState(w io.Writer, projectID, topicID string, opts ...option.ClientOption) error {
+	// projectID := "my-project-id"
+	// topicID := "my-topic"
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	client, err := pubsub.NewClient(ctx, projectID, opts...)
+	if err != nil {
+		return fmt.Errorf("pubsub.NewClient: %w", err)
+	}
+	defer client.Close()
+
+	cfg, err := client.Topic(topicID).Config(ctx)
+	if err != nil {
+		return fmt.Errorf("Config: %w", err)
+	}
+	if cfg.IngestionDataSourceSettings == nil {
+		return fmt.Errorf("topic %v is not an import topic", topicID)
+	}
+
+	switch src := cfg.IngestionDataSourceSettings.Source.(type) {
+	case *pubsub.IngestionDataSourceAWSKinesis:
+		switch src.State {
+		case pubsub.AWSKinesisStateActive:
+			fmt.Fprintf(w, "Topic %v is ingesting from Kinesis stream %v\n", topicID, src.StreamARN)
+		case pubsub.AWSKinesisStatePermissionDenied, pubsub.AWSKinesisStatePublishPermissionDenied:
+			fmt.Fprintf(w, "Topic %v cannot ingest from %v: permission denied (state %v)\n", topicID, src.StreamARN, src.State)
+		default:
+			fmt.Fprintf(w, "Topic %v ingestion from Kinesis stream %v is in state %v\n", topicID, src.StreamARN, src.State)
+		}
+	case *pubsub.IngestionDataSourceCloudStorage:
+		switch src.State {
+		case pubsub.CloudStorageIngestionStateActive:
+			fmt.Fprintf(w, "Topic %v is ingesting from gs://%v\n", topicID, src.Bucket)
+		case pubsub.CloudStorageIngestionPermissionDenied, pubsub.CloudStorageIngestionPublishPermissionDenied:
+			fmt.Fprintf(w, "Topic %v cannot ingest from gs://%v: permission denied (state %v)\n", topicID, src.Bucket, src.State)
+		default:
+			fmt.Fprintf(w, "Topic %v ingestion from gs://%v is in state %v\n", topicID, src.Bucket, src.State)
+		}
+	default:
+		fmt.Fprintf(w, "Topic %v has an unknown ingestion source %T\n", topicID, src)
+	}
+	return nil
+}
+
+// [END pubsub_get_topic_ingestion_state]
@@ -18,18 +18,22 @@ package topics
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"cloud.google.com/go/pubsub"
 	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
 )
 
-func listSubscriptions(projectID, topicID string) ([]*pubsub.Subscription, error) {
+func listSubscriptions(projectID, topicID string, opts ...option.ClientOption) ([]*pubsub.Subscription, error) {
 	// projectID := "my-project-id"
 	// topicName := "projects/sample-248520/topics/ocr-go-test-topic"
-	ctx := context.Background()
-	client, err := pubsub.NewClient(ctx, projectID)
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	client, err := pubsub.NewClient(ctx, projectID, opts...)
 	if err != nil {
-		return nil, fmt.Errorf("pubsub.NewClient: %v", err)
+		return nil, fmt.Errorf("pubsub.NewClient: %w", err)
 	}
 
 	var subs []*pubsub.Subscription
@@ -41,7 +45,7 @@ func listSubscriptions(projectID, topicID string) ([]*pubsub.Subscription, error
 			break
 		}
 		if err != nil {
-			return nil, fmt.Errorf("Next: %v", err)
+			return nil, fmt.Errorf("Next: %w", err)
 		}
 		subs = append(subs, sub)
 	}
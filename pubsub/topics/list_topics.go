@@ -18,17 +18,21 @@ package topics
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"cloud.google.com/go/pubsub"
 	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
 )
 
-func list(projectID string) ([]*pubsub.Topic, error) {
+func list(projectID string, opts ...option.ClientOption) ([]*pubsub.Topic, error) {
 	// projectID := "my-project-id"
-	ctx := context.Background()
-	client, err := pubsub.NewClient(ctx, projectID)
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	client, err := pubsub.NewClient(ctx, projectID, opts...)
 	if err != nil {
-		return nil, fmt.Errorf("pubsub.NewClient: %v", err)
+		return nil, fmt.Errorf("pubsub.NewClient: %w", err)
 	}
 
 	var topics []*pubsub.Topic
@@ -40,7 +44,7 @@ func list(projectID string) ([]*pubsub.Topic, error) {
 			break
 		}
 		if err != nil {
-			return nil, fmt.Errorf("Next: %v", err)
+			return nil, fmt.Errorf("Next: %w", err)
 		}
 		topics = append(topics, topic)
 	}
@@ -0,0 +1,108 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package topics
+
+// [START pubsub_publish_high_throughput]
+import (
+	"context"
+	"fmt"
+	"io"
+	"runtime"
+	"strconv"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"google.golang.org/api/option"
+)
+
+// publishHighThroughput publishes n messages from a pool of goroutines and
+// reports the publish rate.
+//
+// Publish itself never blocks on the network: it adds the message to a batch
+// and returns a PublishResult. Waiting on each result before publishing the
+// next message serializes every round trip, so instead the publishing
+// goroutines hand results to a separate collector. A semaphore caps how many
+// messages are in flight at once, which bounds memory if Pub/Sub slows down.
+func publishHighThroughput(w io.Writer, projectID, topicID string, n int, opts ...option.ClientOption) error {
+	// projectID := "my-project-id"
+	// topicID := "my-topic"
+	// n := 100000
+	const timeout = time.Minute
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	client, err := pubsub.NewClient(ctx, projectID, opts...)
+	if err != nil {
+		return fmt.Errorf("pubsub.NewClient: %w", err)
+	}
+	defer client.Close()
+
+	t := client.Topic(topicID)
+	defer t.Stop()
+
+	const maxInFlight = 10000
+	sem := make(chan struct{}, maxInFlight)
+	results := make(chan *pubsub.PublishResult, maxInFlight)
+
+	// The collector checks results as they complete, off the publishing
+	// goroutines' hot path.
+	var failed int
+	var firstErr error
+	collected := make(chan struct{})
+	go func() {
+		defer close(collected)
+		for res := range results {
+			if _, err := res.Get(ctx); err != nil {
+				failed++
+				if firstErr == nil {
+					firstErr = err
+				}
+			}
+			<-sem
+		}
+	}()
+
+	start := time.Now()
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < runtime.NumCPU(); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				sem <- struct{}{}
+				results <- t.Publish(ctx, &pubsub.Message{
+					Data: []byte("Message " + strconv.Itoa(i)),
+				})
+			}
+		}()
+	}
+	for i := 0; i < n; i++ {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+	close(results)
+	<-collected
+	elapsed := time.Since(start)
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d messages did not publish successfully, first error: %w", failed, n, firstErr)
+	}
+	fmt.Fprintf(w, "Published %d messages in %v (%.0f messages/sec)\n", n, elapsed.Round(time.Millisecond), float64(n)/elapsed.Seconds())
+	return nil
+}
+
+// [END pubsub_publish_high_throughput]
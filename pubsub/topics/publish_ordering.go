@@ -21,20 +21,23 @@ import (
 	"io"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"cloud.google.com/go/pubsub"
 	"google.golang.org/api/option"
 )
 
-func publishWithOrderingKey(w io.Writer, projectID, topicID string) {
+func publishWithOrderingKey(w io.Writer, projectID, topicID string, opts ...option.ClientOption) {
 	// projectID := "my-project-id"
 	// topicID := "my-topic"
-	ctx := context.Background()
+	const timeout = time.Minute
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
 
 	// Sending messages to the same region ensures they are received in order
-	// even when multiple publishers are used.
-	client, err := pubsub.NewClient(ctx, projectID,
-		option.WithEndpoint("us-east1-pubsub.googleapis.com:443"))
+	// even when multiple publishers are used. An endpoint in opts overrides it.
+	opts = append([]option.ClientOption{option.WithEndpoint("us-east1-pubsub.googleapis.com:443")}, opts...)
+	client, err := pubsub.NewClient(ctx, projectID, opts...)
 	if err != nil {
 		fmt.Fprintf(w, "pubsub.NewClient: %v", err)
 		return
@@ -82,7 +85,7 @@ func publishWithOrderingKey(w io.Writer, projectID, topicID string) {
 			_, err := res.Get(ctx)
 			if err != nil {
 				// Error handling code can be added here.
-				fmt.Printf("Failed to publish: %s\n", err)
+				fmt.Fprintf(w, "Failed to publish: %s\n", err)
 				atomic.AddUint64(&totalErrors, 1)
 				return
 			}
@@ -0,0 +1,128 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package topics
+
+// [START pubsub_publish_ordering_keys_with_resume]
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"google.golang.org/api/option"
+)
+
+// publishOrderedWithResume publishes several messages for each of a few
+// ordering keys. If a message fails to publish, the client stops accepting
+// messages for its ordering key so that none can be delivered out of order.
+// The sample then calls ResumePublish and republishes, starting from the
+// failed message, so the order is kept.
+func publishOrderedWithResume(w io.Writer, projectID, topicID, region string, opts ...option.ClientOption) error {
+	// projectID := "my-project-id"
+	// topicID := "my-topic"
+	// region := "us-east1"
+	const timeout = time.Minute
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	// Ordering is only guaranteed for messages published in the same
+	// region. The global endpoint routes each request to the nearest region,
+	// which can change between requests, so pin the client to a regional
+	// endpoint when more than one publisher uses the same ordering key. An
+	// endpoint in opts overrides it.
+	opts = append([]option.ClientOption{option.WithEndpoint(fmt.Sprintf("%s-pubsub.googleapis.com:443", region))}, opts...)
+	client, err := pubsub.NewClient(ctx, projectID, opts...)
+	if err != nil {
+		return fmt.Errorf("pubsub.NewClient: %w", err)
+	}
+	defer client.Close()
+
+	t := client.Topic(topicID)
+	t.EnableMessageOrdering = true
+	defer t.Stop()
+
+	messages := map[string][]string{
+		"key1": {"key1-message1", "key1-message2", "key1-message3"},
+		"key2": {"key2-message1", "key2-message2", "key2-message3"},
+	}
+
+	// Messages with different ordering keys are independent, so publish
+	// each key concurrently.
+	var wg sync.WaitGroup
+	errs := make(chan error, len(messages))
+	for key, msgs := range messages {
+		wg.Add(1)
+		go func(key string, msgs []string) {
+			defer wg.Done()
+			if err := publishKeyInOrder(ctx, t, key, msgs); err != nil {
+				errs <- err
+			}
+		}(key, msgs)
+	}
+	wg.Wait()
+	close(errs)
+	if err := <-errs; err != nil {
+		return err
+	}
+
+	total := 0
+	for _, msgs := range messages {
+		total += len(msgs)
+	}
+	fmt.Fprintf(w, "Published %d messages with ordering keys in order\n", total)
+	return nil
+}
+
+// publishKeyInOrder publishes msgs with the ordering key, resuming publishing
+// for the key and retrying from the first failed message when needed.
+func publishKeyInOrder(ctx context.Context, t *pubsub.Topic, key string, msgs []string) error {
+	const maxAttempts = 3
+	pending := msgs
+	for attempt := 1; ; attempt++ {
+		results := make([]*pubsub.PublishResult, len(pending))
+		for i, m := range pending {
+			results[i] = t.Publish(ctx, &pubsub.Message{
+				Data:        []byte(m),
+				OrderingKey: key,
+			})
+		}
+
+		// Results are checked in publish order: once one fails, every
+		// later message with the same key fails too.
+		failed := -1
+		var err error
+		for i, res := range results {
+			if _, err = res.Get(ctx); err != nil {
+				failed = i
+				break
+			}
+		}
+		if failed < 0 {
+			return nil
+		}
+		if attempt == maxAttempts {
+			return fmt.Errorf("publishing %q with ordering key %q: %w", pending[failed], key, err)
+		}
+
+		// Until ResumePublish is called, Publish fails immediately for
+		// this ordering key.
+		t.ResumePublish(key)
+		pending = pending[failed:]
+	}
+}
+
+// [END pubsub_publish_ordering_keys_with_resume]
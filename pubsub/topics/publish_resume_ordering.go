@@ -19,20 +19,23 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"time"
 
 	"cloud.google.com/go/pubsub"
 	"google.golang.org/api/option"
 )
 
-func resumePublishWithOrderingKey(w io.Writer, projectID, topicID string) {
+func resumePublishWithOrderingKey(w io.Writer, projectID, topicID string, opts ...option.ClientOption) {
 	// projectID := "my-project-id"
 	// topicID := "my-topic"
-	ctx := context.Background()
+	const timeout = time.Minute
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
 
 	// Sending messages to the same region ensures they are received in order
-	// even when multiple publishers are used.
-	client, err := pubsub.NewClient(ctx, projectID,
-		option.WithEndpoint("us-east1-pubsub.googleapis.com:443"))
+	// even when multiple publishers are used. An endpoint in opts overrides it.
+	opts = append([]option.ClientOption{option.WithEndpoint("us-east1-pubsub.googleapis.com:443")}, opts...)
+	client, err := pubsub.NewClient(ctx, projectID, opts...)
 	if err != nil {
 		fmt.Fprintf(w, "pubsub.NewClient: %v", err)
 		return
@@ -50,7 +53,7 @@ func resumePublishWithOrderingKey(w io.Writer, projectID, topicID string) {
 	_, err = res.Get(ctx)
 	if err != nil {
 		// Error handling code can be added here.
-		fmt.Printf("Failed to publish: %s\n", err)
+		fmt.Fprintf(w, "Failed to publish: %s\n", err)
 
 		// Resume publish on an ordering key that has had unrecoverable errors.
 		// After such an error publishes with this ordering key will fail
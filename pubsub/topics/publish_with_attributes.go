@@ -0,0 +1,76 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package topics
+
+// [START pubsub_publish_with_attributes]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"google.golang.org/api/option"
+)
+
+// publishWithAttributes publishes messages that carry attributes describing
+// them. Subscriptions can filter on attributes, so subscribers only receive,
+// and pay for, the messages they need.
+func publishWithAttributes(w io.Writer, projectID, topicID string, opts ...option.ClientOption) error {
+	// projectID := "my-project-id"
+	// topicID := "my-topic"
+	const timeout = time.Minute
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	client, err := pubsub.NewClient(ctx, projectID, opts...)
+	if err != nil {
+		return fmt.Errorf("pubsub.NewClient: %w", err)
+	}
+	defer client.Close()
+
+	orders := []struct {
+		data     string
+		region   string
+		priority string
+	}{
+		{"order-1", "us", "high"},
+		{"order-2", "eu", "low"},
+		{"order-3", "us", "low"},
+		{"order-4", "asia", "high"},
+	}
+
+	t := client.Topic(topicID)
+	defer t.Stop()
+	var results []*pubsub.PublishResult
+	for _, o := range orders {
+		results = append(results, t.Publish(ctx, &pubsub.Message{
+			Data: []byte(o.data),
+			Attributes: map[string]string{
+				"region":   o.region,
+				"priority": o.priority,
+			},
+		}))
+	}
+	for i, res := range results {
+		id, err := res.Get(ctx)
+		if err != nil {
+			return fmt.Errorf("Get: %w", err)
+		}
+		fmt.Fprintf(w, "Published %s (region=%s, priority=%s); msg ID: %v\n", orders[i].data, orders[i].region, orders[i].priority, id)
+	}
+	return nil
+}
+
+// [END pubsub_publish_with_attributes]
@@ -0,0 +1,107 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package topics
+
+// [START pubsub_publisher_retry_settings]
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	vkit "cloud.google.com/go/pubsub/apiv1"
+	gax "github.com/googleapis/gax-go/v2"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// publishWithRetrySettings publishes a message with custom RPC retry
+// parameters and an overall publish timeout.
+//
+// By default the client retries a failed Publish RPC for up to 60 seconds,
+// and keeps trying to publish a message for up to 60 seconds in total, so a
+// publisher that cannot reach Pub/Sub stalls for a minute before Get returns
+// an error. Tighter settings make the publisher fail fast so the application
+// can react.
+func publishWithRetrySettings(w io.Writer, projectID, topicID, msg string, opts ...option.ClientOption) error {
+	// projectID := "my-project-id"
+	// topicID := "my-topic"
+	// msg := "Hello World"
+	const timeout = time.Minute
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	config := &pubsub.ClientConfig{
+		PublisherCallOptions: &vkit.PublisherCallOptions{
+			Publish: []gax.CallOption{
+				gax.WithRetry(func() gax.Retryer {
+					return gax.OnCodes([]codes.Code{
+						codes.Aborted,
+						codes.Canceled,
+						codes.Internal,
+						codes.ResourceExhausted,
+						codes.Unknown,
+						codes.Unavailable,
+						codes.DeadlineExceeded,
+					}, gax.Backoff{
+						Initial:    250 * time.Millisecond, // default 100 milliseconds
+						Max:        5 * time.Second,        // default 60 seconds
+						Multiplier: 1.45,                   // default 1.3
+					})
+				}),
+				// Each Publish RPC, including each retry, may take at
+				// most this long.
+				gax.WithTimeout(10 * time.Second),
+			},
+		},
+	}
+	client, err := pubsub.NewClientWithConfig(ctx, projectID, config, opts...)
+	if err != nil {
+		return fmt.Errorf("pubsub.NewClientWithConfig: %w", err)
+	}
+	defer client.Close()
+
+	t := client.Topic(topicID)
+	// Give up on a message, including all of its retries, after 20 seconds.
+	t.PublishSettings.Timeout = 20 * time.Second
+	defer t.Stop()
+
+	result := t.Publish(ctx, &pubsub.Message{
+		Data: []byte(msg),
+	})
+	id, err := result.Get(ctx)
+	if isDeadlineExceeded(err) {
+		// The message was not published in time. Depending on the
+		// application, log it, store it to publish later, or surface the
+		// failure to the caller.
+		return fmt.Errorf("publish timed out after %v: %w", t.PublishSettings.Timeout, err)
+	}
+	if err != nil {
+		return fmt.Errorf("Get: %w", err)
+	}
+	fmt.Fprintf(w, "Published a message with retry settings: %v\n", id)
+	return nil
+}
+
+// isDeadlineExceeded reports whether err means a publish ran out of time. The
+// deadline can expire in the client, or in an RPC on the server.
+func isDeadlineExceeded(err error) bool {
+	return errors.Is(err, context.DeadlineExceeded) || status.Code(err) == codes.DeadlineExceeded
+}
+
+// [END pubsub_publisher_retry_settings]
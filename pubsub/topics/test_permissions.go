@@ -19,17 +19,21 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"time"
 
 	"cloud.google.com/go/pubsub"
+	"google.golang.org/api/option"
 )
 
-func testPermissions(w io.Writer, projectID, topicID string) ([]string, error) {
+func testPermissions(w io.Writer, projectID, topicID string, opts ...option.ClientOption) ([]string, error) {
 	// projectID := "my-project-id"
 	// topicID := "my-topic"
-	ctx := context.Background()
-	client, err := pubsub.NewClient(ctx, projectID)
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	client, err := pubsub.NewClient(ctx, projectID, opts...)
 	if err != nil {
-		return nil, fmt.Errorf("pubsub.NewClient: %v", err)
+		return nil, fmt.Errorf("pubsub.NewClient: %w", err)
 	}
 
 	topic := client.Topic(topicID)
@@ -38,7 +42,7 @@ func testPermissions(w io.Writer, projectID, topicID string) ([]string, error) {
 		"pubsub.topics.update",
 	})
 	if err != nil {
-		return nil, fmt.Errorf("TestPermissions: %v", err)
+		return nil, fmt.Errorf("TestPermissions: %w", err)
 	}
 	for _, perm := range perms {
 		fmt.Fprintf(w, "Allowed: %v\n", perm)
@@ -19,7 +19,10 @@ package topics
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io/ioutil"
+	"os"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -27,9 +30,12 @@ import (
 	"cloud.google.com/go/iam"
 	"cloud.google.com/go/pubsub"
 	"github.com/GoogleCloudPlatform/golang-samples/internal/testutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
-var topicID string
+// topicID is the topic shared by the tests, unique to this run.
+var topicID = testutil.UniqueName("topic")
 
 // once guards cleanup related operations in setup. No need to set up and tear
 // down every time, so this speeds things up.
@@ -37,9 +43,8 @@ var once sync.Once
 
 func setup(t *testing.T) *pubsub.Client {
 	ctx := context.Background()
-	tc := testutil.SystemTest(t)
+	tc := testutil.PubsubEmulatorTest(t)
 
-	topicID = "test-topic"
 	var err error
 	client, err := pubsub.NewClient(ctx, tc.ProjectID)
 	if err != nil {
@@ -65,7 +70,7 @@ func setup(t *testing.T) *pubsub.Client {
 
 func TestCreate(t *testing.T) {
 	client := setup(t)
-	tc := testutil.SystemTest(t)
+	tc := testutil.PubsubEmulatorTest(t)
 	buf := new(bytes.Buffer)
 	if err := create(buf, tc.ProjectID, topicID); err != nil {
 		t.Fatalf("failed to create a topic: %v", err)
@@ -80,9 +85,9 @@ func TestCreate(t *testing.T) {
 }
 
 func TestList(t *testing.T) {
-	tc := testutil.SystemTest(t)
+	tc := testutil.PubsubEmulatorTest(t)
 
-	testutil.Retry(t, 10, time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
 		topics, err := list(tc.ProjectID)
 		if err != nil {
 			r.Errorf("failed to list topics: %v", err)
@@ -106,7 +111,7 @@ func TestPublish(t *testing.T) {
 	// Nothing much to do here, unless we are consuming.
 	// TODO(jbd): Merge topics and subscriptions programs maybe?
 	ctx := context.Background()
-	tc := testutil.SystemTest(t)
+	tc := testutil.PubsubEmulatorTest(t)
 	client := setup(t)
 	client.CreateTopic(ctx, topicID)
 	buf := new(bytes.Buffer)
@@ -117,7 +122,7 @@ func TestPublish(t *testing.T) {
 
 func TestPublishThatScales(t *testing.T) {
 	ctx := context.Background()
-	tc := testutil.SystemTest(t)
+	tc := testutil.PubsubEmulatorTest(t)
 	client := setup(t)
 	client.CreateTopic(ctx, topicID)
 	buf := new(bytes.Buffer)
@@ -126,19 +131,65 @@ func TestPublishThatScales(t *testing.T) {
 	}
 }
 
-func TestPublishWithSettings(t *testing.T) {
+func TestPublishHighThroughput(t *testing.T) {
 	ctx := context.Background()
 	tc := testutil.SystemTest(t)
 	client := setup(t)
 	client.CreateTopic(ctx, topicID)
+	buf := new(bytes.Buffer)
+	if err := publishHighThroughput(buf, tc.ProjectID, topicID, 1000); err != nil {
+		t.Fatalf("publishHighThroughput: %v", err)
+	}
+	if got, want := buf.String(), "Published 1000 messages"; !strings.Contains(got, want) {
+		t.Errorf("publishHighThroughput got %q, want to contain %q", got, want)
+	}
+}
+
+func TestPublishWithSettings(t *testing.T) {
+	ctx := context.Background()
+	tc := testutil.PubsubEmulatorTest(t)
+	client := setup(t)
+	client.CreateTopic(ctx, topicID)
 	if err := publishWithSettings(ioutil.Discard, tc.ProjectID, topicID); err != nil {
 		t.Errorf("failed to publish message: %v", err)
 	}
 }
 
+func TestPublishWithRetrySettings(t *testing.T) {
+	ctx := context.Background()
+	tc := testutil.PubsubEmulatorTest(t)
+	client := setup(t)
+	client.CreateTopic(ctx, topicID)
+	buf := new(bytes.Buffer)
+	if err := publishWithRetrySettings(buf, tc.ProjectID, topicID, "hello"); err != nil {
+		t.Fatalf("publishWithRetrySettings: %v", err)
+	}
+	if got, want := buf.String(), "Published a message with retry settings"; !strings.Contains(got, want) {
+		t.Errorf("publishWithRetrySettings got %q, want to contain %q", got, want)
+	}
+}
+
+func TestIsDeadlineExceeded(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{nil, false},
+		{context.DeadlineExceeded, true},
+		{fmt.Errorf("publish: %w", context.DeadlineExceeded), true},
+		{status.Error(codes.DeadlineExceeded, "deadline"), true},
+		{status.Error(codes.Unavailable, "unavailable"), false},
+	}
+	for _, tc := range tests {
+		if got := isDeadlineExceeded(tc.err); got != tc.want {
+			t.Errorf("isDeadlineExceeded(%v) got %v, want %v", tc.err, got, tc.want)
+		}
+	}
+}
+
 func TestPublishCustomAttributes(t *testing.T) {
 	ctx := context.Background()
-	tc := testutil.SystemTest(t)
+	tc := testutil.PubsubEmulatorTest(t)
 	client := setup(t)
 	client.CreateTopic(ctx, topicID)
 	buf := new(bytes.Buffer)
@@ -147,13 +198,28 @@ func TestPublishCustomAttributes(t *testing.T) {
 	}
 }
 
+func TestPublishWithAttributes(t *testing.T) {
+	ctx := context.Background()
+	tc := testutil.PubsubEmulatorTest(t)
+	client := setup(t)
+	client.CreateTopic(ctx, topicID)
+	buf := new(bytes.Buffer)
+	if err := publishWithAttributes(buf, tc.ProjectID, topicID); err != nil {
+		t.Fatalf("publishWithAttributes: %v", err)
+	}
+	if got, want := strings.Count(buf.String(), "msg ID"), 4; got != want {
+		t.Errorf("publishWithAttributes published %d messages, want %d: %q", got, want, buf.String())
+	}
+}
+
 func TestIAM(t *testing.T) {
+	testutil.SkipIfPubsubEmulator(t, "IAM")
 	ctx := context.Background()
 	tc := testutil.SystemTest(t)
 	client := setup(t)
 	client.CreateTopic(ctx, topicID)
 
-	testutil.Retry(t, 10, time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
 		buf := new(bytes.Buffer)
 		perms, err := testPermissions(buf, tc.ProjectID, topicID)
 		if err != nil {
@@ -164,13 +230,13 @@ func TestIAM(t *testing.T) {
 		}
 	})
 
-	testutil.Retry(t, 10, time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
 		if err := addUsers(tc.ProjectID, topicID); err != nil {
 			r.Errorf("addUsers: %v", err)
 		}
 	})
 
-	testutil.Retry(t, 10, time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
 		buf := new(bytes.Buffer)
 		policy, err := policy(buf, tc.ProjectID, topicID)
 		if err != nil {
@@ -183,11 +249,46 @@ func TestIAM(t *testing.T) {
 			r.Errorf("want %q as viewer, policy=%v", member, policy)
 		}
 	})
+
+	const (
+		member = "group:cloud-logs@google.com"
+		role   = iam.RoleName("roles/pubsub.publisher")
+	)
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
+		if err := addTopicIAMMember(ioutil.Discard, tc.ProjectID, topicID, member, role); err != nil {
+			r.Errorf("addTopicIAMMember: %v", err)
+		}
+	})
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
+		policy, err := policy(ioutil.Discard, tc.ProjectID, topicID)
+		if err != nil {
+			r.Errorf("policy: %v", err)
+			return
+		}
+		if !policy.HasRole(member, role) {
+			r.Errorf("want %q as %v, policy=%v", member, role, policy)
+		}
+	})
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
+		if err := removeTopicIAMMember(ioutil.Discard, tc.ProjectID, topicID, member, role); err != nil {
+			r.Errorf("removeTopicIAMMember: %v", err)
+		}
+	})
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
+		policy, err := policy(ioutil.Discard, tc.ProjectID, topicID)
+		if err != nil {
+			r.Errorf("policy: %v", err)
+			return
+		}
+		if policy.HasRole(member, role) {
+			r.Errorf("want %q removed from %v, policy=%v", member, role, policy)
+		}
+	})
 }
 
 func TestDelete(t *testing.T) {
 	ctx := context.Background()
-	tc := testutil.SystemTest(t)
+	tc := testutil.PubsubEmulatorTest(t)
 	client := setup(t)
 
 	topic := client.Topic(topicID)
@@ -244,3 +345,248 @@ func TestResumePublishWithOrderingKey(t *testing.T) {
 		t.Fatalf("failed to resume with ordering keys:\n got: %v", got)
 	}
 }
+
+func TestPublishOrderedWithResume(t *testing.T) {
+	ctx := context.Background()
+	tc := testutil.SystemTest(t)
+	client := setup(t)
+	client.CreateTopic(ctx, topicID)
+	buf := new(bytes.Buffer)
+	if err := publishOrderedWithResume(buf, tc.ProjectID, topicID, "us-east1"); err != nil {
+		t.Fatalf("publishOrderedWithResume: %v", err)
+	}
+	if got, want := buf.String(), "Published 6 messages with ordering keys in order\n"; got != want {
+		t.Errorf("publishOrderedWithResume got %q, want %q", got, want)
+	}
+}
+
+func TestTopicRetention(t *testing.T) {
+	ctx := context.Background()
+	tc := testutil.SystemTest(t)
+	client := setup(t)
+	retentionTopicID := topicID + "-retention"
+	topic := client.Topic(retentionTopicID)
+	if ok, err := topic.Exists(ctx); err != nil {
+		t.Fatalf("Exists: %v", err)
+	} else if ok {
+		topic.Delete(ctx)
+	}
+	defer topic.Delete(ctx)
+
+	checkRetention := func(want time.Duration) {
+		t.Helper()
+		cfg, err := topic.Config(ctx)
+		if err != nil {
+			t.Fatalf("Config: %v", err)
+		}
+		got, _ := cfg.RetentionDuration.(time.Duration)
+		if got != want {
+			t.Errorf("RetentionDuration got %v, want %v", cfg.RetentionDuration, want)
+		}
+	}
+
+	if err := createTopicWithRetention(ioutil.Discard, tc.ProjectID, retentionTopicID, time.Hour); err != nil {
+		t.Fatalf("createTopicWithRetention: %v", err)
+	}
+	checkRetention(time.Hour)
+
+	if err := updateTopicRetention(ioutil.Discard, tc.ProjectID, retentionTopicID, 2*time.Hour); err != nil {
+		t.Fatalf("updateTopicRetention: %v", err)
+	}
+	checkRetention(2 * time.Hour)
+
+	if err := clearTopicRetention(ioutil.Discard, tc.ProjectID, retentionTopicID); err != nil {
+		t.Fatalf("clearTopicRetention: %v", err)
+	}
+	checkRetention(0)
+}
+
+func TestListAndDetachSubscriptions(t *testing.T) {
+	ctx := context.Background()
+	tc := testutil.SystemTest(t)
+	client := setup(t)
+	detachTopicID := topicID + "-detach"
+	topic := client.Topic(detachTopicID)
+	if ok, err := topic.Exists(ctx); err != nil {
+		t.Fatalf("Exists: %v", err)
+	} else if !ok {
+		if topic, err = client.CreateTopic(ctx, detachTopicID); err != nil {
+			t.Fatalf("CreateTopic: %v", err)
+		}
+	}
+	defer topic.Delete(ctx)
+
+	subID := detachTopicID + "-sub"
+	sub := client.Subscription(subID)
+	if ok, err := sub.Exists(ctx); err != nil {
+		t.Fatalf("Exists: %v", err)
+	} else if ok {
+		sub.Delete(ctx)
+	}
+	sub, err := client.CreateSubscription(ctx, subID, pubsub.SubscriptionConfig{Topic: topic})
+	if err != nil {
+		t.Fatalf("CreateSubscription: %v", err)
+	}
+	defer sub.Delete(ctx)
+
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
+		subs, err := listSubscriptions(tc.ProjectID, detachTopicID)
+		if err != nil {
+			r.Errorf("listSubscriptions: %v", err)
+			return
+		}
+		for _, s := range subs {
+			if s.ID() == subID {
+				return
+			}
+		}
+		r.Errorf("listSubscriptions got %v, want %q", subs, subID)
+	})
+
+	buf := new(bytes.Buffer)
+	if err := detachTopicSubscription(buf, tc.ProjectID, detachTopicID, sub.String()); err != nil {
+		t.Fatalf("detachTopicSubscription: %v", err)
+	}
+	if got, want := buf.String(), "Detached subscription"; !strings.Contains(got, want) {
+		t.Errorf("detachTopicSubscription got %q, want to contain %q", got, want)
+	}
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
+		cfg, err := sub.Config(ctx)
+		if err != nil {
+			r.Errorf("Config: %v", err)
+			return
+		}
+		if !cfg.Detached {
+			r.Errorf("subscription %q is not detached", subID)
+		}
+	})
+
+	if err := detachTopicSubscription(ioutil.Discard, tc.ProjectID, detachTopicID, "projects/p/subscriptions/not-attached"); err == nil {
+		t.Errorf("detachTopicSubscription of an unknown subscription got nil error")
+	}
+}
+
+func TestUpdateTopic(t *testing.T) {
+	testutil.SkipIfPubsubEmulator(t, "Schemas")
+	ctx := context.Background()
+	tc := testutil.SystemTest(t)
+	client := setup(t)
+	updateTopicID := topicID + "-update"
+	topic := client.Topic(updateTopicID)
+	if ok, err := topic.Exists(ctx); err != nil {
+		t.Fatalf("Exists: %v", err)
+	} else if !ok {
+		if topic, err = client.CreateTopic(ctx, updateTopicID); err != nil {
+			t.Fatalf("CreateTopic: %v", err)
+		}
+	}
+	defer topic.Delete(ctx)
+
+	schemaClient, err := pubsub.NewSchemaClient(ctx, tc.ProjectID)
+	if err != nil {
+		t.Fatalf("pubsub.NewSchemaClient: %v", err)
+	}
+	defer schemaClient.Close()
+	schemaID := fmt.Sprintf("%s-schema-%d", updateTopicID, time.Now().UnixNano())
+	schema, err := schemaClient.CreateSchema(ctx, schemaID, pubsub.SchemaConfig{
+		Type:       pubsub.SchemaAvro,
+		Definition: `{"type":"record","name":"Payment","fields":[{"name":"id","type":"string"}]}`,
+	})
+	if err != nil {
+		t.Fatalf("CreateSchema: %v", err)
+	}
+	defer func() {
+		// The schema can only be deleted once no topic uses it.
+		topic.Delete(ctx)
+		schemaClient.DeleteSchema(ctx, schemaID)
+	}()
+
+	buf := new(bytes.Buffer)
+	if err := updateTopic(buf, tc.ProjectID, updateTopicID, schema.Name); err != nil {
+		t.Fatalf("updateTopic: %v", err)
+	}
+	cfg, err := topic.Config(ctx)
+	if err != nil {
+		t.Fatalf("Config: %v", err)
+	}
+	if cfg.SchemaSettings == nil || cfg.SchemaSettings.Schema != schema.Name {
+		t.Errorf("SchemaSettings got %+v, want schema %q", cfg.SchemaSettings, schema.Name)
+	}
+	if got, want := cfg.Labels["team"], "payments"; got != want {
+		t.Errorf("Labels[team] got %q, want %q", got, want)
+	}
+}
+
+func TestCloudStorageIngestionTopic(t *testing.T) {
+	testutil.SkipIfPubsubEmulator(t, "Import topics")
+	ctx := context.Background()
+	tc := testutil.SystemTest(t)
+	client := setup(t)
+	ingestTopicID := topicID + "-gcs-ingestion"
+	bucket := testutil.UniqueName("pubsub-ingestion")
+	testutil.CleanBucket(ctx, t, tc.ProjectID, bucket)
+
+	topic := client.Topic(ingestTopicID)
+	if ok, err := topic.Exists(ctx); err != nil {
+		t.Fatalf("Exists: %v", err)
+	} else if ok {
+		topic.Delete(ctx)
+	}
+	defer topic.Delete(ctx)
+
+	buf := new(bytes.Buffer)
+	if err := createTopicWithCloudStorageIngestion(buf, tc.ProjectID, ingestTopicID, bucket, "**.txt", time.Now()); err != nil {
+		t.Fatalf("createTopicWithCloudStorageIngestion: %v", err)
+	}
+	if got, want := buf.String(), "Cloud Storage import topic created"; !strings.Contains(got, want) {
+		t.Errorf("createTopicWithCloudStorageIngestion got %q, want to contain %q", got, want)
+	}
+
+	// The service agent may not have access to the bucket yet, so only
+	// check that a state is reported.
+	buf.Reset()
+	if err := getTopicIngestionState(buf, tc.ProjectID, ingestTopicID); err != nil {
+		t.Fatalf("getTopicIngestionState: %v", err)
+	}
+	if got, want := buf.String(), bucket; !strings.Contains(got, want) {
+		t.Errorf("getTopicIngestionState got %q, want to contain %q", got, want)
+	}
+}
+
+// TestKinesisIngestionTopic needs an AWS Kinesis stream and consumer, and an
+// AWS role that trusts the service account, so it only runs when they are
+// configured.
+func TestKinesisIngestionTopic(t *testing.T) {
+	testutil.SkipIfPubsubEmulator(t, "Import topics")
+	ctx := context.Background()
+	tc := testutil.SystemTest(t)
+	var (
+		streamARN      = os.Getenv("GOLANG_SAMPLES_KINESIS_STREAM_ARN")
+		consumerARN    = os.Getenv("GOLANG_SAMPLES_KINESIS_CONSUMER_ARN")
+		awsRoleARN     = os.Getenv("GOLANG_SAMPLES_KINESIS_AWS_ROLE_ARN")
+		serviceAccount = os.Getenv("GOLANG_SAMPLES_KINESIS_GCP_SERVICE_ACCOUNT")
+	)
+	if streamARN == "" || consumerARN == "" || awsRoleARN == "" || serviceAccount == "" {
+		t.Skip("GOLANG_SAMPLES_KINESIS_* environment variables not set")
+	}
+	client := setup(t)
+	ingestTopicID := topicID + "-kinesis-ingestion"
+	topic := client.Topic(ingestTopicID)
+	if ok, err := topic.Exists(ctx); err != nil {
+		t.Fatalf("Exists: %v", err)
+	} else if ok {
+		topic.Delete(ctx)
+	}
+	defer topic.Delete(ctx)
+
+	if err := createTopicWithKinesisIngestion(ioutil.Discard, tc.ProjectID, ingestTopicID, streamARN, consumerARN, awsRoleARN, serviceAccount); err != nil {
+		t.Fatalf("createTopicWithKinesisIngestion: %v", err)
+	}
+	buf := new(bytes.Buffer)
+	if err := getTopicIngestionState(buf, tc.ProjectID, ingestTopicID); err != nil {
+		t.Fatalf("getTopicIngestionState: %v", err)
+	}
+	if got, want := buf.String(), streamARN; !strings.Contains(got, want) {
+		t.Errorf("getTopicIngestionState got %q, want to contain %q", got, want)
+	}
+}
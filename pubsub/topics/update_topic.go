@@ -0,0 +1,65 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package topics
+
+// [START pubsub_update_topic]
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"google.golang.org/api/option"
+)
+
+// updateTopic changes the settings of an existing topic: it binds the topic
+// to a schema, keeps messages for two days, and labels the topic. Only the
+// fields set in TopicConfigToUpdate are changed.
+func updateTopic(w io.Writer, projectID, topicID, schemaName string, opts ...option.ClientOption) error {
+	// projectID := "my-project-id"
+	// topicID := "my-topic"
+	// schemaName := "projects/my-project-id/schemas/my-schema"
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	client, err := pubsub.NewClient(ctx, projectID, opts...)
+	if err != nil {
+		return fmt.Errorf("pubsub.NewClient: %w", err)
+	}
+	defer client.Close()
+
+	cfg, err := client.Topic(topicID).Update(ctx, pubsub.TopicConfigToUpdate{
+		// Messages published from now on must conform to the schema.
+		SchemaSettings: &pubsub.SchemaSettings{
+			Schema:   schemaName,
+			Encoding: pubsub.EncodingJSON,
+		},
+		RetentionDuration: 48 * time.Hour,
+		// Labels replace all of the topic's existing labels.
+		Labels: map[string]string{
+			"team": "payments",
+			"env":  "prod",
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("Update: %w", err)
+	}
+	fmt.Fprintf(w, "Updated topic %v: schema %v, retention %v, labels %v\n",
+		topicID, cfg.SchemaSettings.Schema, cfg.RetentionDuration, cfg.Labels)
+	return nil
+}
+
+// [END pubsub_update_topic]
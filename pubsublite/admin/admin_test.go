@@ -0,0 +1,78 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/GoogleCloudPlatform/golang-samples/internal/testutil"
+)
+
+const (
+	region = "us-central1"
+	zone   = "us-central1-a"
+)
+
+func TestAdmin(t *testing.T) {
+	tc := testutil.SystemTest(t)
+	suffix := time.Now().Unix()
+	reservationID := fmt.Sprintf("lite-res-%d", suffix)
+	topicID := fmt.Sprintf("lite-topic-%d", suffix)
+	subID := fmt.Sprintf("lite-sub-%d", suffix)
+
+	buf := new(bytes.Buffer)
+	if err := createReservation(buf, tc.ProjectID, region, reservationID, 4); err != nil {
+		t.Fatalf("createReservation: %v", err)
+	}
+	if got, want := buf.String(), "Created reservation"; !strings.Contains(got, want) {
+		t.Errorf("createReservation got %q, want to contain %q", got, want)
+	}
+
+	buf.Reset()
+	if err := createTopic(buf, tc.ProjectID, region, zone, topicID, reservationID); err != nil {
+		t.Fatalf("createTopic: %v", err)
+	}
+	if got, want := buf.String(), topicID; !strings.Contains(got, want) {
+		t.Errorf("createTopic got %q, want to contain %q", got, want)
+	}
+
+	buf.Reset()
+	if err := createSubscription(buf, tc.ProjectID, region, zone, topicID, subID); err != nil {
+		t.Fatalf("createSubscription: %v", err)
+	}
+	if got, want := buf.String(), subID; !strings.Contains(got, want) {
+		t.Errorf("createSubscription got %q, want to contain %q", got, want)
+	}
+
+	// Delete in reverse order: a reservation cannot be deleted while a
+	// topic uses it.
+	buf.Reset()
+	if err := deleteSubscription(buf, tc.ProjectID, region, zone, subID); err != nil {
+		t.Errorf("deleteSubscription: %v", err)
+	}
+	if err := deleteTopic(buf, tc.ProjectID, region, zone, topicID); err != nil {
+		t.Errorf("deleteTopic: %v", err)
+	}
+	if err := deleteReservation(buf, tc.ProjectID, region, reservationID); err != nil {
+		t.Errorf("deleteReservation: %v", err)
+	}
+	if got, want := strings.Count(buf.String(), "Deleted"), 3; got != want {
+		t.Errorf("got %d deletions, want %d: %q", got, want, buf.String())
+	}
+}
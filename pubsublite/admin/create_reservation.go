@@ -0,0 +1,54 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+// [START pubsublite_create_reservation]
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"cloud.google.com/go/pubsublite"
+)
+
+// createReservation creates a reservation of throughput capacity that
+// topics in the same region can share, instead of each topic provisioning
+// its own.
+func createReservation(w io.Writer, projectID, region, reservationID string, throughputCapacity int) error {
+	// projectID := "my-project-id"
+	// region := "us-central1"
+	// reservationID := "my-reservation"
+	// throughputCapacity := 4
+	ctx := context.Background()
+	client, err := pubsublite.NewAdminClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("pubsublite.NewAdminClient: %w", err)
+	}
+	defer client.Close()
+
+	res, err := client.CreateReservation(ctx, pubsublite.ReservationConfig{
+		Name: fmt.Sprintf("projects/%s/locations/%s/reservations/%s", projectID, region, reservationID),
+		// Each unit of capacity allows 1 MiB/s of publish throughput and
+		// 2 MiB/s of subscribe throughput.
+		ThroughputCapacity: throughputCapacity,
+	})
+	if err != nil {
+		return fmt.Errorf("CreateReservation: %w", err)
+	}
+	fmt.Fprintf(w, "Created reservation: %s\n", res.Name)
+	return nil
+}
+
+// [END pubsublite_create_reservation]
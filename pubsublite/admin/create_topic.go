@@ -0,0 +1,62 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+// [START pubsublite_create_topic]
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"cloud.google.com/go/pubsublite"
+)
+
+// createTopic creates a Lite topic that takes its throughput from a
+// reservation. location is a region for a regional topic or a zone, such as
+// us-central1-a, for a zonal topic.
+func createTopic(w io.Writer, projectID, region, location, topicID, reservationID string) error {
+	// projectID := "my-project-id"
+	// region := "us-central1"
+	// location := "us-central1-a"
+	// topicID := "my-topic"
+	// reservationID := "my-reservation"
+	ctx := context.Background()
+	client, err := pubsublite.NewAdminClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("pubsublite.NewAdminClient: %w", err)
+	}
+	defer client.Close()
+
+	topic, err := client.CreateTopic(ctx, pubsublite.TopicConfig{
+		Name: fmt.Sprintf("projects/%s/locations/%s/topics/%s", projectID, location, topicID),
+		// Messages with the same ordering key go to the same partition, so
+		// the partition count bounds how many keys are processed in
+		// parallel.
+		PartitionCount:             2,
+		PublishCapacityMiBPerSec:   4,
+		SubscribeCapacityMiBPerSec: 8,
+		// Storage is provisioned, and paid for, per partition.
+		PerPartitionBytes:     30 * 1024 * 1024 * 1024, // 30 GiB
+		RetentionDuration:     pubsublite.InfiniteRetention,
+		ThroughputReservation: fmt.Sprintf("projects/%s/locations/%s/reservations/%s", projectID, region, reservationID),
+	})
+	if err != nil {
+		return fmt.Errorf("CreateTopic: %w", err)
+	}
+	fmt.Fprintf(w, "Created topic: %s\n", topic.Name)
+	return nil
+}
+
+// [END pubsublite_create_topic]
@@ -0,0 +1,47 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+// [START pubsublite_delete_subscription]
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"cloud.google.com/go/pubsublite"
+)
+
+// deleteSubscription deletes a Lite subscription.
+func deleteSubscription(w io.Writer, projectID, region, location, subID string) error {
+	// projectID := "my-project-id"
+	// region := "us-central1"
+	// location := "us-central1-a"
+	// subID := "my-subscription"
+	ctx := context.Background()
+	client, err := pubsublite.NewAdminClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("pubsublite.NewAdminClient: %w", err)
+	}
+	defer client.Close()
+
+	name := fmt.Sprintf("projects/%s/locations/%s/subscriptions/%s", projectID, location, subID)
+	if err := client.DeleteSubscription(ctx, name); err != nil {
+		return fmt.Errorf("DeleteSubscription: %w", err)
+	}
+	fmt.Fprintf(w, "Deleted subscription: %s\n", name)
+	return nil
+}
+
+// [END pubsublite_delete_subscription]
@@ -0,0 +1,70 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package publish
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/pubsublite"
+	"github.com/GoogleCloudPlatform/golang-samples/internal/testutil"
+)
+
+const (
+	region = "us-central1"
+	zone   = "us-central1-a"
+)
+
+func TestPublishWithOrderingKey(t *testing.T) {
+	tc := testutil.SystemTest(t)
+	ctx := context.Background()
+	admin, err := pubsublite.NewAdminClient(ctx, region)
+	if err != nil {
+		t.Fatalf("pubsublite.NewAdminClient: %v", err)
+	}
+	defer admin.Close()
+
+	topicID := fmt.Sprintf("lite-publish-%d", time.Now().Unix())
+	topic, err := admin.CreateTopic(ctx, pubsublite.TopicConfig{
+		Name:                       fmt.Sprintf("projects/%s/locations/%s/topics/%s", tc.ProjectID, zone, topicID),
+		PartitionCount:             2,
+		PublishCapacityMiBPerSec:   4,
+		SubscribeCapacityMiBPerSec: 4,
+		PerPartitionBytes:          30 * 1024 * 1024 * 1024,
+		RetentionDuration:          time.Hour,
+	})
+	if err != nil {
+		t.Fatalf("CreateTopic: %v", err)
+	}
+	defer admin.DeleteTopic(ctx, topic.Name)
+
+	keys := []string{"key-a", "key-b", "key-c"}
+	buf := new(bytes.Buffer)
+	partitions, err := publishWithOrderingKey(buf, tc.ProjectID, zone, topicID, keys, 3)
+	if err != nil {
+		t.Fatalf("publishWithOrderingKey: %v", err)
+	}
+	if len(partitions) != len(keys) {
+		t.Errorf("publishWithOrderingKey got partitions for %d keys, want %d: %q", len(partitions), len(keys), buf.String())
+	}
+	for key, p := range partitions {
+		if p < 0 || p >= topic.PartitionCount {
+			t.Errorf("key %q went to partition %d, want one of %d partitions", key, p, topic.PartitionCount)
+		}
+	}
+}
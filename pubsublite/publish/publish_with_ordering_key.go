@@ -0,0 +1,80 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package publish
+
+// [START pubsublite_publish_with_ordering_key]
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"cloud.google.com/go/pubsub"
+	"cloud.google.com/go/pubsublite/pscompat"
+)
+
+// publishWithOrderingKey publishes messages with ordering keys to a Lite
+// topic. All messages with the same key are routed to the same partition,
+// so subscribers receive them in the order they were published. Messages
+// without a key are spread over the partitions round robin.
+func publishWithOrderingKey(w io.Writer, projectID, location, topicID string, keys []string, msgsPerKey int) (map[string]int, error) {
+	// projectID := "my-project-id"
+	// location := "us-central1-a"
+	// topicID := "my-topic"
+	// keys := []string{"customer-1", "customer-2"}
+	// msgsPerKey := 5
+	ctx := context.Background()
+	topicPath := fmt.Sprintf("projects/%s/locations/%s/topics/%s", projectID, location, topicID)
+
+	// Unlike the Pub/Sub client, the publisher client is bound to a single
+	// topic.
+	publisher, err := pscompat.NewPublisherClient(ctx, topicPath)
+	if err != nil {
+		return nil, fmt.Errorf("pscompat.NewPublisherClient: %w", err)
+	}
+	defer publisher.Stop()
+
+	type published struct {
+		key    string
+		result *pubsub.PublishResult
+	}
+	var results []published
+	for i := 0; i < msgsPerKey; i++ {
+		for _, key := range keys {
+			r := publisher.Publish(ctx, &pubsub.Message{
+				Data:        []byte(fmt.Sprintf("%s message #%d", key, i)),
+				OrderingKey: key,
+			})
+			results = append(results, published{key, r})
+		}
+	}
+
+	// The ID of a published message encodes its partition and offset.
+	partitions := make(map[string]int)
+	for _, p := range results {
+		id, err := p.result.Get(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("Get: %w", err)
+		}
+		metadata, err := pscompat.ParseMessageMetadata(id)
+		if err != nil {
+			return nil, fmt.Errorf("ParseMessageMetadata(%q): %w", id, err)
+		}
+		partitions[p.key] = metadata.Partition
+		fmt.Fprintf(w, "Published %q to partition %d at offset %d\n", p.key, metadata.Partition, metadata.Offset)
+	}
+	return partitions, nil
+}
+
+// [END pubsublite_publish_with_ordering_key]
@@ -0,0 +1,71 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package subscribe
+
+// [START pubsublite_subscribe_flow_control]
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync/atomic"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"cloud.google.com/go/pubsublite/pscompat"
+)
+
+// receiveWithFlowControl receives messages from a Lite subscription for the
+// given duration, holding at most 1,000 messages or 10 MiB per partition.
+//
+// Flow control in Pub/Sub Lite applies to each partition the subscriber is
+// assigned, so a subscriber of a topic with many partitions can hold many
+// times these limits in total.
+func receiveWithFlowControl(w io.Writer, projectID, location, subID string, duration time.Duration) (int32, error) {
+	// projectID := "my-project-id"
+	// location := "us-central1-a"
+	// subID := "my-subscription"
+	// duration := 90 * time.Second
+	ctx := context.Background()
+	subPath := fmt.Sprintf("projects/%s/locations/%s/subscriptions/%s", projectID, location, subID)
+
+	settings := pscompat.DefaultReceiveSettings
+	settings.MaxOutstandingMessages = 1000
+	settings.MaxOutstandingBytes = 10 * 1024 * 1024 // 10 MiB
+	subscriber, err := pscompat.NewSubscriberClientWithSettings(ctx, subPath, settings)
+	if err != nil {
+		return 0, fmt.Errorf("pscompat.NewSubscriberClientWithSettings: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, duration)
+	defer cancel()
+
+	var received int32
+	// Messages from one partition are delivered in order, one at a time;
+	// messages from different partitions are handled concurrently.
+	err = subscriber.Receive(ctx, func(ctx context.Context, msg *pubsub.Message) {
+		atomic.AddInt32(&received, 1)
+		// Lite has no per-message redelivery: acking advances the
+		// partition's committed cursor once all earlier messages are
+		// acked too.
+		msg.Ack()
+	})
+	if err != nil {
+		return received, fmt.Errorf("Receive: %w", err)
+	}
+	fmt.Fprintf(w, "Received %d messages\n", received)
+	return received, nil
+}
+
+// [END pubsublite_subscribe_flow_control]
@@ -0,0 +1,93 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package subscribe
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"cloud.google.com/go/pubsublite"
+	"cloud.google.com/go/pubsublite/pscompat"
+	"github.com/GoogleCloudPlatform/golang-samples/internal/testutil"
+)
+
+const (
+	region = "us-central1"
+	zone   = "us-central1-a"
+)
+
+func TestReceiveWithFlowControl(t *testing.T) {
+	tc := testutil.SystemTest(t)
+	ctx := context.Background()
+	admin, err := pubsublite.NewAdminClient(ctx, region)
+	if err != nil {
+		t.Fatalf("pubsublite.NewAdminClient: %v", err)
+	}
+	defer admin.Close()
+
+	suffix := time.Now().Unix()
+	topicID := fmt.Sprintf("lite-subscribe-%d", suffix)
+	subID := fmt.Sprintf("lite-subscribe-sub-%d", suffix)
+	topic, err := admin.CreateTopic(ctx, pubsublite.TopicConfig{
+		Name:                       fmt.Sprintf("projects/%s/locations/%s/topics/%s", tc.ProjectID, zone, topicID),
+		PartitionCount:             1,
+		PublishCapacityMiBPerSec:   4,
+		SubscribeCapacityMiBPerSec: 4,
+		PerPartitionBytes:          30 * 1024 * 1024 * 1024,
+		RetentionDuration:          time.Hour,
+	})
+	if err != nil {
+		t.Fatalf("CreateTopic: %v", err)
+	}
+	defer admin.DeleteTopic(ctx, topic.Name)
+	sub, err := admin.CreateSubscription(ctx, pubsublite.SubscriptionConfig{
+		Name:                fmt.Sprintf("projects/%s/locations/%s/subscriptions/%s", tc.ProjectID, zone, subID),
+		Topic:               topic.Name,
+		DeliveryRequirement: pubsublite.DeliverImmediately,
+	})
+	if err != nil {
+		t.Fatalf("CreateSubscription: %v", err)
+	}
+	defer admin.DeleteSubscription(ctx, sub.Name)
+
+	publisher, err := pscompat.NewPublisherClient(ctx, topic.Name)
+	if err != nil {
+		t.Fatalf("pscompat.NewPublisherClient: %v", err)
+	}
+	const numMsgs = 10
+	var results []*pubsub.PublishResult
+	for i := 0; i < numMsgs; i++ {
+		results = append(results, publisher.Publish(ctx, &pubsub.Message{Data: []byte(fmt.Sprintf("message#%d", i))}))
+	}
+	for _, r := range results {
+		if _, err := r.Get(ctx); err != nil {
+			t.Fatalf("Get: %v", err)
+		}
+	}
+	publisher.Stop()
+
+	buf := new(bytes.Buffer)
+	received, err := receiveWithFlowControl(buf, tc.ProjectID, zone, subID, 60*time.Second)
+	if err != nil {
+		t.Fatalf("receiveWithFlowControl: %v", err)
+	}
+	if received != numMsgs {
+		t.Errorf("receiveWithFlowControl got %d messages, want %d: %q", received, numMsgs, buf.String())
+	}
+}
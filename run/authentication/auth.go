@@ -32,7 +32,7 @@ func makeGetRequest(serviceURL string) (*http.Response, error) {
 	tokenURL := fmt.Sprintf("/instance/service-accounts/default/identity?audience=%s", serviceURL)
 	idToken, err := metadata.Get(tokenURL)
 	if err != nil {
-		return nil, fmt.Errorf("metadata.Get: failed to query id_token: %+v", err)
+		return nil, fmt.Errorf("metadata.Get: failed to query id_token: %w", err)
 	}
 	req, err := http.NewRequest("GET", serviceURL, nil)
 	if err != nil {
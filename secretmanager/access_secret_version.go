@@ -35,7 +35,7 @@ func accessSecretVersion(w io.Writer, name string) error {
 	ctx := context.Background()
 	client, err := secretmanager.NewClient(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to create secretmanager client: %v", err)
+		return fmt.Errorf("failed to create secretmanager client: %w", err)
 	}
 
 	// Build the request.
@@ -46,7 +46,7 @@ func accessSecretVersion(w io.Writer, name string) error {
 	// Call the API.
 	result, err := client.AccessSecretVersion(ctx, req)
 	if err != nil {
-		return fmt.Errorf("failed to access secret version: %v", err)
+		return fmt.Errorf("failed to access secret version: %w", err)
 	}
 
 	// WARNING: Do not print the secret in a production environment - this snippet
@@ -36,7 +36,7 @@ func addSecretVersion(w io.Writer, parent string) error {
 	ctx := context.Background()
 	client, err := secretmanager.NewClient(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to create secretmanager client: %v", err)
+		return fmt.Errorf("failed to create secretmanager client: %w", err)
 	}
 
 	// Build the request.
@@ -50,7 +50,7 @@ func addSecretVersion(w io.Writer, parent string) error {
 	// Call the API.
 	result, err := client.AddSecretVersion(ctx, req)
 	if err != nil {
-		return fmt.Errorf("failed to add secret version: %v", err)
+		return fmt.Errorf("failed to add secret version: %w", err)
 	}
 	fmt.Fprintf(w, "Added secret version: %s\n", result.Name)
 	return nil
@@ -31,7 +31,7 @@ func deleteSecret(name string) error {
 	ctx := context.Background()
 	client, err := secretmanager.NewClient(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to create secretmanager client: %v", err)
+		return fmt.Errorf("failed to create secretmanager client: %w", err)
 	}
 
 	// Build the request.
@@ -41,7 +41,7 @@ func deleteSecret(name string) error {
 
 	// Call the API.
 	if err := client.DeleteSecret(ctx, req); err != nil {
-		return fmt.Errorf("failed to delete secret: %v", err)
+		return fmt.Errorf("failed to delete secret: %w", err)
 	}
 	return nil
 }
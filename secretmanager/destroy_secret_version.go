@@ -32,7 +32,7 @@ func destroySecretVersion(name string) error {
 	ctx := context.Background()
 	client, err := secretmanager.NewClient(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to create secretmanager client: %v", err)
+		return fmt.Errorf("failed to create secretmanager client: %w", err)
 	}
 
 	// Build the request.
@@ -42,7 +42,7 @@ func destroySecretVersion(name string) error {
 
 	// Call the API.
 	if _, err := client.DestroySecretVersion(ctx, req); err != nil {
-		return fmt.Errorf("failed to destroy secret version: %v", err)
+		return fmt.Errorf("failed to destroy secret version: %w", err)
 	}
 	return nil
 }
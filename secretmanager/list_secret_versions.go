@@ -34,7 +34,7 @@ func listSecretVersions(w io.Writer, parent string) error {
 	ctx := context.Background()
 	client, err := secretmanager.NewClient(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to create secretmanager client: %v", err)
+		return fmt.Errorf("failed to create secretmanager client: %w", err)
 	}
 
 	// Build the request.
@@ -51,7 +51,7 @@ func listSecretVersions(w io.Writer, parent string) error {
 		}
 
 		if err != nil {
-			return fmt.Errorf("failed to list secret versions: %v", err)
+			return fmt.Errorf("failed to list secret versions: %w", err)
 		}
 
 		fmt.Fprintf(w, "Found secret version %s with state %s\n",
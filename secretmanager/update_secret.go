@@ -33,7 +33,7 @@ func updateSecret(w io.Writer, name string) error {
 	ctx := context.Background()
 	client, err := secretmanager.NewClient(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to create secretmanager client: %v", err)
+		return fmt.Errorf("failed to create secretmanager client: %w", err)
 	}
 
 	// Build the request.
@@ -52,7 +52,7 @@ func updateSecret(w io.Writer, name string) error {
 	// Call the API.
 	result, err := client.UpdateSecret(ctx, req)
 	if err != nil {
-		return fmt.Errorf("failed to update secret: %v", err)
+		return fmt.Errorf("failed to update secret: %w", err)
 	}
 	fmt.Fprintf(w, "Updated secret: %s\n", result.Name)
 	return nil
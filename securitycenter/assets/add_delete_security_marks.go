@@ -33,7 +33,7 @@ func addDeleteSecurityMarks(w io.Writer, assetName string) error {
 	ctx := context.Background()
 	client, err := securitycenter.NewClient(ctx)
 	if err != nil {
-		return fmt.Errorf("securitycenter.NewClient: %v", err)
+		return fmt.Errorf("securitycenter.NewClient: %w", err)
 	}
 	defer client.Close() // Closing the client safely cleans up background resources.
 
@@ -52,7 +52,7 @@ func addDeleteSecurityMarks(w io.Writer, assetName string) error {
 
 	updatedMarks, err := client.UpdateSecurityMarks(ctx, req)
 	if err != nil {
-		return fmt.Errorf("UpdateSecurityMarks: %v", err)
+		return fmt.Errorf("UpdateSecurityMarks: %w", err)
 	}
 
 	fmt.Fprintf(w, "Updated marks: %s\n", updatedMarks.Name)
@@ -49,7 +49,7 @@ func getRandomAsset(client *securitycenter.Client, orgID string) (*securitycente
 			break
 		}
 		if err != nil {
-			return nil, fmt.Errorf("it.Next(): %v", err)
+			return nil, fmt.Errorf("it.Next(): %w", err)
 		}
 		assetsCount++
 		if rand.Float64() < 1.0/float64(assetsCount) {
@@ -95,7 +95,7 @@ func attemptLease(client *securitycenter.Client, asset *securitycenterpb.Asset,
 		},
 	})
 	if err != nil {
-		return fmt.Errorf("UpdateSecurityMarks: %v", err)
+		return fmt.Errorf("UpdateSecurityMarks: %w", err)
 	}
 	// Randomize wake-up in case we are in the edge case of two writes
 	time.Sleep(time.Duration((100 * rand.Int63n(20))) * time.Millisecond)
@@ -109,7 +109,7 @@ func attemptLease(client *securitycenter.Client, asset *securitycenterpb.Asset,
 		return fmt.Errorf("didn't find asset %s", asset.Name)
 	}
 	if err != nil {
-		return fmt.Errorf("it.Next: %v", err)
+		return fmt.Errorf("it.Next: %w", err)
 	}
 	asset = result.Asset
 
@@ -130,7 +130,7 @@ func initAssetForManipulation() error {
 	ctx := context.Background()
 	client, err := securitycenter.NewClient(ctx)
 	if err != nil {
-		return fmt.Errorf("securitycenter.NewClient: %v", err)
+		return fmt.Errorf("securitycenter.NewClient: %w", err)
 	}
 	defer client.Close() // Closing the client safely cleans up background resources.
 
@@ -173,7 +173,7 @@ func TestMain(m *testing.M) {
 }
 
 func TestListAllAssets(t *testing.T) {
-	testutil.Retry(t, 5, 5*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 5 * time.Second, Max: 10 * time.Second, Multiplier: 2, MaxAttempts: 5}, func(r *testutil.R) {
 		buf := new(bytes.Buffer)
 		orgID := setup(t)
 		err := listAllAssets(buf, orgID)
@@ -191,7 +191,7 @@ func TestListAllAssets(t *testing.T) {
 }
 
 func TestListAllProjectAssets(t *testing.T) {
-	testutil.Retry(t, 5, 5*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 5 * time.Second, Max: 10 * time.Second, Multiplier: 2, MaxAttempts: 5}, func(r *testutil.R) {
 		buf := new(bytes.Buffer)
 		orgID := setup(t)
 		err := listAllProjectAssets(buf, orgID)
@@ -208,7 +208,7 @@ func TestListAllProjectAssets(t *testing.T) {
 }
 
 func TestListAllProjectAssetsAtTime(t *testing.T) {
-	testutil.Retry(t, 5, 5*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 5 * time.Second, Max: 10 * time.Second, Multiplier: 2, MaxAttempts: 5}, func(r *testutil.R) {
 		orgID := setup(t)
 		buf := new(bytes.Buffer)
 		var nothingInstant = time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
@@ -241,7 +241,7 @@ func TestListAllProjectAssetsAtTime(t *testing.T) {
 }
 
 func TestListAllProjectAssetsAndStateChanges(t *testing.T) {
-	testutil.Retry(t, 5, 5*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 5 * time.Second, Max: 10 * time.Second, Multiplier: 2, MaxAttempts: 5}, func(r *testutil.R) {
 		buf := new(bytes.Buffer)
 		orgID := setup(t)
 		err := listAllProjectAssetsAndStateChanges(buf, orgID)
@@ -259,7 +259,7 @@ func TestListAllProjectAssetsAndStateChanges(t *testing.T) {
 
 func TestAddSecurityMarks(t *testing.T) {
 	setup(t)
-	testutil.Retry(t, 5, 5*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 5 * time.Second, Max: 10 * time.Second, Multiplier: 2, MaxAttempts: 5}, func(r *testutil.R) {
 		buf := new(bytes.Buffer)
 		err := deleteSecurityMarks(buf, marksAssetName)
 		if err != nil {
@@ -286,7 +286,7 @@ func TestAddSecurityMarks(t *testing.T) {
 
 func TestDeleteSecurityMarks(t *testing.T) {
 	setup(t)
-	testutil.Retry(t, 5, 5*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 5 * time.Second, Max: 10 * time.Second, Multiplier: 2, MaxAttempts: 5}, func(r *testutil.R) {
 		buf := new(bytes.Buffer)
 		err := addSecurityMarks(buf, marksAssetName)
 		if err != nil {
@@ -314,7 +314,7 @@ func TestDeleteSecurityMarks(t *testing.T) {
 
 func TestAddDeleteSecurityMarks(t *testing.T) {
 	setup(t)
-	testutil.Retry(t, 5, 5*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 5 * time.Second, Max: 10 * time.Second, Multiplier: 2, MaxAttempts: 5}, func(r *testutil.R) {
 		buf := new(bytes.Buffer)
 		err := addSecurityMarks(buf, marksAssetName)
 		if err != nil {
@@ -341,7 +341,7 @@ func TestAddDeleteSecurityMarks(t *testing.T) {
 }
 
 func TestListWithSecurityMarks(t *testing.T) {
-	testutil.Retry(t, 5, 5*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 5 * time.Second, Max: 10 * time.Second, Multiplier: 2, MaxAttempts: 5}, func(r *testutil.R) {
 		buf := new(bytes.Buffer)
 		orgID := setup(t)
 		err := addSecurityMarks(buf, marksAssetName)
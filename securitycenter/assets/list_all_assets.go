@@ -33,7 +33,7 @@ func listAllAssets(w io.Writer, orgID string) error {
 	ctx := context.Background()
 	client, err := securitycenter.NewClient(ctx)
 	if err != nil {
-		return fmt.Errorf("securitycenter.NewClient: %v", err)
+		return fmt.Errorf("securitycenter.NewClient: %w", err)
 	}
 	defer client.Close() // Closing the client safely cleans up background resources.
 
@@ -49,7 +49,7 @@ func listAllAssets(w io.Writer, orgID string) error {
 			break
 		}
 		if err != nil {
-			return fmt.Errorf("ListAssets: %v", err)
+			return fmt.Errorf("ListAssets: %w", err)
 		}
 		asset := result.Asset
 		properties := asset.SecurityCenterProperties
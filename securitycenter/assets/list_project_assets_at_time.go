@@ -35,14 +35,14 @@ func listAllProjectAssetsAtTime(w io.Writer, orgID string, asOf time.Time) error
 	ctx := context.Background()
 	client, err := securitycenter.NewClient(ctx)
 	if err != nil {
-		return fmt.Errorf("securitycenter.NewClient: %v", err)
+		return fmt.Errorf("securitycenter.NewClient: %w", err)
 	}
 	defer client.Close() // Closing the client safely cleans up background resources.
 
 	// Convert the time to a Timestamp protobuf
 	readTime, err := ptypes.TimestampProto(asOf)
 	if err != nil {
-		return fmt.Errorf("TimestampProto(%v): %v", asOf, err)
+		return fmt.Errorf("TimestampProto(%v): %w", asOf, err)
 	}
 
 	req := &securitycenterpb.ListAssetsRequest{
@@ -59,7 +59,7 @@ func listAllProjectAssetsAtTime(w io.Writer, orgID string, asOf time.Time) error
 			break
 		}
 		if err != nil {
-			return fmt.Errorf("ListAssets: %v", err)
+			return fmt.Errorf("ListAssets: %w", err)
 		}
 		asset := result.Asset
 		properties := asset.SecurityCenterProperties
@@ -37,13 +37,13 @@ func createFindingWithProperties(w io.Writer, sourceName string) error {
 	ctx := context.Background()
 	client, err := securitycenter.NewClient(ctx)
 	if err != nil {
-		return fmt.Errorf("securitycenter.NewClient: %v", err)
+		return fmt.Errorf("securitycenter.NewClient: %w", err)
 	}
 	defer client.Close() // Closing the client safely cleans up background resources.
 	// Use now as the eventTime for the security finding.
 	eventTime, err := ptypes.TimestampProto(time.Now())
 	if err != nil {
-		return fmt.Errorf("TimestampProto: %v", err)
+		return fmt.Errorf("TimestampProto: %w", err)
 	}
 
 	req := &securitycenterpb.CreateFindingRequest{
@@ -72,7 +72,7 @@ func createFindingWithProperties(w io.Writer, sourceName string) error {
 
 	finding, err := client.CreateFinding(ctx, req)
 	if err != nil {
-		return fmt.Errorf("CreateFinding: %v", err)
+		return fmt.Errorf("CreateFinding: %w", err)
 	}
 	fmt.Fprintf(w, "New finding created: %s\n", finding.Name)
 	fmt.Fprintf(w, "Event time (Epoch Seconds): %d\n", eventTime.Seconds)
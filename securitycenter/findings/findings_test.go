@@ -37,7 +37,7 @@ var untouchedFindingName = ""
 func createTestFinding(ctx context.Context, client *securitycenter.Client, findingID string, category string) (*securitycenterpb.Finding, error) {
 	eventTime, err := ptypes.TimestampProto(time.Now())
 	if err != nil {
-		return nil, fmt.Errorf("TimestampProto: %v", err)
+		return nil, fmt.Errorf("TimestampProto: %w", err)
 	}
 
 	req := &securitycenterpb.CreateFindingRequest{
@@ -68,7 +68,7 @@ func setupEntities() error {
 	ctx := context.Background()
 	client, err := securitycenter.NewClient(ctx)
 	if err != nil {
-		return fmt.Errorf("securitycenter.NewClient: %v", err)
+		return fmt.Errorf("securitycenter.NewClient: %w", err)
 	}
 	defer client.Close() // Closing the client safely cleans up background resources.
 
@@ -81,17 +81,17 @@ func setupEntities() error {
 	})
 
 	if err != nil {
-		return fmt.Errorf("CreateSource: %v", err)
+		return fmt.Errorf("CreateSource: %w", err)
 	}
 	sourceName = source.Name
 	finding, err := createTestFinding(ctx, client, "updated", "MEDIUM_RISK_ONE")
 	if err != nil {
-		return fmt.Errorf("createTestFinding: %v", err)
+		return fmt.Errorf("createTestFinding: %w", err)
 	}
 	findingName = finding.Name
 	finding, err = createTestFinding(ctx, client, "untouched", "XSS")
 	if err != nil {
-		return fmt.Errorf("createTestFinding: %v", err)
+		return fmt.Errorf("createTestFinding: %w", err)
 	}
 	untouchedFindingName = finding.Name
 	return nil
@@ -118,7 +118,7 @@ func TestMain(m *testing.M) {
 }
 
 func TestCreateSource(t *testing.T) {
-	testutil.Retry(t, 5, 5*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 5 * time.Second, Max: 10 * time.Second, Multiplier: 2, MaxAttempts: 5}, func(r *testutil.R) {
 		orgID := setup(t)
 		buf := new(bytes.Buffer)
 
@@ -141,7 +141,7 @@ func TestCreateSource(t *testing.T) {
 
 func TestGetSource(t *testing.T) {
 	setup(t)
-	testutil.Retry(t, 5, 5*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 5 * time.Second, Max: 10 * time.Second, Multiplier: 2, MaxAttempts: 5}, func(r *testutil.R) {
 		buf := new(bytes.Buffer)
 
 		err := getSource(buf, sourceName)
@@ -163,7 +163,7 @@ func TestGetSource(t *testing.T) {
 
 func TestListSources(t *testing.T) {
 	setup(t)
-	testutil.Retry(t, 5, 5*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 5 * time.Second, Max: 10 * time.Second, Multiplier: 2, MaxAttempts: 5}, func(r *testutil.R) {
 		buf := new(bytes.Buffer)
 
 		err := listSources(buf, orgID)
@@ -185,7 +185,7 @@ func TestListSources(t *testing.T) {
 
 func TestUpdateSource(t *testing.T) {
 	setup(t)
-	testutil.Retry(t, 5, 5*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 5 * time.Second, Max: 10 * time.Second, Multiplier: 2, MaxAttempts: 5}, func(r *testutil.R) {
 		buf := new(bytes.Buffer)
 
 		err := updateSource(buf, sourceName)
@@ -211,7 +211,7 @@ func TestUpdateSource(t *testing.T) {
 
 func TestCreateFinding(t *testing.T) {
 	setup(t)
-	testutil.Retry(t, 5, 5*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 5 * time.Second, Max: 10 * time.Second, Multiplier: 2, MaxAttempts: 5}, func(r *testutil.R) {
 		buf := new(bytes.Buffer)
 
 		err := createFinding(buf, sourceName)
@@ -230,7 +230,7 @@ func TestCreateFinding(t *testing.T) {
 
 func TestCreateFindingWithProperties(t *testing.T) {
 	setup(t)
-	testutil.Retry(t, 5, 5*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 5 * time.Second, Max: 10 * time.Second, Multiplier: 2, MaxAttempts: 5}, func(r *testutil.R) {
 		buf := new(bytes.Buffer)
 
 		err := createFindingWithProperties(buf, sourceName)
@@ -256,7 +256,7 @@ func TestCreateFindingWithProperties(t *testing.T) {
 
 func TestUpdateFindingSourceProperties(t *testing.T) {
 	setup(t)
-	testutil.Retry(t, 5, 5*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 5 * time.Second, Max: 10 * time.Second, Multiplier: 2, MaxAttempts: 5}, func(r *testutil.R) {
 		buf := new(bytes.Buffer)
 
 		err := updateFindingSourceProperties(buf, findingName)
@@ -278,7 +278,7 @@ func TestUpdateFindingSourceProperties(t *testing.T) {
 
 func TestSetFindingState(t *testing.T) {
 	setup(t)
-	testutil.Retry(t, 5, 5*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 5 * time.Second, Max: 10 * time.Second, Multiplier: 2, MaxAttempts: 5}, func(r *testutil.R) {
 		buf := new(bytes.Buffer)
 
 		err := setFindingState(buf, findingName)
@@ -300,7 +300,7 @@ func TestSetFindingState(t *testing.T) {
 
 func TestTestIam(t *testing.T) {
 	setup(t)
-	testutil.Retry(t, 5, 5*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 5 * time.Second, Max: 10 * time.Second, Multiplier: 2, MaxAttempts: 5}, func(r *testutil.R) {
 		buf := new(bytes.Buffer)
 
 		err := testIam(buf, sourceName)
@@ -319,7 +319,7 @@ func TestTestIam(t *testing.T) {
 }
 
 func TestListAllFindings(t *testing.T) {
-	testutil.Retry(t, 5, 5*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 5 * time.Second, Max: 10 * time.Second, Multiplier: 2, MaxAttempts: 5}, func(r *testutil.R) {
 		orgID := setup(t)
 		buf := new(bytes.Buffer)
 
@@ -343,7 +343,7 @@ func TestListAllFindings(t *testing.T) {
 
 func TestListFilteredFindings(t *testing.T) {
 	setup(t)
-	testutil.Retry(t, 5, 5*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 5 * time.Second, Max: 10 * time.Second, Multiplier: 2, MaxAttempts: 5}, func(r *testutil.R) {
 		buf := new(bytes.Buffer)
 
 		err := listFilteredFindings(buf, sourceName)
@@ -366,7 +366,7 @@ func TestListFilteredFindings(t *testing.T) {
 
 func TestListFindingsAtTime(t *testing.T) {
 	setup(t)
-	testutil.Retry(t, 5, 5*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 5 * time.Second, Max: 10 * time.Second, Multiplier: 2, MaxAttempts: 5}, func(r *testutil.R) {
 		buf := new(bytes.Buffer)
 
 		err := listFindingsAtTime(buf, sourceName)
@@ -387,7 +387,7 @@ func TestListFindingsAtTime(t *testing.T) {
 
 func TestAddSecurityMarks(t *testing.T) {
 	setup(t)
-	testutil.Retry(t, 5, 5*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 5 * time.Second, Max: 10 * time.Second, Multiplier: 2, MaxAttempts: 5}, func(r *testutil.R) {
 		buf := new(bytes.Buffer)
 
 		err := addSecurityMarks(buf, findingName)
@@ -409,7 +409,7 @@ func TestAddSecurityMarks(t *testing.T) {
 }
 
 func TestListFindingsWithMarks(t *testing.T) {
-	testutil.Retry(t, 5, 5*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 5 * time.Second, Max: 10 * time.Second, Multiplier: 2, MaxAttempts: 5}, func(r *testutil.R) {
 		orgID := setup(t)
 		buf := new(bytes.Buffer)
 		// Ensure security marks have been added so filter is effective.
@@ -441,7 +441,7 @@ func TestListFindingsWithMarks(t *testing.T) {
 
 func TestGetSourceIamPolicy(t *testing.T) {
 	setup(t)
-	testutil.Retry(t, 5, 5*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 5 * time.Second, Max: 10 * time.Second, Multiplier: 2, MaxAttempts: 5}, func(r *testutil.R) {
 		buf := new(bytes.Buffer)
 
 		err := getSourceIamPolicy(buf, sourceName)
@@ -460,7 +460,7 @@ func TestGetSourceIamPolicy(t *testing.T) {
 
 func TestSetSourceIamPolicy(t *testing.T) {
 	setup(t)
-	testutil.Retry(t, 5, 5*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 5 * time.Second, Max: 10 * time.Second, Multiplier: 2, MaxAttempts: 5}, func(r *testutil.R) {
 		buf := new(bytes.Buffer)
 
 		user := "csccclienttest@gmail.com"
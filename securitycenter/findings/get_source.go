@@ -32,7 +32,7 @@ func getSource(w io.Writer, sourceName string) error {
 	ctx := context.Background()
 	client, err := securitycenter.NewClient(ctx)
 	if err != nil {
-		return fmt.Errorf("securitycenter.NewClient: %v", err)
+		return fmt.Errorf("securitycenter.NewClient: %w", err)
 	}
 	defer client.Close() // Closing the client safely cleans up background resources.
 
@@ -41,7 +41,7 @@ func getSource(w io.Writer, sourceName string) error {
 	}
 	source, err := client.GetSource(ctx, req)
 	if err != nil {
-		return fmt.Errorf("GetSource: %v", err)
+		return fmt.Errorf("GetSource: %w", err)
 	}
 	fmt.Fprintf(w, "Source: %v\n", source.Name)
 	fmt.Fprintf(w, "Display Name: %v\n", source.DisplayName)
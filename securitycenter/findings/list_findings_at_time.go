@@ -38,12 +38,12 @@ func listFindingsAtTime(w io.Writer, sourceName string) error {
 	ctx := context.Background()
 	client, err := securitycenter.NewClient(ctx)
 	if err != nil {
-		return fmt.Errorf("securitycenter.NewClient: %v", err)
+		return fmt.Errorf("securitycenter.NewClient: %w", err)
 	}
 	defer client.Close() // Closing the client safely cleans up background resources.
 	fiveDaysAgo, err := ptypes.TimestampProto(time.Now().AddDate(0, 0, -5))
 	if err != nil {
-		return fmt.Errorf("Error converting five days ago: %v", err)
+		return fmt.Errorf("Error converting five days ago: %w", err)
 	}
 
 	req := &securitycenterpb.ListFindingsRequest{
@@ -57,7 +57,7 @@ func listFindingsAtTime(w io.Writer, sourceName string) error {
 			break
 		}
 		if err != nil {
-			return fmt.Errorf("it.Next: %v", err)
+			return fmt.Errorf("it.Next: %w", err)
 		}
 		finding := result.Finding
 		fmt.Fprintf(w, "Finding Name: %s, ", finding.Name)
@@ -36,13 +36,13 @@ func updateFindingSourceProperties(w io.Writer, findingName string) error {
 	ctx := context.Background()
 	client, err := securitycenter.NewClient(ctx)
 	if err != nil {
-		return fmt.Errorf("securitycenter.NewClient: %v", err)
+		return fmt.Errorf("securitycenter.NewClient: %w", err)
 	}
 	defer client.Close() // Closing the client safely cleans up background resources.
 	// Use now as the eventTime for the security finding.
 	eventTime, err := ptypes.TimestampProto(time.Now())
 	if err != nil {
-		return fmt.Errorf("TimestampProto: %v", err)
+		return fmt.Errorf("TimestampProto: %w", err)
 	}
 
 	req := &securitycenterpb.UpdateFindingRequest{
@@ -64,7 +64,7 @@ func updateFindingSourceProperties(w io.Writer, findingName string) error {
 
 	finding, err := client.UpdateFinding(ctx, req)
 	if err != nil {
-		return fmt.Errorf("UpdateFinding: %v", err)
+		return fmt.Errorf("UpdateFinding: %w", err)
 	}
 	fmt.Fprintf(w, "Finding updated: %s\n", finding.Name)
 	fmt.Fprintf(w, "Finding state: %v\n", finding.State)
@@ -33,7 +33,7 @@ func setup(t *testing.T) string {
 }
 
 func TestEnableAssetDiscovery(t *testing.T) {
-	testutil.Retry(t, 5, 5*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 5 * time.Second, Max: 10 * time.Second, Multiplier: 2, MaxAttempts: 5}, func(r *testutil.R) {
 		orgID := setup(t)
 		buf := new(bytes.Buffer)
 
@@ -55,7 +55,7 @@ func TestEnableAssetDiscovery(t *testing.T) {
 }
 
 func TestGetOrgSettings(t *testing.T) {
-	testutil.Retry(t, 5, 5*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 5 * time.Second, Max: 10 * time.Second, Multiplier: 2, MaxAttempts: 5}, func(r *testutil.R) {
 		orgID := setup(t)
 		buf := new(bytes.Buffer)
 
@@ -50,7 +50,7 @@ func TestSample(t *testing.T) {
 	adminClient, dataClient := createClients(ctx, dbName)
 	defer func() {
 		dataClient.Close()
-		testutil.Retry(t, 10, time.Second, func(r *testutil.R) {
+		testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
 			err := adminClient.DropDatabase(ctx, &adminpb.DropDatabaseRequest{Database: dbName})
 			if err != nil {
 				r.Errorf("DropDatabase(%q): %v", dbName, err)
@@ -61,7 +61,7 @@ func initTest(t *testing.T, id string) (dbName string, cleanup func()) {
 			adminClient.DropDatabase(ctx, &adminpb.DropDatabaseRequest{Database: dbName}))
 	}
 	cleanup = func() {
-		testutil.Retry(t, 10, time.Second, func(r *testutil.R) {
+		testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
 			err := adminClient.DropDatabase(ctx, &adminpb.DropDatabaseRequest{Database: dbName})
 			if err != nil {
 				r.Errorf("DropDatabase(%q): %v", dbName, err)
@@ -107,7 +107,7 @@ func initBackupTest(t *testing.T, id, dbName string) (restoreDBName, backupID, c
 	}
 
 	cleanup = func() {
-		testutil.Retry(t, 10, time.Second, func(r *testutil.R) {
+		testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
 			err := adminClient.DropDatabase(ctx, &adminpb.DropDatabaseRequest{Database: restoreDBName})
 			if err != nil {
 				r.Errorf("DropDatabase(%q): %v", restoreDBName, err)
@@ -435,13 +435,13 @@ func cleanupInstance(projectID, instanceID string) error {
 	ctx := context.Background()
 	instanceAdmin, err := instance.NewInstanceAdminClient(ctx)
 	if err != nil {
-		return fmt.Errorf("cannot create instance databaseAdmin client: %v", err)
+		return fmt.Errorf("cannot create instance databaseAdmin client: %w", err)
 	}
 	defer instanceAdmin.Close()
 
 	instanceName := fmt.Sprintf("projects/%s/instances/%s", projectID, instanceID)
 	if err := instanceAdmin.DeleteInstance(ctx, &instancepb.DeleteInstanceRequest{Name: instanceName}); err != nil {
-		return fmt.Errorf("failed to delete instance %s (error %v), might need a manual removal",
+		return fmt.Errorf("failed to delete instance %s (error %w), might need a manual removal",
 			instanceName, err)
 	}
 	return nil
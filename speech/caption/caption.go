@@ -41,7 +41,7 @@ The path to the audio file may be a GCS URI (gs://...).
 func main() {
 	flag.Parse()
 	if len(os.Args) < 2 {
-		fmt.Fprintln(os.Stderr, usage)
+		fmt.Fprint(os.Stderr, usage)
 		os.Exit(2)
 	}
 
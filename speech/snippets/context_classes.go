@@ -34,7 +34,7 @@ func contextClasses(w io.Writer, gcsURI string) error {
 
 	client, err := speech.NewClient(ctx)
 	if err != nil {
-		return fmt.Errorf("NewClient: %v", err)
+		return fmt.Errorf("NewClient: %w", err)
 	}
 	defer client.Close()
 
@@ -57,7 +57,7 @@ func contextClasses(w io.Writer, gcsURI string) error {
 		},
 	})
 	if err != nil {
-		return fmt.Errorf("Recognize: %v", err)
+		return fmt.Errorf("Recognize: %w", err)
 	}
 
 	// Print the results.
@@ -36,13 +36,13 @@ func modelSelection(w io.Writer, path string) error {
 
 	client, err := speech.NewClient(ctx)
 	if err != nil {
-		return fmt.Errorf("NewClient: %v", err)
+		return fmt.Errorf("NewClient: %w", err)
 	}
 
 	// path = "../testdata/Google_Gnome.wav"
 	data, err := ioutil.ReadFile(path)
 	if err != nil {
-		return fmt.Errorf("ReadFile: %v", err)
+		return fmt.Errorf("ReadFile: %w", err)
 	}
 
 	req := &speechpb.RecognizeRequest{
@@ -59,7 +59,7 @@ func modelSelection(w io.Writer, path string) error {
 
 	resp, err := client.Recognize(ctx, req)
 	if err != nil {
-		return fmt.Errorf("Recognize: %v", err)
+		return fmt.Errorf("Recognize: %w", err)
 	}
 
 	for i, result := range resp.Results {
@@ -15,14 +15,19 @@
 package acl
 
 import (
+	"bytes"
 	"context"
 	"fmt"
-	"io/ioutil"
+	"io"
+	"strings"
 	"testing"
-	"time"
 
+	"cloud.google.com/go/iam"
 	"cloud.google.com/go/storage"
 	"github.com/GoogleCloudPlatform/golang-samples/internal/testutil"
+	"github.com/google/go-cmp/cmp"
+	iampb "google.golang.org/genproto/googleapis/iam/v1"
+	"google.golang.org/genproto/googleapis/type/expr"
 )
 
 // TestACL runs all of the package tests.
@@ -36,7 +41,7 @@ func TestACL(t *testing.T) {
 	defer client.Close()
 
 	var (
-		bucket                = tc.ProjectID + "-samples-acl-bucket-1"
+		bucket                = testutil.UniqueName("acl-bucket-1")
 		object                = "foo.txt"
 		allAuthenticatedUsers = storage.AllAuthenticatedUsers
 	)
@@ -54,41 +59,61 @@ func TestACL(t *testing.T) {
 		t.Errorf("Writer.Close: %v", err)
 	}
 
-	// Run all the tests.
-	if err := addBucketOwner(bucket, allAuthenticatedUsers); err != nil {
-		t.Errorf("addBucketOwner: %v", err)
+	// Run all the tests, in order, checking what each sample prints.
+	tests := []struct {
+		name string
+		run  func(w io.Writer) error
+		want string
+	}{
+		{"addBucketOwner", func(w io.Writer) error {
+			return addBucketOwner(ctx, w, client, bucket, allAuthenticatedUsers)
+		}, "Added allAuthenticatedUsers as owner of bucket"},
+		{"addBucketDefaultOwner", func(w io.Writer) error {
+			return addBucketDefaultOwner(ctx, w, client, bucket, allAuthenticatedUsers)
+		}, "Added allAuthenticatedUsers as default owner"},
+		{"printBucketACL", func(w io.Writer) error {
+			return printBucketACL(ctx, w, client, bucket)
+		}, "allAuthenticatedUsers"},
+		{"printBucketACLForUser", func(w io.Writer) error {
+			return printBucketACLForUser(ctx, w, client, bucket, allAuthenticatedUsers)
+		}, "ACL rule role: OWNER"},
+		{"removeBucketDefaultOwner", func(w io.Writer) error {
+			return removeBucketDefaultOwner(ctx, w, client, bucket, allAuthenticatedUsers)
+		}, "Removed allAuthenticatedUsers from the default owners"},
+		{"removeBucketOwner", func(w io.Writer) error {
+			return removeBucketOwner(ctx, w, client, bucket, allAuthenticatedUsers)
+		}, "Removed allAuthenticatedUsers from the owners of bucket"},
+		{"addFileOwner", func(w io.Writer) error {
+			return addFileOwner(ctx, w, client, bucket, object, allAuthenticatedUsers)
+		}, "Added allAuthenticatedUsers as owner of object foo.txt"},
+		{"printFileACL", func(w io.Writer) error {
+			return printFileACL(ctx, w, client, bucket, object)
+		}, "allAuthenticatedUsers"},
+		{"printFileACLForUser", func(w io.Writer) error {
+			return printFileACLForUser(ctx, w, client, bucket, object, allAuthenticatedUsers)
+		}, "ACL rule role: OWNER"},
+		{"checkObjectAccess", func(w io.Writer) error {
+			_, err := checkObjectAccess(ctx, w, client, bucket, object, "user:someone@example.com")
+			return err
+		}, "object ACL: allAuthenticatedUsers has OWNER"},
+		{"removeFileOwner", func(w io.Writer) error {
+			return removeFileOwner(ctx, w, client, bucket, object, allAuthenticatedUsers)
+		}, "Removed allAuthenticatedUsers from the owners of object foo.txt"},
 	}
-	if err := addBucketDefaultOwner(bucket, allAuthenticatedUsers); err != nil {
-		t.Errorf("addBucketDefaultOwner: %v", err)
-	}
-	if err := printBucketACL(ioutil.Discard, bucket); err != nil {
-		t.Errorf("printBucketACL: %v", err)
-	}
-	if err := printBucketACLForUser(ioutil.Discard, bucket, allAuthenticatedUsers); err != nil {
-		t.Errorf("printBucketACLForUser: %v", err)
-	}
-	if err := removeBucketDefaultOwner(bucket, allAuthenticatedUsers); err != nil {
-		t.Errorf("removeBucketDefaultOwner: %v", err)
-	}
-	if err := removeBucketOwner(bucket, allAuthenticatedUsers); err != nil {
-		t.Errorf("removeBucketOwner: %v", err)
-	}
-	if err := addFileOwner(bucket, object, allAuthenticatedUsers); err != nil {
-		t.Errorf("addFileOwner: %v", err)
-	}
-	if err := printFileACL(ioutil.Discard, bucket, object); err != nil {
-		t.Errorf("printFileACL: %v", err)
-	}
-	if err := printFileACLForUser(ioutil.Discard, bucket, object, allAuthenticatedUsers); err != nil {
-		t.Errorf("printFileACLForUser: %v", err)
-	}
-	if err := removeFileOwner(bucket, object, allAuthenticatedUsers); err != nil {
-		t.Errorf("removeFileOwner: %v", err)
+	for _, tt := range tests {
+		var buf bytes.Buffer
+		if err := tt.run(&buf); err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if got := buf.String(); !strings.Contains(got, tt.want) {
+			t.Errorf("%s got %q, want to contain %q", tt.name, got, tt.want)
+		}
 	}
 
-	testutil.Retry(t, 10, time.Second, func(r *testutil.R) {
-		// Cleanup, this part won't be executed if Fatal happens.
-		// TODO(jbd): Implement garbage cleaning.
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
+		// Cleanup, this part won't be executed if Fatal happens. Buckets
+		// left behind are deleted by testutil.DeleteStaleResources.
 		b := client.Bucket(bucket)
 		if err := b.Object(object).Delete(ctx); err != nil {
 			r.Errorf("Object(%q).Delete: %v", object, err)
@@ -98,3 +123,105 @@ func TestACL(t *testing.T) {
 		}
 	})
 }
+
+func TestEvaluateAccess(t *testing.T) {
+	const alice = "user:alice@example.com"
+	policy := func(role string, members ...string) *iam.Policy3 {
+		return &iam.Policy3{Bindings: []*iampb.Binding{{Role: role, Members: members}}}
+	}
+	var (
+		fineGrained = &storage.BucketAttrs{}
+		uniform     = &storage.BucketAttrs{UniformBucketLevelAccess: storage.UniformBucketLevelAccess{Enabled: true}}
+		enforced    = &storage.BucketAttrs{PublicAccessPrevention: storage.PublicAccessPreventionEnforced}
+	)
+	conditional := policy("roles/storage.objectViewer", alice)
+	conditional.Bindings[0].Condition = &expr.Expr{Expression: "request.time < timestamp('2030-01-01T00:00:00Z')"}
+
+	tests := []struct {
+		name       string
+		principal  string
+		attrs      *storage.BucketAttrs
+		policy     *iam.Policy3
+		rules      []storage.ACLRule
+		wantRead   bool
+		wantGrants []string
+	}{
+		{
+			name:       "IAM role",
+			principal:  alice,
+			attrs:      uniform,
+			policy:     policy("roles/storage.objectViewer", alice),
+			wantRead:   true,
+			wantGrants: []string{"bucket IAM: user:alice@example.com has roles/storage.objectViewer"},
+		},
+		{
+			name:      "role without objects.get",
+			principal: alice,
+			attrs:     uniform,
+			policy:    policy("roles/storage.legacyBucketReader", alice),
+		},
+		{
+			name:       "domain",
+			principal:  alice,
+			attrs:      uniform,
+			policy:     policy("roles/storage.objectViewer", "domain:example.com"),
+			wantRead:   true,
+			wantGrants: []string{"bucket IAM: domain:example.com has roles/storage.objectViewer"},
+		},
+		{
+			name:      "conditional",
+			principal: alice,
+			attrs:     uniform,
+			policy:    conditional,
+		},
+		{
+			name:      "ACL ignored under uniform access",
+			principal: alice,
+			attrs:     uniform,
+			policy:    &iam.Policy3{},
+			rules:     []storage.ACLRule{{Entity: "user-alice@example.com", Role: storage.RoleReader}},
+		},
+		{
+			name:       "ACL",
+			principal:  "serviceAccount:app@my-project.iam.gserviceaccount.com",
+			attrs:      fineGrained,
+			policy:     &iam.Policy3{},
+			rules:      []storage.ACLRule{{Entity: "user-app@my-project.iam.gserviceaccount.com", Role: storage.RoleReader}},
+			wantRead:   true,
+			wantGrants: []string{"object ACL: user-app@my-project.iam.gserviceaccount.com has READER"},
+		},
+		{
+			name:       "public",
+			principal:  alice,
+			attrs:      fineGrained,
+			policy:     policy("roles/storage.objectViewer", iam.AllAuthenticatedUsers),
+			rules:      []storage.ACLRule{{Entity: storage.AllUsers, Role: storage.RoleReader}},
+			wantRead:   true,
+			wantGrants: []string{"bucket IAM: allAuthenticatedUsers has roles/storage.objectViewer", "object ACL: allUsers has READER"},
+		},
+		{
+			name:      "public access prevention",
+			principal: iam.AllUsers,
+			attrs:     enforced,
+			policy:    policy("roles/storage.objectViewer", iam.AllUsers),
+			rules:     []storage.ACLRule{{Entity: storage.AllUsers, Role: storage.RoleReader}},
+		},
+		{
+			name:      "allUsers is not authenticated",
+			principal: iam.AllUsers,
+			attrs:     uniform,
+			policy:    policy("roles/storage.objectViewer", iam.AllAuthenticatedUsers),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := evaluateAccess(tc.principal, tc.attrs, tc.policy, tc.rules)
+			if r.CanRead != tc.wantRead {
+				t.Errorf("CanRead got %v, want %v (notes: %q)", r.CanRead, tc.wantRead, r.Notes)
+			}
+			if diff := cmp.Diff(tc.wantGrants, r.Grants); diff != "" {
+				t.Errorf("Grants mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -18,27 +18,27 @@ package acl
 import (
 	"context"
 	"fmt"
+	"io"
+	"time"
 
 	"cloud.google.com/go/storage"
 )
 
 // addBucketDefaultOwner adds default ACL to the specified bucket.
-func addBucketDefaultOwner(bucket string, entity storage.ACLEntity) error {
+func addBucketDefaultOwner(ctx context.Context, w io.Writer, client *storage.Client, bucket string, entity storage.ACLEntity) error {
 	// bucket := "bucket-name"
 	// entity := storage.AllUsers
-	role := storage.RoleOwner
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
 
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
+	role := storage.RoleOwner
 
 	acl := client.Bucket(bucket).DefaultObjectACL()
 	if err := acl.Set(ctx, entity, role); err != nil {
-		return fmt.Errorf("ACLHandle.Set: %v", err)
+		return fmt.Errorf("ACLHandle.Set: %w", err)
 	}
+	fmt.Fprintf(w, "Added %v as default owner of objects in bucket %v.\n", entity, bucket)
 	return nil
 }
 
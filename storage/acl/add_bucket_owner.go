@@ -18,27 +18,27 @@ package acl
 import (
 	"context"
 	"fmt"
+	"io"
+	"time"
 
 	"cloud.google.com/go/storage"
 )
 
 // addBucketOwner adds ACL to the specified bucket.
-func addBucketOwner(bucket string, entity storage.ACLEntity) error {
+func addBucketOwner(ctx context.Context, w io.Writer, client *storage.Client, bucket string, entity storage.ACLEntity) error {
 	// bucket := "bucket-name"
 	// entity := storage.AllUsers
-	role := storage.RoleOwner
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
 
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
+	role := storage.RoleOwner
 
 	acl := client.Bucket(bucket).ACL()
 	if err := acl.Set(ctx, entity, role); err != nil {
-		return fmt.Errorf("ACLHandle.Set: %v", err)
+		return fmt.Errorf("ACLHandle.Set: %w", err)
 	}
+	fmt.Fprintf(w, "Added %v as owner of bucket %v.\n", entity, bucket)
 	return nil
 }
 
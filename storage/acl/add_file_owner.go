@@ -18,28 +18,28 @@ package acl
 import (
 	"context"
 	"fmt"
+	"io"
+	"time"
 
 	"cloud.google.com/go/storage"
 )
 
 // addFileOwner adds ACL to the specified object.
-func addFileOwner(bucket, object string, entity storage.ACLEntity) error {
+func addFileOwner(ctx context.Context, w io.Writer, client *storage.Client, bucket, object string, entity storage.ACLEntity) error {
 	// bucket := "bucket-name"
 	// object := "object-name"
 	// entity := storage.AllUsers
-	role := storage.RoleOwner
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
 
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
+	role := storage.RoleOwner
 
 	acl := client.Bucket(bucket).Object(object).ACL()
 	if err := acl.Set(ctx, entity, role); err != nil {
-		return fmt.Errorf("ACLHandle.Set: %v", err)
+		return fmt.Errorf("ACLHandle.Set: %w", err)
 	}
+	fmt.Fprintf(w, "Added %v as owner of object %v in bucket %v.\n", entity, object, bucket)
 	return nil
 }
 
@@ -0,0 +1,210 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package acl
+
+// [START storage_check_object_access]
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/iam"
+	"cloud.google.com/go/storage"
+)
+
+// objectReadRoles are the predefined roles that grant storage.objects.get.
+var objectReadRoles = map[string]bool{
+	"roles/storage.admin":              true,
+	"roles/storage.objectAdmin":        true,
+	"roles/storage.objectUser":         true,
+	"roles/storage.objectViewer":       true,
+	"roles/storage.legacyObjectOwner":  true,
+	"roles/storage.legacyObjectReader": true,
+}
+
+// accessReport explains whether a principal can read an object.
+type accessReport struct {
+	CanRead bool
+	// Grants are the IAM bindings and ACL rules that let the principal
+	// read the object.
+	Grants []string
+	// Notes are what the check ignored or could not evaluate.
+	Notes []string
+}
+
+// checkObjectAccess reports whether principal can read an object, and why,
+// by evaluating the bucket's IAM policy, the object's ACL when uniform
+// bucket-level access is off, and public access prevention.
+// principal is an IAM member, such as "user:alice@example.com",
+// "serviceAccount:app@my-project.iam.gserviceaccount.com" or "allUsers".
+//
+// Only the bucket is checked: roles granted on the project, folder or
+// organization, custom roles and group memberships are reported as notes.
+// Policy Troubleshooter checks all of them.
+func checkObjectAccess(ctx context.Context, w io.Writer, client *storage.Client, bucket, object, principal string) (*accessReport, error) {
+	// bucket := "bucket-name"
+	// object := "object-name"
+	// principal := "user:alice@example.com"
+	const timeout = 30 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	b := client.Bucket(bucket)
+	attrs, err := b.Attrs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Bucket(%q).Attrs: %w", bucket, err)
+	}
+	policy, err := b.IAM().V3().Policy(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Bucket(%q).IAM().V3().Policy: %w", bucket, err)
+	}
+	// Object ACLs are ignored, and can't be read, while uniform
+	// bucket-level access is on.
+	var rules []storage.ACLRule
+	if !attrs.UniformBucketLevelAccess.Enabled {
+		if rules, err = b.Object(object).ACL().List(ctx); err != nil {
+			return nil, fmt.Errorf("Object(%q).ACL().List: %w", object, err)
+		}
+	}
+
+	r := evaluateAccess(principal, attrs, policy, rules)
+	verdict := "cannot"
+	if r.CanRead {
+		verdict = "can"
+	}
+	fmt.Fprintf(w, "%v %s read gs://%v/%v\n", principal, verdict, bucket, object)
+	for _, g := range r.Grants {
+		fmt.Fprintf(w, "- %s\n", g)
+	}
+	for _, n := range r.Notes {
+		fmt.Fprintf(w, "Note: %s\n", n)
+	}
+	return r, nil
+}
+
+// evaluateAccess decides whether principal can read an object given its
+// bucket's attributes and IAM policy, and the object's ACL rules.
+func evaluateAccess(principal string, attrs *storage.BucketAttrs, policy *iam.Policy3, rules []storage.ACLRule) *accessReport {
+	r := &accessReport{}
+	isPublic := func(member string) bool {
+		return member == iam.AllUsers || member == iam.AllAuthenticatedUsers
+	}
+	// papBlocks reports whether public access prevention voids a grant
+	// to member.
+	papBlocks := func(member string) bool {
+		return attrs.PublicAccessPrevention == storage.PublicAccessPreventionEnforced && isPublic(member)
+	}
+	// publicGrant is set when a grant to the public lets principal read.
+	var publicGrant bool
+
+	for _, binding := range policy.Bindings {
+		for _, member := range binding.Members {
+			if !memberMatches(member, principal) {
+				continue
+			}
+			grant := fmt.Sprintf("bucket IAM: %v has %v", member, binding.Role)
+			switch {
+			case !objectReadRoles[binding.Role] && !strings.HasPrefix(binding.Role, "roles/"):
+				r.Notes = append(r.Notes, fmt.Sprintf("%v has custom role %v, which was not evaluated", member, binding.Role))
+			case !objectReadRoles[binding.Role]:
+				// The role does not include storage.objects.get.
+			case papBlocks(member):
+				r.Notes = append(r.Notes, grant+", but public access prevention is enforced")
+			case binding.Condition != nil:
+				r.Notes = append(r.Notes, fmt.Sprintf("%s if %q, which was not evaluated", grant, binding.Condition.Expression))
+			default:
+				r.CanRead = true
+				r.Grants = append(r.Grants, grant)
+				publicGrant = publicGrant || isPublic(member)
+			}
+		}
+	}
+
+	if attrs.UniformBucketLevelAccess.Enabled {
+		r.Notes = append(r.Notes, "uniform bucket-level access is on, so object ACLs are ignored")
+		rules = nil
+	}
+	for _, rule := range rules {
+		member, ok := aclMember(rule.Entity)
+		if !ok || !memberMatches(member, principal) {
+			continue
+		}
+		// Every ACL role, READER, WRITER or OWNER, allows reading.
+		grant := fmt.Sprintf("object ACL: %v has %v", rule.Entity, rule.Role)
+		if papBlocks(member) {
+			r.Notes = append(r.Notes, grant+", but public access prevention is enforced")
+			continue
+		}
+		r.CanRead = true
+		r.Grants = append(r.Grants, grant)
+		publicGrant = publicGrant || isPublic(member)
+	}
+
+	if attrs.PublicAccessPrevention == storage.PublicAccessPreventionInherited && publicGrant {
+		r.Notes = append(r.Notes, "public access prevention is inherited, so an organization policy may still block the public grants")
+	}
+	if !isPublic(principal) {
+		r.Notes = append(r.Notes, "roles granted on the project, folder or organization, and through groups, were not evaluated")
+	}
+	return r
+}
+
+// memberMatches reports whether an IAM member, as found in a binding,
+// includes principal. Group memberships can't be resolved here.
+func memberMatches(member, principal string) bool {
+	switch {
+	case member == principal, member == iam.AllUsers:
+		return true
+	case member == iam.AllAuthenticatedUsers:
+		return principal != iam.AllUsers
+	case strings.HasPrefix(member, "domain:"):
+		domain := strings.TrimPrefix(member, "domain:")
+		for _, prefix := range []string{"user:", "group:"} {
+			if strings.HasPrefix(principal, prefix) && strings.HasSuffix(principal, "@"+domain) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// aclMember converts an ACL entity, such as "user-alice@example.com", to the
+// IAM member it names. Project entities, such as "project-viewers-123",
+// have no IAM member.
+func aclMember(entity storage.ACLEntity) (string, bool) {
+	e := string(entity)
+	switch {
+	case entity == storage.AllUsers:
+		return iam.AllUsers, true
+	case entity == storage.AllAuthenticatedUsers:
+		return iam.AllAuthenticatedUsers, true
+	case strings.HasPrefix(e, "user-"):
+		email := strings.TrimPrefix(e, "user-")
+		// ACLs name service accounts as users.
+		if strings.HasSuffix(email, ".gserviceaccount.com") {
+			return "serviceAccount:" + email, true
+		}
+		return "user:" + email, true
+	case strings.HasPrefix(e, "group-"):
+		return "group:" + strings.TrimPrefix(e, "group-"), true
+	case strings.HasPrefix(e, "domain-"):
+		return "domain:" + strings.TrimPrefix(e, "domain-"), true
+	}
+	return "", false
+}
+
+// [END storage_check_object_access]
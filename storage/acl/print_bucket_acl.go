@@ -19,23 +19,21 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"time"
 
 	"cloud.google.com/go/storage"
 )
 
 // printBucketACL lists bucket ACL.
-func printBucketACL(w io.Writer, bucket string) error {
+func printBucketACL(ctx context.Context, w io.Writer, client *storage.Client, bucket string) error {
 	// bucket := "bucket-name"
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
 
 	rules, err := client.Bucket(bucket).ACL().List(ctx)
 	if err != nil {
-		return fmt.Errorf("ACLHandle.List: %v", err)
+		return fmt.Errorf("ACLHandle.List: %w", err)
 	}
 	for _, rule := range rules {
 		fmt.Fprintf(w, "ACL rule: %v\n", rule)
@@ -19,24 +19,22 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"time"
 
 	"cloud.google.com/go/storage"
 )
 
 // printBucketACLForUser lists bucket ACL using a filter.
-func printBucketACLForUser(w io.Writer, bucket string, entity storage.ACLEntity) error {
+func printBucketACLForUser(ctx context.Context, w io.Writer, client *storage.Client, bucket string, entity storage.ACLEntity) error {
 	// bucket := "bucket-name"
 	// entity := storage.AllUsers
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
 
 	rules, err := client.Bucket(bucket).ACL().List(ctx)
 	if err != nil {
-		return fmt.Errorf("ACLHandle.List: %v", err)
+		return fmt.Errorf("ACLHandle.List: %w", err)
 	}
 	for _, r := range rules {
 		if r.Entity == entity {
@@ -19,24 +19,22 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"time"
 
 	"cloud.google.com/go/storage"
 )
 
 // printFileACL lists ACL of the specified object.
-func printFileACL(w io.Writer, bucket, object string) error {
+func printFileACL(ctx context.Context, w io.Writer, client *storage.Client, bucket, object string) error {
 	// bucket := "bucket-name"
 	// object := "object-name"
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
 
 	rules, err := client.Bucket(bucket).Object(object).ACL().List(ctx)
 	if err != nil {
-		return fmt.Errorf("ACLHandle.List: %v", err)
+		return fmt.Errorf("ACLHandle.List: %w", err)
 	}
 	for _, rule := range rules {
 		fmt.Fprintf(w, "ACL rule: %v\n", rule)
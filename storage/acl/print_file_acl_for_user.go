@@ -19,25 +19,23 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"time"
 
 	"cloud.google.com/go/storage"
 )
 
 // printFileACLForUser lists ACL of the specified object with filter.
-func printFileACLForUser(w io.Writer, bucket, object string, entity storage.ACLEntity) error {
+func printFileACLForUser(ctx context.Context, w io.Writer, client *storage.Client, bucket, object string, entity storage.ACLEntity) error {
 	// bucket := "bucket-name"
 	// object := "object-name"
 	// entity := storage.AllAuthenticatedUsers
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
 
 	rules, err := client.Bucket(bucket).ACL().List(ctx)
 	if err != nil {
-		return fmt.Errorf("ACLHandle.List: %v", err)
+		return fmt.Errorf("ACLHandle.List: %w", err)
 	}
 	for _, r := range rules {
 		if r.Entity == entity {
@@ -18,25 +18,25 @@ package acl
 import (
 	"context"
 	"fmt"
+	"io"
+	"time"
 
 	"cloud.google.com/go/storage"
 )
 
 // deleteDefaultBucketACL removes default ACL from a bucket.
-func removeBucketDefaultOwner(bucket string, entity storage.ACLEntity) error {
+func removeBucketDefaultOwner(ctx context.Context, w io.Writer, client *storage.Client, bucket string, entity storage.ACLEntity) error {
 	// bucket := "bucket-name"
 	// entity := storage.AllUsers
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
 
 	acl := client.Bucket(bucket).DefaultObjectACL()
 	if err := acl.Delete(ctx, entity); err != nil {
-		return fmt.Errorf("ACLHandle.Delete: %v", err)
+		return fmt.Errorf("ACLHandle.Delete: %w", err)
 	}
+	fmt.Fprintf(w, "Removed %v from the default owners of objects in bucket %v.\n", entity, bucket)
 	return nil
 }
 
@@ -18,26 +18,26 @@ package acl
 import (
 	"context"
 	"fmt"
+	"io"
+	"time"
 
 	"cloud.google.com/go/storage"
 )
 
 // removeFileOwner removes default ACL from the given object.
-func removeFileOwner(bucket, object string, entity storage.ACLEntity) error {
+func removeFileOwner(ctx context.Context, w io.Writer, client *storage.Client, bucket, object string, entity storage.ACLEntity) error {
 	// bucket := "bucket-name"
 	// object := "object-name"
 	// entity := storage.AllUsers
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
 
 	acl := client.Bucket(bucket).Object(object).ACL()
 	if err := acl.Delete(ctx, entity); err != nil {
-		return fmt.Errorf("ACLHandle.Delete: %v", err)
+		return fmt.Errorf("ACLHandle.Delete: %w", err)
 	}
+	fmt.Fprintf(w, "Removed %v from the owners of object %v in bucket %v.\n", entity, object, bucket)
 	return nil
 }
 
@@ -0,0 +1,115 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package benchmark
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/GoogleCloudPlatform/golang-samples/internal/testutil"
+)
+
+func TestPercentile(t *testing.T) {
+	var latencies []time.Duration
+	for i := 100; i >= 1; i-- {
+		latencies = append(latencies, time.Duration(i)*time.Millisecond)
+	}
+	tests := []struct {
+		p    float64
+		want time.Duration
+	}{
+		{0, time.Millisecond},
+		{50, 50 * time.Millisecond},
+		{90, 90 * time.Millisecond},
+		{99, 99 * time.Millisecond},
+		{100, 100 * time.Millisecond},
+	}
+	for _, tc := range tests {
+		if got := percentile(latencies, tc.p); got != tc.want {
+			t.Errorf("percentile(p%v) got %v, want %v", tc.p, got, tc.want)
+		}
+	}
+	if got := percentile(nil, 50); got != 0 {
+		t.Errorf("percentile(nil) got %v, want 0", got)
+	}
+}
+
+func TestWriteReport(t *testing.T) {
+	results := []*result{
+		{api: "http", op: "read", latencies: []time.Duration{time.Second}, bytes: 1 << 20, elapsed: time.Second},
+		{api: "grpc", op: "read", latencies: []time.Duration{time.Second}, bytes: 2 << 20, elapsed: time.Second},
+	}
+	buf := new(bytes.Buffer)
+	if err := writeReport(buf, results); err != nil {
+		t.Fatalf("writeReport: %v", err)
+	}
+	for _, want := range []string{"p99", "http", "grpc", "1.0", "2.0"} {
+		if got := buf.String(); !strings.Contains(got, want) {
+			t.Errorf("writeReport got %q, want to contain %q", got, want)
+		}
+	}
+}
+
+// TestCompareClients runs the workload through the JSON and gRPC clients and
+// prints the comparison. It is skipped unless GOLANG_SAMPLES_STORAGE_BENCHMARK
+// is set.
+func TestCompareClients(t *testing.T) {
+	if os.Getenv("GOLANG_SAMPLES_STORAGE_BENCHMARK") == "" {
+		t.Skip("GOLANG_SAMPLES_STORAGE_BENCHMARK not set")
+	}
+	tc := testutil.SystemTest(t)
+	ctx := context.Background()
+	bucket := testutil.UniqueName("storage-benchmark")
+	testutil.CleanBucket(ctx, t, tc.ProjectID, bucket)
+
+	wl := workload{objectSize: 4 << 20, objects: 64, concurrency: 8}
+	clients := map[string]func(context.Context) (*storage.Client, error){
+		"http": func(ctx context.Context) (*storage.Client, error) { return storage.NewClient(ctx) },
+		"grpc": func(ctx context.Context) (*storage.Client, error) { return storage.NewGRPCClient(ctx) },
+	}
+	var apis []string
+	for api := range clients {
+		apis = append(apis, api)
+	}
+	sort.Strings(apis)
+
+	prefix := fmt.Sprintf("run-%d", time.Now().Unix())
+	var writes, reads []*result
+	for _, api := range apis {
+		client, err := clients[api](ctx)
+		if err != nil {
+			t.Fatalf("%s client: %v", api, err)
+		}
+		w, r, err := runWorkload(ctx, client, api, bucket, prefix, wl)
+		client.Close()
+		if err != nil {
+			t.Fatalf("runWorkload: %v", err)
+		}
+		writes = append(writes, w)
+		reads = append(reads, r)
+	}
+
+	fmt.Printf("%d objects of %d bytes, %d at a time\n", wl.objects, wl.objectSize, wl.concurrency)
+	if err := writeReport(os.Stdout, append(writes, reads...)); err != nil {
+		t.Fatalf("writeReport: %v", err)
+	}
+}
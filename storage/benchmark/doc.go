@@ -0,0 +1,24 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package benchmark compares the JSON (HTTP) and gRPC Cloud Storage clients
+// by running the same read and write workload through both and reporting
+// latency percentiles and throughput side by side.
+//
+// The comparison talks to Cloud Storage and moves real data, so it only runs
+// when GOLANG_SAMPLES_STORAGE_BENCHMARK is set:
+//
+//	GOLANG_SAMPLES_PROJECT_ID=my-project GOLANG_SAMPLES_STORAGE_BENCHMARK=1 \
+//		go test -v -run TestCompareClients ./storage/benchmark
+package benchmark
@@ -0,0 +1,65 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package benchmark
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+	"time"
+)
+
+// percentile returns the p-th percentile (0-100) of the latencies using the
+// nearest-rank method.
+func percentile(latencies []time.Duration, p float64) time.Duration {
+	if len(latencies) == 0 {
+		return 0
+	}
+	sorted := append([]time.Duration(nil), latencies...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	rank := int(p/100*float64(len(sorted))+0.5) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	if rank >= len(sorted) {
+		rank = len(sorted) - 1
+	}
+	return sorted[rank]
+}
+
+// throughput returns the result's throughput in MiB/s.
+func (r *result) throughput() float64 {
+	if r.elapsed <= 0 {
+		return 0
+	}
+	return float64(r.bytes) / (1 << 20) / r.elapsed.Seconds()
+}
+
+// writeReport prints one row per result, so results for the same operation
+// with different clients line up for comparison.
+func writeReport(w io.Writer, results []*result) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "op\tapi\tops\tp50\tp90\tp99\tMiB/s\t")
+	for _, r := range results {
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%v\t%v\t%v\t%.1f\t\n",
+			r.op, r.api, len(r.latencies),
+			percentile(r.latencies, 50).Round(time.Millisecond),
+			percentile(r.latencies, 90).Round(time.Millisecond),
+			percentile(r.latencies, 99).Round(time.Millisecond),
+			r.throughput())
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,127 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package benchmark
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/storage"
+)
+
+// workload describes what each client is asked to do.
+type workload struct {
+	objectSize  int // bytes per object
+	objects     int // objects written, then read back
+	concurrency int // operations in flight at once
+}
+
+// result holds the measurements for one kind of operation on one client.
+type result struct {
+	api       string
+	op        string
+	latencies []time.Duration
+	bytes     int64
+	elapsed   time.Duration
+}
+
+// runWorkload writes wl.objects objects with client and reads each one back,
+// returning the write and read results. Objects are named after prefix so
+// that runs with different clients do not overwrite each other.
+func runWorkload(ctx context.Context, client *storage.Client, api, bucket, prefix string, wl workload) (writes, reads *result, err error) {
+	data := bytes.Repeat([]byte("a"), wl.objectSize)
+	names := make([]string, wl.objects)
+	for i := range names {
+		names[i] = fmt.Sprintf("%s/%s/object-%d", prefix, api, i)
+	}
+	b := client.Bucket(bucket)
+
+	writes, err = measure(api, "write", names, wl.concurrency, func(name string) (int64, error) {
+		wc := b.Object(name).NewWriter(ctx)
+		if _, err := wc.Write(data); err != nil {
+			wc.Close()
+			return 0, fmt.Errorf("Writer.Write: %w", err)
+		}
+		if err := wc.Close(); err != nil {
+			return 0, fmt.Errorf("Writer.Close: %w", err)
+		}
+		return int64(len(data)), nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reads, err = measure(api, "read", names, wl.concurrency, func(name string) (int64, error) {
+		rc, err := b.Object(name).NewReader(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("Object(%q).NewReader: %w", name, err)
+		}
+		defer rc.Close()
+		n, err := io.Copy(ioutil.Discard, rc)
+		if err != nil {
+			return n, fmt.Errorf("io.Copy: %w", err)
+		}
+		return n, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return writes, reads, nil
+}
+
+// measure runs op on every name with at most concurrency calls in flight and
+// records the latency of each call.
+func measure(api, op string, names []string, concurrency int, fn func(name string) (int64, error)) (*result, error) {
+	res := &result{api: api, op: op}
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		firstErr error
+	)
+	sem := make(chan struct{}, concurrency)
+	start := time.Now()
+	for _, name := range names {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(name string) {
+			defer func() { <-sem; wg.Done() }()
+			opStart := time.Now()
+			n, err := fn(name)
+			latency := time.Since(opStart)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+				}
+				return
+			}
+			res.latencies = append(res.latencies, latency)
+			res.bytes += n
+		}(name)
+	}
+	wg.Wait()
+	res.elapsed = time.Since(start)
+	if firstErr != nil {
+		return nil, fmt.Errorf("%s %s: %w", api, op, firstErr)
+	}
+	return res, nil
+}
@@ -27,27 +27,21 @@ import (
 )
 
 // addBucketConditionalIAMBinding adds bucket conditional IAM binding.
-func addBucketConditionalIAMBinding(w io.Writer, bucketName, role, member, title, description, expression string) error {
+func addBucketConditionalIAMBinding(ctx context.Context, w io.Writer, client *storage.Client, bucketName, role, member, title, description, expression string) error {
 	// bucketName := "bucket-name"
 	// role := "bucket-level IAM role"
 	// member := "bucket-level IAM member"
 	// title := "condition title"
 	// description := "condition description"
 	// expression := "condition expression"
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
-
-	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	bucket := client.Bucket(bucketName)
 	policy, err := bucket.IAM().V3().Policy(ctx)
 	if err != nil {
-		return fmt.Errorf("Bucket(%q).IAM().V3().Policy: %v", bucketName, err)
+		return fmt.Errorf("Bucket(%q).IAM().V3().Policy: %w", bucketName, err)
 	}
 
 	policy.Bindings = append(policy.Bindings, &iampb.Binding{
@@ -61,7 +55,7 @@ func addBucketConditionalIAMBinding(w io.Writer, bucketName, role, member, title
 	})
 
 	if err := bucket.IAM().V3().SetPolicy(ctx, policy); err != nil {
-		return fmt.Errorf("Bucket(%q).IAM().V3().SetPolicy: %v", bucketName, err)
+		return fmt.Errorf("Bucket(%q).IAM().V3().SetPolicy: %w", bucketName, err)
 	}
 	// NOTE: It may be necessary to retry this operation if IAM policies are
 	// being modified concurrently. SetPolicy will return an error if the policy
@@ -26,22 +26,16 @@ import (
 )
 
 // addBucketIAMMember adds the bucket IAM member to permission role.
-func addBucketIAMMember(w io.Writer, bucketName string) error {
+func addBucketIAMMember(ctx context.Context, w io.Writer, client *storage.Client, bucketName string) error {
 	// bucketName := "bucket-name"
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
-
-	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	bucket := client.Bucket(bucketName)
 	policy, err := bucket.IAM().Policy(ctx)
 	if err != nil {
-		return fmt.Errorf("Bucket(%q).IAM().Policy: %v", bucketName, err)
+		return fmt.Errorf("Bucket(%q).IAM().Policy: %w", bucketName, err)
 	}
 	// Other valid prefixes are "serviceAccount:", "user:"
 	// See the documentation for more values.
@@ -51,7 +45,7 @@ func addBucketIAMMember(w io.Writer, bucketName string) error {
 
 	policy.Add(identity, role)
 	if err := bucket.IAM().SetPolicy(ctx, policy); err != nil {
-		return fmt.Errorf("Bucket(%q).IAM().SetPolicy: %v", bucketName, err)
+		return fmt.Errorf("Bucket(%q).IAM().SetPolicy: %w", bucketName, err)
 	}
 	// NOTE: It may be necessary to retry this operation if IAM policies are
 	// being modified concurrently. SetPolicy will return an error if the policy
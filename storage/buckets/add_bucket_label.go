@@ -25,25 +25,19 @@ import (
 )
 
 // addBucketLabel adds a label on a bucket.
-func addBucketLabel(w io.Writer, bucketName, labelName, labelValue string) error {
+func addBucketLabel(ctx context.Context, w io.Writer, client *storage.Client, bucketName, labelName, labelValue string) error {
 	// bucketName := "bucket-name"
 	// labelName := "label-name"
 	// labelValue := "label-value"
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
-
-	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	bucket := client.Bucket(bucketName)
 	bucketAttrsToUpdate := storage.BucketAttrsToUpdate{}
 	bucketAttrsToUpdate.SetLabel(labelName, labelValue)
 	if _, err := bucket.Update(ctx, bucketAttrsToUpdate); err != nil {
-		return fmt.Errorf("Bucket(%q).Update: %v", bucketName, err)
+		return fmt.Errorf("Bucket(%q).Update: %w", bucketName, err)
 	}
 	fmt.Fprintf(w, "Added label %q with value %q to bucket %v\n", labelName, labelValue, bucketName)
 	return nil
@@ -19,7 +19,6 @@ import (
 	"context"
 	"fmt"
 	"io/ioutil"
-	"os"
 	"reflect"
 	"strings"
 	"testing"
@@ -31,42 +30,68 @@ import (
 	iampb "google.golang.org/genproto/googleapis/iam/v1"
 )
 
+// bucketName is the bucket shared by the tests of this file, from TestCreate
+// to TestDelete.
+var bucketName = testutil.UniqueName("buckets")
+
 func TestCreate(t *testing.T) {
-	tc := testutil.SystemTest(t)
-	bucketName := tc.ProjectID + "-storage-buckets-tests"
+	tc := testutil.StorageEmulatorTest(t)
+	ctx := context.Background()
+	client, err := storage.NewClient(ctx)
+	if err != nil {
+		t.Fatalf("storage.NewClient: %v", err)
+	}
+	defer client.Close()
 
 	// Clean up bucket before running tests.
-	deleteBucket(ioutil.Discard, bucketName)
-	if err := createBucket(ioutil.Discard, tc.ProjectID, bucketName); err != nil {
+	testutil.BucketLimiter.Take(t)
+	deleteBucket(ctx, ioutil.Discard, client, bucketName)
+	testutil.BucketLimiter.Take(t)
+	if err := createBucket(ctx, ioutil.Discard, client, tc.ProjectID, bucketName); err != nil {
 		t.Fatalf("createBucket: %v", err)
 	}
 }
 
 func TestCreateBucketClassLocation(t *testing.T) {
 	tc := testutil.SystemTest(t)
-	name := tc.ProjectID + "-storage-buckets-tests-attrs"
+	ctx := context.Background()
+	client, err := storage.NewClient(ctx)
+	if err != nil {
+		t.Fatalf("storage.NewClient: %v", err)
+	}
+	defer client.Close()
+
+	name := testutil.UniqueName("buckets-attrs")
 
 	// Clean up bucket before running the test.
-	deleteBucket(ioutil.Discard, name)
-	if err := createBucketClassLocation(ioutil.Discard, tc.ProjectID, name); err != nil {
+	testutil.BucketLimiter.Take(t)
+	deleteBucket(ctx, ioutil.Discard, client, name)
+	testutil.BucketLimiter.Take(t)
+	if err := createBucketClassLocation(ctx, ioutil.Discard, client, tc.ProjectID, name); err != nil {
 		t.Fatalf("createBucketClassLocation: %v", err)
 	}
-	if err := deleteBucket(ioutil.Discard, name); err != nil {
+	testutil.BucketLimiter.Take(t)
+	if err := deleteBucket(ctx, ioutil.Discard, client, name); err != nil {
 		t.Fatalf("deleteBucket: %v", err)
 	}
 }
 
 func TestListBuckets(t *testing.T) {
-	tc := testutil.SystemTest(t)
-	bucketName := tc.ProjectID + "-storage-buckets-tests"
+	tc := testutil.StorageEmulatorTest(t)
+	ctx := context.Background()
+	client, err := storage.NewClient(ctx)
+	if err != nil {
+		t.Fatalf("storage.NewClient: %v", err)
+	}
+	defer client.Close()
 
-	buckets, err := listBuckets(ioutil.Discard, tc.ProjectID)
+	buckets, err := listBuckets(ctx, ioutil.Discard, client, tc.ProjectID)
 	if err != nil {
 		t.Fatalf("listBuckets: %v", err)
 	}
 
 	var ok bool
-	testutil.Retry(t, 5, 2*time.Second, func(r *testutil.R) { // for eventual consistency
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 2 * time.Second, Max: 4 * time.Second, Multiplier: 2, MaxAttempts: 5}, func(r *testutil.R) { // for eventual consistency
 		for _, b := range buckets {
 			if b == bucketName {
 				ok = true
@@ -80,11 +105,16 @@ func TestListBuckets(t *testing.T) {
 }
 
 func TestGetBucketMetadata(t *testing.T) {
-	tc := testutil.SystemTest(t)
-	bucketName := tc.ProjectID + "-storage-buckets-tests"
+	testutil.SystemTest(t)
+	ctx := context.Background()
+	client, err := storage.NewClient(ctx)
+	if err != nil {
+		t.Fatalf("storage.NewClient: %v", err)
+	}
+	defer client.Close()
 
 	buf := new(bytes.Buffer)
-	if _, err := getBucketMetadata(buf, bucketName); err != nil {
+	if _, err := getBucketMetadata(ctx, buf, client, bucketName); err != nil {
 		t.Errorf("getBucketMetadata: %#v", err)
 	}
 
@@ -95,21 +125,28 @@ func TestGetBucketMetadata(t *testing.T) {
 }
 
 func TestIAM(t *testing.T) {
-	tc := testutil.SystemTest(t)
-	bucketName := tc.ProjectID + "-storage-buckets-tests"
+	testutil.SystemTest(t)
+	ctx := context.Background()
+	client, err := storage.NewClient(ctx)
+	if err != nil {
+		t.Fatalf("storage.NewClient: %v", err)
+	}
+	defer client.Close()
 
-	if _, err := getBucketPolicy(ioutil.Discard, bucketName); err != nil {
+	if _, err := getBucketPolicy(ctx, ioutil.Discard, client, bucketName); err != nil {
 		t.Errorf("getBucketPolicy: %#v", err)
 	}
-	if err := addBucketIAMMember(ioutil.Discard, bucketName); err != nil {
+	testutil.IAMLimiter.Take(t)
+	if err := addBucketIAMMember(ctx, ioutil.Discard, client, bucketName); err != nil {
 		t.Errorf("addBucketIAMMember: %v", err)
 	}
-	if err := removeBucketIAMMember(ioutil.Discard, bucketName); err != nil {
+	testutil.IAMLimiter.Take(t)
+	if err := removeBucketIAMMember(ctx, ioutil.Discard, client, bucketName); err != nil {
 		t.Errorf("removeBucketIAMMember: %v", err)
 	}
 
 	// Uniform bucket-level access is required to use IAM with conditions.
-	if err := enableUniformBucketLevelAccess(ioutil.Discard, bucketName); err != nil {
+	if err := enableUniformBucketLevelAccess(ctx, ioutil.Discard, client, bucketName); err != nil {
 		t.Fatalf("enableUniformBucketLevelAccess:  %v", err)
 	}
 
@@ -119,16 +156,68 @@ func TestIAM(t *testing.T) {
 	description := "description"
 	expression := "resource.name.startsWith(\"projects/_/buckets/bucket-name/objects/prefix-a-\")"
 
-	if err := addBucketConditionalIAMBinding(ioutil.Discard, bucketName, role, member, title, description, expression); err != nil {
+	testutil.IAMLimiter.Take(t)
+	if err := addBucketConditionalIAMBinding(ctx, ioutil.Discard, client, bucketName, role, member, title, description, expression); err != nil {
 		t.Errorf("addBucketConditionalIAMBinding: %v", err)
 	}
-	if err := removeBucketConditionalIAMBinding(ioutil.Discard, bucketName, role, title, description, expression); err != nil {
+	testutil.IAMLimiter.Take(t)
+	if err := removeBucketConditionalIAMBinding(ctx, ioutil.Discard, client, bucketName, role, title, description, expression); err != nil {
 		t.Errorf("removeBucketConditionalIAMBinding: %v", err)
 	}
 }
+
+// TestCrossProjectIAM grants a service account of another project a role
+// on a bucket.
+func TestCrossProjectIAM(t *testing.T) {
+	tc := testutil.SecondProjectTest(t)
+	ctx := context.Background()
+	client, err := storage.NewClient(ctx)
+	if err != nil {
+		t.Fatalf("storage.NewClient: %v", err)
+	}
+	defer client.Close()
+
+	bucketName := testutil.UniqueName("cross-project-iam")
+	testutil.CleanBucket(ctx, t, tc.ProjectID, bucketName)
+	t.Cleanup(func() { testutil.DeleteBucket(context.Background(), t, bucketName) })
+	// Uniform bucket-level access is required to use IAM with conditions.
+	if err := enableUniformBucketLevelAccess(ctx, ioutil.Discard, client, bucketName); err != nil {
+		t.Fatalf("enableUniformBucketLevelAccess: %v", err)
+	}
+
+	role := "roles/storage.objectViewer"
+	member := "serviceAccount:" + tc.SecondServiceAccount
+	title := "cross-project"
+	description := "read access for a service account of another project"
+	expression := fmt.Sprintf("resource.name.startsWith(\"projects/_/buckets/%s/objects/shared-\")", bucketName)
+
+	testutil.IAMLimiter.Take(t)
+	if err := addBucketConditionalIAMBinding(ctx, ioutil.Discard, client, bucketName, role, member, title, description, expression); err != nil {
+		t.Fatalf("addBucketConditionalIAMBinding: %v", err)
+	}
+	policy, err := client.Bucket(bucketName).IAM().V3().Policy(ctx)
+	if err != nil {
+		t.Fatalf("Bucket(%q).IAM().V3().Policy: %v", bucketName, err)
+	}
+	found := false
+	for _, b := range policy.Bindings {
+		for _, m := range b.Members {
+			if b.Role == role && m == member {
+				found = true
+			}
+		}
+	}
+	if !found {
+		t.Errorf("policy of %q has no %s binding for %s", bucketName, role, member)
+	}
+	testutil.IAMLimiter.Take(t)
+	if err := removeBucketConditionalIAMBinding(ctx, ioutil.Discard, client, bucketName, role, title, description, expression); err != nil {
+		t.Errorf("removeBucketConditionalIAMBinding: %v", err)
+	}
+}
+
 func TestCORSConfiguration(t *testing.T) {
-	tc := testutil.SystemTest(t)
-	bucketName := tc.ProjectID + "-storage-buckets-tests"
+	testutil.SystemTest(t)
 
 	ctx := context.Background()
 	client, err := storage.NewClient(ctx)
@@ -145,7 +234,7 @@ func TestCORSConfiguration(t *testing.T) {
 			ResponseHeaders: []string{"Content-Type"},
 		},
 	}
-	if err := setBucketCORSConfiguration(ioutil.Discard, bucketName, want[0].MaxAge, want[0].Methods, want[0].Origins, want[0].ResponseHeaders); err != nil {
+	if err := setBucketCORSConfiguration(ctx, ioutil.Discard, client, bucketName, want[0].MaxAge, want[0].Methods, want[0].Origins, want[0].ResponseHeaders); err != nil {
 		t.Fatalf("setBucketCORSConfiguration: %v", err)
 	}
 	attrs, err := client.Bucket(bucketName).Attrs(ctx)
@@ -155,7 +244,7 @@ func TestCORSConfiguration(t *testing.T) {
 	if !reflect.DeepEqual(attrs.CORS, want) {
 		t.Fatalf("Unexpected CORS Configuration: got: %v, want: %v", attrs.CORS, want)
 	}
-	if err := removeBucketCORSConfiguration(ioutil.Discard, bucketName); err != nil {
+	if err := removeBucketCORSConfiguration(ctx, ioutil.Discard, client, bucketName); err != nil {
 		t.Fatalf("removeBucketCORSConfiguration: %v", err)
 	}
 	attrs, err = client.Bucket(bucketName).Attrs(ctx)
@@ -168,31 +257,40 @@ func TestCORSConfiguration(t *testing.T) {
 }
 
 func TestRequesterPays(t *testing.T) {
-	tc := testutil.SystemTest(t)
-	bucketName := tc.ProjectID + "-storage-buckets-tests"
+	testutil.SystemTest(t)
+	ctx := context.Background()
+	client, err := storage.NewClient(ctx)
+	if err != nil {
+		t.Fatalf("storage.NewClient: %v", err)
+	}
+	defer client.Close()
 
 	// Tests which update the bucket metadata must be retried in order to avoid
 	// flakes from rate limits.
-	testutil.Retry(t, 5, 2*time.Second, func(r *testutil.R) {
-		if err := enableRequesterPays(ioutil.Discard, bucketName); err != nil {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 2 * time.Second, Max: 4 * time.Second, Multiplier: 2, MaxAttempts: 5}, func(r *testutil.R) {
+		if err := enableRequesterPays(ctx, ioutil.Discard, client, bucketName); err != nil {
 			r.Errorf("enableRequesterPays: %#v", err)
 		}
 	})
-	testutil.Retry(t, 5, 2*time.Second, func(r *testutil.R) {
-		if err := disableRequesterPays(ioutil.Discard, bucketName); err != nil {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 2 * time.Second, Max: 4 * time.Second, Multiplier: 2, MaxAttempts: 5}, func(r *testutil.R) {
+		if err := disableRequesterPays(ctx, ioutil.Discard, client, bucketName); err != nil {
 			r.Errorf("disableRequesterPays: %#v", err)
 		}
 	})
-	if err := getRequesterPaysStatus(ioutil.Discard, bucketName); err != nil {
+	var buf bytes.Buffer
+	if err := getRequesterPaysStatus(ctx, &buf, client, bucketName); err != nil {
 		t.Errorf("getRequesterPaysStatus: %#v", err)
 	}
+	if got, want := buf.String(), "Is requester pays enabled? false\n"; got != want {
+		t.Errorf("getRequesterPaysStatus got %q, want %q", got, want)
+	}
 }
 
 func TestKMS(t *testing.T) {
 	tc := testutil.SystemTest(t)
-	bucketName := tc.ProjectID + "-storage-buckets-tests"
 
 	ctx := context.Background()
+	kmsKeyName := testutil.KMSKeyName(ctx, t, tc.ProjectID)
 	testutil.CleanBucket(ctx, t, tc.ProjectID, bucketName)
 
 	client, err := storage.NewClient(ctx)
@@ -201,16 +299,8 @@ func TestKMS(t *testing.T) {
 	}
 	defer client.Close()
 
-	keyRingID := os.Getenv("GOLANG_SAMPLES_KMS_KEYRING")
-	cryptoKeyID := os.Getenv("GOLANG_SAMPLES_KMS_CRYPTOKEY")
-
-	if keyRingID == "" || cryptoKeyID == "" {
-		t.Skip("GOLANG_SAMPLES_KMS_KEYRING and GOLANG_SAMPLES_KMS_CRYPTOKEY must be set")
-	}
-
-	kmsKeyName := fmt.Sprintf("projects/%s/locations/%s/keyRings/%s/cryptoKeys/%s", tc.ProjectID, "global", keyRingID, cryptoKeyID)
-	testutil.Retry(t, 5, 2*time.Second, func(r *testutil.R) {
-		if err := setBucketDefaultKMSKey(ioutil.Discard, bucketName, kmsKeyName); err != nil {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 2 * time.Second, Max: 4 * time.Second, Multiplier: 2, MaxAttempts: 5}, func(r *testutil.R) {
+		if err := setBucketDefaultKMSKey(ctx, ioutil.Discard, client, bucketName, kmsKeyName); err != nil {
 			r.Errorf("setBucketDefaultKMSKey: failed to enable default KMS key (%q): %v", kmsKeyName, err)
 		}
 	})
@@ -221,8 +311,8 @@ func TestKMS(t *testing.T) {
 	if attrs.Encryption.DefaultKMSKeyName != kmsKeyName {
 		t.Fatalf("Default KMS key was not set correctly: got %v, want %v", attrs.Encryption.DefaultKMSKeyName, kmsKeyName)
 	}
-	testutil.Retry(t, 5, 2*time.Second, func(r *testutil.R) {
-		if err := removeBucketDefaultKMSKey(ioutil.Discard, bucketName); err != nil {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 2 * time.Second, Max: 4 * time.Second, Multiplier: 2, MaxAttempts: 5}, func(r *testutil.R) {
+		if err := removeBucketDefaultKMSKey(ctx, ioutil.Discard, client, bucketName); err != nil {
 			r.Errorf("removeBucketDefaultKMSKey: failed to remove default KMS key: %v", err)
 		}
 	})
@@ -237,72 +327,77 @@ func TestKMS(t *testing.T) {
 
 func TestBucketLock(t *testing.T) {
 	tc := testutil.SystemTest(t)
-	bucketName := tc.ProjectID + "-storage-buckets-tests"
+	ctx := context.Background()
+	client, err := storage.NewClient(ctx)
+	if err != nil {
+		t.Fatalf("storage.NewClient: %v", err)
+	}
+	defer client.Close()
 
 	retentionPeriod := 5 * time.Second
-	testutil.Retry(t, 10, 10*time.Second, func(r *testutil.R) {
-		if err := setRetentionPolicy(ioutil.Discard, bucketName, retentionPeriod); err != nil {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 10 * time.Second, Max: 20 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
+		if err := setRetentionPolicy(ctx, ioutil.Discard, client, bucketName, retentionPeriod); err != nil {
 			r.Errorf("setRetentionPolicy: %v", err)
 		}
 	})
 
-	attrs, err := getRetentionPolicy(ioutil.Discard, bucketName)
+	attrs, err := getRetentionPolicy(ctx, ioutil.Discard, client, bucketName)
 	if err != nil {
 		t.Fatalf("getRetentionPolicy: %v", err)
 	}
 	if attrs.RetentionPolicy.RetentionPeriod != retentionPeriod {
 		t.Fatalf("retention period is not the expected value (%q): %v", retentionPeriod, attrs.RetentionPolicy.RetentionPeriod)
 	}
-	testutil.Retry(t, 10, 10*time.Second, func(r *testutil.R) {
-		if err := enableDefaultEventBasedHold(ioutil.Discard, bucketName); err != nil {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 10 * time.Second, Max: 20 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
+		if err := enableDefaultEventBasedHold(ctx, ioutil.Discard, client, bucketName); err != nil {
 			r.Errorf("enableDefaultEventBasedHold: %v", err)
 		}
 	})
 
-	attrs, err = getDefaultEventBasedHold(ioutil.Discard, bucketName)
+	attrs, err = getDefaultEventBasedHold(ctx, ioutil.Discard, client, bucketName)
 	if err != nil {
 		t.Fatalf("getDefaultEventBasedHold: %v", err)
 	}
 	if !attrs.DefaultEventBasedHold {
 		t.Fatalf("default event-based hold was not enabled")
 	}
-	testutil.Retry(t, 10, 10*time.Second, func(r *testutil.R) {
-		if err := disableDefaultEventBasedHold(ioutil.Discard, bucketName); err != nil {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 10 * time.Second, Max: 20 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
+		if err := disableDefaultEventBasedHold(ctx, ioutil.Discard, client, bucketName); err != nil {
 			r.Errorf("disableDefaultEventBasedHold: %v", err)
 		}
 	})
 
-	attrs, err = getDefaultEventBasedHold(ioutil.Discard, bucketName)
+	attrs, err = getDefaultEventBasedHold(ctx, ioutil.Discard, client, bucketName)
 	if err != nil {
 		t.Fatalf("getDefaultEventBasedHold: %v", err)
 	}
 	if attrs.DefaultEventBasedHold {
 		t.Fatalf("default event-based hold was not disabled")
 	}
-	testutil.Retry(t, 10, 10*time.Second, func(r *testutil.R) {
-		if err := removeRetentionPolicy(ioutil.Discard, bucketName); err != nil {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 10 * time.Second, Max: 20 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
+		if err := removeRetentionPolicy(ctx, ioutil.Discard, client, bucketName); err != nil {
 			r.Errorf("removeRetentionPolicy: %v", err)
 		}
 	})
 
-	attrs, err = getRetentionPolicy(ioutil.Discard, bucketName)
+	attrs, err = getRetentionPolicy(ctx, ioutil.Discard, client, bucketName)
 	if err != nil {
 		t.Fatalf("getRetentionPolicy: %v", err)
 	}
 	if attrs.RetentionPolicy != nil {
 		t.Fatalf("retention period to not be set")
 	}
-	testutil.Retry(t, 10, 10*time.Second, func(r *testutil.R) {
-		if err := setRetentionPolicy(ioutil.Discard, bucketName, retentionPeriod); err != nil {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 10 * time.Second, Max: 20 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
+		if err := setRetentionPolicy(ctx, ioutil.Discard, client, bucketName, retentionPeriod); err != nil {
 			r.Errorf("setRetentionPolicy: %v", err)
 		}
 	})
 
-	testutil.Retry(t, 10, 10*time.Second, func(r *testutil.R) {
-		if err := lockRetentionPolicy(ioutil.Discard, bucketName); err != nil {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 10 * time.Second, Max: 20 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
+		if err := lockRetentionPolicy(ctx, ioutil.Discard, client, bucketName); err != nil {
 			r.Errorf("lockRetentionPolicy: %v", err)
 		}
-		attrs, err := getRetentionPolicy(ioutil.Discard, bucketName)
+		attrs, err := getRetentionPolicy(ctx, ioutil.Discard, client, bucketName)
 		if err != nil {
 			r.Errorf("getRetentionPolicy: %v", err)
 		}
@@ -312,64 +407,78 @@ func TestBucketLock(t *testing.T) {
 	})
 
 	time.Sleep(5 * time.Second)
-	deleteBucket(ioutil.Discard, bucketName)
+	testutil.BucketLimiter.Take(t)
+	deleteBucket(ctx, ioutil.Discard, client, bucketName)
 	time.Sleep(5 * time.Second)
 
-	if err := createBucket(ioutil.Discard, tc.ProjectID, bucketName); err != nil {
+	testutil.BucketLimiter.Take(t)
+	if err := createBucket(ctx, ioutil.Discard, client, tc.ProjectID, bucketName); err != nil {
 		t.Fatalf("createBucket: %v", err)
 	}
 }
 
 func TestUniformBucketLevelAccess(t *testing.T) {
-	tc := testutil.SystemTest(t)
-	bucketName := tc.ProjectID + "-storage-buckets-tests"
+	testutil.SystemTest(t)
+	ctx := context.Background()
+	client, err := storage.NewClient(ctx)
+	if err != nil {
+		t.Fatalf("storage.NewClient: %v", err)
+	}
+	defer client.Close()
 
-	testutil.Retry(t, 10, 10*time.Second, func(r *testutil.R) {
-		if err := enableUniformBucketLevelAccess(ioutil.Discard, bucketName); err != nil {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 10 * time.Second, Max: 20 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
+		if err := enableUniformBucketLevelAccess(ctx, ioutil.Discard, client, bucketName); err != nil {
 			r.Errorf("enableUniformBucketLevelAccess: %v", err)
 		}
 	})
 
-	attrs, err := getUniformBucketLevelAccess(ioutil.Discard, bucketName)
+	var buf bytes.Buffer
+	attrs, err := getUniformBucketLevelAccess(ctx, &buf, client, bucketName)
 	if err != nil {
 		t.Fatalf("getUniformBucketLevelAccess: %v", err)
 	}
 	if !attrs.UniformBucketLevelAccess.Enabled {
 		t.Fatalf("Uniform bucket-level access was not enabled for (%q).", bucketName)
 	}
+	if got, want := buf.String(), "Uniform bucket-level access is enabled"; !strings.Contains(got, want) {
+		t.Errorf("getUniformBucketLevelAccess got %q, want to contain %q", got, want)
+	}
 
-	testutil.Retry(t, 10, 10*time.Second, func(r *testutil.R) {
-		if err := disableUniformBucketLevelAccess(ioutil.Discard, bucketName); err != nil {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 10 * time.Second, Max: 20 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
+		if err := disableUniformBucketLevelAccess(ctx, ioutil.Discard, client, bucketName); err != nil {
 			r.Errorf("disableUniformBucketLevelAccess: %v", err)
 		}
 	})
 
-	attrs, err = getUniformBucketLevelAccess(ioutil.Discard, bucketName)
+	buf.Reset()
+	attrs, err = getUniformBucketLevelAccess(ctx, &buf, client, bucketName)
 	if err != nil {
 		t.Fatalf("getUniformBucketLevelAccess: %v", err)
 	}
 	if attrs.UniformBucketLevelAccess.Enabled {
 		t.Fatalf("Uniform bucket-level access was not disabled for (%q).", bucketName)
 	}
+	if got, want := buf.String(), "Uniform bucket-level access is not enabled"; !strings.Contains(got, want) {
+		t.Errorf("getUniformBucketLevelAccess got %q, want to contain %q", got, want)
+	}
 }
 
 func TestLifecycleManagement(t *testing.T) {
 	tc := testutil.SystemTest(t)
-	bucketName := tc.ProjectID + "-storage-buckets-tests"
-
 	ctx := context.Background()
+	client, err := storage.NewClient(ctx)
+	if err != nil {
+		t.Fatalf("storage.NewClient: %v", err)
+	}
+	defer client.Close()
+
 	testutil.CleanBucket(ctx, t, tc.ProjectID, bucketName)
 
-	if err := enableBucketLifecycleManagement(ioutil.Discard, bucketName); err != nil {
+	if err := enableBucketLifecycleManagement(ctx, ioutil.Discard, client, bucketName); err != nil {
 		t.Fatalf("enableBucketLifecycleManagement: %v", err)
 	}
 
 	// verify lifecycle is set
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		t.Fatalf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
 
 	attrs, err := client.Bucket(bucketName).Attrs(ctx)
 	if err != nil {
@@ -390,7 +499,7 @@ func TestLifecycleManagement(t *testing.T) {
 		t.Fatalf("Unexpected lifecycle rule: got: %v, want: %v", r, want)
 	}
 
-	if err := disableBucketLifecycleManagement(ioutil.Discard, bucketName); err != nil {
+	if err := disableBucketLifecycleManagement(ctx, ioutil.Discard, client, bucketName); err != nil {
 		t.Fatalf("disableBucketLifecycleManagement: %v", err)
 	}
 
@@ -406,7 +515,6 @@ func TestLifecycleManagement(t *testing.T) {
 
 func TestBucketLabel(t *testing.T) {
 	tc := testutil.SystemTest(t)
-	bucketName := tc.ProjectID + "-storage-buckets-tests"
 
 	ctx := context.Background()
 	testutil.CleanBucket(ctx, t, tc.ProjectID, bucketName)
@@ -419,8 +527,8 @@ func TestBucketLabel(t *testing.T) {
 
 	labelName := "label-name"
 	labelValue := "label-value"
-	testutil.Retry(t, 10, 10*time.Second, func(r *testutil.R) {
-		if err := addBucketLabel(ioutil.Discard, bucketName, labelName, labelValue); err != nil {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 10 * time.Second, Max: 20 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
+		if err := addBucketLabel(ctx, ioutil.Discard, client, bucketName, labelName, labelValue); err != nil {
 			r.Errorf("addBucketLabel: %v", err)
 		}
 	})
@@ -435,8 +543,8 @@ func TestBucketLabel(t *testing.T) {
 	} else {
 		t.Fatalf("The label(%q) was not set on a bucket(%v)", labelName, bucketName)
 	}
-	testutil.Retry(t, 10, 10*time.Second, func(r *testutil.R) {
-		if err := removeBucketLabel(ioutil.Discard, bucketName, labelName); err != nil {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 10 * time.Second, Max: 20 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
+		if err := removeBucketLabel(ctx, ioutil.Discard, client, bucketName, labelName); err != nil {
 			r.Errorf("removeBucketLabel: %v", err)
 		}
 	})
@@ -451,7 +559,6 @@ func TestBucketLabel(t *testing.T) {
 
 func TestBucketWebsiteInfo(t *testing.T) {
 	tc := testutil.SystemTest(t)
-	bucketName := tc.ProjectID + "-storage-buckets-tests"
 
 	ctx := context.Background()
 	testutil.CleanBucket(ctx, t, tc.ProjectID, bucketName)
@@ -464,8 +571,8 @@ func TestBucketWebsiteInfo(t *testing.T) {
 
 	index := "index.html"
 	notFoundPage := "404.html"
-	testutil.Retry(t, 10, 10*time.Second, func(r *testutil.R) {
-		if err := setBucketWebsiteInfo(ioutil.Discard, bucketName, index, notFoundPage); err != nil {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 10 * time.Second, Max: 20 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
+		if err := setBucketWebsiteInfo(ctx, ioutil.Discard, client, bucketName, index, notFoundPage); err != nil {
 			r.Errorf("setBucketWebsiteInfo: %v", err)
 		}
 	})
@@ -483,7 +590,6 @@ func TestBucketWebsiteInfo(t *testing.T) {
 
 func TestSetBucketPublicIAM(t *testing.T) {
 	tc := testutil.SystemTest(t)
-	bucketName := tc.ProjectID + "-storage-buckets-tests"
 
 	ctx := context.Background()
 	testutil.CleanBucket(ctx, t, tc.ProjectID, bucketName)
@@ -494,7 +600,8 @@ func TestSetBucketPublicIAM(t *testing.T) {
 	}
 	defer client.Close()
 
-	if err := setBucketPublicIAM(ioutil.Discard, bucketName); err != nil {
+	testutil.IAMLimiter.Take(t)
+	if err := setBucketPublicIAM(ctx, ioutil.Discard, client, bucketName); err != nil {
 		t.Fatalf("setBucketPublicIAM: %v", err)
 	}
 	policy, err := client.Bucket(bucketName).IAM().V3().Policy(ctx)
@@ -512,10 +619,16 @@ func TestSetBucketPublicIAM(t *testing.T) {
 }
 
 func TestDelete(t *testing.T) {
-	tc := testutil.SystemTest(t)
-	bucketName := tc.ProjectID + "-storage-buckets-tests"
+	testutil.StorageEmulatorTest(t)
+	ctx := context.Background()
+	client, err := storage.NewClient(ctx)
+	if err != nil {
+		t.Fatalf("storage.NewClient: %v", err)
+	}
+	defer client.Close()
 
-	if err := deleteBucket(ioutil.Discard, bucketName); err != nil {
+	testutil.BucketLimiter.Take(t)
+	if err := deleteBucket(ctx, ioutil.Discard, client, bucketName); err != nil {
 		t.Fatalf("deleteBucket: %v", err)
 	}
 }
@@ -25,22 +25,16 @@ import (
 )
 
 // createBucket creates a new bucket in the project.
-func createBucket(w io.Writer, projectID, bucketName string) error {
+func createBucket(ctx context.Context, w io.Writer, client *storage.Client, projectID, bucketName string) error {
 	// projectID := "my-project-id"
 	// bucketName := "bucket-name"
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
-
-	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	bucket := client.Bucket(bucketName)
 	if err := bucket.Create(ctx, projectID, nil); err != nil {
-		return fmt.Errorf("Bucket(%q).Create: %v", bucketName, err)
+		return fmt.Errorf("Bucket(%q).Create: %w", bucketName, err)
 	}
 	fmt.Fprintf(w, "Bucket %v created\n", bucketName)
 	return nil
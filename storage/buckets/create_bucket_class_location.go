@@ -26,17 +26,11 @@ import (
 
 // createBucketClassLocation creates a new bucket in the project with Storage class and
 // location.
-func createBucketClassLocation(w io.Writer, projectID, bucketName string) error {
+func createBucketClassLocation(ctx context.Context, w io.Writer, client *storage.Client, projectID, bucketName string) error {
 	// projectID := "my-project-id"
 	// bucketName := "bucket-name"
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
-
-	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	storageClassAndLocation := &storage.BucketAttrs{
@@ -45,7 +39,7 @@ func createBucketClassLocation(w io.Writer, projectID, bucketName string) error
 	}
 	bucket := client.Bucket(bucketName)
 	if err := bucket.Create(ctx, projectID, storageClassAndLocation); err != nil {
-		return fmt.Errorf("Bucket(%q).Create: %v", bucketName, err)
+		return fmt.Errorf("Bucket(%q).Create: %w", bucketName, err)
 	}
 	fmt.Fprintf(w, "Created bucket %v in %v with storage class %v\n", bucketName, storageClassAndLocation.Location, storageClassAndLocation.StorageClass)
 	return nil
@@ -25,18 +25,12 @@ import (
 )
 
 // setBucketWebsiteInfo sets website configuration on a bucket.
-func setBucketWebsiteInfo(w io.Writer, bucketName, indexPage, notFoundPage string) error {
+func setBucketWebsiteInfo(ctx context.Context, w io.Writer, client *storage.Client, bucketName, indexPage, notFoundPage string) error {
 	// bucketName := "www.example.com"
 	// indexPage := "index.html"
 	// notFoundPage := "404.html"
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
-
-	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	bucket := client.Bucket(bucketName)
@@ -47,7 +41,7 @@ func setBucketWebsiteInfo(w io.Writer, bucketName, indexPage, notFoundPage strin
 		},
 	}
 	if _, err := bucket.Update(ctx, bucketAttrsToUpdate); err != nil {
-		return fmt.Errorf("Bucket(%q).Update: %v", bucketName, err)
+		return fmt.Errorf("Bucket(%q).Update: %w", bucketName, err)
 	}
 	fmt.Fprintf(w, "Static website bucket %v is set up to use %v as the index page and %v as the 404 page\n", bucketName, indexPage, notFoundPage)
 	return nil
@@ -25,21 +25,15 @@ import (
 )
 
 // deleteBucket deletes the bucket.
-func deleteBucket(w io.Writer, bucketName string) error {
+func deleteBucket(ctx context.Context, w io.Writer, client *storage.Client, bucketName string) error {
 	// bucketName := "bucket-name"
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
-
-	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	bucket := client.Bucket(bucketName)
 	if err := bucket.Delete(ctx); err != nil {
-		return fmt.Errorf("Bucket(%q).Delete: %v", bucketName, err)
+		return fmt.Errorf("Bucket(%q).Delete: %w", bucketName, err)
 	}
 	fmt.Fprintf(w, "Bucket %v deleted\n", bucketName)
 	return nil
@@ -26,16 +26,10 @@ import (
 
 // disableBucketLifecycleManagement removes all existing lifecycle rules
 // from the bucket.
-func disableBucketLifecycleManagement(w io.Writer, bucketName string) error {
+func disableBucketLifecycleManagement(ctx context.Context, w io.Writer, client *storage.Client, bucketName string) error {
 	// bucketName := "bucket-name"
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
-
-	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	bucket := client.Bucket(bucketName)
@@ -43,9 +37,8 @@ func disableBucketLifecycleManagement(w io.Writer, bucketName string) error {
 		Lifecycle: &storage.Lifecycle{},
 	}
 
-	_, err = bucket.Update(ctx, bucketAttrsToUpdate)
-	if err != nil {
-		return fmt.Errorf("Bucket(%q).Update: %v", bucketName, err)
+	if _, err := bucket.Update(ctx, bucketAttrsToUpdate); err != nil {
+		return fmt.Errorf("Bucket(%q).Update: %w", bucketName, err)
 	}
 	fmt.Fprintf(w, "Lifecycle management is disabled for bucket %v.\n", bucketName)
 
@@ -25,16 +25,10 @@ import (
 )
 
 // disableUniformBucketLevelAccess sets uniform bucket-level access to false.
-func disableUniformBucketLevelAccess(w io.Writer, bucketName string) error {
+func disableUniformBucketLevelAccess(ctx context.Context, w io.Writer, client *storage.Client, bucketName string) error {
 	// bucketName := "bucket-name"
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
-
-	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	bucket := client.Bucket(bucketName)
@@ -44,7 +38,7 @@ func disableUniformBucketLevelAccess(w io.Writer, bucketName string) error {
 		},
 	}
 	if _, err := bucket.Update(ctx, disableUniformBucketLevelAccess); err != nil {
-		return fmt.Errorf("Bucket(%q).Update: %v", bucketName, err)
+		return fmt.Errorf("Bucket(%q).Update: %w", bucketName, err)
 	}
 	fmt.Fprintf(w, "Uniform bucket-level access was disabled for %v\n", bucketName)
 	return nil
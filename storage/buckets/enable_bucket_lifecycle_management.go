@@ -26,16 +26,10 @@ import (
 
 // enableBucketLifecycleManagement adds a lifecycle delete rule with the
 // condition that the object is 100 days old.
-func enableBucketLifecycleManagement(w io.Writer, bucketName string) error {
+func enableBucketLifecycleManagement(ctx context.Context, w io.Writer, client *storage.Client, bucketName string) error {
 	// bucketName := "bucket-name"
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
-
-	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	bucket := client.Bucket(bucketName)
@@ -54,7 +48,7 @@ func enableBucketLifecycleManagement(w io.Writer, bucketName string) error {
 
 	attrs, err := bucket.Update(ctx, bucketAttrsToUpdate)
 	if err != nil {
-		return fmt.Errorf("Bucket(%q).Update: %v", bucketName, err)
+		return fmt.Errorf("Bucket(%q).Update: %w", bucketName, err)
 	}
 	fmt.Fprintf(w, "Lifecycle management is enabled for bucket %v\n and the rules are:\n", bucketName)
 	for _, rule := range attrs.Lifecycle.Rules {
@@ -25,16 +25,10 @@ import (
 )
 
 // enableDefaultEventBasedHold sets event-based hold to true.
-func enableDefaultEventBasedHold(w io.Writer, bucketName string) error {
+func enableDefaultEventBasedHold(ctx context.Context, w io.Writer, client *storage.Client, bucketName string) error {
 	// bucketName := "bucket-name"
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
-
-	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	bucket := client.Bucket(bucketName)
@@ -42,7 +36,7 @@ func enableDefaultEventBasedHold(w io.Writer, bucketName string) error {
 		DefaultEventBasedHold: true,
 	}
 	if _, err := bucket.Update(ctx, bucketAttrsToUpdate); err != nil {
-		return fmt.Errorf("Bucket(%q).Update: %v", bucketName, err)
+		return fmt.Errorf("Bucket(%q).Update: %w", bucketName, err)
 	}
 	fmt.Fprintf(w, "Default event-based hold was enabled for %v\n", bucketName)
 	return nil
@@ -25,16 +25,10 @@ import (
 )
 
 // enableUniformBucketLevelAccess sets uniform bucket-level access to true.
-func enableUniformBucketLevelAccess(w io.Writer, bucketName string) error {
+func enableUniformBucketLevelAccess(ctx context.Context, w io.Writer, client *storage.Client, bucketName string) error {
 	// bucketName := "bucket-name"
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
-
-	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	bucket := client.Bucket(bucketName)
@@ -44,7 +38,7 @@ func enableUniformBucketLevelAccess(w io.Writer, bucketName string) error {
 		},
 	}
 	if _, err := bucket.Update(ctx, enableUniformBucketLevelAccess); err != nil {
-		return fmt.Errorf("Bucket(%q).Update: %v", bucketName, err)
+		return fmt.Errorf("Bucket(%q).Update: %w", bucketName, err)
 	}
 	fmt.Fprintf(w, "Uniform bucket-level access was enabled for %v\n", bucketName)
 	return nil
@@ -0,0 +1,178 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package buckets
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"cloud.google.com/go/iam"
+	"cloud.google.com/go/storage"
+)
+
+// The functions below export the samples that storage/cmd/gcsutil and
+// cmd/samplectl are built from, so that the tools run the same code as the
+// documentation.
+
+// ListBuckets runs the storage_list_buckets sample.
+func ListBuckets(ctx context.Context, w io.Writer, client *storage.Client, projectID string) ([]string, error) {
+	return listBuckets(ctx, w, client, projectID)
+}
+
+// AddBucketIAMMember runs the storage_add_bucket_iam_member sample.
+func AddBucketIAMMember(ctx context.Context, w io.Writer, client *storage.Client, bucketName string) error {
+	return addBucketIAMMember(ctx, w, client, bucketName)
+}
+
+// AddBucketLabel runs the storage_add_bucket_label sample.
+func AddBucketLabel(ctx context.Context, w io.Writer, client *storage.Client, bucketName, labelName, labelValue string) error {
+	return addBucketLabel(ctx, w, client, bucketName, labelName, labelValue)
+}
+
+// CreateBucket runs the storage_create_bucket sample.
+func CreateBucket(ctx context.Context, w io.Writer, client *storage.Client, projectID, bucketName string) error {
+	return createBucket(ctx, w, client, projectID, bucketName)
+}
+
+// CreateBucketClassLocation runs the storage_create_bucket_class_location sample.
+func CreateBucketClassLocation(ctx context.Context, w io.Writer, client *storage.Client, projectID, bucketName string) error {
+	return createBucketClassLocation(ctx, w, client, projectID, bucketName)
+}
+
+// SetBucketWebsiteInfo runs the storage_define_bucket_website_configuration sample.
+func SetBucketWebsiteInfo(ctx context.Context, w io.Writer, client *storage.Client, bucketName, indexPage, notFoundPage string) error {
+	return setBucketWebsiteInfo(ctx, w, client, bucketName, indexPage, notFoundPage)
+}
+
+// DeleteBucket runs the storage_delete_bucket sample.
+func DeleteBucket(ctx context.Context, w io.Writer, client *storage.Client, bucketName string) error {
+	return deleteBucket(ctx, w, client, bucketName)
+}
+
+// DisableBucketLifecycleManagement runs the storage_disable_bucket_lifecycle_management sample.
+func DisableBucketLifecycleManagement(ctx context.Context, w io.Writer, client *storage.Client, bucketName string) error {
+	return disableBucketLifecycleManagement(ctx, w, client, bucketName)
+}
+
+// DisableDefaultEventBasedHold runs the storage_disable_default_event_based_hold sample.
+func DisableDefaultEventBasedHold(ctx context.Context, w io.Writer, client *storage.Client, bucketName string) error {
+	return disableDefaultEventBasedHold(ctx, w, client, bucketName)
+}
+
+// DisableRequesterPays runs the storage_disable_requester_pays sample.
+func DisableRequesterPays(ctx context.Context, w io.Writer, client *storage.Client, bucketName string) error {
+	return disableRequesterPays(ctx, w, client, bucketName)
+}
+
+// DisableUniformBucketLevelAccess runs the storage_disable_uniform_bucket_level_access sample.
+func DisableUniformBucketLevelAccess(ctx context.Context, w io.Writer, client *storage.Client, bucketName string) error {
+	return disableUniformBucketLevelAccess(ctx, w, client, bucketName)
+}
+
+// EnableBucketLifecycleManagement runs the storage_enable_bucket_lifecycle_management sample.
+func EnableBucketLifecycleManagement(ctx context.Context, w io.Writer, client *storage.Client, bucketName string) error {
+	return enableBucketLifecycleManagement(ctx, w, client, bucketName)
+}
+
+// EnableDefaultEventBasedHold runs the storage_enable_default_event_based_hold sample.
+func EnableDefaultEventBasedHold(ctx context.Context, w io.Writer, client *storage.Client, bucketName string) error {
+	return enableDefaultEventBasedHold(ctx, w, client, bucketName)
+}
+
+// EnableRequesterPays runs the storage_enable_requester_pays sample.
+func EnableRequesterPays(ctx context.Context, w io.Writer, client *storage.Client, bucketName string) error {
+	return enableRequesterPays(ctx, w, client, bucketName)
+}
+
+// EnableUniformBucketLevelAccess runs the storage_enable_uniform_bucket_level_access sample.
+func EnableUniformBucketLevelAccess(ctx context.Context, w io.Writer, client *storage.Client, bucketName string) error {
+	return enableUniformBucketLevelAccess(ctx, w, client, bucketName)
+}
+
+// GetBucketMetadata runs the storage_get_bucket_metadata sample.
+func GetBucketMetadata(ctx context.Context, w io.Writer, client *storage.Client, bucketName string) (*storage.BucketAttrs, error) {
+	return getBucketMetadata(ctx, w, client, bucketName)
+}
+
+// GetBucketPolicy runs the storage_view_bucket_iam_members sample.
+func GetBucketPolicy(ctx context.Context, w io.Writer, client *storage.Client, bucketName string) (*iam.Policy3, error) {
+	return getBucketPolicy(ctx, w, client, bucketName)
+}
+
+// GetDefaultEventBasedHold runs the storage_get_default_event_based_hold sample.
+func GetDefaultEventBasedHold(ctx context.Context, w io.Writer, client *storage.Client, bucketName string) (*storage.BucketAttrs, error) {
+	return getDefaultEventBasedHold(ctx, w, client, bucketName)
+}
+
+// GetRequesterPaysStatus runs the storage_get_requester_pays_status sample.
+func GetRequesterPaysStatus(ctx context.Context, w io.Writer, client *storage.Client, bucketName string) error {
+	return getRequesterPaysStatus(ctx, w, client, bucketName)
+}
+
+// GetRetentionPolicy runs the storage_get_retention_policy sample.
+func GetRetentionPolicy(ctx context.Context, w io.Writer, client *storage.Client, bucketName string) (*storage.BucketAttrs, error) {
+	return getRetentionPolicy(ctx, w, client, bucketName)
+}
+
+// GetUniformBucketLevelAccess runs the storage_get_uniform_bucket_level_access sample.
+func GetUniformBucketLevelAccess(ctx context.Context, w io.Writer, client *storage.Client, bucketName string) (*storage.BucketAttrs, error) {
+	return getUniformBucketLevelAccess(ctx, w, client, bucketName)
+}
+
+// LockRetentionPolicy runs the storage_lock_retention_policy sample.
+func LockRetentionPolicy(ctx context.Context, w io.Writer, client *storage.Client, bucketName string) error {
+	return lockRetentionPolicy(ctx, w, client, bucketName)
+}
+
+// RemoveBucketCORSConfiguration runs the storage_remove_cors_configuration sample.
+func RemoveBucketCORSConfiguration(ctx context.Context, w io.Writer, client *storage.Client, bucketName string) error {
+	return removeBucketCORSConfiguration(ctx, w, client, bucketName)
+}
+
+// RemoveBucketDefaultKMSKey runs the storage_bucket_delete_default_kms_key sample.
+func RemoveBucketDefaultKMSKey(ctx context.Context, w io.Writer, client *storage.Client, bucketName string) error {
+	return removeBucketDefaultKMSKey(ctx, w, client, bucketName)
+}
+
+// RemoveBucketIAMMember runs the storage_remove_bucket_iam_member sample.
+func RemoveBucketIAMMember(ctx context.Context, w io.Writer, client *storage.Client, bucketName string) error {
+	return removeBucketIAMMember(ctx, w, client, bucketName)
+}
+
+// RemoveBucketLabel runs the storage_remove_bucket_label sample.
+func RemoveBucketLabel(ctx context.Context, w io.Writer, client *storage.Client, bucketName, labelName string) error {
+	return removeBucketLabel(ctx, w, client, bucketName, labelName)
+}
+
+// RemoveRetentionPolicy runs the storage_remove_retention_policy sample.
+func RemoveRetentionPolicy(ctx context.Context, w io.Writer, client *storage.Client, bucketName string) error {
+	return removeRetentionPolicy(ctx, w, client, bucketName)
+}
+
+// SetBucketDefaultKMSKey runs the storage_set_bucket_default_kms_key sample.
+func SetBucketDefaultKMSKey(ctx context.Context, w io.Writer, client *storage.Client, bucketName, keyName string) error {
+	return setBucketDefaultKMSKey(ctx, w, client, bucketName, keyName)
+}
+
+// SetBucketPublicIAM runs the storage_set_bucket_public_iam sample.
+func SetBucketPublicIAM(ctx context.Context, w io.Writer, client *storage.Client, bucketName string) error {
+	return setBucketPublicIAM(ctx, w, client, bucketName)
+}
+
+// SetRetentionPolicy runs the storage_set_retention_policy sample.
+func SetRetentionPolicy(ctx context.Context, w io.Writer, client *storage.Client, bucketName string, retentionPeriod time.Duration) error {
+	return setRetentionPolicy(ctx, w, client, bucketName, retentionPeriod)
+}
@@ -26,21 +26,15 @@ import (
 )
 
 // getBucketPolicy gets the bucket IAM policy.
-func getBucketPolicy(w io.Writer, bucketName string) (*iam.Policy3, error) {
+func getBucketPolicy(ctx context.Context, w io.Writer, client *storage.Client, bucketName string) (*iam.Policy3, error) {
 	// bucketName := "bucket-name"
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
-
-	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	policy, err := client.Bucket(bucketName).IAM().V3().Policy(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("Bucket(%q).IAM().V3().Policy: %v", bucketName, err)
+		return nil, fmt.Errorf("Bucket(%q).IAM().V3().Policy: %w", bucketName, err)
 	}
 	for _, binding := range policy.Bindings {
 		fmt.Fprintf(w, "%q: %q (condition: %v)\n", binding.Role, binding.Members, binding.Condition)
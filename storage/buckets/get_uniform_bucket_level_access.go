@@ -25,21 +25,15 @@ import (
 )
 
 // getUniformBucketLevelAccess gets uniform bucket-level access.
-func getUniformBucketLevelAccess(w io.Writer, bucketName string) (*storage.BucketAttrs, error) {
+func getUniformBucketLevelAccess(ctx context.Context, w io.Writer, client *storage.Client, bucketName string) (*storage.BucketAttrs, error) {
 	// bucketName := "bucket-name"
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
-
-	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	attrs, err := client.Bucket(bucketName).Attrs(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("Bucket(%q).Attrs: %v", bucketName, err)
+		return nil, fmt.Errorf("Bucket(%q).Attrs: %w", bucketName, err)
 	}
 	uniformBucketLevelAccess := attrs.UniformBucketLevelAccess
 	if uniformBucketLevelAccess.Enabled {
@@ -26,16 +26,10 @@ import (
 )
 
 // listBuckets lists buckets in the project.
-func listBuckets(w io.Writer, projectID string) ([]string, error) {
+func listBuckets(ctx context.Context, w io.Writer, client *storage.Client, projectID string) ([]string, error) {
 	// projectID := "my-project-id"
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
-
-	ctx, cancel := context.WithTimeout(ctx, time.Second*30)
+	const timeout = 30 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	var buckets []string
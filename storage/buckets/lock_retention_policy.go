@@ -25,34 +25,28 @@ import (
 )
 
 // lockRetentionPolicy locks bucket retention policy.
-func lockRetentionPolicy(w io.Writer, bucketName string) error {
+func lockRetentionPolicy(ctx context.Context, w io.Writer, client *storage.Client, bucketName string) error {
 	// bucketName := "bucket-name"
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
-
-	ctx, cancel := context.WithTimeout(ctx, time.Second*50)
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	bucket := client.Bucket(bucketName)
 	attrs, err := bucket.Attrs(ctx)
 	if err != nil {
-		return fmt.Errorf("Bucket(%q).Attrs: %v", bucketName, err)
+		return fmt.Errorf("Bucket(%q).Attrs: %w", bucketName, err)
 	}
 
 	conditions := storage.BucketConditions{
 		MetagenerationMatch: attrs.MetaGeneration,
 	}
 	if err := bucket.If(conditions).LockRetentionPolicy(ctx); err != nil {
-		return fmt.Errorf("Bucket(%q).LockRetentionPolicy: %v", bucketName, err)
+		return fmt.Errorf("Bucket(%q).LockRetentionPolicy: %w", bucketName, err)
 	}
 
 	lockedAttrs, err := bucket.Attrs(ctx)
 	if err != nil {
-		return fmt.Errorf("Bucket(%q).Attrs: lockedAttrs: %v", bucketName, err)
+		return fmt.Errorf("Bucket(%q).Attrs: lockedAttrs: %w", bucketName, err)
 	}
 
 	fmt.Fprintf(w, "Retention policy for %v is now locked\n", bucketName)
@@ -25,26 +25,20 @@ import (
 )
 
 // removeBucketConditionalIAMBinding removes bucket conditional IAM binding.
-func removeBucketConditionalIAMBinding(w io.Writer, bucketName, role, title, description, expression string) error {
+func removeBucketConditionalIAMBinding(ctx context.Context, w io.Writer, client *storage.Client, bucketName, role, title, description, expression string) error {
 	// bucketName := "bucket-name"
 	// role := "bucket-level IAM role"
 	// title := "condition title"
 	// description := "condition description"
 	// expression := "condition expression"
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
-
-	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	bucket := client.Bucket(bucketName)
 	policy, err := bucket.IAM().V3().Policy(ctx)
 	if err != nil {
-		return fmt.Errorf("Bucket(%q).IAM().V3().Policy: %v", bucketName, err)
+		return fmt.Errorf("Bucket(%q).IAM().V3().Policy: %w", bucketName, err)
 	}
 
 	// Find the index of the binding matching inputs.
@@ -68,7 +62,7 @@ func removeBucketConditionalIAMBinding(w io.Writer, bucketName, role, title, des
 	policy.Bindings = append(policy.Bindings[:i], policy.Bindings[i+1:]...)
 
 	if err := bucket.IAM().V3().SetPolicy(ctx, policy); err != nil {
-		return fmt.Errorf("Bucket(%q).IAM().V3().SetPolicy: %v", bucketName, err)
+		return fmt.Errorf("Bucket(%q).IAM().V3().SetPolicy: %w", bucketName, err)
 	}
 	// NOTE: It may be necessary to retry this operation if IAM policies are
 	// being modified concurrently. SetPolicy will return an error if the policy
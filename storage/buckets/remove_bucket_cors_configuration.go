@@ -25,16 +25,10 @@ import (
 )
 
 // removeBucketCORSConfiguration removes the CORS configuration from a bucket.
-func removeBucketCORSConfiguration(w io.Writer, bucketName string) error {
+func removeBucketCORSConfiguration(ctx context.Context, w io.Writer, client *storage.Client, bucketName string) error {
 	// bucketName := "bucket-name"
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
-
-	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	bucket := client.Bucket(bucketName)
@@ -42,7 +36,7 @@ func removeBucketCORSConfiguration(w io.Writer, bucketName string) error {
 		CORS: []storage.CORS{},
 	}
 	if _, err := bucket.Update(ctx, bucketAttrsToUpdate); err != nil {
-		return fmt.Errorf("Bucket(%q).Update: %v", bucketName, err)
+		return fmt.Errorf("Bucket(%q).Update: %w", bucketName, err)
 	}
 	fmt.Fprintf(w, "Removed CORS configuration from a bucket %v\n", bucketName)
 	return nil
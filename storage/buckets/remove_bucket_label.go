@@ -25,24 +25,18 @@ import (
 )
 
 // removeBucketLabel removes a label on a bucket.
-func removeBucketLabel(w io.Writer, bucketName, labelName string) error {
+func removeBucketLabel(ctx context.Context, w io.Writer, client *storage.Client, bucketName, labelName string) error {
 	// bucketName := "bucket-name"
 	// labelName := "label-name"
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
-
-	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	bucket := client.Bucket(bucketName)
 	bucketAttrsToUpdate := storage.BucketAttrsToUpdate{}
 	bucketAttrsToUpdate.DeleteLabel(labelName)
 	if _, err := bucket.Update(ctx, bucketAttrsToUpdate); err != nil {
-		return fmt.Errorf("Bucket(%q).Update: %v", bucketName, err)
+		return fmt.Errorf("Bucket(%q).Update: %w", bucketName, err)
 	}
 	fmt.Fprintf(w, "Removed label %q from bucket %v\n", labelName, bucketName)
 	return nil
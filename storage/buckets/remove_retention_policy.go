@@ -25,22 +25,16 @@ import (
 )
 
 // removeRetentionPolicy removes bucket retention policy.
-func removeRetentionPolicy(w io.Writer, bucketName string) error {
+func removeRetentionPolicy(ctx context.Context, w io.Writer, client *storage.Client, bucketName string) error {
 	// bucketName := "bucket-name"
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
-
-	ctx, cancel := context.WithTimeout(ctx, time.Second*50)
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	bucket := client.Bucket(bucketName)
 	attrs, err := bucket.Attrs(ctx)
 	if err != nil {
-		return fmt.Errorf("Bucket(%q).Attrs: %v", bucketName, err)
+		return fmt.Errorf("Bucket(%q).Attrs: %w", bucketName, err)
 	}
 	if attrs.RetentionPolicy.IsLocked {
 		return fmt.Errorf("retention policy is locked")
@@ -50,7 +44,7 @@ func removeRetentionPolicy(w io.Writer, bucketName string) error {
 		RetentionPolicy: &storage.RetentionPolicy{},
 	}
 	if _, err := bucket.Update(ctx, bucketAttrsToUpdate); err != nil {
-		return fmt.Errorf("Bucket(%q).Update: %v", bucketName, err)
+		return fmt.Errorf("Bucket(%q).Update: %w", bucketName, err)
 	}
 	fmt.Fprintf(w, "Retention period for %v has been removed\n", bucketName)
 	return nil
@@ -25,20 +25,14 @@ import (
 )
 
 // setBucketCORSConfiguration sets a CORS configuration on a bucket.
-func setBucketCORSConfiguration(w io.Writer, bucketName string, maxAge time.Duration, methods, origins, responseHeaders []string) error {
+func setBucketCORSConfiguration(ctx context.Context, w io.Writer, client *storage.Client, bucketName string, maxAge time.Duration, methods, origins, responseHeaders []string) error {
 	// bucketName := "bucket-name"
 	// maxAge := time.Hour
 	// methods := []string{"GET"}
 	// origins := []string{"some-origin.com"}
 	// responseHeaders := []string{"Content-Type"}
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
-
-	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	bucket := client.Bucket(bucketName)
@@ -52,7 +46,7 @@ func setBucketCORSConfiguration(w io.Writer, bucketName string, maxAge time.Dura
 			}},
 	}
 	if _, err := bucket.Update(ctx, bucketAttrsToUpdate); err != nil {
-		return fmt.Errorf("Bucket(%q).Update: %v", bucketName, err)
+		return fmt.Errorf("Bucket(%q).Update: %w", bucketName, err)
 	}
 	fmt.Fprintf(w, "Bucket %v was updated with a CORS config to allow %v requests from %v sharing %v responses across origins\n", bucketName, methods, origins, responseHeaders)
 	return nil
@@ -25,17 +25,11 @@ import (
 )
 
 // setBucketDefaultKMSKey sets the Cloud KMS encryption key for the bucket.
-func setBucketDefaultKMSKey(w io.Writer, bucketName, keyName string) error {
+func setBucketDefaultKMSKey(ctx context.Context, w io.Writer, client *storage.Client, bucketName, keyName string) error {
 	// bucketName := "bucket-name"
 	// keyName := "key"
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
-
-	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	bucket := client.Bucket(bucketName)
@@ -43,7 +37,7 @@ func setBucketDefaultKMSKey(w io.Writer, bucketName, keyName string) error {
 		Encryption: &storage.BucketEncryption{DefaultKMSKeyName: keyName},
 	}
 	if _, err := bucket.Update(ctx, bucketAttrsToUpdate); err != nil {
-		return fmt.Errorf("Bucket(%q).Update: %v", bucketName, err)
+		return fmt.Errorf("Bucket(%q).Update: %w", bucketName, err)
 	}
 	fmt.Fprintf(w, "Default KMS Key Name: %v", bucketAttrsToUpdate.Encryption.DefaultKMSKeyName)
 	return nil
@@ -19,6 +19,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"time"
 
 	"cloud.google.com/go/iam"
 	"cloud.google.com/go/storage"
@@ -26,18 +27,15 @@ import (
 )
 
 // setBucketPublicIAM makes all objects in a bucket publicly readable.
-func setBucketPublicIAM(w io.Writer, bucketName string) error {
+func setBucketPublicIAM(ctx context.Context, w io.Writer, client *storage.Client, bucketName string) error {
 	// bucketName := "bucket-name"
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
 
 	policy, err := client.Bucket(bucketName).IAM().V3().Policy(ctx)
 	if err != nil {
-		return fmt.Errorf("Bucket(%q).IAM().V3().Policy: %v", bucketName, err)
+		return fmt.Errorf("Bucket(%q).IAM().V3().Policy: %w", bucketName, err)
 	}
 	role := "roles/storage.objectViewer"
 	policy.Bindings = append(policy.Bindings, &iampb.Binding{
@@ -45,7 +43,7 @@ func setBucketPublicIAM(w io.Writer, bucketName string) error {
 		Members: []string{iam.AllUsers},
 	})
 	if err := client.Bucket(bucketName).IAM().V3().SetPolicy(ctx, policy); err != nil {
-		return fmt.Errorf("Bucket(%q).IAM().SetPolicy: %v", bucketName, err)
+		return fmt.Errorf("Bucket(%q).IAM().SetPolicy: %w", bucketName, err)
 	}
 	fmt.Fprintf(w, "Bucket %v is now publicly readable\n", bucketName)
 	return nil
@@ -25,17 +25,11 @@ import (
 )
 
 // setRetentionPolicy sets the bucket retention period.
-func setRetentionPolicy(w io.Writer, bucketName string, retentionPeriod time.Duration) error {
+func setRetentionPolicy(ctx context.Context, w io.Writer, client *storage.Client, bucketName string, retentionPeriod time.Duration) error {
 	// bucketName := "bucket-name"
 	// retentionPeriod := time.Second
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
-
-	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	bucket := client.Bucket(bucketName)
@@ -45,7 +39,7 @@ func setRetentionPolicy(w io.Writer, bucketName string, retentionPeriod time.Dur
 		},
 	}
 	if _, err := bucket.Update(ctx, bucketAttrsToUpdate); err != nil {
-		return fmt.Errorf("Bucket(%q).Update: %v", bucketName, err)
+		return fmt.Errorf("Bucket(%q).Update: %w", bucketName, err)
 	}
 	fmt.Fprintf(w, "Retention policy for %v was set to %v\n", bucketName, bucketAttrsToUpdate.RetentionPolicy.RetentionPeriod)
 	return nil
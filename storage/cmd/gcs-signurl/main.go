@@ -0,0 +1,163 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command gcs-signurl prints V4 signed URLs for Cloud Storage objects.
+//
+// Usage:
+//
+//	gcs-signurl get|put|post [flags] BUCKET OBJECT
+//
+// Flags may also follow BUCKET and OBJECT:
+//
+//	gcs-signurl put my-bucket photos/cat.jpg -expires 1h -header "Content-Type: image/jpeg"
+//
+// URLs are signed as the service account of Application Default Credentials:
+// with its key when the credentials are a service account key file, or
+// through the IAM Credentials API for the metadata server's service account
+// on Compute Engine, Cloud Run or GKE. Use -impersonate to sign as another
+// service account. Signing through the IAM Credentials API needs the
+// roles/iam.serviceAccountTokenCreator role on the signing account.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/GoogleCloudPlatform/golang-samples/storage/internal/urlsigner"
+)
+
+const usage = `usage: gcs-signurl get|put|post [flags] BUCKET OBJECT
+
+Flags:
+`
+
+// headerFlags collects repeated -header flags.
+type headerFlags []string
+
+func (h *headerFlags) String() string { return strings.Join(*h, ", ") }
+
+func (h *headerFlags) Set(v string) error {
+	if !strings.Contains(v, ":") {
+		return fmt.Errorf("header %q is not in the form \"Name: value\"", v)
+	}
+	*h = append(*h, v)
+	return nil
+}
+
+// request is a parsed command line.
+type request struct {
+	method      string
+	bucket      string
+	object      string
+	expires     time.Duration
+	headers     headerFlags
+	impersonate string
+}
+
+func main() {
+	if err := run(context.Background(), os.Stdout, os.Stderr, os.Args[1:]); err != nil {
+		fmt.Fprintf(os.Stderr, "gcs-signurl: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, stdout, stderr io.Writer, args []string) error {
+	req, err := parseArgs(stderr, args)
+	if err != nil {
+		return err
+	}
+
+	s, err := urlsigner.New(ctx, req.impersonate)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	expires := time.Now().Add(req.expires)
+	switch req.method {
+	case "GET", "PUT":
+		u, err := s.SignedURL(req.method, req.bucket, req.object, expires, req.headers)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(stdout, u)
+	case "POST":
+		policy, err := s.PostPolicy(req.bucket, req.object, expires, req.headers)
+		if err != nil {
+			return err
+		}
+		enc := json.NewEncoder(stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(struct {
+			URL    string            `json:"url"`
+			Fields map[string]string `json:"fields"`
+		}{policy.URL, policy.Fields})
+	}
+	return nil
+}
+
+func parseArgs(stderr io.Writer, args []string) (*request, error) {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, usage)
+		return nil, errors.New("missing subcommand")
+	}
+	req := &request{method: strings.ToUpper(args[0])}
+	switch req.method {
+	case "GET", "PUT", "POST":
+	default:
+		return nil, fmt.Errorf("unknown subcommand %q; want get, put, or post", args[0])
+	}
+
+	fs := flag.NewFlagSet("gcs-signurl "+args[0], flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprint(stderr, usage)
+		fs.PrintDefaults()
+	}
+	fs.DurationVar(&req.expires, "expires", 15*time.Minute, "how long the URL stays valid; at most 168h")
+	fs.Var(&req.headers, "header", "header the request must send, as \"Name: value\"; may be repeated")
+	fs.StringVar(&req.impersonate, "impersonate", "", "email of a service account to sign as")
+
+	// The flag package stops at the first positional argument, so parse
+	// repeatedly to allow flags after BUCKET and OBJECT.
+	var positional []string
+	rest := args[1:]
+	for {
+		if err := fs.Parse(rest); err != nil {
+			return nil, err
+		}
+		if fs.NArg() == 0 {
+			break
+		}
+		positional = append(positional, fs.Arg(0))
+		rest = fs.Args()[1:]
+	}
+	if len(positional) != 2 {
+		fs.Usage()
+		return nil, fmt.Errorf("want BUCKET and OBJECT, got %d arguments", len(positional))
+	}
+	req.bucket, req.object = positional[0], positional[1]
+
+	if req.expires <= 0 || req.expires > 7*24*time.Hour {
+		return nil, fmt.Errorf("-expires must be between 0 and 168h, got %v", req.expires)
+	}
+	return req, nil
+}
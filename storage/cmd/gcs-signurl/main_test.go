@@ -0,0 +1,67 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"io/ioutil"
+	"testing"
+	"time"
+)
+
+func TestParseArgs(t *testing.T) {
+	tests := []struct {
+		args    []string
+		want    request
+		wantErr bool
+	}{
+		{
+			args: []string{"get", "my-bucket", "foo.txt"},
+			want: request{method: "GET", bucket: "my-bucket", object: "foo.txt", expires: 15 * time.Minute},
+		},
+		{
+			args: []string{"put", "my-bucket", "foo.txt", "-expires", "1h", "-header", "Content-Type: text/plain"},
+			want: request{method: "PUT", bucket: "my-bucket", object: "foo.txt", expires: time.Hour,
+				headers: headerFlags{"Content-Type: text/plain"}},
+		},
+		{
+			args: []string{"post", "-impersonate", "sa@my-project.iam.gserviceaccount.com", "my-bucket", "-expires", "5m", "foo.txt"},
+			want: request{method: "POST", bucket: "my-bucket", object: "foo.txt", expires: 5 * time.Minute,
+				impersonate: "sa@my-project.iam.gserviceaccount.com"},
+		},
+		{args: nil, wantErr: true},
+		{args: []string{"delete", "my-bucket", "foo.txt"}, wantErr: true},
+		{args: []string{"get", "my-bucket"}, wantErr: true},
+		{args: []string{"get", "my-bucket", "foo.txt", "-expires", "200h"}, wantErr: true},
+		{args: []string{"get", "my-bucket", "foo.txt", "-header", "no-colon"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := parseArgs(ioutil.Discard, tt.args)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("parseArgs(%q) got nil error, want non-nil", tt.args)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parseArgs(%q): %v", tt.args, err)
+			continue
+		}
+		if got.method != tt.want.method || got.bucket != tt.want.bucket || got.object != tt.want.object ||
+			got.expires != tt.want.expires || got.impersonate != tt.want.impersonate ||
+			got.headers.String() != tt.want.headers.String() {
+			t.Errorf("parseArgs(%q) got %+v, want %+v", tt.args, *got, tt.want)
+		}
+	}
+}
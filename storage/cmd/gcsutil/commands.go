@@ -0,0 +1,317 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/GoogleCloudPlatform/golang-samples/storage/buckets"
+	"github.com/GoogleCloudPlatform/golang-samples/storage/internal/urlsigner"
+	"github.com/GoogleCloudPlatform/golang-samples/storage/objects"
+	"google.golang.org/api/iterator"
+)
+
+// ls lists buckets, or the objects under a bucket prefix.
+func ls(ctx context.Context, w io.Writer, client *storage.Client, args []string) error {
+	if len(args) > 1 {
+		return errors.New("want at most one gs://BUCKET[/PREFIX]")
+	}
+	if len(args) == 0 {
+		projectID := os.Getenv("GOOGLE_CLOUD_PROJECT")
+		if projectID == "" {
+			return errors.New("GOOGLE_CLOUD_PROJECT must be set to list buckets")
+		}
+		_, err := buckets.ListBuckets(ctx, w, client, projectID)
+		return err
+	}
+
+	l, err := parseLocation(args[0])
+	if err != nil {
+		return err
+	}
+	if !l.remote() {
+		return fmt.Errorf("%q is not a gs:// URL", args[0])
+	}
+	return objects.ListFilesWithPrefix(ctx, w, client, l.bucket, l.object, "")
+}
+
+// cp copies between local files and objects in any direction.
+func cp(ctx context.Context, w io.Writer, client *storage.Client, args []string) error {
+	if len(args) != 2 {
+		return errors.New("want SRC and DST")
+	}
+	src, err := parseLocation(args[0])
+	if err != nil {
+		return err
+	}
+	dst, err := parseLocation(args[1])
+	if err != nil {
+		return err
+	}
+	// Copying into a "directory" keeps the source file name.
+	if dst.remote() && (dst.object == "" || strings.HasSuffix(dst.object, "/")) {
+		dst.object += path.Base(src.String())
+	}
+	if !dst.remote() {
+		if fi, err := os.Stat(dst.path); err == nil && fi.IsDir() {
+			dst.path = filepath.Join(dst.path, path.Base(src.String()))
+		}
+	}
+	return copyLocation(ctx, w, client, src, dst)
+}
+
+// copyLocation copies src to dst with the storage_copy_file,
+// storage_download_file or storage_upload_file sample.
+func copyLocation(ctx context.Context, w io.Writer, client *storage.Client, src, dst location) error {
+	switch {
+	case src.remote() && dst.remote():
+		// Server-side copy; the data does not pass through this machine.
+		return objects.CopyFile(ctx, w, client, dst.bucket, src.bucket, src.object, dst.object)
+	case src.remote():
+		data, err := objects.DownloadFile(ctx, w, client, src.bucket, src.object)
+		if err != nil {
+			return err
+		}
+		if err := os.MkdirAll(filepath.Dir(dst.path), 0755); err != nil {
+			return err
+		}
+		return ioutil.WriteFile(dst.path, data, 0644)
+	case dst.remote():
+		return objects.UploadFile(ctx, w, client, dst.bucket, dst.object, src.path)
+	default:
+		return errors.New("at least one of SRC and DST must be a gs:// URL")
+	}
+}
+
+// rm deletes objects.
+func rm(ctx context.Context, w io.Writer, client *storage.Client, args []string) error {
+	if len(args) == 0 {
+		return errors.New("want at least one gs://BUCKET/OBJECT")
+	}
+	for _, arg := range args {
+		l, err := parseObject(arg)
+		if err != nil {
+			return err
+		}
+		if err := objects.DeleteFile(ctx, w, client, l.bucket, l.object); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// stat prints the metadata of an object.
+func stat(ctx context.Context, w io.Writer, client *storage.Client, args []string) error {
+	if len(args) != 1 {
+		return errors.New("want exactly one gs://BUCKET/OBJECT")
+	}
+	l, err := parseObject(args[0])
+	if err != nil {
+		return err
+	}
+	_, err = objects.GetMetadata(ctx, w, client, l.bucket, l.object)
+	return err
+}
+
+// signurl prints a V4 signed URL for an object.
+func signurl(ctx context.Context, w io.Writer, _ *storage.Client, args []string) error {
+	fs := flag.NewFlagSet("signurl", flag.ContinueOnError)
+	method := fs.String("method", "GET", "HTTP method the URL is valid for")
+	expires := fs.Duration("expires", 15*time.Minute, "how long the URL stays valid")
+	impersonate := fs.String("impersonate", "", "email of a service account to sign as")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return errors.New("want exactly one gs://BUCKET/OBJECT")
+	}
+	l, err := parseObject(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	s, err := urlsigner.New(ctx, *impersonate)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+	u, err := s.SignedURL(strings.ToUpper(*method), l.bucket, l.object, time.Now().Add(*expires), nil)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(w, u)
+	return nil
+}
+
+// rsync makes DST_DIR contain the same files as SRC_DIR. Files are compared
+// by size and CRC32C checksum.
+func rsync(ctx context.Context, w io.Writer, client *storage.Client, args []string) error {
+	fs := flag.NewFlagSet("rsync", flag.ContinueOnError)
+	deleteExtra := fs.Bool("delete", false, "delete files in DST_DIR that are not in SRC_DIR")
+	dryRun := fs.Bool("n", false, "print what would be done without doing it")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 2 {
+		return errors.New("want SRC_DIR and DST_DIR")
+	}
+	src, err := parseLocation(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	dst, err := parseLocation(fs.Arg(1))
+	if err != nil {
+		return err
+	}
+	if src.remote() == dst.remote() {
+		return errors.New("exactly one of SRC_DIR and DST_DIR must be a gs:// URL")
+	}
+	src, dst = asDir(src), asDir(dst)
+
+	srcFiles, err := listFiles(ctx, client, src)
+	if err != nil {
+		return err
+	}
+	dstFiles, err := listFiles(ctx, client, dst)
+	if err != nil {
+		return err
+	}
+
+	copies, deletes := planSync(srcFiles, dstFiles, *deleteExtra)
+	for _, name := range copies {
+		from, to := child(src, name), child(dst, name)
+		fmt.Fprintf(w, "Copying %v to %v\n", from, to)
+		if *dryRun {
+			continue
+		}
+		if err := copyLocation(ctx, ioutil.Discard, client, from, to); err != nil {
+			return err
+		}
+	}
+	for _, name := range deletes {
+		target := child(dst, name)
+		fmt.Fprintf(w, "Removing %v\n", target)
+		if *dryRun {
+			continue
+		}
+		if target.remote() {
+			err = objects.DeleteFile(ctx, ioutil.Discard, client, target.bucket, target.object)
+		} else {
+			err = os.Remove(target.path)
+		}
+		if err != nil {
+			return fmt.Errorf("remove %v: %w", target, err)
+		}
+	}
+	return nil
+}
+
+// asDir makes sure a remote location is a prefix ending in "/".
+func asDir(l location) location {
+	if l.remote() && l.object != "" && !strings.HasSuffix(l.object, "/") {
+		l.object += "/"
+	}
+	return l
+}
+
+// child returns the location of name relative to the directory dir.
+func child(dir location, name string) location {
+	if dir.remote() {
+		dir.object += name
+		return dir
+	}
+	dir.path = filepath.Join(dir.path, filepath.FromSlash(name))
+	return dir
+}
+
+// fileInfo is what rsync compares to decide whether a file changed.
+type fileInfo struct {
+	size   int64
+	crc32c uint32
+}
+
+// listFiles returns the files under a directory, keyed by their slash
+// separated path relative to it.
+func listFiles(ctx context.Context, client *storage.Client, dir location) (map[string]fileInfo, error) {
+	files := map[string]fileInfo{}
+	if dir.remote() {
+		it := client.Bucket(dir.bucket).Objects(ctx, &storage.Query{Prefix: dir.object})
+		for {
+			attrs, err := it.Next()
+			if err == iterator.Done {
+				return files, nil
+			}
+			if err != nil {
+				return nil, fmt.Errorf("Bucket(%q).Objects: %w", dir.bucket, err)
+			}
+			files[strings.TrimPrefix(attrs.Name, dir.object)] = fileInfo{size: attrs.Size, crc32c: attrs.CRC32C}
+		}
+	}
+
+	err := filepath.Walk(dir.path, func(p string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if fi.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(dir.path, p)
+		if err != nil {
+			return err
+		}
+		data, err := ioutil.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(rel)] = fileInfo{size: fi.Size(), crc32c: checksum(data)}
+		return nil
+	})
+	if os.IsNotExist(err) {
+		return files, nil
+	}
+	return files, err
+}
+
+// planSync returns the names to copy from src to dst, and the names to delete
+// from dst, sorted.
+func planSync(src, dst map[string]fileInfo, deleteExtra bool) (copies, deletes []string) {
+	for name, s := range src {
+		if d, ok := dst[name]; !ok || d != s {
+			copies = append(copies, name)
+		}
+	}
+	if deleteExtra {
+		for name := range dst {
+			if _, ok := src[name]; !ok {
+				deletes = append(deletes, name)
+			}
+		}
+	}
+	sort.Strings(copies)
+	sort.Strings(deletes)
+	return copies, deletes
+}
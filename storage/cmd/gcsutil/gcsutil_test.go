@@ -0,0 +1,140 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"cloud.google.com/go/storage"
+	"github.com/GoogleCloudPlatform/golang-samples/internal/testutil"
+)
+
+func TestParseLocation(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    location
+		wantErr bool
+	}{
+		{in: "gs://b", want: location{bucket: "b"}},
+		{in: "gs://b/", want: location{bucket: "b"}},
+		{in: "gs://b/dir/obj.txt", want: location{bucket: "b", object: "dir/obj.txt"}},
+		{in: "local/file.txt", want: location{path: "local/file.txt"}},
+		{in: "gs://", wantErr: true},
+		{in: "gs:///obj", wantErr: true},
+	}
+	for _, tc := range tests {
+		got, err := parseLocation(tc.in)
+		if (err != nil) != tc.wantErr {
+			t.Errorf("parseLocation(%q) got err %v, want err %v", tc.in, err, tc.wantErr)
+			continue
+		}
+		if got != tc.want {
+			t.Errorf("parseLocation(%q) got %+v, want %+v", tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestPlanSync(t *testing.T) {
+	src := map[string]fileInfo{
+		"same.txt":    {size: 1, crc32c: 1},
+		"changed.txt": {size: 1, crc32c: 2},
+		"new.txt":     {size: 3, crc32c: 3},
+	}
+	dst := map[string]fileInfo{
+		"same.txt":    {size: 1, crc32c: 1},
+		"changed.txt": {size: 1, crc32c: 9},
+		"extra.txt":   {size: 4, crc32c: 4},
+	}
+
+	copies, deletes := planSync(src, dst, false)
+	if want := []string{"changed.txt", "new.txt"}; !reflect.DeepEqual(copies, want) {
+		t.Errorf("planSync copies got %v, want %v", copies, want)
+	}
+	if len(deletes) != 0 {
+		t.Errorf("planSync without delete got deletes %v, want none", deletes)
+	}
+
+	_, deletes = planSync(src, dst, true)
+	if want := []string{"extra.txt"}; !reflect.DeepEqual(deletes, want) {
+		t.Errorf("planSync deletes got %v, want %v", deletes, want)
+	}
+}
+
+func TestCommands(t *testing.T) {
+	tc := testutil.SystemTest(t)
+	ctx := context.Background()
+	client, err := storage.NewClient(ctx)
+	if err != nil {
+		t.Fatalf("storage.NewClient: %v", err)
+	}
+	defer client.Close()
+
+	bucket := testutil.UniqueName("gcsutil")
+	testutil.CleanBucket(ctx, t, tc.ProjectID, bucket)
+
+	dir, err := ioutil.TempDir("", "gcsutil")
+	if err != nil {
+		t.Fatalf("ioutil.TempDir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	src := filepath.Join(dir, "src")
+	if err := os.MkdirAll(filepath.Join(src, "sub"), 0755); err != nil {
+		t.Fatalf("os.MkdirAll: %v", err)
+	}
+	for name, content := range map[string]string{"a.txt": "hello", "sub/b.txt": "world"} {
+		if err := ioutil.WriteFile(filepath.Join(src, filepath.FromSlash(name)), []byte(content), 0644); err != nil {
+			t.Fatalf("ioutil.WriteFile: %v", err)
+		}
+	}
+
+	run := func(name string, args ...string) string {
+		t.Helper()
+		buf := new(bytes.Buffer)
+		if err := commands[name].run(ctx, buf, client, args); err != nil {
+			t.Fatalf("%s %v: %v", name, args, err)
+		}
+		return buf.String()
+	}
+	prefix := "gs://" + bucket + "/sync/"
+
+	run("rsync", src, prefix)
+	if got := run("ls", prefix); !strings.Contains(got, "sync/a.txt\n") || !strings.Contains(got, "sync/sub/b.txt\n") {
+		t.Errorf("ls got %q, want sync/a.txt and sync/sub/b.txt", got)
+	}
+	if got := run("rsync", src, prefix); got != "" {
+		t.Errorf("second rsync got %q, want nothing to do", got)
+	}
+	if got, want := run("stat", prefix+"a.txt"), "Size: 5\n"; !strings.Contains(got, want) {
+		t.Errorf("stat got %q, want to contain %q", got, want)
+	}
+
+	local := filepath.Join(dir, "copy.txt")
+	run("cp", prefix+"sub/b.txt", local)
+	if got, err := ioutil.ReadFile(local); err != nil || string(got) != "world" {
+		t.Errorf("cp downloaded %q (err %v), want %q", got, err, "world")
+	}
+
+	run("rm", prefix+"a.txt", prefix+"sub/b.txt")
+	if got := run("ls", prefix); got != "" {
+		t.Errorf("ls after rm got %q, want nothing", got)
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// location is either a local path or an object (or prefix) in a bucket.
+type location struct {
+	bucket string
+	object string
+	path   string
+}
+
+func (l location) remote() bool { return l.bucket != "" }
+
+func (l location) String() string {
+	if l.remote() {
+		return "gs://" + l.bucket + "/" + l.object
+	}
+	return l.path
+}
+
+// parseLocation parses a gs://BUCKET/OBJECT URL or a local path.
+func parseLocation(s string) (location, error) {
+	if !strings.HasPrefix(s, "gs://") {
+		return location{path: s}, nil
+	}
+	rest := strings.TrimPrefix(s, "gs://")
+	if rest == "" || strings.HasPrefix(rest, "/") {
+		return location{}, fmt.Errorf("%q: missing bucket name", s)
+	}
+	parts := strings.SplitN(rest, "/", 2)
+	l := location{bucket: parts[0]}
+	if len(parts) == 2 {
+		l.object = parts[1]
+	}
+	return l, nil
+}
+
+// parseObject parses a gs:// URL that must name an object.
+func parseObject(s string) (location, error) {
+	l, err := parseLocation(s)
+	if err != nil {
+		return location{}, err
+	}
+	if !l.remote() || l.object == "" {
+		return location{}, fmt.Errorf("%q is not a gs://BUCKET/OBJECT URL", s)
+	}
+	return l, nil
+}
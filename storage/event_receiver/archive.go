@@ -0,0 +1,71 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+// [START storage_event_receiver_archive]
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/googleapi"
+)
+
+// archiver copies every new object into another bucket, in the Archive
+// storage class, as "SOURCE_BUCKET/OBJECT".
+type archiver struct {
+	client *storage.Client
+	bucket string
+}
+
+// archive copies the object generation the event is about. It is safe to
+// call again for the same event: a copy that already exists is kept.
+func (a *archiver) archive(ctx context.Context, e *objectEvent) error {
+	if e.Bucket == a.bucket {
+		// Archiving the archive bucket's own objects would trigger
+		// itself forever.
+		return nil
+	}
+	src := a.client.Bucket(e.Bucket).Object(e.Name)
+	if e.Generation > 0 {
+		// Copy the generation that was finalized, even if it has been
+		// overwritten since.
+		src = src.Generation(e.Generation)
+	}
+	dst := a.client.Bucket(a.bucket).Object(e.Bucket + "/" + e.Name).If(storage.Conditions{DoesNotExist: true})
+	c := dst.CopierFrom(src)
+	c.StorageClass = "ARCHIVE"
+	_, err := c.Run(ctx)
+	var gerr *googleapi.Error
+	switch {
+	case err == nil:
+		log.Printf("Archived gs://%s/%s#%d", e.Bucket, e.Name, e.Generation)
+		return nil
+	case errors.As(err, &gerr) && gerr.Code == http.StatusPreconditionFailed:
+		// An earlier delivery of this event already made the copy.
+		return nil
+	case errors.Is(err, storage.ErrObjectNotExist):
+		// The object was deleted before it could be copied; there is
+		// nothing left to archive.
+		log.Printf("gs://%s/%s#%d no longer exists", e.Bucket, e.Name, e.Generation)
+		return nil
+	}
+	return fmt.Errorf("CopierFrom.Run: %w", err)
+}
+
+// [END storage_event_receiver_archive]
@@ -0,0 +1,171 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+// [START storage_event_receiver_decode]
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// objectEvent is a change to a Cloud Storage object, however it was
+// delivered. Type is one of the notification event types, such as
+// OBJECT_FINALIZE.
+type objectEvent struct {
+	ID          string
+	Type        string
+	Bucket      string
+	Name        string
+	Generation  int64
+	ContentType string
+	Size        int64
+	TimeCreated time.Time
+}
+
+// storageObjectData is the object resource that Eventarc sends as the data
+// of a google.cloud.storage.object.v1.* CloudEvent, and that a notification
+// with the JSON_API_V1 payload format sends as the message data.
+type storageObjectData struct {
+	Bucket      string    `json:"bucket"`
+	Name        string    `json:"name"`
+	Generation  int64     `json:"generation,string"`
+	ContentType string    `json:"contentType"`
+	Size        int64     `json:"size,string"`
+	TimeCreated time.Time `json:"timeCreated"`
+}
+
+// pushRequest is the body of a Pub/Sub push request, and the data of the
+// google.cloud.pubsub.topic.v1.messagePublished CloudEvent that Eventarc
+// sends for a Pub/Sub trigger.
+type pushRequest struct {
+	Message struct {
+		Attributes map[string]string `json:"attributes"`
+		Data       []byte            `json:"data"`
+		MessageID  string            `json:"messageId"`
+	} `json:"message"`
+	Subscription string `json:"subscription"`
+}
+
+// cloudEvent is a CloudEvent in the structured content mode, where the
+// attributes and the data are all in the body.
+type cloudEvent struct {
+	ID   string          `json:"id"`
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
+// storageEventTypes maps the CloudEvent types of Cloud Storage to the event
+// types of Pub/Sub notifications.
+var storageEventTypes = map[string]string{
+	"google.cloud.storage.object.v1.finalized":       "OBJECT_FINALIZE",
+	"google.cloud.storage.object.v1.deleted":         "OBJECT_DELETE",
+	"google.cloud.storage.object.v1.archived":        "OBJECT_ARCHIVE",
+	"google.cloud.storage.object.v1.metadataUpdated": "OBJECT_METADATA_UPDATE",
+}
+
+const messagePublishedType = "google.cloud.pubsub.topic.v1.messagePublished"
+
+// decodeEvent decodes the object event in r, which is one of:
+//
+//   - a CloudEvent from an Eventarc Cloud Storage trigger, in the binary
+//     (ce-* headers) or structured content mode;
+//   - a CloudEvent from an Eventarc Pub/Sub trigger on a topic that receives
+//     Cloud Storage notifications;
+//   - a Pub/Sub push request for such a topic.
+func decodeEvent(r *http.Request) (*objectEvent, error) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("io.ReadAll: %w", err)
+	}
+
+	ce := cloudEvent{
+		ID:   r.Header.Get("Ce-Id"),
+		Type: r.Header.Get("Ce-Type"),
+		Data: body,
+	}
+	if mt, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mt == "application/cloudevents+json" {
+		if err := json.Unmarshal(body, &ce); err != nil {
+			return nil, fmt.Errorf("json.Unmarshal CloudEvent: %w", err)
+		}
+	}
+
+	switch {
+	case storageEventTypes[ce.Type] != "":
+		var d storageObjectData
+		if err := json.Unmarshal(ce.Data, &d); err != nil {
+			return nil, fmt.Errorf("json.Unmarshal %s: %w", ce.Type, err)
+		}
+		e := fromObjectData(&d)
+		e.ID = ce.ID
+		e.Type = storageEventTypes[ce.Type]
+		return e, nil
+	case ce.Type == messagePublishedType, ce.Type == "":
+		var p pushRequest
+		if err := json.Unmarshal(ce.Data, &p); err != nil {
+			return nil, fmt.Errorf("json.Unmarshal push request: %w", err)
+		}
+		return fromNotification(&p)
+	}
+	return nil, fmt.Errorf("unsupported event type %q", ce.Type)
+}
+
+func fromObjectData(d *storageObjectData) *objectEvent {
+	return &objectEvent{
+		Bucket:      d.Bucket,
+		Name:        d.Name,
+		Generation:  d.Generation,
+		ContentType: d.ContentType,
+		Size:        d.Size,
+		TimeCreated: d.TimeCreated,
+	}
+}
+
+// fromNotification decodes a Cloud Storage notification delivered through
+// Pub/Sub. The attributes are always present; the message data holds the
+// object only with the JSON_API_V1 payload format.
+// See https://cloud.google.com/storage/docs/pubsub-notifications#format.
+func fromNotification(p *pushRequest) (*objectEvent, error) {
+	attrs := p.Message.Attributes
+	e := &objectEvent{}
+	if attrs["payloadFormat"] == "JSON_API_V1" && len(p.Message.Data) > 0 {
+		var d storageObjectData
+		if err := json.Unmarshal(p.Message.Data, &d); err != nil {
+			return nil, fmt.Errorf("json.Unmarshal notification payload: %w", err)
+		}
+		e = fromObjectData(&d)
+	}
+	e.ID = p.Message.MessageID
+	e.Type = attrs["eventType"]
+	e.Bucket = attrs["bucketId"]
+	e.Name = attrs["objectId"]
+	if e.Type == "" || e.Bucket == "" || e.Name == "" {
+		return nil, fmt.Errorf("message %q is not a Cloud Storage notification", e.ID)
+	}
+	if g := attrs["objectGeneration"]; g != "" {
+		gen, err := strconv.ParseInt(g, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("objectGeneration: %w", err)
+		}
+		e.Generation = gen
+	}
+	return e, nil
+}
+
+// [END storage_event_receiver_decode]
@@ -0,0 +1,61 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+// [START storage_event_receiver_handler]
+import (
+	"context"
+	"log"
+	"net/http"
+)
+
+// receiver handles Cloud Storage object events pushed by Eventarc or a
+// Pub/Sub push subscription, and passes every new object to process.
+//
+// Both deliver at least once, and retry while the response is not a 2xx.
+// process must therefore be idempotent, and the receiver answers 2xx for
+// events it chooses to ignore, so that they are not sent again.
+type receiver struct {
+	process func(ctx context.Context, e *objectEvent) error
+}
+
+func (h *receiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	e, err := decodeEvent(r)
+	if err != nil {
+		// A request that can't be decoded won't decode on a retry
+		// either; configure a dead-letter topic to keep these.
+		log.Printf("decodeEvent: %v", err)
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+	if e.Type != "OBJECT_FINALIZE" {
+		log.Printf("Ignoring %s event %s for gs://%s/%s", e.Type, e.ID, e.Bucket, e.Name)
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	if err := h.process(r.Context(), e); err != nil {
+		// Ask for a retry.
+		log.Printf("Processing gs://%s/%s#%d: %v", e.Bucket, e.Name, e.Generation, err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// [END storage_event_receiver_handler]
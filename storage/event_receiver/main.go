@@ -0,0 +1,73 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command event_receiver is a sample Cloud Run service that receives Cloud
+// Storage object events and processes every new object.
+//
+// It accepts events from an Eventarc Cloud Storage trigger:
+//
+//	gcloud eventarc triggers create my-trigger \
+//	    --destination-run-service=event-receiver \
+//	    --event-filters="type=google.cloud.storage.object.v1.finalized" \
+//	    --event-filters="bucket=my-bucket" \
+//	    --service-account=PROJECT_NUMBER-compute@developer.gserviceaccount.com
+//
+// and Cloud Storage notifications delivered by an Eventarc Pub/Sub trigger or
+// a Pub/Sub push subscription.
+//
+// Set ARCHIVE_BUCKET to copy every new object into that bucket in the Archive
+// storage class; otherwise the service only logs the objects. Replace
+// archiver with your own processing, such as making thumbnails of images.
+package main
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"os"
+
+	"cloud.google.com/go/storage"
+	"github.com/GoogleCloudPlatform/golang-samples/internal/endpoint"
+)
+
+func main() {
+	ctx := context.Background()
+
+	h := &receiver{
+		process: func(ctx context.Context, e *objectEvent) error {
+			log.Printf("New object gs://%s/%s#%d: %d bytes of %s", e.Bucket, e.Name, e.Generation, e.Size, e.ContentType)
+			return nil
+		},
+	}
+	if bucket := os.Getenv("ARCHIVE_BUCKET"); bucket != "" {
+		client, err := storage.NewClient(ctx, endpoint.Storage()...)
+		if err != nil {
+			log.Fatalf("storage.NewClient: %v", err)
+		}
+		defer client.Close()
+		a := &archiver{client: client, bucket: bucket}
+		h.process = a.archive
+	}
+	http.Handle("/", h)
+
+	port := os.Getenv("PORT")
+	if port == "" {
+		port = "8080"
+		log.Printf("Defaulting to port %s", port)
+	}
+	log.Printf("Listening on port %s", port)
+	if err := http.ListenAndServe(":"+port, nil); err != nil {
+		log.Fatal(err)
+	}
+}
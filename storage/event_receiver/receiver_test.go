@@ -0,0 +1,204 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/GoogleCloudPlatform/golang-samples/internal/testutil"
+	"github.com/google/go-cmp/cmp"
+)
+
+const objectJSON = `{"bucket":"my-bucket","name":"photos/cat.jpg","generation":"1700000000000000","contentType":"image/jpeg","size":"1024","timeCreated":"2026-01-02T03:04:05.000Z"}`
+
+func newEventRequest(body string, header map[string]string) *http.Request {
+	r := httptest.NewRequest("POST", "/", strings.NewReader(body))
+	for k, v := range header {
+		r.Header.Set(k, v)
+	}
+	return r
+}
+
+func TestDecodeEvent(t *testing.T) {
+	created := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	full := objectEvent{
+		Type:        "OBJECT_FINALIZE",
+		Bucket:      "my-bucket",
+		Name:        "photos/cat.jpg",
+		Generation:  1700000000000000,
+		ContentType: "image/jpeg",
+		Size:        1024,
+		TimeCreated: created,
+	}
+	data := base64.StdEncoding.EncodeToString([]byte(objectJSON))
+	push := `{"subscription":"projects/p/subscriptions/s","message":{"messageId":"42","data":"` + data + `","attributes":{"eventType":"OBJECT_FINALIZE","bucketId":"my-bucket","objectId":"photos/cat.jpg","objectGeneration":"1700000000000000","payloadFormat":"JSON_API_V1"}}}`
+
+	tests := []struct {
+		name   string
+		body   string
+		header map[string]string
+		want   objectEvent
+	}{
+		{
+			name: "Eventarc binary",
+			body: objectJSON,
+			header: map[string]string{
+				"Ce-Id":        "42",
+				"Ce-Type":      "google.cloud.storage.object.v1.finalized",
+				"Content-Type": "application/json",
+			},
+			want: full,
+		},
+		{
+			name:   "Eventarc structured",
+			body:   `{"specversion":"1.0","id":"42","type":"google.cloud.storage.object.v1.deleted","data":` + objectJSON + `}`,
+			header: map[string]string{"Content-Type": "application/cloudevents+json; charset=utf-8"},
+			want: func() objectEvent {
+				e := full
+				e.Type = "OBJECT_DELETE"
+				return e
+			}(),
+		},
+		{
+			name:   "Eventarc Pub/Sub trigger",
+			body:   push,
+			header: map[string]string{"Ce-Id": "99", "Ce-Type": messagePublishedType},
+			want:   full,
+		},
+		{
+			name: "Pub/Sub push",
+			body: push,
+			want: full,
+		},
+		{
+			name: "Pub/Sub push without payload",
+			body: `{"message":{"messageId":"42","attributes":{"eventType":"OBJECT_FINALIZE","bucketId":"my-bucket","objectId":"photos/cat.jpg","objectGeneration":"7","payloadFormat":"NONE"}}}`,
+			want: objectEvent{Type: "OBJECT_FINALIZE", Bucket: "my-bucket", Name: "photos/cat.jpg", Generation: 7},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := decodeEvent(newEventRequest(tc.body, tc.header))
+			if err != nil {
+				t.Fatalf("decodeEvent: %v", err)
+			}
+			tc.want.ID = "42"
+			if diff := cmp.Diff(tc.want, *got); diff != "" {
+				t.Errorf("decodeEvent mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	for _, bad := range []struct {
+		body   string
+		header map[string]string
+	}{
+		{objectJSON, map[string]string{"Ce-Type": "google.cloud.audit.log.v1.written"}},
+		{`{"message":{"messageId":"1","attributes":{"foo":"bar"}}}`, nil},
+		{`not JSON`, map[string]string{"Ce-Type": "google.cloud.storage.object.v1.finalized"}},
+	} {
+		if _, err := decodeEvent(newEventRequest(bad.body, bad.header)); err == nil {
+			t.Errorf("decodeEvent(%s, %v) got nil error, want non-nil", bad.body, bad.header)
+		}
+	}
+}
+
+func TestReceiver(t *testing.T) {
+	var processed []string
+	h := &receiver{
+		process: func(_ context.Context, e *objectEvent) error {
+			if e.Name == "fail" {
+				return errors.New("failed")
+			}
+			processed = append(processed, e.Name)
+			return nil
+		},
+	}
+	finalized := map[string]string{"Ce-Id": "1", "Ce-Type": "google.cloud.storage.object.v1.finalized"}
+	deleted := map[string]string{"Ce-Id": "2", "Ce-Type": "google.cloud.storage.object.v1.deleted"}
+
+	tests := []struct {
+		body       string
+		header     map[string]string
+		wantStatus int
+	}{
+		{`{"bucket":"b","name":"new"}`, finalized, http.StatusNoContent},
+		{`{"bucket":"b","name":"gone"}`, deleted, http.StatusNoContent},
+		{`{"bucket":"b","name":"fail"}`, finalized, http.StatusInternalServerError},
+		{`{}`, map[string]string{"Ce-Type": "unknown"}, http.StatusBadRequest},
+	}
+	for _, tc := range tests {
+		rr := httptest.NewRecorder()
+		h.ServeHTTP(rr, newEventRequest(tc.body, tc.header))
+		if rr.Code != tc.wantStatus {
+			t.Errorf("%s %s got status %d, want %d", tc.header["Ce-Type"], tc.body, rr.Code, tc.wantStatus)
+		}
+	}
+	if diff := cmp.Diff([]string{"new"}, processed); diff != "" {
+		t.Errorf("processed mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestArchive(t *testing.T) {
+	tc := testutil.StorageEmulatorTest(t)
+	ctx := context.Background()
+	client, err := storage.NewClient(ctx)
+	if err != nil {
+		t.Fatalf("storage.NewClient: %v", err)
+	}
+	defer client.Close()
+
+	src := testutil.UniqueName("event-src")
+	dst := testutil.UniqueName("event-archive")
+	testutil.CleanBucket(ctx, t, tc.ProjectID, src)
+	testutil.CleanBucket(ctx, t, tc.ProjectID, dst)
+
+	wc := client.Bucket(src).Object("cat.txt").NewWriter(ctx)
+	io.WriteString(wc, "meow")
+	if err := wc.Close(); err != nil {
+		t.Fatalf("Writer.Close: %v", err)
+	}
+
+	a := &archiver{client: client, bucket: dst}
+	e := &objectEvent{Type: "OBJECT_FINALIZE", Bucket: src, Name: "cat.txt", Generation: wc.Attrs().Generation}
+	// The second call is a redelivery of the same event.
+	for i := 0; i < 2; i++ {
+		if err := a.archive(ctx, e); err != nil {
+			t.Fatalf("archive #%d: %v", i, err)
+		}
+	}
+	r, err := client.Bucket(dst).Object(src + "/cat.txt").NewReader(ctx)
+	if err != nil {
+		t.Fatalf("NewReader: %v", err)
+	}
+	defer r.Close()
+	if b, _ := io.ReadAll(r); string(b) != "meow" {
+		t.Errorf("archived copy got %q, want %q", b, "meow")
+	}
+
+	// Events for the archive bucket itself are skipped.
+	if err := a.archive(ctx, &objectEvent{Bucket: dst, Name: src + "/cat.txt"}); err != nil {
+		t.Errorf("archive of the archive bucket: %v", err)
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcclient
+
+// [START storage_grpc_check_direct_connectivity]
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+)
+
+// checkDirectConnectivity reports whether requests from this machine reach
+// Cloud Storage over Direct Connectivity. DirectPath is only available from
+// Compute Engine in the same region as the bucket; everywhere else the client
+// silently falls back to the regular Google Front End (GFE) path.
+func checkDirectConnectivity(w io.Writer, bucket string) (bool, error) {
+	// bucket := "bucket-name"
+	ctx := context.Background()
+
+	// Record the address of the server that answered each call.
+	var p peer.Peer
+	recordPeer := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(ctx, method, req, reply, cc, append(opts, grpc.Peer(&p))...)
+	}
+	client, err := storage.NewGRPCClient(ctx, option.WithGRPCDialOption(grpc.WithChainUnaryInterceptor(recordPeer)))
+	if err != nil {
+		return false, fmt.Errorf("storage.NewGRPCClient: %w", err)
+	}
+	defer client.Close()
+
+	if _, err := client.Bucket(bucket).Attrs(ctx); err != nil {
+		return false, fmt.Errorf("Bucket(%q).Attrs: %w", bucket, err)
+	}
+	if p.Addr == nil {
+		return false, fmt.Errorf("no peer recorded for Bucket(%q).Attrs", bucket)
+	}
+
+	direct := isDirectPathAddr(p.Addr)
+	if direct {
+		fmt.Fprintf(w, "Connected to %v over Direct Connectivity\n", p.Addr)
+	} else {
+		fmt.Fprintf(w, "Connected to %v through the Google Front End\n", p.Addr)
+	}
+	return direct, nil
+}
+
+// isDirectPathAddr reports whether addr is in one of the ranges DirectPath
+// backends are served from.
+func isDirectPathAddr(addr net.Addr) bool {
+	host, _, err := net.SplitHostPort(addr.String())
+	if err != nil {
+		host = addr.String()
+	}
+	return strings.HasPrefix(host, "2001:4860:8040") || strings.HasPrefix(host, "34.126.")
+}
+
+// [END storage_grpc_check_direct_connectivity]
@@ -0,0 +1,49 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcclient
+
+// [START storage_grpc_connection_pool]
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/option"
+)
+
+// createGRPCClientPool creates a gRPC storage client that spreads its
+// requests over poolSize connections. Each gRPC connection multiplexes a
+// limited number of concurrent streams, so workloads that keep many large
+// reads or writes in flight at once benefit from a bigger pool.
+func createGRPCClientPool(w io.Writer, bucket string, poolSize int) error {
+	// bucket := "bucket-name"
+	// poolSize := 8
+	ctx := context.Background()
+	client, err := storage.NewGRPCClient(ctx, option.WithGRPCConnectionPool(poolSize))
+	if err != nil {
+		return fmt.Errorf("storage.NewGRPCClient: %w", err)
+	}
+	defer client.Close()
+
+	attrs, err := client.Bucket(bucket).Attrs(ctx)
+	if err != nil {
+		return fmt.Errorf("Bucket(%q).Attrs: %w", bucket, err)
+	}
+	fmt.Fprintf(w, "Connected to bucket %v over gRPC with %d connections\n", attrs.Name, poolSize)
+	return nil
+}
+
+// [END storage_grpc_connection_pool]
@@ -0,0 +1,91 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcclient
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net"
+	"strings"
+	"testing"
+
+	"cloud.google.com/go/storage"
+	"github.com/GoogleCloudPlatform/golang-samples/internal/testutil"
+)
+
+func TestIsDirectPathAddr(t *testing.T) {
+	tests := []struct {
+		addr string
+		want bool
+	}{
+		{"[2001:4860:8040:801::1]:443", true},
+		{"34.126.10.1:443", true},
+		{"[2607:f8b0:4004:c07::80]:443", false},
+		{"142.250.65.80:443", false},
+	}
+	for _, tc := range tests {
+		addr, err := net.ResolveTCPAddr("tcp", tc.addr)
+		if err != nil {
+			t.Fatalf("net.ResolveTCPAddr(%q): %v", tc.addr, err)
+		}
+		if got := isDirectPathAddr(addr); got != tc.want {
+			t.Errorf("isDirectPathAddr(%v) got %v, want %v", tc.addr, got, tc.want)
+		}
+	}
+}
+
+func TestGRPCClient(t *testing.T) {
+	tc := testutil.SystemTest(t)
+	ctx := context.Background()
+	bucket := testutil.UniqueName("grpc-client")
+	object := "throughput.bin"
+	testutil.CleanBucket(ctx, t, tc.ProjectID, bucket)
+
+	client, err := storage.NewClient(ctx)
+	if err != nil {
+		t.Fatalf("storage.NewClient: %v", err)
+	}
+	defer client.Close()
+	wc := client.Bucket(bucket).Object(object).NewWriter(ctx)
+	if _, err := wc.Write(bytes.Repeat([]byte("x"), 1<<20)); err != nil {
+		t.Fatalf("Writer.Write: %v", err)
+	}
+	if err := wc.Close(); err != nil {
+		t.Fatalf("Writer.Close: %v", err)
+	}
+
+	buf := new(bytes.Buffer)
+	if err := createGRPCClientPool(buf, bucket, 4); err != nil {
+		t.Fatalf("createGRPCClientPool: %v", err)
+	}
+	if got, want := buf.String(), "4 connections"; !strings.Contains(got, want) {
+		t.Errorf("createGRPCClientPool got %q, want to contain %q", got, want)
+	}
+
+	// Whether DirectPath is used depends on where the test runs, so only
+	// check that the sample can tell.
+	if _, err := checkDirectConnectivity(ioutil.Discard, bucket); err != nil {
+		t.Errorf("checkDirectConnectivity: %v", err)
+	}
+
+	buf.Reset()
+	if err := measureReadThroughput(buf, bucket, object, 4, []int{1, 2}); err != nil {
+		t.Fatalf("measureReadThroughput: %v", err)
+	}
+	if got, want := strings.Count(buf.String(), "MiB/s"), 2; got != want {
+		t.Errorf("measureReadThroughput printed %d results, want %d: %q", got, want, buf.String())
+	}
+}
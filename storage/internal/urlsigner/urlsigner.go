@@ -0,0 +1,168 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package urlsigner signs Cloud Storage URLs and POST policies on behalf of a
+// service account. It is shared by the commands under storage/cmd.
+package urlsigner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/textproto"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/compute/metadata"
+	credentials "cloud.google.com/go/iam/credentials/apiv1"
+	"cloud.google.com/go/storage"
+	"golang.org/x/oauth2/google"
+	credentialspb "google.golang.org/genproto/googleapis/iam/credentials/v1"
+)
+
+// Signer holds what is needed to sign on behalf of a service account: either
+// its private key, or a function that signs through the IAM Credentials API.
+type Signer struct {
+	googleAccessID string
+	privateKey     []byte
+	signBytes      func([]byte) ([]byte, error)
+	close          func() error
+}
+
+// New returns a Signer for the service account to impersonate. When
+// impersonate is empty, it signs as the service account of Application
+// Default Credentials: with its private key if the credentials are a service
+// account key, or through the IAM Credentials API for the service account of
+// the metadata server on Compute Engine, Cloud Run, GKE and App Engine. That
+// account needs the roles/iam.serviceAccountTokenCreator role on itself.
+func New(ctx context.Context, impersonate string) (*Signer, error) {
+	if impersonate != "" {
+		return iamSigner(ctx, impersonate)
+	}
+
+	creds, err := google.FindDefaultCredentials(ctx, storage.ScopeReadOnly)
+	if err != nil {
+		return nil, fmt.Errorf("google.FindDefaultCredentials: %w", err)
+	}
+	if conf, err := google.JWTConfigFromJSON(creds.JSON); err == nil {
+		return &Signer{googleAccessID: conf.Email, privateKey: conf.PrivateKey}, nil
+	}
+	if !metadata.OnGCE() {
+		return nil, errors.New("default credentials are neither a service account key nor a metadata server service account, impersonate a service account instead")
+	}
+	email, err := metadata.Email("default")
+	if err != nil {
+		return nil, fmt.Errorf("metadata.Email: %w", err)
+	}
+	return iamSigner(ctx, email)
+}
+
+// iamSigner returns a Signer that signs as email through the IAM Credentials
+// API.
+func iamSigner(ctx context.Context, email string) (*Signer, error) {
+	c, err := credentials.NewIamCredentialsClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("credentials.NewIamCredentialsClient: %w", err)
+	}
+	return &Signer{
+		googleAccessID: email,
+		signBytes: func(b []byte) ([]byte, error) {
+			resp, err := c.SignBlob(ctx, &credentialspb.SignBlobRequest{
+				Name:    "projects/-/serviceAccounts/" + email,
+				Payload: b,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("SignBlob: %w", err)
+			}
+			return resp.SignedBlob, nil
+		},
+		close: c.Close,
+	}, nil
+}
+
+// FromKey returns a Signer that signs with a PEM encoded private key.
+func FromKey(googleAccessID string, privateKey []byte) *Signer {
+	return &Signer{googleAccessID: googleAccessID, privateKey: privateKey}
+}
+
+// Close releases the resources held by the Signer.
+func (s *Signer) Close() error {
+	if s.close == nil {
+		return nil
+	}
+	return s.close()
+}
+
+// SignedURL returns a V4 signed URL for method. The request made with the URL
+// must send the given headers with the same values.
+func (s *Signer) SignedURL(method, bucket, object string, expires time.Time, headers []string) (string, error) {
+	opts := &storage.SignedURLOptions{
+		Scheme:         storage.SigningSchemeV4,
+		Method:         method,
+		GoogleAccessID: s.googleAccessID,
+		PrivateKey:     s.privateKey,
+		SignBytes:      s.signBytes,
+		Expires:        expires,
+	}
+	for _, h := range headers {
+		name, value := splitHeader(h)
+		if name == "Content-Type" {
+			opts.ContentType = value
+			continue
+		}
+		opts.Headers = append(opts.Headers, name+":"+value)
+	}
+	u, err := storage.SignedURL(bucket, object, opts)
+	if err != nil {
+		return "", fmt.Errorf("storage.SignedURL: %w", err)
+	}
+	return u, nil
+}
+
+// PostPolicy returns a V4 signed POST policy for an HTML form upload. Only
+// the Content-Type and x-goog-meta-* headers can be constrained.
+func (s *Signer) PostPolicy(bucket, object string, expires time.Time, headers []string) (*storage.PostPolicyV4, error) {
+	fields := &storage.PolicyV4Fields{}
+	for _, h := range headers {
+		name, value := splitHeader(h)
+		switch {
+		case name == "Content-Type":
+			fields.ContentType = value
+		case strings.HasPrefix(strings.ToLower(name), "x-goog-meta-"):
+			if fields.Metadata == nil {
+				fields.Metadata = map[string]string{}
+			}
+			fields.Metadata[strings.ToLower(name)] = value
+		default:
+			return nil, fmt.Errorf("header %q cannot be used with post", name)
+		}
+	}
+	policy, err := storage.GenerateSignedPostPolicyV4(bucket, object, &storage.PostPolicyV4Options{
+		GoogleAccessID: s.googleAccessID,
+		PrivateKey:     s.privateKey,
+		SignBytes:      s.signBytes,
+		Expires:        expires,
+		Fields:         fields,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("storage.GenerateSignedPostPolicyV4: %w", err)
+	}
+	return policy, nil
+}
+
+// splitHeader splits "Name: value" into a canonical name and a trimmed value.
+func splitHeader(h string) (name, value string) {
+	i := strings.Index(h, ":")
+	return textproto.CanonicalMIMEHeaderKey(strings.TrimSpace(h[:i])), strings.TrimSpace(h[i+1:])
+}
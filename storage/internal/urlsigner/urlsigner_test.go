@@ -0,0 +1,81 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package urlsigner
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testSigner(t *testing.T) *Signer {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("rsa.GenerateKey: %v", err)
+	}
+	pemKey := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	return FromKey("test@my-project.iam.gserviceaccount.com", pemKey)
+}
+
+func TestSignedURL(t *testing.T) {
+	s := testSigner(t)
+	u, err := s.SignedURL("PUT", "my-bucket", "foo.txt", time.Now().Add(time.Hour), []string{
+		"Content-Type: text/plain",
+		"x-goog-meta-owner: gopher",
+	})
+	if err != nil {
+		t.Fatalf("SignedURL: %v", err)
+	}
+	parsed, err := url.Parse(u)
+	if err != nil {
+		t.Fatalf("url.Parse: %v", err)
+	}
+	q := parsed.Query()
+	if got, want := q.Get("X-Goog-Algorithm"), "GOOG4-RSA-SHA256"; got != want {
+		t.Errorf("X-Goog-Algorithm got %q, want %q", got, want)
+	}
+	for _, h := range []string{"content-type", "x-goog-meta-owner"} {
+		if got := q.Get("X-Goog-SignedHeaders"); !strings.Contains(got, h) {
+			t.Errorf("X-Goog-SignedHeaders got %q, want to contain %q", got, h)
+		}
+	}
+}
+
+func TestPostPolicy(t *testing.T) {
+	s := testSigner(t)
+	policy, err := s.PostPolicy("my-bucket", "foo.txt", time.Now().Add(time.Hour), []string{
+		"Content-Type: text/plain",
+		"X-Goog-Meta-Owner: gopher",
+	})
+	if err != nil {
+		t.Fatalf("PostPolicy: %v", err)
+	}
+	if got, want := policy.Fields["content-type"], "text/plain"; got != want {
+		t.Errorf("content-type field got %q, want %q", got, want)
+	}
+	if got, want := policy.Fields["x-goog-meta-owner"], "gopher"; got != want {
+		t.Errorf("x-goog-meta-owner field got %q, want %q", got, want)
+	}
+
+	if _, err := s.PostPolicy("my-bucket", "foo.txt", time.Now().Add(time.Hour), []string{"Range: bytes=0-1"}); err == nil {
+		t.Errorf("PostPolicy with a Range header got nil error, want non-nil")
+	}
+}
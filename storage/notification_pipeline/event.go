@@ -0,0 +1,90 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+// [START storage_pubsub_notification_event]
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+)
+
+// objectEvent is a Cloud Storage notification decoded from a Pub/Sub message.
+// The attributes are always present; the payload is only set when the
+// notification configuration uses the JSON_API_V1 payload format.
+// See https://cloud.google.com/storage/docs/pubsub-notifications#format.
+type objectEvent struct {
+	EventType          string
+	BucketID           string
+	ObjectID           string
+	ObjectGeneration   int64
+	EventTime          time.Time
+	NotificationConfig string
+	PayloadFormat      string
+
+	Object *objectPayload
+}
+
+// objectPayload holds the fields of the object resource that are sent as the
+// message data with the JSON_API_V1 payload format.
+type objectPayload struct {
+	Name        string    `json:"name"`
+	Bucket      string    `json:"bucket"`
+	ContentType string    `json:"contentType"`
+	Size        int64     `json:"size,string"`
+	MD5Hash     string    `json:"md5Hash"`
+	Generation  int64     `json:"generation,string"`
+	TimeCreated time.Time `json:"timeCreated"`
+}
+
+// parseObjectEvent decodes the attributes and payload of a notification.
+func parseObjectEvent(msg *pubsub.Message) (*objectEvent, error) {
+	attrs := msg.Attributes
+	e := &objectEvent{
+		EventType:          attrs["eventType"],
+		BucketID:           attrs["bucketId"],
+		ObjectID:           attrs["objectId"],
+		NotificationConfig: attrs["notificationConfig"],
+		PayloadFormat:      attrs["payloadFormat"],
+	}
+	if e.EventType == "" || e.BucketID == "" || e.ObjectID == "" {
+		return nil, fmt.Errorf("message %v is not a Cloud Storage notification", msg.ID)
+	}
+
+	var err error
+	if g := attrs["objectGeneration"]; g != "" {
+		if e.ObjectGeneration, err = strconv.ParseInt(g, 10, 64); err != nil {
+			return nil, fmt.Errorf("objectGeneration: %w", err)
+		}
+	}
+	if t := attrs["eventTime"]; t != "" {
+		if e.EventTime, err = time.Parse(time.RFC3339Nano, t); err != nil {
+			return nil, fmt.Errorf("eventTime: %w", err)
+		}
+	}
+
+	if e.PayloadFormat == "JSON_API_V1" && len(msg.Data) > 0 {
+		e.Object = &objectPayload{}
+		if err := json.Unmarshal(msg.Data, e.Object); err != nil {
+			return nil, fmt.Errorf("json.Unmarshal: %w", err)
+		}
+	}
+	return e, nil
+}
+
+// [END storage_pubsub_notification_event]
@@ -0,0 +1,60 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command notification_pipeline wires a Cloud Storage bucket to a Pub/Sub
+// topic, uploads an object, and prints the OBJECT_FINALIZE notification that
+// the upload produces.
+//
+// Usage:
+//
+//	go run . -project my-project -bucket my-bucket -topic my-topic -subscription my-sub
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"time"
+
+	"github.com/GoogleCloudPlatform/golang-samples/internal/endpoint"
+)
+
+func main() {
+	projectID := flag.String("project", os.Getenv("GOOGLE_CLOUD_PROJECT"), "project that owns the bucket and the topic")
+	bucket := flag.String("bucket", "", "bucket to watch")
+	topicID := flag.String("topic", "gcs-notifications", "topic that receives the notifications; created if missing")
+	subID := flag.String("subscription", "gcs-notifications-sub", "subscription used to read the notifications; created if missing")
+	object := flag.String("object", "notification-test.txt", "name of the object to upload")
+	timeout := flag.Duration("timeout", 2*time.Minute, "how long to wait for the notification")
+	flag.Parse()
+
+	if *projectID == "" || *bucket == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	p, err := newPipeline(ctx, *projectID, *bucket, *topicID, *subID, endpoint.Storage(), endpoint.PubSub())
+	if err != nil {
+		log.Fatalf("newPipeline: %v", err)
+	}
+	defer p.Close(context.Background())
+
+	if err := p.Run(ctx, os.Stdout, *object, []byte("Hello, notifications!")); err != nil {
+		log.Fatalf("Run: %v", err)
+	}
+}
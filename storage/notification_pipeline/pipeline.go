@@ -0,0 +1,194 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+// [START storage_pubsub_notification_pipeline]
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+
+	"cloud.google.com/go/pubsub"
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/option"
+)
+
+// pipeline connects a bucket to a Pub/Sub subscription through a
+// notification configuration.
+type pipeline struct {
+	storage *storage.Client
+	pubsub  *pubsub.Client
+
+	bucket         string
+	topic          *pubsub.Topic
+	sub            *pubsub.Subscription
+	notificationID string
+}
+
+// newPipeline creates the topic and subscription if they do not exist, allows
+// the Cloud Storage service agent to publish to the topic, and adds a
+// notification configuration for OBJECT_FINALIZE events to the bucket.
+// storageOpts and pubsubOpts configure the two clients, such as their
+// endpoints.
+func newPipeline(ctx context.Context, projectID, bucket, topicID, subID string, storageOpts, pubsubOpts []option.ClientOption) (*pipeline, error) {
+	sc, err := storage.NewClient(ctx, storageOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("storage.NewClient: %w", err)
+	}
+	pc, err := pubsub.NewClient(ctx, projectID, pubsubOpts...)
+	if err != nil {
+		sc.Close()
+		return nil, fmt.Errorf("pubsub.NewClient: %w", err)
+	}
+	p := &pipeline{storage: sc, pubsub: pc, bucket: bucket}
+
+	if err := p.setup(ctx, projectID, topicID, subID); err != nil {
+		p.Close(ctx)
+		return nil, err
+	}
+	return p, nil
+}
+
+func (p *pipeline) setup(ctx context.Context, projectID, topicID, subID string) error {
+	p.topic = p.pubsub.Topic(topicID)
+	ok, err := p.topic.Exists(ctx)
+	if err != nil {
+		return fmt.Errorf("Topic(%q).Exists: %w", topicID, err)
+	}
+	if !ok {
+		if p.topic, err = p.pubsub.CreateTopic(ctx, topicID); err != nil {
+			return fmt.Errorf("CreateTopic(%q): %w", topicID, err)
+		}
+	}
+
+	// Cloud Storage publishes notifications as its own service agent, which
+	// needs the publisher role on the topic.
+	serviceAgent, err := p.storage.ServiceAccount(ctx, projectID)
+	if err != nil {
+		return fmt.Errorf("ServiceAccount: %w", err)
+	}
+	policy, err := p.topic.IAM().Policy(ctx)
+	if err != nil {
+		return fmt.Errorf("Topic(%q).IAM().Policy: %w", topicID, err)
+	}
+	policy.Add("serviceAccount:"+serviceAgent, "roles/pubsub.publisher")
+	if err := p.topic.IAM().SetPolicy(ctx, policy); err != nil {
+		return fmt.Errorf("Topic(%q).IAM().SetPolicy: %w", topicID, err)
+	}
+
+	p.sub = p.pubsub.Subscription(subID)
+	ok, err = p.sub.Exists(ctx)
+	if err != nil {
+		return fmt.Errorf("Subscription(%q).Exists: %w", subID, err)
+	}
+	if !ok {
+		if p.sub, err = p.pubsub.CreateSubscription(ctx, subID, pubsub.SubscriptionConfig{
+			Topic: p.topic,
+		}); err != nil {
+			return fmt.Errorf("CreateSubscription(%q): %w", subID, err)
+		}
+	}
+
+	n, err := p.storage.Bucket(p.bucket).AddNotification(ctx, &storage.Notification{
+		TopicProjectID: projectID,
+		TopicID:        topicID,
+		PayloadFormat:  storage.JSONPayload,
+		EventTypes:     []string{storage.ObjectFinalizeEvent},
+	})
+	if err != nil {
+		return fmt.Errorf("Bucket(%q).AddNotification: %w", p.bucket, err)
+	}
+	p.notificationID = n.ID
+	return nil
+}
+
+// Run uploads an object and waits until the notification for that upload is
+// received, printing the decoded event to w.
+func (p *pipeline) Run(ctx context.Context, w io.Writer, object string, data []byte) error {
+	wc := p.storage.Bucket(p.bucket).Object(object).NewWriter(ctx)
+	wc.ContentType = "text/plain"
+	if _, err := wc.Write(data); err != nil {
+		return fmt.Errorf("Writer.Write: %w", err)
+	}
+	if err := wc.Close(); err != nil {
+		return fmt.Errorf("Writer.Close: %w", err)
+	}
+	generation := wc.Attrs().Generation
+	fmt.Fprintf(w, "Uploaded gs://%s/%s (generation %d)\n", p.bucket, object, generation)
+
+	event, err := p.receive(ctx, object, generation)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "Received %s for gs://%s/%s (generation %d) at %v\n",
+		event.EventType, event.BucketID, event.ObjectID, event.ObjectGeneration, event.EventTime)
+	if o := event.Object; o != nil {
+		fmt.Fprintf(w, "Object size: %d bytes, content type: %s, MD5: %s\n", o.Size, o.ContentType, o.MD5Hash)
+	}
+	return nil
+}
+
+// receive pulls messages until the OBJECT_FINALIZE event of the given object
+// generation arrives. Other notifications are acknowledged and ignored.
+func (p *pipeline) receive(ctx context.Context, object string, generation int64) (*objectEvent, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// The callback may be invoked concurrently.
+	var mu sync.Mutex
+	var found *objectEvent
+	var parseErr error
+	err := p.sub.Receive(ctx, func(_ context.Context, msg *pubsub.Message) {
+		msg.Ack()
+		e, err := parseObjectEvent(msg)
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			parseErr = err
+			cancel()
+			return
+		}
+		if e.EventType != storage.ObjectFinalizeEvent || e.ObjectID != object || e.ObjectGeneration != generation {
+			return
+		}
+		found = e
+		cancel()
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Receive: %w", err)
+	}
+	if parseErr != nil {
+		return nil, parseErr
+	}
+	if found == nil {
+		return nil, fmt.Errorf("no notification received for gs://%s/%s: %v", p.bucket, object, ctx.Err())
+	}
+	return found, nil
+}
+
+// Close removes the notification configuration and closes the clients. The
+// topic and subscription are left in place so they can be reused.
+func (p *pipeline) Close(ctx context.Context) error {
+	var err error
+	if p.notificationID != "" {
+		err = p.storage.Bucket(p.bucket).DeleteNotification(ctx, p.notificationID)
+	}
+	p.pubsub.Close()
+	p.storage.Close()
+	return err
+}
+
+// [END storage_pubsub_notification_pipeline]
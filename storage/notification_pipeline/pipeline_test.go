@@ -0,0 +1,96 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"github.com/GoogleCloudPlatform/golang-samples/internal/endpoint"
+	"github.com/GoogleCloudPlatform/golang-samples/internal/testutil"
+)
+
+func TestParseObjectEvent(t *testing.T) {
+	msg := &pubsub.Message{
+		ID: "1",
+		Attributes: map[string]string{
+			"eventType":          "OBJECT_FINALIZE",
+			"bucketId":           "my-bucket",
+			"objectId":           "foo.txt",
+			"objectGeneration":   "1600000000000000",
+			"eventTime":          "2020-09-13T12:26:40.000000Z",
+			"notificationConfig": "projects/_/buckets/my-bucket/notificationConfigs/1",
+			"payloadFormat":      "JSON_API_V1",
+		},
+		Data: []byte(`{"name":"foo.txt","bucket":"my-bucket","contentType":"text/plain","size":"11","md5Hash":"XrY7u+Ae7tCTyyK7j1rNww==","generation":"1600000000000000","timeCreated":"2020-09-13T12:26:40.000Z"}`),
+	}
+	e, err := parseObjectEvent(msg)
+	if err != nil {
+		t.Fatalf("parseObjectEvent: %v", err)
+	}
+	if got, want := e.ObjectGeneration, int64(1600000000000000); got != want {
+		t.Errorf("ObjectGeneration got %d, want %d", got, want)
+	}
+	if want := time.Date(2020, 9, 13, 12, 26, 40, 0, time.UTC); !e.EventTime.Equal(want) {
+		t.Errorf("EventTime got %v, want %v", e.EventTime, want)
+	}
+	if e.Object == nil {
+		t.Fatalf("Object is nil, want the decoded payload")
+	}
+	if got, want := e.Object.Size, int64(11); got != want {
+		t.Errorf("Object.Size got %d, want %d", got, want)
+	}
+	if got, want := e.Object.ContentType, "text/plain"; got != want {
+		t.Errorf("Object.ContentType got %q, want %q", got, want)
+	}
+
+	if _, err := parseObjectEvent(&pubsub.Message{ID: "2"}); err == nil {
+		t.Errorf("parseObjectEvent of a message without attributes got nil error, want non-nil")
+	}
+}
+
+func TestPipeline(t *testing.T) {
+	tc := testutil.SystemTest(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Minute)
+	defer cancel()
+
+	bucket := testutil.UniqueName("notification-pipeline")
+	testutil.CleanBucket(ctx, t, tc.ProjectID, bucket)
+
+	p, err := newPipeline(ctx, tc.ProjectID, bucket, "samples-notification-topic", "samples-notification-sub", endpoint.Storage(), endpoint.PubSub())
+	if err != nil {
+		t.Fatalf("newPipeline: %v", err)
+	}
+	defer func() {
+		if err := p.Close(context.Background()); err != nil {
+			t.Errorf("Close: %v", err)
+		}
+	}()
+
+	buf := new(bytes.Buffer)
+	if err := p.Run(ctx, buf, "foo.txt", []byte("hello world")); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if got, want := buf.String(), "Received OBJECT_FINALIZE for gs://"+bucket+"/foo.txt"; !strings.Contains(got, want) {
+		t.Errorf("Run got %q, want to contain %q", got, want)
+	}
+	if got, want := buf.String(), "Object size: 11 bytes"; !strings.Contains(got, want) {
+		t.Errorf("Run got %q, want to contain %q", got, want)
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objects
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"cloud.google.com/go/storage"
+	"github.com/GoogleCloudPlatform/golang-samples/internal/testutil"
+)
+
+// BenchmarkTransfer measures the upload and download samples. Run it with
+//
+//	GOLANG_SAMPLES_BENCHMARK=1 go test -run '^$' -bench Transfer ./storage/objects/
+func BenchmarkTransfer(b *testing.B) {
+	tc := testutil.BenchmarkTest(b)
+	ctx := context.Background()
+	client, err := storage.NewClient(ctx)
+	if err != nil {
+		b.Fatalf("storage.NewClient: %v", err)
+	}
+	defer client.Close()
+
+	bucket := testutil.UniqueName("benchmark")
+	testutil.CleanBucket(ctx, b, tc.ProjectID, bucket)
+	fi, err := os.Stat("notes.txt")
+	if err != nil {
+		b.Fatalf("os.Stat: %v", err)
+	}
+
+	b.Run("uploadFile", func(b *testing.B) {
+		b.SetBytes(fi.Size())
+		for i := 0; i < b.N; i++ {
+			object := fmt.Sprintf("upload-%d.txt", i)
+			if err := uploadFile(ctx, ioutil.Discard, client, bucket, object, "notes.txt"); err != nil {
+				b.Fatalf("uploadFile: %v", err)
+			}
+		}
+	})
+
+	const object = "download.txt"
+	if err := uploadFile(ctx, ioutil.Discard, client, bucket, object, "notes.txt"); err != nil {
+		b.Fatalf("uploadFile: %v", err)
+	}
+	b.Run("downloadFile", func(b *testing.B) {
+		b.SetBytes(fi.Size())
+		for i := 0; i < b.N; i++ {
+			if _, err := downloadFile(ctx, ioutil.Discard, client, bucket, object); err != nil {
+				b.Fatalf("downloadFile: %v", err)
+			}
+		}
+	})
+}
@@ -25,17 +25,11 @@ import (
 )
 
 // changeObjectStorageClass changes the storage class of a single object.
-func changeObjectStorageClass(w io.Writer, bucket, object string) error {
+func changeObjectStorageClass(ctx context.Context, w io.Writer, client *storage.Client, bucket, object string) error {
 	// bucket := "bucket-name"
 	// object := "object-name"
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
-
-	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	const timeout = time.Minute
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	bkt := client.Bucket(bucket)
@@ -48,7 +42,7 @@ func changeObjectStorageClass(w io.Writer, bucket, object string) error {
 	copier := obj.CopierFrom(obj)
 	copier.StorageClass = newStorageClass
 	if _, err := copier.Run(ctx); err != nil {
-		return fmt.Errorf("copier.Run: %v", err)
+		return fmt.Errorf("copier.Run: %w", err)
 	}
 	fmt.Fprintf(w, "Object %v in bucket %v had its storage class set to %v\n", object, bucket, newStorageClass)
 	return nil
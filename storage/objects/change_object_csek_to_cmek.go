@@ -26,24 +26,18 @@ import (
 
 // сhangeObjectCSEKtoKMS changes the key used to encrypt an object from
 // a customer-supplied encryption key to a customer-managed encryption key.
-func сhangeObjectCSEKToKMS(w io.Writer, bucket, object string, encryptionKey []byte, kmsKeyName string) error {
+func сhangeObjectCSEKToKMS(ctx context.Context, w io.Writer, client *storage.Client, bucket, object string, encryptionKey []byte, kmsKeyName string) error {
 	// bucket := "bucket-name"
 	// object := "object-name"
-
 	// encryptionKey is the Base64 encoded decryption key, which should be the same
 	// key originally used to encrypt the object.
 	// encryptionKey := []byte("TIbv/fjexq+VmtXzAlc63J4z5kFmWJ6NdAPQulQBT7g=")
 
 	// kmsKeyName is the name of the KMS key to manage this object with.
 	// kmsKeyName := "projects/projectId/locations/global/keyRings/keyRingID/cryptoKeys/cryptoKeyID"
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
 
-	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	const timeout = time.Minute
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	bkt := client.Bucket(bucket)
@@ -52,7 +46,7 @@ func сhangeObjectCSEKToKMS(w io.Writer, bucket, object string, encryptionKey []
 	c := obj.CopierFrom(src)
 	c.DestinationKMSKeyName = kmsKeyName
 	if _, err := c.Run(ctx); err != nil {
-		return fmt.Errorf("Copier.Run: %v", err)
+		return fmt.Errorf("Copier.Run: %w", err)
 	}
 	fmt.Fprintf(w, "Object %v in bucket %v is now managed by the KMS key %v instead of a customer-supplied encryption key\n", object, bucket, kmsKeyName)
 	return nil
@@ -25,20 +25,13 @@ import (
 )
 
 // composeFile composes source objects to create a composite object.
-func composeFile(w io.Writer, bucket, object1, object2, toObject string) error {
+func composeFile(ctx context.Context, w io.Writer, client *storage.Client, bucket, object1, object2, toObject string) error {
 	// bucket := "bucket-name"
 	// object1 := "object-name-1"
 	// object2 := "object-name-2"
 	// toObject := "object-name-3"
-
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
-
-	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	const timeout = time.Minute
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	src1 := client.Bucket(bucket).Object(object1)
@@ -47,9 +40,8 @@ func composeFile(w io.Writer, bucket, object1, object2, toObject string) error {
 
 	// ComposerFrom takes varargs, so you can put as many objects here
 	// as you want.
-	_, err = dst.ComposerFrom(src1, src2).Run(ctx)
-	if err != nil {
-		return fmt.Errorf("ComposerFrom: %v", err)
+	if _, err := dst.ComposerFrom(src1, src2).Run(ctx); err != nil {
+		return fmt.Errorf("ComposerFrom: %w", err)
 	}
 	fmt.Fprintf(w, "New composite object %v was created by combining %v and %v\n", toObject, object1, object2)
 	return nil
@@ -25,26 +25,20 @@ import (
 )
 
 // copyFile copies an object into specified bucket.
-func copyFile(w io.Writer, dstBucket, srcBucket, srcObject string) error {
+func copyFile(ctx context.Context, w io.Writer, client *storage.Client, dstBucket, srcBucket, srcObject, dstObject string) error {
 	// dstBucket := "bucket-1"
 	// srcBucket := "bucket-2"
 	// srcObject := "object"
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
-
-	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	// dstObject := "object-copy"
+	const timeout = time.Minute
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	dstObject := srcObject + "-copy"
 	src := client.Bucket(srcBucket).Object(srcObject)
 	dst := client.Bucket(dstBucket).Object(dstObject)
 
 	if _, err := dst.CopierFrom(src).Run(ctx); err != nil {
-		return fmt.Errorf("Object(%q).CopierFrom(%q).Run: %v", dstObject, srcObject, err)
+		return fmt.Errorf("Object(%q).CopierFrom(%q).Run: %w", dstObject, srcObject, err)
 	}
 	fmt.Fprintf(w, "Blob %v in bucket %v copied to blob %v in bucket %v.\n", srcObject, srcBucket, dstObject, dstBucket)
 	return nil
@@ -25,28 +25,22 @@ import (
 )
 
 // copyOldVersionOfObject copies a noncurrent version of an object.
-func copyOldVersionOfObject(w io.Writer, bucket, srcObject, dstObject string, gen int64) error {
+func copyOldVersionOfObject(ctx context.Context, w io.Writer, client *storage.Client, bucket, srcObject, dstObject string, gen int64) error {
 	// bucket := "bucket-name"
 	// srcObject := "source-object-name"
 	// dstObject := "destination-object-name"
-
 	// gen is the generation of srcObject to copy.
 	// gen := 1587012235914578
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
 
-	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	const timeout = time.Minute
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	src := client.Bucket(bucket).Object(srcObject)
 	dst := client.Bucket(bucket).Object(dstObject)
 
 	if _, err := dst.CopierFrom(src.Generation(gen)).Run(ctx); err != nil {
-		return fmt.Errorf("Object(%q).CopierFrom(%q).Generation(%v).Run: %v", dstObject, srcObject, gen, err)
+		return fmt.Errorf("Object(%q).CopierFrom(%q).Generation(%v).Run: %w", dstObject, srcObject, gen, err)
 	}
 	fmt.Fprintf(w, "Generation %v of object %v in bucket %v was copied to %v\n", gen, srcObject, bucket, dstObject)
 	return nil
@@ -25,22 +25,16 @@ import (
 )
 
 // deleteFile removes specified object.
-func deleteFile(w io.Writer, bucket, object string) error {
+func deleteFile(ctx context.Context, w io.Writer, client *storage.Client, bucket, object string) error {
 	// bucket := "bucket-name"
 	// object := "object-name"
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
-
-	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	o := client.Bucket(bucket).Object(object)
 	if err := o.Delete(ctx); err != nil {
-		return fmt.Errorf("Object(%q).Delete: %v", object, err)
+		return fmt.Errorf("Object(%q).Delete: %w", object, err)
 	}
 	fmt.Fprintf(w, "Blob %v deleted.\n", object)
 	return nil
@@ -25,25 +25,19 @@ import (
 )
 
 // deleteOldVersionOfObject deletes a noncurrent version of an object.
-func deleteOldVersionOfObject(w io.Writer, bucketName, objectName string, gen int64) error {
+func deleteOldVersionOfObject(ctx context.Context, w io.Writer, client *storage.Client, bucketName, objectName string, gen int64) error {
 	// bucketName := "bucket-name"
 	// objectName := "object-name"
-
 	// gen is the generation of objectName to delete.
 	// gen := 1587012235914578
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
 
-	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	obj := client.Bucket(bucketName).Object(objectName)
 	if err := obj.Generation(gen).Delete(ctx); err != nil {
-		return fmt.Errorf("Bucket(%q).Object(%q).Generation(%v).Delete: %v", bucketName, objectName, gen, err)
+		return fmt.Errorf("Bucket(%q).Object(%q).Generation(%v).Delete: %w", bucketName, objectName, gen, err)
 	}
 	fmt.Fprintf(w, "Generation %v of object %v was deleted from %v\n", gen, objectName, bucketName)
 	return nil
@@ -26,31 +26,25 @@ import (
 )
 
 // downloadEncryptedFile reads an encrypted object.
-func downloadEncryptedFile(w io.Writer, bucket, object string, secretKey []byte) ([]byte, error) {
+func downloadEncryptedFile(ctx context.Context, w io.Writer, client *storage.Client, bucket, object string, secretKey []byte) ([]byte, error) {
 	// bucket := "bucket-name"
 	// object := "object-name"
 	// key := []byte("secret-encryption-key")
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
-
 	obj := client.Bucket(bucket).Object(object)
 
-	ctx, cancel := context.WithTimeout(ctx, time.Second*50)
+	const timeout = time.Minute
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	rc, err := obj.Key(secretKey).NewReader(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("Object(%q).Key(%q).NewReader: %v", object, secretKey, err)
+		return nil, fmt.Errorf("Object(%q).Key(%q).NewReader: %w", object, secretKey, err)
 	}
 	defer rc.Close()
 
 	data, err := ioutil.ReadAll(rc)
 	if err != nil {
-		return nil, fmt.Errorf("ioutil.ReadAll: %v", err)
+		return nil, fmt.Errorf("ioutil.ReadAll: %w", err)
 	}
 	fmt.Fprintf(w, "File %v downloaded with encryption key.\n", object)
 	return data, nil
@@ -26,28 +26,22 @@ import (
 )
 
 // downloadFile downloads an object.
-func downloadFile(w io.Writer, bucket, object string) ([]byte, error) {
+func downloadFile(ctx context.Context, w io.Writer, client *storage.Client, bucket, object string) ([]byte, error) {
 	// bucket := "bucket-name"
 	// object := "object-name"
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
-
-	ctx, cancel := context.WithTimeout(ctx, time.Second*50)
+	const timeout = time.Minute
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	rc, err := client.Bucket(bucket).Object(object).NewReader(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("Object(%q).NewReader: %v", object, err)
+		return nil, fmt.Errorf("Object(%q).NewReader: %w", object, err)
 	}
 	defer rc.Close()
 
 	data, err := ioutil.ReadAll(rc)
 	if err != nil {
-		return nil, fmt.Errorf("ioutil.ReadAll: %v", err)
+		return nil, fmt.Errorf("ioutil.ReadAll: %w", err)
 	}
 	fmt.Fprintf(w, "Blob %v downloaded.\n", object)
 	return data, nil
@@ -0,0 +1,77 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objects
+
+// [START storage_download_file_handle_errors]
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/GoogleCloudPlatform/golang-samples/internal/apierrors"
+	"github.com/googleapis/gax-go/v2"
+)
+
+// downloadFileHandleErrors downloads an object and reacts to each kind of
+// failure differently, going by the error's code instead of its message.
+// The client retries transient failures with exponential backoff; all
+// others are returned straight away.
+func downloadFileHandleErrors(ctx context.Context, w io.Writer, client *storage.Client, bucket, object string) ([]byte, error) {
+	// bucket := "bucket-name"
+	// object := "object-name"
+	const timeout = time.Minute
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Make up to 5 attempts, starting 500ms apart, and retry the errors
+	// apierrors.Retryable lists.
+	o := client.Bucket(bucket).Object(object).Retryer(
+		storage.WithBackoff(gax.Backoff{Initial: 500 * time.Millisecond, Multiplier: 2}),
+		storage.WithMaxAttempts(5),
+		storage.WithErrorFunc(apierrors.Retryable),
+	)
+	data, err := readObject(ctx, o)
+	if errors.Is(err, storage.ErrObjectNotExist) || errors.Is(err, storage.ErrBucketNotExist) {
+		fmt.Fprintf(w, "Blob %v does not exist in bucket %v.\n", object, bucket)
+		return nil, err
+	}
+	switch action := apierrors.Decide(err); action {
+	case apierrors.ActionNone:
+		fmt.Fprintf(w, "Blob %v downloaded.\n", object)
+		return data, nil
+	case apierrors.ActionPermissionDenied:
+		fmt.Fprintf(w, "Not allowed to read blob %v; check the bucket's IAM policy.\n", object)
+		return nil, err
+	case apierrors.ActionRetry:
+		return nil, fmt.Errorf("giving up after 5 attempts: %w", err)
+	default:
+		return nil, fmt.Errorf("%v: %w", action, err)
+	}
+}
+
+func readObject(ctx context.Context, o *storage.ObjectHandle) ([]byte, error) {
+	rc, err := o.NewReader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return ioutil.ReadAll(rc)
+}
+
+// [END storage_download_file_handle_errors]
@@ -23,33 +23,27 @@ import (
 	"time"
 
 	"cloud.google.com/go/storage"
-	"google.golang.org/api/option"
 )
 
-// downloadPublicFile downloads a public object.
-func downloadPublicFile(w io.Writer, bucket, object string) ([]byte, error) {
+// downloadPublicFile downloads a public object. Public objects can be read
+// by a client that does not authenticate with the server, created with
+// storage.NewClient(ctx, option.WithoutAuthentication()).
+func downloadPublicFile(ctx context.Context, w io.Writer, client *storage.Client, bucket, object string) ([]byte, error) {
 	// bucket := "bucket-name"
 	// object := "object-name"
-	ctx := context.Background()
-	// Create a client that does not authenticate with the server.
-	client, err := storage.NewClient(ctx, option.WithoutAuthentication())
-	if err != nil {
-		return nil, fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
-
-	ctx, cancel := context.WithTimeout(ctx, time.Second*50)
+	const timeout = time.Minute
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	rc, err := client.Bucket(bucket).Object(object).NewReader(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("Object(%q).NewReader: %v", object, err)
+		return nil, fmt.Errorf("Object(%q).NewReader: %w", object, err)
 	}
 	defer rc.Close()
 
 	data, err := ioutil.ReadAll(rc)
 	if err != nil {
-		return nil, fmt.Errorf("ioutil.ReadAll: %v", err)
+		return nil, fmt.Errorf("ioutil.ReadAll: %w", err)
 	}
 	fmt.Fprintf(w, "Blob %v downloaded.\n", object)
 	return data, nil
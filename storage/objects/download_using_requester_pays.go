@@ -26,38 +26,32 @@ import (
 )
 
 // downloadUsingRequesterPays downloads an object using billing project.
-func downloadUsingRequesterPays(w io.Writer, bucket, object, billingProjectID string) error {
+func downloadUsingRequesterPays(ctx context.Context, w io.Writer, client *storage.Client, bucket, object, billingProjectID string) error {
 	// bucket := "bucket-name"
 	// object := "object-name"
 	// billingProjectID := "billing_account_id"
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
-
 	b := client.Bucket(bucket).UserProject(billingProjectID)
 	src := b.Object(object)
 
 	// Open local file.
 	f, err := os.OpenFile("notes.txt", os.O_RDWR|os.O_CREATE, 0755)
 	if err != nil {
-		return fmt.Errorf("os.OpenFile: %v", err)
+		return fmt.Errorf("os.OpenFile: %w", err)
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, time.Second*50)
+	const timeout = time.Minute
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	rc, err := src.NewReader(ctx)
 	if err != nil {
-		return fmt.Errorf("Object(%q).NewReader: %v", object, err)
+		return fmt.Errorf("Object(%q).NewReader: %w", object, err)
 	}
 	if _, err := io.Copy(f, rc); err != nil {
-		return fmt.Errorf("io.Copy: %v", err)
+		return fmt.Errorf("io.Copy: %w", err)
 	}
 	if err := rc.Close(); err != nil {
-		return fmt.Errorf("Reader.Close: %v", err)
+		return fmt.Errorf("Reader.Close: %w", err)
 	}
 	fmt.Fprintf(w, "Downloaded using %v as billing project.\n", billingProjectID)
 	return nil
@@ -0,0 +1,90 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objects
+
+// [START storage_download_with_fallback]
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/GoogleCloudPlatform/golang-samples/internal/apierrors"
+)
+
+// downloadWithFallback reads an object through primary and, if that fails
+// with a transient error or takes too long, reads it again through
+// secondary. For a dual-region or multi-region bucket, configure the two
+// clients to reach it by different paths, so that an outage of one doesn't
+// affect the other. For example, a gRPC client and a JSON client with a
+// regional endpoint:
+//
+//	primary, err := storage.NewGRPCClient(ctx)
+//	secondary, err := storage.NewClient(ctx, option.WithEndpoint("https://storage.us-east1.rep.googleapis.com/storage/v1/"))
+func downloadWithFallback(ctx context.Context, w io.Writer, primary, secondary *storage.Client, bucket, object string) ([]byte, error) {
+	// bucket := "bucket-name"
+	// object := "object-name"
+
+	// attemptTimeout bounds the read through the primary client, so that a
+	// hung connection leaves time to try the secondary. Raise it for large
+	// objects.
+	const attemptTimeout = 10 * time.Second
+	const timeout = time.Minute
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	read := func(ctx context.Context, o *storage.ObjectHandle) ([]byte, error) {
+		r, err := o.NewReader(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("Object(%q).NewReader: %w", object, err)
+		}
+		defer r.Close()
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return nil, fmt.Errorf("io.ReadAll: %w", err)
+		}
+		return data, nil
+	}
+
+	// Retry once through the primary client before falling back, rather
+	// than retrying until attemptTimeout.
+	o := primary.Bucket(bucket).Object(object).Retryer(storage.WithMaxAttempts(2))
+	attemptCtx, cancelAttempt := context.WithTimeout(ctx, attemptTimeout)
+	data, err := read(attemptCtx, o)
+	cancelAttempt()
+	if err == nil {
+		fmt.Fprintf(w, "Blob %v downloaded through the primary client.\n", object)
+		return data, nil
+	}
+	// Fall back only when another path could succeed: not for a missing
+	// object or a denied request, which the secondary would report too, and
+	// not when the caller's own context is done.
+	attemptTimedOut := errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil
+	if !apierrors.Retryable(err) && !attemptTimedOut {
+		return nil, err
+	}
+	fmt.Fprintf(w, "Primary read of %v failed (%v), falling back.\n", object, err)
+
+	data, err = read(ctx, secondary.Bucket(bucket).Object(object))
+	if err != nil {
+		return nil, fmt.Errorf("secondary: %w", err)
+	}
+	fmt.Fprintf(w, "Blob %v downloaded through the secondary client.\n", object)
+	return data, nil
+}
+
+// [END storage_download_with_fallback]
@@ -0,0 +1,163 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objects
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"cloud.google.com/go/storage"
+)
+
+// The functions below export the samples that storage/cmd/gcsutil and
+// cmd/samplectl are built from, so that the tools run the same code as the
+// documentation.
+
+// ListFilesWithPrefix runs the storage_list_files_with_prefix sample.
+func ListFilesWithPrefix(ctx context.Context, w io.Writer, client *storage.Client, bucket, prefix, delim string) error {
+	return listFilesWithPrefix(ctx, w, client, bucket, prefix, delim)
+}
+
+// CopyFile runs the storage_copy_file sample.
+func CopyFile(ctx context.Context, w io.Writer, client *storage.Client, dstBucket, srcBucket, srcObject, dstObject string) error {
+	return copyFile(ctx, w, client, dstBucket, srcBucket, srcObject, dstObject)
+}
+
+// UploadFile runs the storage_upload_file sample.
+func UploadFile(ctx context.Context, w io.Writer, client *storage.Client, bucket, object, fileName string) error {
+	return uploadFile(ctx, w, client, bucket, object, fileName)
+}
+
+// DownloadFile runs the storage_download_file sample.
+func DownloadFile(ctx context.Context, w io.Writer, client *storage.Client, bucket, object string) ([]byte, error) {
+	return downloadFile(ctx, w, client, bucket, object)
+}
+
+// DeleteFile runs the storage_delete_file sample.
+func DeleteFile(ctx context.Context, w io.Writer, client *storage.Client, bucket, object string) error {
+	return deleteFile(ctx, w, client, bucket, object)
+}
+
+// GetMetadata runs the storage_get_metadata sample.
+func GetMetadata(ctx context.Context, w io.Writer, client *storage.Client, bucket, object string) (*storage.ObjectAttrs, error) {
+	return getMetadata(ctx, w, client, bucket, object)
+}
+
+// ChangeObjectStorageClass runs the storage_change_file_storage_class sample.
+func ChangeObjectStorageClass(ctx context.Context, w io.Writer, client *storage.Client, bucket, object string) error {
+	return changeObjectStorageClass(ctx, w, client, bucket, object)
+}
+
+// ComposeFile runs the storage_compose_file sample.
+func ComposeFile(ctx context.Context, w io.Writer, client *storage.Client, bucket, object1, object2, toObject string) error {
+	return composeFile(ctx, w, client, bucket, object1, object2, toObject)
+}
+
+// CopyOldVersionOfObject runs the storage_copy_file_archived_generation sample.
+func CopyOldVersionOfObject(ctx context.Context, w io.Writer, client *storage.Client, bucket, srcObject, dstObject string, gen int64) error {
+	return copyOldVersionOfObject(ctx, w, client, bucket, srcObject, dstObject, gen)
+}
+
+// DeleteOldVersionOfObject runs the storage_delete_file_archived_generation sample.
+func DeleteOldVersionOfObject(ctx context.Context, w io.Writer, client *storage.Client, bucketName, objectName string, gen int64) error {
+	return deleteOldVersionOfObject(ctx, w, client, bucketName, objectName, gen)
+}
+
+// DisableVersioning runs the storage_disable_versioning sample.
+func DisableVersioning(ctx context.Context, w io.Writer, client *storage.Client, bucketName string) error {
+	return disableVersioning(ctx, w, client, bucketName)
+}
+
+// DownloadPublicFile runs the storage_download_public_file sample.
+func DownloadPublicFile(ctx context.Context, w io.Writer, client *storage.Client, bucket, object string) ([]byte, error) {
+	return downloadPublicFile(ctx, w, client, bucket, object)
+}
+
+// DownloadUsingRequesterPays runs the storage_download_file_requester_pays sample.
+func DownloadUsingRequesterPays(ctx context.Context, w io.Writer, client *storage.Client, bucket, object, billingProjectID string) error {
+	return downloadUsingRequesterPays(ctx, w, client, bucket, object, billingProjectID)
+}
+
+// EnableVersioning runs the storage_enable_versioning sample.
+func EnableVersioning(ctx context.Context, w io.Writer, client *storage.Client, bucketName string) error {
+	return enableVersioning(ctx, w, client, bucketName)
+}
+
+// GenerateV4GetObjectSignedURL runs the storage_generate_signed_url_v4 sample.
+func GenerateV4GetObjectSignedURL(w io.Writer, bucket, object, serviceAccount string) (string, error) {
+	return generateV4GetObjectSignedURL(w, bucket, object, serviceAccount)
+}
+
+// GenerateV4PutObjectSignedURL runs the storage_generate_upload_signed_url_v4 sample.
+func GenerateV4PutObjectSignedURL(w io.Writer, bucket, object, serviceAccount string) (string, error) {
+	return generateV4PutObjectSignedURL(w, bucket, object, serviceAccount)
+}
+
+// HoldInventory runs the storage_hold_inventory sample.
+func HoldInventory(ctx context.Context, w io.Writer, client *storage.Client, bucket string) error {
+	_, err := holdInventory(ctx, w, client, bucket)
+	return err
+}
+
+// ListFiles runs the storage_list_files sample.
+func ListFiles(ctx context.Context, w io.Writer, client *storage.Client, bucket string) error {
+	return listFiles(ctx, w, client, bucket)
+}
+
+// ListFilesAllVersion runs the storage_list_file_archived_generations sample.
+func ListFilesAllVersion(ctx context.Context, w io.Writer, client *storage.Client, bucket string) error {
+	return listFilesAllVersion(ctx, w, client, bucket)
+}
+
+// MoveFile runs the storage_move_file sample.
+func MoveFile(ctx context.Context, w io.Writer, client *storage.Client, bucket, object string) error {
+	return moveFile(ctx, w, client, bucket, object)
+}
+
+// ReleaseEventBasedHold runs the storage_release_event_based_hold sample.
+func ReleaseEventBasedHold(ctx context.Context, w io.Writer, client *storage.Client, bucket, object string) error {
+	return releaseEventBasedHold(ctx, w, client, bucket, object)
+}
+
+// ReleaseTemporaryHold runs the storage_release_temporary_hold sample.
+func ReleaseTemporaryHold(ctx context.Context, w io.Writer, client *storage.Client, bucket, object string) error {
+	return releaseTemporaryHold(ctx, w, client, bucket, object)
+}
+
+// SetEventBasedHold runs the storage_set_event_based_hold sample.
+func SetEventBasedHold(ctx context.Context, w io.Writer, client *storage.Client, bucket, object string) error {
+	return setEventBasedHold(ctx, w, client, bucket, object)
+}
+
+// SetTemporaryHold runs the storage_set_temporary_hold sample.
+func SetTemporaryHold(ctx context.Context, w io.Writer, client *storage.Client, bucket, object string) error {
+	return setTemporaryHold(ctx, w, client, bucket, object)
+}
+
+// UploadFileIfNotExists runs the storage_upload_file_if_not_exists sample.
+func UploadFileIfNotExists(ctx context.Context, w io.Writer, client *storage.Client, bucket, object, content string) (created bool, err error) {
+	return uploadFileIfNotExists(ctx, w, client, bucket, object, content)
+}
+
+// UploadWithKMSKey runs the storage_upload_with_kms_key sample.
+func UploadWithKMSKey(ctx context.Context, w io.Writer, client *storage.Client, bucket, object, keyName string) error {
+	return uploadWithKMSKey(ctx, w, client, bucket, object, keyName)
+}
+
+// UploadWithRetryAfter runs the storage_upload_with_retry_after sample.
+func UploadWithRetryAfter(ctx context.Context, w io.Writer, client *storage.Client, bucket, object, fileName string, maxElapsed time.Duration) error {
+	return uploadWithRetryAfter(ctx, w, client, bucket, object, fileName, maxElapsed)
+}
@@ -45,11 +45,11 @@ func generateSignedPostPolicyV4(w io.Writer, bucket, object, serviceAccountJSONP
 	// serviceAccountJSONPath := "service_account.json"
 	jsonKey, err := ioutil.ReadFile(serviceAccountJSONPath)
 	if err != nil {
-		return nil, fmt.Errorf("ioutil.ReadFile: %v", err)
+		return nil, fmt.Errorf("ioutil.ReadFile: %w", err)
 	}
 	conf, err := google.JWTConfigFromJSON(jsonKey)
 	if err != nil {
-		return nil, fmt.Errorf("google.JWTConfigFromJSON: %v", err)
+		return nil, fmt.Errorf("google.JWTConfigFromJSON: %w", err)
 	}
 	metadata := map[string]string{
 		"x-goog-meta-test": "data",
@@ -65,12 +65,12 @@ func generateSignedPostPolicyV4(w io.Writer, bucket, object, serviceAccountJSONP
 
 	policy, err := storage.GenerateSignedPostPolicyV4(bucket, object, opts)
 	if err != nil {
-		return nil, fmt.Errorf("storage.GenerateSignedPostPolicyV4: %v", err)
+		return nil, fmt.Errorf("storage.GenerateSignedPostPolicyV4: %w", err)
 	}
 
 	// Generate the form, using the data from the policy.
 	if err = tmpl.Execute(w, policy); err != nil {
-		return policy, fmt.Errorf("executing template: %v", err)
+		return policy, fmt.Errorf("executing template: %w", err)
 	}
 
 	return policy, nil
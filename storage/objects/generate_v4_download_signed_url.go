@@ -0,0 +1,126 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objects
+
+// [START storage_generate_signed_url_v4_download]
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/url"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"golang.org/x/oauth2/google"
+)
+
+// generateV4DownloadSignedURL generates a GET signed URL that makes browsers
+// save the object as fileName, with the given Content-Type, instead of
+// displaying it. The response headers are set by signed query parameters, so
+// the holder of the URL can't change them, for example to have an uploaded
+// HTML file rendered on the bucket's domain.
+func generateV4DownloadSignedURL(w io.Writer, bucket, object, fileName, contentType, serviceAccount string) (string, error) {
+	// bucket := "bucket-name"
+	// object := "users/123/report-7d1f.pdf"
+	// fileName := "Quarterly report.pdf"
+	// contentType := "application/pdf"
+	// serviceAccount := "service_account.json"
+	jsonKey, err := ioutil.ReadFile(serviceAccount)
+	if err != nil {
+		return "", fmt.Errorf("ioutil.ReadFile: %w", err)
+	}
+	conf, err := google.JWTConfigFromJSON(jsonKey)
+	if err != nil {
+		return "", fmt.Errorf("google.JWTConfigFromJSON: %w", err)
+	}
+
+	// FormatMediaType quotes the name, and encodes names that aren't
+	// plain ASCII as filename*=utf-8''..., as RFC 6266 requires.
+	disposition := mime.FormatMediaType("attachment", map[string]string{"filename": fileName})
+	if fileName == "" || disposition == "" {
+		return "", fmt.Errorf("invalid file name %q", fileName)
+	}
+	opts := &storage.SignedURLOptions{
+		Scheme:         storage.SigningSchemeV4,
+		Method:         "GET",
+		GoogleAccessID: conf.Email,
+		PrivateKey:     conf.PrivateKey,
+		Expires:        time.Now().Add(15 * time.Minute),
+		QueryParameters: url.Values{
+			"response-content-disposition": {disposition},
+			"response-content-type":        {contentType},
+		},
+	}
+	u, err := storage.SignedURL(bucket, object, opts)
+	if err != nil {
+		return "", fmt.Errorf("storage.SignedURL: %w", err)
+	}
+
+	fmt.Fprintln(w, "Generated GET signed URL:")
+	fmt.Fprintf(w, "%q\n", u)
+	fmt.Fprintf(w, "It downloads the object as %s.\n", disposition)
+	return u, nil
+}
+
+// generateV4TypedUploadSignedURL generates a PUT signed URL that only
+// accepts an upload with the given Content-Type, and stores the object with
+// a Content-Disposition that makes later downloads save it as fileName. The
+// headers are signed: an upload that omits them or sends other values is
+// rejected.
+func generateV4TypedUploadSignedURL(w io.Writer, bucket, object, fileName, contentType, serviceAccount string) (string, error) {
+	// bucket := "bucket-name"
+	// object := "users/123/report-7d1f.pdf"
+	// fileName := "Quarterly report.pdf"
+	// contentType := "application/pdf"
+	// serviceAccount := "service_account.json"
+	jsonKey, err := ioutil.ReadFile(serviceAccount)
+	if err != nil {
+		return "", fmt.Errorf("ioutil.ReadFile: %w", err)
+	}
+	conf, err := google.JWTConfigFromJSON(jsonKey)
+	if err != nil {
+		return "", fmt.Errorf("google.JWTConfigFromJSON: %w", err)
+	}
+
+	disposition := mime.FormatMediaType("attachment", map[string]string{"filename": fileName})
+	if fileName == "" || disposition == "" {
+		return "", fmt.Errorf("invalid file name %q", fileName)
+	}
+	headers := []string{
+		"Content-Type: " + contentType,
+		"Content-Disposition: " + disposition,
+	}
+	opts := &storage.SignedURLOptions{
+		Scheme:         storage.SigningSchemeV4,
+		Method:         "PUT",
+		GoogleAccessID: conf.Email,
+		PrivateKey:     conf.PrivateKey,
+		Expires:        time.Now().Add(15 * time.Minute),
+		Headers:        headers,
+	}
+	u, err := storage.SignedURL(bucket, object, opts)
+	if err != nil {
+		return "", fmt.Errorf("storage.SignedURL: %w", err)
+	}
+
+	fmt.Fprintln(w, "Generated PUT signed URL:")
+	fmt.Fprintf(w, "%q\n", u)
+	fmt.Fprintln(w, "Upload with exactly these headers, for example:")
+	fmt.Fprintf(w, "curl -X PUT -H %q -H %q --upload-file my-file %q\n", headers[0], headers[1], u)
+	return u, nil
+}
+
+// [END storage_generate_signed_url_v4_download]
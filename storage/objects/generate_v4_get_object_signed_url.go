@@ -32,11 +32,11 @@ func generateV4GetObjectSignedURL(w io.Writer, bucket, object, serviceAccount st
 	// serviceAccount := "service_account.json"
 	jsonKey, err := ioutil.ReadFile(serviceAccount)
 	if err != nil {
-		return "", fmt.Errorf("ioutil.ReadFile: %v", err)
+		return "", fmt.Errorf("ioutil.ReadFile: %w", err)
 	}
 	conf, err := google.JWTConfigFromJSON(jsonKey)
 	if err != nil {
-		return "", fmt.Errorf("google.JWTConfigFromJSON: %v", err)
+		return "", fmt.Errorf("google.JWTConfigFromJSON: %w", err)
 	}
 	opts := &storage.SignedURLOptions{
 		Scheme:         storage.SigningSchemeV4,
@@ -47,7 +47,7 @@ func generateV4GetObjectSignedURL(w io.Writer, bucket, object, serviceAccount st
 	}
 	u, err := storage.SignedURL(bucket, object, opts)
 	if err != nil {
-		return "", fmt.Errorf("storage.SignedURL: %v", err)
+		return "", fmt.Errorf("storage.SignedURL: %w", err)
 	}
 
 	fmt.Fprintln(w, "Generated GET signed URL:")
@@ -25,23 +25,17 @@ import (
 )
 
 // getMetadata prints all of the object attributes.
-func getMetadata(w io.Writer, bucket, object string) (*storage.ObjectAttrs, error) {
+func getMetadata(ctx context.Context, w io.Writer, client *storage.Client, bucket, object string) (*storage.ObjectAttrs, error) {
 	// bucket := "bucket-name"
 	// object := "object-name"
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
-
-	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	o := client.Bucket(bucket).Object(object)
 	attrs, err := o.Attrs(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("Object(%q).Attrs: %v", object, err)
+		return nil, fmt.Errorf("Object(%q).Attrs: %w", object, err)
 	}
 	fmt.Fprintf(w, "Bucket: %v\n", attrs.Bucket)
 	fmt.Fprintf(w, "CacheControl: %v\n", attrs.CacheControl)
@@ -26,16 +26,10 @@ import (
 )
 
 // listFiles lists objects within specified bucket.
-func listFiles(w io.Writer, bucket string) error {
+func listFiles(ctx context.Context, w io.Writer, client *storage.Client, bucket string) error {
 	// bucket := "bucket-name"
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
-
-	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	const timeout = 30 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	it := client.Bucket(bucket).Objects(ctx, nil)
@@ -45,7 +39,7 @@ func listFiles(w io.Writer, bucket string) error {
 			break
 		}
 		if err != nil {
-			return fmt.Errorf("Bucket(%q).Objects: %v", bucket, err)
+			return fmt.Errorf("Bucket(%q).Objects: %w", bucket, err)
 		}
 		fmt.Fprintln(w, attrs.Name)
 	}
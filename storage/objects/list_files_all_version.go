@@ -26,16 +26,10 @@ import (
 )
 
 // listFilesAllVersion lists both live and noncurrent versions of objects within specified bucket.
-func listFilesAllVersion(w io.Writer, bucket string) error {
+func listFilesAllVersion(ctx context.Context, w io.Writer, client *storage.Client, bucket string) error {
 	// bucket := "bucket-name"
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
-
-	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	const timeout = 30 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	it := client.Bucket(bucket).Objects(ctx, &storage.Query{
@@ -48,7 +42,7 @@ func listFilesAllVersion(w io.Writer, bucket string) error {
 			break
 		}
 		if err != nil {
-			return fmt.Errorf("Bucket(%q).Objects(): %v", bucket, err)
+			return fmt.Errorf("Bucket(%q).Objects(): %w", bucket, err)
 		}
 		fmt.Fprintln(w, attrs.Name, attrs.Generation, attrs.Metageneration)
 	}
@@ -26,17 +26,10 @@ import (
 )
 
 // listFilesWithPrefix lists objects using prefix and delimeter.
-func listFilesWithPrefix(w io.Writer, bucket, prefix, delim string) error {
+func listFilesWithPrefix(ctx context.Context, w io.Writer, client *storage.Client, bucket, prefix, delim string) error {
 	// bucket := "bucket-name"
 	// prefix := "/foo"
 	// delim := "_"
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
-
 	// Prefixes and delimiters can be used to emulate directory listings.
 	// Prefixes can be used to filter objects starting with prefix.
 	// The delimiter argument can be used to restrict the results to only the
@@ -53,7 +46,8 @@ func listFilesWithPrefix(w io.Writer, bucket, prefix, delim string) error {
 	//
 	// However, if you specify prefix="a/" and delim="/", you'll get back:
 	//   /a/1.txt
-	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	const timeout = 30 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	it := client.Bucket(bucket).Objects(ctx, &storage.Query{
@@ -66,7 +60,7 @@ func listFilesWithPrefix(w io.Writer, bucket, prefix, delim string) error {
 			break
 		}
 		if err != nil {
-			return fmt.Errorf("Bucket(%q).Objects(): %v", bucket, err)
+			return fmt.Errorf("Bucket(%q).Objects(): %w", bucket, err)
 		}
 		fmt.Fprintln(w, attrs.Name)
 	}
@@ -487,12 +487,12 @@ func generateV4GetObjectSignedURL(w io.Writer, client *storage.Client, bucketNam
 	// [START storage_generate_signed_url_v4]
 	jsonKey, err := ioutil.ReadFile(serviceAccount)
 	if err != nil {
-		return "", fmt.Errorf("cannot read the JSON key file, err: %v", err)
+		return "", fmt.Errorf("cannot read the JSON key file, err: %w", err)
 	}
 
 	conf, err := google.JWTConfigFromJSON(jsonKey)
 	if err != nil {
-		return "", fmt.Errorf("google.JWTConfigFromJSON: %v", err)
+		return "", fmt.Errorf("google.JWTConfigFromJSON: %w", err)
 	}
 
 	opts := &storage.SignedURLOptions{
@@ -505,7 +505,7 @@ func generateV4GetObjectSignedURL(w io.Writer, client *storage.Client, bucketNam
 
 	u, err := storage.SignedURL(bucketName, objectName, opts)
 	if err != nil {
-		return "", fmt.Errorf("Unable to generate a signed URL: %v", err)
+		return "", fmt.Errorf("Unable to generate a signed URL: %w", err)
 	}
 
 	fmt.Fprintln(w, "Generated GET signed URL:")
@@ -520,11 +520,11 @@ func generateV4PutObjectSignedURL(w io.Writer, client *storage.Client, bucketNam
 	// [START storage_generate_upload_signed_url_v4]
 	jsonKey, err := ioutil.ReadFile(serviceAccount)
 	if err != nil {
-		return "", fmt.Errorf("cannot read the JSON key file, err: %v", err)
+		return "", fmt.Errorf("cannot read the JSON key file, err: %w", err)
 	}
 	conf, err := google.JWTConfigFromJSON(jsonKey)
 	if err != nil {
-		return "", fmt.Errorf("google.JWTConfigFromJSON: %v", err)
+		return "", fmt.Errorf("google.JWTConfigFromJSON: %w", err)
 	}
 
 	opts := &storage.SignedURLOptions{
@@ -540,7 +540,7 @@ func generateV4PutObjectSignedURL(w io.Writer, client *storage.Client, bucketNam
 
 	u, err := storage.SignedURL(bucketName, objectName, opts)
 	if err != nil {
-		return "", fmt.Errorf("Unable to generate a signed URL: %v", err)
+		return "", fmt.Errorf("Unable to generate a signed URL: %w", err)
 	}
 
 	fmt.Fprintln(w, "Generated PUT signed URL:")
@@ -551,8 +551,6 @@ func generateV4PutObjectSignedURL(w io.Writer, client *storage.Client, bucketNam
 	return u, nil
 }
 
-// TODO(jbd): Add test for downloadUsingRequesterPays.
-
 const helptext = `usage: objects -o=bucket:name [subcommand] <args...>
 
 subcommands:
@@ -25,22 +25,16 @@ import (
 )
 
 // makePublic gives all users read access to an object.
-func makePublic(w io.Writer, bucket, object string, entity storage.ACLEntity, role storage.ACLRole) error {
+func makePublic(ctx context.Context, w io.Writer, client *storage.Client, bucket, object string, entity storage.ACLEntity, role storage.ACLRole) error {
 	// bucket := "bucket-name"
 	// object := "object-name"
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
-
-	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	acl := client.Bucket(bucket).Object(object).ACL()
 	if err := acl.Set(ctx, entity, role); err != nil {
-		return fmt.Errorf("ACLHandle.Set: %v", err)
+		return fmt.Errorf("ACLHandle.Set: %w", err)
 	}
 	fmt.Fprintf(w, "Blob %v is now publicly accessible.\n", object)
 	return nil
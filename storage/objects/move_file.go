@@ -25,17 +25,11 @@ import (
 )
 
 // moveFile moves an object into another location.
-func moveFile(w io.Writer, bucket, object string) error {
+func moveFile(ctx context.Context, w io.Writer, client *storage.Client, bucket, object string) error {
 	// bucket := "bucket-name"
 	// object := "object-name"
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
-
-	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	const timeout = time.Minute
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	dstName := object + "-rename"
@@ -43,10 +37,10 @@ func moveFile(w io.Writer, bucket, object string) error {
 	dst := client.Bucket(bucket).Object(dstName)
 
 	if _, err := dst.CopierFrom(src).Run(ctx); err != nil {
-		return fmt.Errorf("Object(%q).CopierFrom(%q).Run: %v", dstName, object, err)
+		return fmt.Errorf("Object(%q).CopierFrom(%q).Run: %w", dstName, object, err)
 	}
 	if err := src.Delete(ctx); err != nil {
-		return fmt.Errorf("Object(%q).Delete: %v", object, err)
+		return fmt.Errorf("Object(%q).Delete: %w", object, err)
 	}
 	fmt.Fprintf(w, "Blob %v moved to %v.\n", object, dstName)
 	return nil
@@ -17,19 +17,33 @@ package objects
 import (
 	"bytes"
 	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"mime/multipart"
 	"net/http"
+	"net/http/httptest"
 	"net/http/httputil"
+	"net/url"
 	"os"
+	"path/filepath"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"cloud.google.com/go/storage"
+	"github.com/GoogleCloudPlatform/golang-samples/internal/apierrors"
 	"github.com/GoogleCloudPlatform/golang-samples/internal/testutil"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // TestObjects runs all samples tests of the package.
@@ -42,34 +56,37 @@ func TestObjects(t *testing.T) {
 	}
 	defer client.Close()
 
+	// The objects live in the package's shared bucket, under this test's
+	// prefix. Versioning is a bucket setting, so the versioning samples get
+	// a bucket of their own.
 	var (
-		bucket           = tc.ProjectID + "-samples-object-bucket-1"
-		dstBucket        = tc.ProjectID + "-samples-object-bucket-2"
-		bucketVersioning = tc.ProjectID + "-bucket-versioning-enabled"
-		object1          = "foo.txt"
-		object2          = "foo/a.txt"
+		bucket           = testutil.SharedBucket(ctx, t, tc.ProjectID)
+		dstBucket        = bucket
+		bucketVersioning = testutil.UniqueName("object-versioning")
+		prefix           = testutil.Prefix(t)
+		object1          = prefix + "foo.txt"
+		object2          = prefix + "foo/a.txt"
 		object3          = "bar.txt"
-		dstObj           = "foobar.txt"
+		dstObj           = prefix + "foobar.txt"
 		allUsers         = storage.AllUsers
 		roleReader       = storage.RoleReader
 	)
+	t.Parallel()
 
-	testutil.CleanBucket(ctx, t, tc.ProjectID, bucket)
-	testutil.CleanBucket(ctx, t, tc.ProjectID, dstBucket)
 	testutil.CleanBucket(ctx, t, tc.ProjectID, bucketVersioning)
 
-	if err := enableVersioning(ioutil.Discard, bucketVersioning); err != nil {
+	if err := enableVersioning(ctx, ioutil.Discard, client, bucketVersioning); err != nil {
 		t.Fatalf("enableVersioning: %v", err)
 	}
 
-	if err := uploadFile(ioutil.Discard, bucket, object1); err != nil {
+	if err := uploadFile(ctx, ioutil.Discard, client, bucket, object1, "notes.txt"); err != nil {
 		t.Fatalf("uploadFile(%q): %v", object1, err)
 	}
-	if err := uploadFile(ioutil.Discard, bucket, object2); err != nil {
+	if err := uploadFile(ctx, ioutil.Discard, client, bucket, object2, "notes.txt"); err != nil {
 		t.Fatalf("uploadFile(%q): %v", object2, err)
 	}
 
-	if err := uploadFile(ioutil.Discard, bucketVersioning, object1); err != nil {
+	if err := uploadFile(ctx, ioutil.Discard, client, bucketVersioning, object1, "notes.txt"); err != nil {
 		t.Fatalf("uploadFile(%q): %v", object1, err)
 	}
 	// Check enableVersioning correctly work.
@@ -86,14 +103,14 @@ func TestObjects(t *testing.T) {
 	// Keep the original generation of object1 before re-uploading
 	// to use in the versioning samples.
 	gen := attrs.Generation
-	if err := uploadFile(ioutil.Discard, bucketVersioning, object1); err != nil {
+	if err := uploadFile(ctx, ioutil.Discard, client, bucketVersioning, object1, "notes.txt"); err != nil {
 		t.Fatalf("uploadFile(%q): %v", object1, err)
 	}
 
 	{
 		// Should only show "foo/a.txt", not "foo.txt"
 		var buf bytes.Buffer
-		if err := listFiles(&buf, bucket); err != nil {
+		if err := listFiles(ctx, &buf, client, bucket); err != nil {
 			t.Fatalf("listFiles: %v", err)
 		}
 		if got, want := buf.String(), object1; !strings.Contains(got, want) {
@@ -106,9 +123,9 @@ func TestObjects(t *testing.T) {
 
 	{
 		// Should only show "foo/a.txt", not "foo.txt"
-		const prefix = "foo/"
+		prefix := prefix + "foo/"
 		var buf bytes.Buffer
-		if err := listFilesWithPrefix(&buf, bucket, prefix, ""); err != nil {
+		if err := listFilesWithPrefix(ctx, &buf, client, bucket, prefix, ""); err != nil {
 			t.Fatalf("listFilesWithPrefix: %v", err)
 		}
 		if got, want := buf.String(), object1; strings.Contains(got, want) {
@@ -122,7 +139,7 @@ func TestObjects(t *testing.T) {
 	{
 		// Should show 2 versions of foo.txt
 		var buf bytes.Buffer
-		if err := listFilesAllVersion(&buf, bucketVersioning); err != nil {
+		if err := listFilesAllVersion(ctx, &buf, client, bucketVersioning); err != nil {
 			t.Fatalf("listFilesAllVersion: %v", err)
 		}
 
@@ -139,14 +156,14 @@ func TestObjects(t *testing.T) {
 	}
 
 	{
-		if err := downloadUsingRequesterPays(ioutil.Discard, bucket, object1, tc.ProjectID); err != nil {
+		if err := downloadUsingRequesterPays(ctx, ioutil.Discard, client, bucket, object1, tc.ProjectID); err != nil {
 			t.Errorf("downloadUsingRequesterPays: %v", err)
 		}
 	}
 	t.Run("changeObjectStorageClass", func(t *testing.T) {
 		bkt := client.Bucket(bucket)
 		obj := bkt.Object(object1)
-		if err := changeObjectStorageClass(ioutil.Discard, bucket, object1); err != nil {
+		if err := changeObjectStorageClass(ctx, ioutil.Discard, client, bucket, object1); err != nil {
 			t.Errorf("changeObjectStorageClass: %v", err)
 		}
 		wantStorageClass := "COLDLINE"
@@ -158,14 +175,14 @@ func TestObjects(t *testing.T) {
 			t.Errorf("object storage class: got %q, want %q", oattrs.StorageClass, wantStorageClass)
 		}
 	})
-	if err := copyOldVersionOfObject(ioutil.Discard, bucketVersioning, object1, object3, gen); err != nil {
+	if err := copyOldVersionOfObject(ctx, ioutil.Discard, client, bucketVersioning, object1, object3, gen); err != nil {
 		t.Fatalf("copyOldVersionOfObject: %v", err)
 	}
 	// Delete the first version of an object1 for a bucketVersioning.
-	if err := deleteOldVersionOfObject(ioutil.Discard, bucketVersioning, object1, gen); err != nil {
+	if err := deleteOldVersionOfObject(ctx, ioutil.Discard, client, bucketVersioning, object1, gen); err != nil {
 		t.Fatalf("deleteOldVersionOfObject: %v", err)
 	}
-	data, err := downloadFile(ioutil.Discard, bucket, object1)
+	data, err := downloadFile(ctx, ioutil.Discard, client, bucket, object1)
 	if err != nil {
 		t.Fatalf("downloadFile: %v", err)
 	}
@@ -173,15 +190,20 @@ func TestObjects(t *testing.T) {
 		t.Errorf("contents = %q; want %q", got, want)
 	}
 
-	_, err = getMetadata(ioutil.Discard, bucket, object1)
+	_, err = getMetadata(ctx, ioutil.Discard, client, bucket, object1)
 	if err != nil {
 		t.Errorf("getMetadata: %v", err)
 	}
 	t.Run("publicFile", func(t *testing.T) {
-		if err := makePublic(ioutil.Discard, bucket, object1, allUsers, roleReader); err != nil {
+		if err := makePublic(ctx, ioutil.Discard, client, bucket, object1, allUsers, roleReader); err != nil {
 			t.Errorf("makePublic: %v", err)
 		}
-		data, err = downloadPublicFile(ioutil.Discard, bucket, object1)
+		anonClient, err := storage.NewClient(ctx, option.WithoutAuthentication())
+		if err != nil {
+			t.Fatalf("storage.NewClient: %v", err)
+		}
+		defer anonClient.Close()
+		data, err := downloadPublicFile(ctx, ioutil.Discard, anonClient, bucket, object1)
 		if err != nil {
 			t.Fatalf("downloadPublicFile: %v", err)
 		}
@@ -190,18 +212,18 @@ func TestObjects(t *testing.T) {
 		}
 	})
 
-	err = moveFile(ioutil.Discard, bucket, object1)
+	err = moveFile(ctx, ioutil.Discard, client, bucket, object1)
 	if err != nil {
 		t.Fatalf("moveFile: %v", err)
 	}
 	// object1's new name.
 	object1 = object1 + "-rename"
 
-	if err := copyFile(ioutil.Discard, dstBucket, bucket, object1); err != nil {
+	if err := copyFile(ctx, ioutil.Discard, client, dstBucket, bucket, object1, object1+"-copy"); err != nil {
 		t.Errorf("copyFile: %v", err)
 	}
 	t.Run("composeFile", func(t *testing.T) {
-		if err := composeFile(ioutil.Discard, bucket, object1, object2, dstObj); err != nil {
+		if err := composeFile(ctx, ioutil.Discard, client, bucket, object1, object2, dstObj); err != nil {
 			t.Errorf("composeFile: %v", err)
 		}
 		bkt := client.Bucket(bucket)
@@ -220,30 +242,30 @@ func TestObjects(t *testing.T) {
 	if err := generateEncryptionKey(ioutil.Discard); err != nil {
 		t.Errorf("generateEncryptionKey: %v", err)
 	}
-	if err := uploadEncryptedFile(ioutil.Discard, bucket, object1, key); err != nil {
+	if err := uploadEncryptedFile(ctx, ioutil.Discard, client, bucket, object1, key); err != nil {
 		t.Errorf("uploadEncryptedFile: %v", err)
 	}
-	data, err = downloadEncryptedFile(ioutil.Discard, bucket, object1, key)
+	data, err = downloadEncryptedFile(ctx, ioutil.Discard, client, bucket, object1, key)
 	if err != nil {
 		t.Errorf("downloadEncryptedFile: %v", err)
 	}
 	if got, want := string(data), "top secret"; got != want {
 		t.Errorf("object content = %q; want %q", got, want)
 	}
-	if err := rotateEncryptionKey(ioutil.Discard, bucket, object1, key, newKey); err != nil {
+	if err := rotateEncryptionKey(ctx, ioutil.Discard, client, bucket, object1, key, newKey); err != nil {
 		t.Errorf("rotateEncryptionKey: %v", err)
 	}
-	if err := deleteFile(ioutil.Discard, bucket, object1); err != nil {
+	if err := deleteFile(ctx, ioutil.Discard, client, bucket, object1); err != nil {
 		t.Errorf("deleteFile: %v", err)
 	}
-	if err := deleteFile(ioutil.Discard, bucket, object2); err != nil {
+	if err := deleteFile(ctx, ioutil.Discard, client, bucket, object2); err != nil {
 		t.Errorf("deleteFile: %v", err)
 	}
 	o := client.Bucket(bucket).Object(dstObj)
 	if err := o.Delete(ctx); err != nil {
 		t.Errorf("Object(%q).Delete: %v", dstObj, err)
 	}
-	if err := disableVersioning(ioutil.Discard, bucketVersioning); err != nil {
+	if err := disableVersioning(ctx, ioutil.Discard, client, bucketVersioning); err != nil {
 		t.Fatalf("disableVersioning: %v", err)
 	}
 	bAttrs, err = bkt.Attrs(ctx)
@@ -253,37 +275,30 @@ func TestObjects(t *testing.T) {
 	if bAttrs.VersioningEnabled {
 		t.Fatalf("object versioning is not disabled")
 	}
-	testutil.Retry(t, 10, time.Second, func(r *testutil.R) {
-		// Cleanup, this part won't be executed if Fatal happens.
-		// TODO(jbd): Implement garbage cleaning.
-		if err := client.Bucket(bucket).Delete(ctx); err != nil {
-			r.Errorf("Bucket(%q).Delete: %v", bucket, err)
-		}
-	})
-
-	testutil.Retry(t, 10, time.Second, func(r *testutil.R) {
-		if err := deleteFile(ioutil.Discard, dstBucket, object1+"-copy"); err != nil {
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
+		// Cleanup, this part won't be executed if Fatal happens. The shared
+		// bucket is deleted when the last test using it finishes, and
+		// buckets left behind are deleted by testutil.DeleteStaleResources.
+		if err := deleteFile(ctx, ioutil.Discard, client, dstBucket, object1+"-copy"); err != nil {
 			r.Errorf("deleteFile: %v", err)
 		}
 	})
 
-	testutil.Retry(t, 10, time.Second, func(r *testutil.R) {
-		if err := client.Bucket(dstBucket).Delete(ctx); err != nil {
-			r.Errorf("Bucket(%q).Delete: %v", dstBucket, err)
-		}
-	})
-
 	// CleanBucket to delete versioned objects in bucket
 	testutil.CleanBucket(ctx, t, tc.ProjectID, bucketVersioning)
-	testutil.Retry(t, 10, time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
 		if err := client.Bucket(bucketVersioning).Delete(ctx); err != nil {
 			r.Errorf("Bucket(%q).Delete: %v", bucketVersioning, err)
 		}
 	})
 }
 
-func TestKMSObjects(t *testing.T) {
-	tc := testutil.SystemTest(t)
+// TestBasicObjectOperations covers the samples a storage emulator supports,
+// so it also runs with only STORAGE_EMULATOR_HOST set, or from a recording
+// with GOLANG_SAMPLES_STORAGE_REPLAY=replay.
+func TestBasicObjectOperations(t *testing.T) {
+	tc := testutil.StorageReplayTest(t, "testdata/replay/TestBasicObjectOperations.json")
+	defer tc.Close()
 	ctx := context.Background()
 	client, err := storage.NewClient(ctx)
 	if err != nil {
@@ -291,24 +306,279 @@ func TestKMSObjects(t *testing.T) {
 	}
 	defer client.Close()
 
-	keyRingID := os.Getenv("GOLANG_SAMPLES_KMS_KEYRING")
-	cryptoKeyID := os.Getenv("GOLANG_SAMPLES_KMS_CRYPTOKEY")
-	if keyRingID == "" || cryptoKeyID == "" {
-		t.Skip("GOLANG_SAMPLES_KMS_KEYRING and GOLANG_SAMPLES_KMS_CRYPTOKEY must be set")
+	var (
+		bucket    = tc.UniqueName("object-basic-1")
+		dstBucket = tc.UniqueName("object-basic-2")
+		object1   = "foo.txt"
+		object2   = "foo/a.txt"
+		dstObj    = "foobar.txt"
+	)
+	testutil.CleanBucket(ctx, t, tc.ProjectID, bucket)
+	testutil.CleanBucket(ctx, t, tc.ProjectID, dstBucket)
+
+	for _, o := range []string{object1, object2} {
+		if err := uploadFile(ctx, ioutil.Discard, client, bucket, o, "notes.txt"); err != nil {
+			t.Fatalf("uploadFile(%q): %v", o, err)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := listFiles(ctx, &buf, client, bucket); err != nil {
+		t.Fatalf("listFiles: %v", err)
+	}
+	for _, want := range []string{object1, object2} {
+		if got := buf.String(); !strings.Contains(got, want) {
+			t.Errorf("listFiles got %q; want to contain %q", got, want)
+		}
+	}
+
+	buf.Reset()
+	if err := listFilesWithPrefix(ctx, &buf, client, bucket, "foo/", ""); err != nil {
+		t.Fatalf("listFilesWithPrefix: %v", err)
+	}
+	if got := buf.String(); strings.Contains(got, object1) || !strings.Contains(got, object2) {
+		t.Errorf("listFilesWithPrefix(%q) got %q; want only %q", "foo/", got, object2)
+	}
+
+	data, err := downloadFile(ctx, ioutil.Discard, client, bucket, object1)
+	if err != nil {
+		t.Fatalf("downloadFile: %v", err)
+	}
+	if got, want := string(data), "Hello\nworld"; got != want {
+		t.Errorf("contents = %q; want %q", got, want)
+	}
+	if _, err := getMetadata(ctx, ioutil.Discard, client, bucket, object1); err != nil {
+		t.Errorf("getMetadata: %v", err)
+	}
+
+	if err := copyFile(ctx, ioutil.Discard, client, dstBucket, bucket, object1, object1+"-copy"); err != nil {
+		t.Errorf("copyFile: %v", err)
+	}
+	if err := composeFile(ctx, ioutil.Discard, client, bucket, object1, object2, dstObj); err != nil {
+		t.Errorf("composeFile: %v", err)
+	}
+	if err := moveFile(ctx, ioutil.Discard, client, bucket, object1); err != nil {
+		t.Fatalf("moveFile: %v", err)
+	}
+	for _, o := range []string{object1 + "-rename", object2, dstObj} {
+		if err := deleteFile(ctx, ioutil.Discard, client, bucket, o); err != nil {
+			t.Errorf("deleteFile(%q): %v", o, err)
+		}
+	}
+	if err := deleteFile(ctx, ioutil.Discard, client, dstBucket, object1+"-copy"); err != nil {
+		t.Errorf("deleteFile(%q): %v", object1+"-copy", err)
 	}
+}
 
-	bucket := tc.ProjectID + "-samples-object-bucket-1"
-	object := "foo.txt"
+func TestClassifyError(t *testing.T) {
+	tests := []struct {
+		err  error
+		want apierrors.Action
+	}{
+		{nil, apierrors.ActionNone},
+		{storage.ErrObjectNotExist, apierrors.ActionNotFound},
+		{fmt.Errorf("Object.Attrs: %w", storage.ErrBucketNotExist), apierrors.ActionNotFound},
+		{&googleapi.Error{Code: 403}, apierrors.ActionPermissionDenied},
+		{&googleapi.Error{Code: 404}, apierrors.ActionNotFound},
+		{&googleapi.Error{Code: 412}, apierrors.ActionPreconditionFailed},
+		{fmt.Errorf("Writer.Close: %w", &googleapi.Error{Code: 429}), apierrors.ActionRetry},
+		{&googleapi.Error{Code: 503}, apierrors.ActionRetry},
+		{&googleapi.Error{Code: 400}, apierrors.ActionFail},
+		{status.Error(codes.Unavailable, "unavailable"), apierrors.ActionRetry},
+		{status.Error(codes.Internal, "oops"), apierrors.ActionFail},
+		{status.Error(codes.PermissionDenied, "denied"), apierrors.ActionPermissionDenied},
+		{status.Error(codes.InvalidArgument, "bad"), apierrors.ActionFail},
+		{context.Canceled, apierrors.ActionFail},
+		{io.ErrUnexpectedEOF, apierrors.ActionRetry},
+	}
+	for _, tc := range tests {
+		if got := classifyError(tc.err); got != tc.want {
+			t.Errorf("classifyError(%v) got %v, want %v", tc.err, got, tc.want)
+		}
+	}
+}
 
+func TestHandleErrors(t *testing.T) {
+	tc := testutil.StorageEmulatorTest(t)
+	ctx := context.Background()
+	client, err := storage.NewClient(ctx)
+	if err != nil {
+		t.Fatalf("storage.NewClient: %v", err)
+	}
+	defer client.Close()
+	bucket := testutil.UniqueName("object-errors")
+	object := "if-not-exists.txt"
 	testutil.CleanBucket(ctx, t, tc.ProjectID, bucket)
 
-	kmsKeyName := fmt.Sprintf("projects/%s/locations/%s/keyRings/%s/cryptoKeys/%s", tc.ProjectID, "global", keyRingID, cryptoKeyID)
+	if _, err := downloadFileHandleErrors(ctx, ioutil.Discard, client, bucket, object); classifyError(err) != apierrors.ActionNotFound {
+		t.Errorf("downloadFileHandleErrors of missing object got err %v, want not found", err)
+	}
+
+	for i, want := range []bool{true, false} {
+		created, err := uploadFileIfNotExists(ctx, ioutil.Discard, client, bucket, object, "hello")
+		if err != nil {
+			t.Fatalf("uploadFileIfNotExists #%d: %v", i, err)
+		}
+		if created != want {
+			t.Errorf("uploadFileIfNotExists #%d got created %v, want %v", i, created, want)
+		}
+	}
+
+	data, err := downloadFileHandleErrors(ctx, ioutil.Discard, client, bucket, object)
+	if err != nil {
+		t.Fatalf("downloadFileHandleErrors: %v", err)
+	}
+	if got, want := string(data), "hello"; got != want {
+		t.Errorf("downloadFileHandleErrors got %q, want %q", got, want)
+	}
+}
+
+func TestUploadWithRetryAfter(t *testing.T) {
+	// The server throttles the first upload and asks for a one second
+	// wait, then accepts the second.
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.Copy(ioutil.Discard, r.Body)
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			http.Error(w, `{"error":{"code":429,"message":"rate limited"}}`, http.StatusTooManyRequests)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"bucket":"my-bucket","name":"notes.txt"}`)
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	client, err := storage.NewClient(ctx, option.WithEndpoint(srv.URL+"/storage/v1/"), option.WithoutAuthentication())
+	if err != nil {
+		t.Fatalf("storage.NewClient: %v", err)
+	}
+	defer client.Close()
+
+	var buf bytes.Buffer
+	start := time.Now()
+	if err := uploadWithRetryAfter(ctx, &buf, client, "my-bucket", "notes.txt", "./notes.txt", time.Minute); err != nil {
+		t.Fatalf("uploadWithRetryAfter: %v", err)
+	}
+	if got := atomic.LoadInt32(&calls); got != 2 {
+		t.Errorf("got %d requests, want 2", got)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("retried after %v, want at least the 1s Retry-After", elapsed)
+	}
+	if got, want := buf.String(), "retrying in 1s"; !strings.Contains(got, want) {
+		t.Errorf("got output %q, want it to contain %q", got, want)
+	}
+
+	// A Retry-After longer than the time left ends the upload at once.
+	atomic.StoreInt32(&calls, 0)
+	err = uploadWithRetryAfter(ctx, ioutil.Discard, client, "my-bucket", "notes.txt", "./notes.txt", 500*time.Millisecond)
+	if err == nil || !strings.Contains(err.Error(), "past the deadline") {
+		t.Errorf("uploadWithRetryAfter with a short deadline got err %v, want past the deadline", err)
+	}
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Errorf("with a short deadline got %d requests, want 1", got)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	header := func(v string) http.Header { return http.Header{"Retry-After": []string{v}} }
+	future := time.Now().Add(time.Minute).UTC().Format(http.TimeFormat)
+	tests := []struct {
+		err    error
+		wantOK bool
+		min    time.Duration
+	}{
+		{&googleapi.Error{Code: 429, Header: header("7")}, true, 7 * time.Second},
+		{fmt.Errorf("Writer.Close: %w", &googleapi.Error{Code: 503, Header: header("2")}), true, 2 * time.Second},
+		{&googleapi.Error{Code: 503, Header: header(future)}, true, 58 * time.Second},
+		{&googleapi.Error{Code: 429}, false, 0},
+		{&googleapi.Error{Code: 429, Header: header("soon")}, false, 0},
+		{&googleapi.Error{Code: 500, Header: header("7")}, false, 0},
+		{io.ErrUnexpectedEOF, false, 0},
+	}
+	for _, tc := range tests {
+		got, ok := retryAfter(tc.err)
+		if ok != tc.wantOK || got < tc.min || (tc.min > 0 && got > tc.min+time.Minute) {
+			t.Errorf("retryAfter(%v) got (%v, %v), want (at least %v, %v)", tc.err, got, ok, tc.min, tc.wantOK)
+		}
+	}
+}
+
+func TestDownloadWithFallback(t *testing.T) {
+	ctx := context.Background()
+	var primaryStatus int32 = http.StatusServiceUnavailable
+	var primaryCalls, secondaryCalls int32
+	newClient := func(calls *int32, h http.HandlerFunc) *storage.Client {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(calls, 1)
+			h(w, r)
+		}))
+		t.Cleanup(srv.Close)
+		client, err := storage.NewClient(ctx, option.WithEndpoint(srv.URL+"/storage/v1/"), option.WithoutAuthentication())
+		if err != nil {
+			t.Fatalf("storage.NewClient: %v", err)
+		}
+		t.Cleanup(func() { client.Close() })
+		return client
+	}
+	primary := newClient(&primaryCalls, func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unavailable", int(atomic.LoadInt32(&primaryStatus)))
+	})
+	secondary := newClient(&secondaryCalls, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		fmt.Fprint(w, "hello")
+	})
+
+	buf := new(bytes.Buffer)
+	data, err := downloadWithFallback(ctx, buf, primary, secondary, "my-bucket", "notes.txt")
+	if err != nil {
+		t.Fatalf("downloadWithFallback: %v", err)
+	}
+	if got, want := string(data), "hello"; got != want {
+		t.Errorf("downloadWithFallback got %q, want %q", got, want)
+	}
+	if got, want := buf.String(), "through the secondary client"; !strings.Contains(got, want) {
+		t.Errorf("downloadWithFallback got output %q, want it to contain %q", got, want)
+	}
+	if got := atomic.LoadInt32(&primaryCalls); got != 2 {
+		t.Errorf("got %d primary requests, want 2", got)
+	}
+
+	// A missing object is missing through any path.
+	atomic.StoreInt32(&primaryStatus, http.StatusNotFound)
+	atomic.StoreInt32(&secondaryCalls, 0)
+	if _, err := downloadWithFallback(ctx, ioutil.Discard, primary, secondary, "my-bucket", "missing.txt"); classifyError(err) != apierrors.ActionNotFound {
+		t.Errorf("downloadWithFallback of a missing object got err %v, want not found", err)
+	}
+	if got := atomic.LoadInt32(&secondaryCalls); got != 0 {
+		t.Errorf("got %d secondary requests for a missing object, want 0", got)
+	}
+}
+
+func TestKMSObjects(t *testing.T) {
+	tc := testutil.SystemTest(t)
+	ctx := context.Background()
+	client, err := storage.NewClient(ctx)
+	if err != nil {
+		t.Fatalf("storage.NewClient: %v", err)
+	}
+	defer client.Close()
+
+	kmsKeyName := testutil.KMSKeyName(ctx, t, tc.ProjectID)
+
+	bucket := testutil.SharedBucket(ctx, t, tc.ProjectID)
+	prefix := testutil.Prefix(t)
+	object := prefix + "foo.txt"
+	t.Parallel()
+
 	t.Run("сhangeObjectCSEKtoKMS", func(t *testing.T) {
-		object1 := "foo.txt"
+		object1 := prefix + "foo.txt"
 		key := []byte("my-secret-AES-256-encryption-key")
 		obj := client.Bucket(bucket).Object(object1)
 
-		testutil.Retry(t, 10, time.Second, func(r *testutil.R) {
+		testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
 			wc := obj.Key(key).NewWriter(ctx)
 			if _, err := wc.Write([]byte("top secret")); err != nil {
 				r.Errorf("Writer.Write: %v", err)
@@ -317,9 +587,12 @@ func TestKMSObjects(t *testing.T) {
 				r.Errorf("Writer.Close: %v", err)
 			}
 		})
-		if err := сhangeObjectCSEKToKMS(ioutil.Discard, bucket, object1, key, kmsKeyName); err != nil {
-			t.Errorf("сhangeObjectCSEKtoKMS: %v", err)
-		}
+		// A newly provisioned key can take a while to be usable.
+		testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
+			if err := сhangeObjectCSEKToKMS(ctx, ioutil.Discard, client, bucket, object1, key, kmsKeyName); err != nil {
+				r.Errorf("сhangeObjectCSEKtoKMS: %v", err)
+			}
+		})
 		attrs, err := obj.Attrs(ctx)
 		if err != nil {
 			t.Errorf("obj.Attrs: %v", err)
@@ -329,8 +602,8 @@ func TestKMSObjects(t *testing.T) {
 		}
 	})
 
-	testutil.Retry(t, 10, time.Second, func(r *testutil.R) {
-		if err := uploadWithKMSKey(ioutil.Discard, bucket, object, kmsKeyName); err != nil {
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
+		if err := uploadWithKMSKey(ctx, ioutil.Discard, client, bucket, object, kmsKeyName); err != nil {
 			r.Errorf("uploadWithKMSKey: %v", err)
 		}
 	})
@@ -345,14 +618,14 @@ func TestV4SignedURL(t *testing.T) {
 	}
 	defer client.Close()
 
-	bucketName := tc.ProjectID + "-signed-url-bucket-name"
-	objectName := "foo.txt"
 	serviceAccount := os.Getenv("GOOGLE_APPLICATION_CREDENTIALS")
 	if serviceAccount == "" {
 		t.Skip("GOOGLE_APPLICATION_CREDENTIALS must be set")
 	}
+	bucketName := testutil.SharedBucket(ctx, t, tc.ProjectID)
+	objectName := testutil.Prefix(t) + "foo.txt"
+	t.Parallel()
 
-	testutil.CleanBucket(ctx, t, tc.ProjectID, bucketName)
 	putBuf := new(bytes.Buffer)
 	putURL, err := generateV4PutObjectSignedURL(putBuf, bucketName, objectName, serviceAccount)
 	if err != nil {
@@ -395,6 +668,70 @@ func TestV4SignedURL(t *testing.T) {
 	if got, want := string(body), "hello world"; got != want {
 		t.Errorf("object content = %q; want %q", got, want)
 	}
+
+	downloadURL, err := generateV4DownloadSignedURL(ioutil.Discard, bucketName, objectName, "hello.txt", "text/plain", serviceAccount)
+	if err != nil {
+		t.Fatalf("generateV4DownloadSignedURL: %v", err)
+	}
+	response, err = http.Get(downloadURL)
+	if err != nil {
+		t.Fatalf("http.Get: %v", err)
+	}
+	response.Body.Close()
+	if got, want := response.Header.Get("Content-Disposition"), `attachment; filename=hello.txt`; got != want {
+		t.Errorf("Content-Disposition = %q; want %q", got, want)
+	}
+}
+
+func TestV4DownloadSignedURL(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("rsa.GenerateKey: %v", err)
+	}
+	pemKey := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	jsonKey, err := json.Marshal(map[string]string{
+		"type":         "service_account",
+		"client_email": "signer@my-project.iam.gserviceaccount.com",
+		"private_key":  string(pemKey),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	serviceAccount := filepath.Join(t.TempDir(), "key.json")
+	if err := os.WriteFile(serviceAccount, jsonKey, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	u, err := generateV4DownloadSignedURL(ioutil.Discard, "my-bucket", "users/1/a.pdf", "Relatório final.pdf", "application/pdf", serviceAccount)
+	if err != nil {
+		t.Fatalf("generateV4DownloadSignedURL: %v", err)
+	}
+	parsed, err := url.Parse(u)
+	if err != nil {
+		t.Fatalf("url.Parse: %v", err)
+	}
+	q := parsed.Query()
+	if got, want := q.Get("response-content-disposition"), "attachment; filename*=utf-8''Relat%C3%B3rio%20final.pdf"; got != want {
+		t.Errorf("response-content-disposition = %q; want %q", got, want)
+	}
+	if got, want := q.Get("response-content-type"), "application/pdf"; got != want {
+		t.Errorf("response-content-type = %q; want %q", got, want)
+	}
+
+	u, err = generateV4TypedUploadSignedURL(ioutil.Discard, "my-bucket", "users/1/a.pdf", "a.pdf", "application/pdf", serviceAccount)
+	if err != nil {
+		t.Fatalf("generateV4TypedUploadSignedURL: %v", err)
+	}
+	if parsed, err = url.Parse(u); err != nil {
+		t.Fatalf("url.Parse: %v", err)
+	}
+	if got, want := parsed.Query().Get("X-Goog-SignedHeaders"), "content-disposition;content-type;host"; got != want {
+		t.Errorf("X-Goog-SignedHeaders = %q; want %q", got, want)
+	}
+
+	if _, err := generateV4DownloadSignedURL(ioutil.Discard, "my-bucket", "a.pdf", "", "application/pdf", serviceAccount); err == nil {
+		t.Errorf("generateV4DownloadSignedURL with an empty file name got nil error, want non-nil")
+	}
 }
 
 func TestPostPolicyV4(t *testing.T) {
@@ -406,7 +743,7 @@ func TestPostPolicyV4(t *testing.T) {
 	}
 	defer client.Close()
 
-	bucketName := tc.ProjectID + "-post-policy-bucket-name"
+	bucketName := testutil.UniqueName("post-policy")
 	objectName := "foo.txt"
 	serviceAccount := os.Getenv("GOOGLE_APPLICATION_CREDENTIALS")
 
@@ -502,7 +839,7 @@ func TestObjectBucketLock(t *testing.T) {
 	defer client.Close()
 
 	var (
-		bucketName      = tc.ProjectID + "-retent-samples-object-bucket"
+		bucketName      = testutil.UniqueName("object-retention")
 		objectName      = "foo.txt"
 		retentionPeriod = 5 * time.Second
 	)
@@ -510,7 +847,7 @@ func TestObjectBucketLock(t *testing.T) {
 	testutil.CleanBucket(ctx, t, tc.ProjectID, bucketName)
 	bucket := client.Bucket(bucketName)
 
-	if err := uploadFile(ioutil.Discard, bucketName, objectName); err != nil {
+	if err := uploadFile(ctx, ioutil.Discard, client, bucketName, objectName, "notes.txt"); err != nil {
 		t.Fatalf("uploadFile(%q): %v", objectName, err)
 	}
 	if _, err := bucket.Update(ctx, storage.BucketAttrsToUpdate{
@@ -520,20 +857,27 @@ func TestObjectBucketLock(t *testing.T) {
 	}); err != nil {
 		t.Errorf("Bucket(%q).Update: %v", bucketName, err)
 	}
-	if err := setEventBasedHold(ioutil.Discard, bucketName, objectName); err != nil {
+	if err := setEventBasedHold(ctx, ioutil.Discard, client, bucketName, objectName); err != nil {
 		t.Errorf("setEventBasedHold(%q, %q): %v", bucketName, objectName, err)
 	}
-	oAttrs, err := getMetadata(ioutil.Discard, bucketName, objectName)
+	oAttrs, err := getMetadata(ctx, ioutil.Discard, client, bucketName, objectName)
 	if err != nil {
 		t.Errorf("getMetadata: %v", err)
 	}
 	if !oAttrs.EventBasedHold {
 		t.Errorf("event-based hold is not enabled")
 	}
-	if err := releaseEventBasedHold(ioutil.Discard, bucketName, objectName); err != nil {
+	held, err := holdInventory(ctx, ioutil.Discard, client, bucketName)
+	if err != nil {
+		t.Errorf("holdInventory: %v", err)
+	}
+	if len(held) != 1 || held[0].Name != objectName || !held[0].EventBasedHold {
+		t.Errorf("holdInventory got %+v, want %q with an event-based hold", held, objectName)
+	}
+	if err := releaseEventBasedHold(ctx, ioutil.Discard, client, bucketName, objectName); err != nil {
 		t.Errorf("releaseEventBasedHold(%q, %q): %v", bucketName, objectName, err)
 	}
-	oAttrs, err = getMetadata(ioutil.Discard, bucketName, objectName)
+	oAttrs, err = getMetadata(ctx, ioutil.Discard, client, bucketName, objectName)
 	if err != nil {
 		t.Errorf("getMetadata: %v", err)
 	}
@@ -545,20 +889,20 @@ func TestObjectBucketLock(t *testing.T) {
 	}); err != nil {
 		t.Errorf("Bucket(%q).Update: %v", bucketName, err)
 	}
-	if err := setTemporaryHold(ioutil.Discard, bucketName, objectName); err != nil {
+	if err := setTemporaryHold(ctx, ioutil.Discard, client, bucketName, objectName); err != nil {
 		t.Errorf("setTemporaryHold(%q, %q): %v", bucketName, objectName, err)
 	}
-	oAttrs, err = getMetadata(ioutil.Discard, bucketName, objectName)
+	oAttrs, err = getMetadata(ctx, ioutil.Discard, client, bucketName, objectName)
 	if err != nil {
 		t.Errorf("getMetadata: %v", err)
 	}
 	if !oAttrs.TemporaryHold {
 		t.Errorf("temporary hold is not disabled")
 	}
-	if err := releaseTemporaryHold(ioutil.Discard, bucketName, objectName); err != nil {
+	if err := releaseTemporaryHold(ctx, ioutil.Discard, client, bucketName, objectName); err != nil {
 		t.Errorf("releaseTemporaryHold(%q, %q): %v", bucketName, objectName, err)
 	}
-	oAttrs, err = getMetadata(ioutil.Discard, bucketName, objectName)
+	oAttrs, err = getMetadata(ctx, ioutil.Discard, client, bucketName, objectName)
 	if err != nil {
 		t.Errorf("getMetadata: %v", err)
 	}
@@ -566,3 +910,76 @@ func TestObjectBucketLock(t *testing.T) {
 		t.Errorf("temporary hold is not disabled")
 	}
 }
+
+func TestCheckHolds(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+	tests := []struct {
+		name        string
+		attrs       storage.ObjectAttrs
+		period      time.Duration
+		wantHeld    bool
+		wantRetain  time.Time
+		wantDeleted time.Time
+	}{
+		{"free", storage.ObjectAttrs{}, 0, false, time.Time{}, time.Time{}},
+		{"retention expired", storage.ObjectAttrs{RetentionExpirationTime: now.Add(-day)}, day, false, time.Time{}, time.Time{}},
+		{"temporary hold", storage.ObjectAttrs{TemporaryHold: true}, 0, true, time.Time{}, now},
+		{"bucket retention", storage.ObjectAttrs{RetentionExpirationTime: now.Add(day)}, 7 * day, true, now.Add(day), now.Add(day)},
+		{"object retention", storage.ObjectAttrs{RetentionExpirationTime: now.Add(day), Retention: &storage.ObjectRetention{Mode: "Locked", RetainUntil: now.Add(30 * day)}}, 0, true, now.Add(30 * day), now.Add(30 * day)},
+		{"event-based hold", storage.ObjectAttrs{EventBasedHold: true}, 7 * day, true, time.Time{}, now.Add(7 * day)},
+		{"both holds and retention", storage.ObjectAttrs{EventBasedHold: true, TemporaryHold: true, RetentionExpirationTime: now.Add(10 * day)}, 7 * day, true, now.Add(10 * day), now.Add(10 * day)},
+	}
+	for _, tc := range tests {
+		got, held := checkHolds(&tc.attrs, tc.period, now)
+		if held != tc.wantHeld {
+			t.Errorf("%s: checkHolds got held %v, want %v", tc.name, held, tc.wantHeld)
+			continue
+		}
+		if !got.RetainUntil.Equal(tc.wantRetain) || !got.EarliestDelete.Equal(tc.wantDeleted) {
+			t.Errorf("%s: checkHolds got (%v, %v), want (%v, %v)", tc.name, got.RetainUntil, got.EarliestDelete, tc.wantRetain, tc.wantDeleted)
+		}
+	}
+}
+
+// TestRequesterPaysSecondProject downloads from a requester-pays bucket,
+// billing a project other than the bucket's.
+func TestRequesterPaysSecondProject(t *testing.T) {
+	tc := testutil.SecondProjectTest(t)
+	ctx := context.Background()
+	client, err := storage.NewClient(ctx)
+	if err != nil {
+		t.Fatalf("storage.NewClient: %v", err)
+	}
+	defer client.Close()
+
+	// Requester pays is a bucket setting, so the test gets a bucket of its
+	// own.
+	bucketName := testutil.UniqueName("requester-pays")
+	testutil.CleanBucket(ctx, t, tc.ProjectID, bucketName)
+	t.Cleanup(func() { testutil.DeleteBucket(context.Background(), t, bucketName) })
+	bucket := client.Bucket(bucketName)
+	if _, err := bucket.Update(ctx, storage.BucketAttrsToUpdate{RequesterPays: true}); err != nil {
+		t.Fatalf("Bucket(%q).Update: %v", bucketName, err)
+	}
+	// Turn requester pays off again, before the cleanup runs, so that
+	// deleting the bucket needs no billing project.
+	defer func() {
+		if _, err := bucket.Update(ctx, storage.BucketAttrsToUpdate{RequesterPays: false}); err != nil {
+			t.Errorf("Bucket(%q).Update: %v", bucketName, err)
+		}
+	}()
+
+	const object = "foo.txt"
+	wc := bucket.UserProject(tc.SecondProjectID).Object(object).NewWriter(ctx)
+	if _, err := wc.Write([]byte("billed to the second project")); err != nil {
+		t.Fatalf("Writer.Write: %v", err)
+	}
+	if err := wc.Close(); err != nil {
+		t.Fatalf("Writer.Close: %v", err)
+	}
+
+	if err := downloadUsingRequesterPays(ctx, ioutil.Discard, client, bucketName, object, tc.SecondProjectID); err != nil {
+		t.Errorf("downloadUsingRequesterPays: %v", err)
+	}
+}
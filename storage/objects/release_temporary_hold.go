@@ -25,17 +25,11 @@ import (
 )
 
 // releaseTemporaryHold releases an object with temporary hold.
-func releaseTemporaryHold(w io.Writer, bucket, object string) error {
+func releaseTemporaryHold(ctx context.Context, w io.Writer, client *storage.Client, bucket, object string) error {
 	// bucket := "bucket-name"
 	// object := "object-name"
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
-
-	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	o := client.Bucket(bucket).Object(object)
@@ -43,7 +37,7 @@ func releaseTemporaryHold(w io.Writer, bucket, object string) error {
 		TemporaryHold: false,
 	}
 	if _, err := o.Update(ctx, objectAttrsToUpdate); err != nil {
-		return fmt.Errorf("Object(%q).Update: %v", object, err)
+		return fmt.Errorf("Object(%q).Update: %w", object, err)
 	}
 	fmt.Fprintf(w, "Temporary hold was released for %v.\n", object)
 	return nil
@@ -25,27 +25,20 @@ import (
 )
 
 // rotateEncryptionKey encrypts an object with the newKey.
-func rotateEncryptionKey(w io.Writer, bucket, object string, key, newKey []byte) error {
+func rotateEncryptionKey(ctx context.Context, w io.Writer, client *storage.Client, bucket, object string, key, newKey []byte) error {
 	// bucket := "bucket-name"
 	// object := "object-name"
 	// key := []byte("encryption-key")
 	// newKey := []byte("new-encryption-key")
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
-
 	obj := client.Bucket(bucket).Object(object)
 
-	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	const timeout = time.Minute
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	// obj is encrypted with key, we are encrypting it with the newKey.
-	_, err = obj.Key(newKey).CopierFrom(obj.Key(key)).Run(ctx)
-	if err != nil {
-		return fmt.Errorf("Key(%q).CopierFrom(%q).Run: %v", newKey, key, err)
+	if _, err := obj.Key(newKey).CopierFrom(obj.Key(key)).Run(ctx); err != nil {
+		return fmt.Errorf("Key(%q).CopierFrom(%q).Run: %w", newKey, key, err)
 	}
 	fmt.Fprintf(w, "Key rotation complete for blob %v.\n", object)
 	return nil
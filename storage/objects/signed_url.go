@@ -16,13 +16,14 @@ package objects
 
 import (
 	"fmt"
+	"io"
 	"io/ioutil"
 	"time"
 
 	"cloud.google.com/go/storage"
 )
 
-func signedURL(bucket, object string) error {
+func signedURL(w io.Writer, bucket, object string) error {
 	// Download a p12 service account private key from the Google Developers Console.
 	// And convert it to PEM by running the command below:
 	//	$ openssl pkcs12 -in key.p12 -passin pass:notasecret -out my-private-key.pem -nodes
@@ -39,6 +40,6 @@ func signedURL(bucket, object string) error {
 	if err != nil {
 		return err
 	}
-	fmt.Println(url)
+	fmt.Fprintln(w, url)
 	return nil
 }
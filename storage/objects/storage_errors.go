@@ -0,0 +1,37 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objects
+
+// [START storage_classify_error]
+import (
+	"errors"
+
+	"cloud.google.com/go/storage"
+	"github.com/GoogleCloudPlatform/golang-samples/internal/apierrors"
+)
+
+// classifyError decides what to do about an error returned by the storage
+// client. Errors are matched by type, never by their message, and transient
+// errors are those apierrors.Retryable lists, whichever API the client used.
+func classifyError(err error) apierrors.Action {
+	// The client's sentinel errors come first: they are returned before any
+	// request is made, or in place of a 404.
+	if errors.Is(err, storage.ErrObjectNotExist) || errors.Is(err, storage.ErrBucketNotExist) {
+		return apierrors.ActionNotFound
+	}
+	return apierrors.Decide(err)
+}
+
+// [END storage_classify_error]
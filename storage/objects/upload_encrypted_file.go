@@ -25,29 +25,23 @@ import (
 )
 
 // uploadEncryptedFile writes an object using AES-256 encryption key.
-func uploadEncryptedFile(w io.Writer, bucket, object string, secretKey []byte) error {
+func uploadEncryptedFile(ctx context.Context, w io.Writer, client *storage.Client, bucket, object string, secretKey []byte) error {
 	// bucket := "bucket-name"
 	// object := "object-name"
 	// secretKey := []byte("secret-key")
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
-
 	obj := client.Bucket(bucket).Object(object)
 
-	ctx, cancel := context.WithTimeout(ctx, time.Second*50)
+	const timeout = time.Minute
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	// Encrypt the object's contents.
 	wc := obj.Key(secretKey).NewWriter(ctx)
 	if _, err := wc.Write([]byte("top secret")); err != nil {
-		return fmt.Errorf("Writer.Write: %v", err)
+		return fmt.Errorf("Writer.Write: %w", err)
 	}
 	if err := wc.Close(); err != nil {
-		return fmt.Errorf("Writer.Close: %v", err)
+		return fmt.Errorf("Writer.Close: %w", err)
 	}
 	fmt.Fprintf(w, "Uploaded encrypted object %v.\n", object)
 	return nil
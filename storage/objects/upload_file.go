@@ -25,34 +25,29 @@ import (
 	"cloud.google.com/go/storage"
 )
 
-// uploadFile uploads an object.
-func uploadFile(w io.Writer, bucket, object string) error {
+// uploadFile uploads the local file fileName as an object.
+func uploadFile(ctx context.Context, w io.Writer, client *storage.Client, bucket, object, fileName string) error {
 	// bucket := "bucket-name"
 	// object := "object-name"
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
-
+	// fileName := "notes.txt"
 	// Open local file.
-	f, err := os.Open("notes.txt")
+	f, err := os.Open(fileName)
 	if err != nil {
-		return fmt.Errorf("os.Open: %v", err)
+		return fmt.Errorf("os.Open: %w", err)
 	}
 	defer f.Close()
 
-	ctx, cancel := context.WithTimeout(ctx, time.Second*50)
+	const timeout = time.Minute
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	// Upload an object with storage.Writer.
 	wc := client.Bucket(bucket).Object(object).NewWriter(ctx)
 	if _, err = io.Copy(wc, f); err != nil {
-		return fmt.Errorf("io.Copy: %v", err)
+		return fmt.Errorf("io.Copy: %w", err)
 	}
 	if err := wc.Close(); err != nil {
-		return fmt.Errorf("Writer.Close: %v", err)
+		return fmt.Errorf("Writer.Close: %w", err)
 	}
 	fmt.Fprintf(w, "Blob %v uploaded.\n", object)
 	return nil
@@ -0,0 +1,60 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objects
+
+// [START storage_upload_file_if_not_exists]
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/GoogleCloudPlatform/golang-samples/internal/apierrors"
+)
+
+// uploadFileIfNotExists creates an object only if no live version of it
+// exists yet. Losing the race to another writer is reported as
+// created == false rather than as an error.
+func uploadFileIfNotExists(ctx context.Context, w io.Writer, client *storage.Client, bucket, object, content string) (created bool, err error) {
+	// bucket := "bucket-name"
+	// object := "object-name"
+	// content := "Hello, world!"
+	const timeout = time.Minute
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	o := client.Bucket(bucket).Object(object).If(storage.Conditions{DoesNotExist: true})
+	wc := o.NewWriter(ctx)
+	if _, err := io.Copy(wc, strings.NewReader(content)); err != nil {
+		wc.Close()
+		return false, fmt.Errorf("io.Copy: %w", err)
+	}
+	err = wc.Close()
+	switch apierrors.Decide(err) {
+	case apierrors.ActionNone:
+		fmt.Fprintf(w, "Blob %v created.\n", object)
+		return true, nil
+	case apierrors.ActionPreconditionFailed:
+		// Another writer created the object first (HTTP 412).
+		fmt.Fprintf(w, "Blob %v already exists, leaving it unchanged.\n", object)
+		return false, nil
+	default:
+		return false, fmt.Errorf("Writer.Close: %w", err)
+	}
+}
+
+// [END storage_upload_file_if_not_exists]
@@ -25,30 +25,24 @@ import (
 )
 
 // uploadWithKMSKey writes an object using Cloud KMS encryption.
-func uploadWithKMSKey(w io.Writer, bucket, object, keyName string) error {
+func uploadWithKMSKey(ctx context.Context, w io.Writer, client *storage.Client, bucket, object, keyName string) error {
 	// bucket := "bucket-name"
 	// object := "object-name"
 	// keyName := "projects/projectId/locations/global/keyRings/keyRingID/cryptoKeys/cryptoKeyID"
-	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
-
 	obj := client.Bucket(bucket).Object(object)
 
-	ctx, cancel := context.WithTimeout(ctx, time.Second*50)
+	const timeout = time.Minute
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	// Encrypt the object's contents.
 	wc := obj.NewWriter(ctx)
 	wc.KMSKeyName = keyName
 	if _, err := wc.Write([]byte("top secret")); err != nil {
-		return fmt.Errorf("Writer.Write: %v", err)
+		return fmt.Errorf("Writer.Write: %w", err)
 	}
 	if err := wc.Close(); err != nil {
-		return fmt.Errorf("Writer.Close: %v", err)
+		return fmt.Errorf("Writer.Close: %w", err)
 	}
 	fmt.Fprintf(w, "Uploaded blob %v with KMS key.\n", object)
 	return nil
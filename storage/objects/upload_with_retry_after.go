@@ -0,0 +1,130 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objects
+
+// [START storage_upload_with_retry_after]
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/GoogleCloudPlatform/golang-samples/internal/apierrors"
+	"github.com/googleapis/gax-go/v2"
+	"google.golang.org/api/googleapi"
+)
+
+// uploadWithRetryAfter uploads the local file fileName as an object, retrying
+// transient failures itself instead of leaving them to the client. When Cloud
+// Storage throttles the upload with a 429 or 503 and says how long to wait in
+// a Retry-After header, it waits at least that long. It gives up once
+// maxElapsed has passed, so one throttled object can't stall a bulk ingest.
+func uploadWithRetryAfter(ctx context.Context, w io.Writer, client *storage.Client, bucket, object, fileName string, maxElapsed time.Duration) error {
+	// bucket := "bucket-name"
+	// object := "object-name"
+	// fileName := "notes.txt"
+	// maxElapsed := 5 * time.Minute
+	f, err := os.Open(fileName)
+	if err != nil {
+		return fmt.Errorf("os.Open: %w", err)
+	}
+	defer f.Close()
+
+	// maxElapsed bounds all attempts together, including the waits between
+	// them.
+	ctx, cancel := context.WithTimeout(ctx, maxElapsed)
+	defer cancel()
+
+	// Turn off the client's own retries, which don't look at Retry-After,
+	// so that every attempt goes through the loop below. Uploading the same
+	// file again is safe: it replaces the object with the same content.
+	o := client.Bucket(bucket).Object(object).Retryer(storage.WithPolicy(storage.RetryNever))
+	bo := gax.Backoff{
+		Initial:    time.Second,
+		Max:        32 * time.Second,
+		Multiplier: 2,
+	}
+	for attempt := 1; ; attempt++ {
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			return fmt.Errorf("Seek: %w", err)
+		}
+		wc := o.NewWriter(ctx)
+		// Send the object in a single request, so that a failed attempt
+		// is retried from the start rather than from a half-written
+		// resumable upload.
+		wc.ChunkSize = 0
+		if _, err = io.Copy(wc, f); err != nil {
+			// The copy error already says why the attempt failed.
+			wc.Close()
+			err = fmt.Errorf("io.Copy: %w", err)
+		} else if err = wc.Close(); err != nil {
+			err = fmt.Errorf("Writer.Close: %w", err)
+		}
+		if err == nil {
+			fmt.Fprintf(w, "Blob %v uploaded after %d attempt(s).\n", object, attempt)
+			return nil
+		}
+		if !apierrors.Retryable(err) {
+			return err
+		}
+
+		delay := bo.Pause()
+		if d, ok := retryAfter(err); ok && d > delay {
+			delay = d
+		}
+		// Don't start a wait that would outlast the deadline.
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+			return fmt.Errorf("giving up after %d attempt(s), next retry in %v is past the deadline: %w", attempt, delay, err)
+		}
+		fmt.Fprintf(w, "Attempt %d failed: %v; retrying in %v.\n", attempt, err, delay)
+		if err := gax.Sleep(ctx, delay); err != nil {
+			return fmt.Errorf("giving up after %d attempt(s): %w", attempt, err)
+		}
+	}
+}
+
+// retryAfter returns how long a 429 or 503 response asked the client to wait
+// before retrying. The Retry-After header holds either a number of seconds or
+// an HTTP date.
+func retryAfter(err error) (time.Duration, bool) {
+	var gerr *googleapi.Error
+	if !errors.As(err, &gerr) {
+		return 0, false
+	}
+	if gerr.Code != http.StatusTooManyRequests && gerr.Code != http.StatusServiceUnavailable {
+		return 0, false
+	}
+	v := gerr.Header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// [END storage_upload_with_retry_after]
@@ -44,11 +44,12 @@ func listGCSBuckets(w io.Writer, googleAccessKeyID string, googleAccessKeySecret
 	client := s3.New(sess)
 	ctx := context.Background()
 
-	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	const timeout = 30 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 	result, err := client.ListBucketsWithContext(ctx, &s3.ListBucketsInput{})
 	if err != nil {
-		return fmt.Errorf("ListBucketsWithContext: %v", err)
+		return fmt.Errorf("ListBucketsWithContext: %w", err)
 	}
 
 	fmt.Fprintf(w, "Buckets:")
@@ -48,7 +48,7 @@ func TestListGCSBuckets(t *testing.T) {
 	buf := new(bytes.Buffer)
 	// New HMAC key may take up to 15s to propagate, so we need to retry for up
 	// to that amount of time.
-	testutil.Retry(t, 75, time.Millisecond*200, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 200 * time.Millisecond, Max: 400 * time.Millisecond, Multiplier: 2, MaxAttempts: 75}, func(r *testutil.R) {
 		buf.Reset()
 		if err := listGCSBuckets(buf, key.AccessID, key.Secret); err != nil {
 			r.Errorf("listGCSBuckets: %v", err)
@@ -70,7 +70,7 @@ func createTestKey(ctx context.Context, t *testing.T, client *storage.Client, pr
 	}
 	key, err := client.CreateHMACKey(ctx, projectID, email)
 	if err != nil {
-		return nil, fmt.Errorf("CreateHMACKey: %v", err)
+		return nil, fmt.Errorf("CreateHMACKey: %w", err)
 	}
 
 	return key, nil
@@ -105,7 +105,7 @@ func TestListGCSObjects(t *testing.T) {
 	defer deleteTestKey(ctx, client, key)
 
 	buf := new(bytes.Buffer)
-	testutil.Retry(t, 5, time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: time.Second, Max: 2 * time.Second, Multiplier: 2, MaxAttempts: 5}, func(r *testutil.R) {
 		if err := listGCSObjects(buf, "cloud-samples-data", key.AccessID, key.Secret); err != nil {
 			r.Errorf("listGCSObjects: %v", err)
 		}
@@ -22,25 +22,27 @@ import (
 	"time"
 
 	"cloud.google.com/go/storage"
+	"google.golang.org/api/option"
 )
 
 // activateHMACKey activates the HMAC key with the given access ID.
-func activateHMACKey(w io.Writer, accessID string, projectID string) (*storage.HMACKey, error) {
+func activateHMACKey(w io.Writer, accessID string, projectID string, opts ...option.ClientOption) (*storage.HMACKey, error) {
 	ctx := context.Background()
 
 	// Initialize client.
-	client, err := storage.NewClient(ctx)
+	client, err := storage.NewClient(ctx, opts...)
 	if err != nil {
-		return nil, fmt.Errorf("storage.NewClient: %v", err)
+		return nil, fmt.Errorf("storage.NewClient: %w", err)
 	}
 	defer client.Close() // Closing the client safely cleans up background resources.
 
 	handle := client.HMACKeyHandle(projectID, accessID)
-	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 	key, err := handle.Update(ctx, storage.HMACKeyAttrsToUpdate{State: "ACTIVE"})
 	if err != nil {
-		return nil, fmt.Errorf("Update: %v", err)
+		return nil, fmt.Errorf("Update: %w", err)
 	}
 
 	fmt.Fprintln(w, "The HMAC key metadata is:")
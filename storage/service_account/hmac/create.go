@@ -22,24 +22,26 @@ import (
 	"time"
 
 	"cloud.google.com/go/storage"
+	"google.golang.org/api/option"
 )
 
 // createHMACKey creates a new HMAC key using the given project and service account.
-func createHMACKey(w io.Writer, projectID string, serviceAccountEmail string) (*storage.HMACKey, error) {
+func createHMACKey(w io.Writer, projectID string, serviceAccountEmail string, opts ...option.ClientOption) (*storage.HMACKey, error) {
 	ctx := context.Background()
 
 	// Initialize client.
-	client, err := storage.NewClient(ctx)
+	client, err := storage.NewClient(ctx, opts...)
 	if err != nil {
-		return nil, fmt.Errorf("storage.NewClient: %v", err)
+		return nil, fmt.Errorf("storage.NewClient: %w", err)
 	}
 	defer client.Close() // Closing the client safely cleans up background resources.
 
-	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 	key, err := client.CreateHMACKey(ctx, projectID, serviceAccountEmail)
 	if err != nil {
-		return nil, fmt.Errorf("CreateHMACKey: %v", err)
+		return nil, fmt.Errorf("CreateHMACKey: %w", err)
 	}
 
 	fmt.Fprintf(w, "%s\n", key)
@@ -22,25 +22,27 @@ import (
 	"time"
 
 	"cloud.google.com/go/storage"
+	"google.golang.org/api/option"
 )
 
 // deleteHMACKey deletes the HMAC key with the given access ID. Key must have state
 // INACTIVE in order to succeed.
-func deleteHMACKey(w io.Writer, accessID string, projectID string) error {
+func deleteHMACKey(w io.Writer, accessID string, projectID string, opts ...option.ClientOption) error {
 	ctx := context.Background()
 
 	// Initialize client.
-	client, err := storage.NewClient(ctx)
+	client, err := storage.NewClient(ctx, opts...)
 	if err != nil {
-		return fmt.Errorf("storage.NewClient: %v", err)
+		return fmt.Errorf("storage.NewClient: %w", err)
 	}
 	defer client.Close() // Closing the client safely cleans up background resources.
 
 	handle := client.HMACKeyHandle(projectID, accessID)
-	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 	if err = handle.Delete(ctx); err != nil {
-		return fmt.Errorf("Delete: %v", err)
+		return fmt.Errorf("Delete: %w", err)
 	}
 
 	fmt.Fprintln(w, "The key is deleted, though it may still appear in ListHMACKeys results.")
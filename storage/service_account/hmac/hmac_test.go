@@ -50,7 +50,7 @@ func TestListKeys(t *testing.T) {
 		t.Fatalf("Error in key creation: %s", err)
 	}
 
-	testutil.Retry(t, 10, 10*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 10 * time.Second, Max: 20 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		keys, err := listHMACKeys(ioutil.Discard, tc.ProjectID)
 		if err != nil {
 			r.Errorf("listHMACKeys raised error: %s", err)
@@ -125,7 +125,7 @@ func TestGetKey(t *testing.T) {
 		t.Errorf("Error in key creation: %s", err)
 	}
 
-	testutil.Retry(t, 10, 10*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 10 * time.Second, Max: 20 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		key, err = getHMACKey(ioutil.Discard, key.AccessID, key.ProjectID)
 		if err != nil {
 			r.Errorf("Error in getHMACKey: %s", err)
@@ -166,7 +166,7 @@ func createTestKey(projectID string) (*storage.HMACKey, error) {
 	ctx := context.Background()
 	key, err := storageClient.CreateHMACKey(ctx, projectID, serviceAccountEmail)
 	if err != nil {
-		return nil, fmt.Errorf("storage.NewClient: %v", err)
+		return nil, fmt.Errorf("storage.NewClient: %w", err)
 	}
 	return key, nil
 }
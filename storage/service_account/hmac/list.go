@@ -23,20 +23,22 @@ import (
 
 	"cloud.google.com/go/storage"
 	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
 )
 
 // listHMACKeys lists all HMAC keys associated with the project.
-func listHMACKeys(w io.Writer, projectID string) ([]*storage.HMACKey, error) {
+func listHMACKeys(w io.Writer, projectID string, opts ...option.ClientOption) ([]*storage.HMACKey, error) {
 	ctx := context.Background()
 
 	// Initialize client.
-	client, err := storage.NewClient(ctx)
+	client, err := storage.NewClient(ctx, opts...)
 	if err != nil {
-		return nil, fmt.Errorf("storage.NewClient: %v", err)
+		return nil, fmt.Errorf("storage.NewClient: %w", err)
 	}
 	defer client.Close() // Closing the client safely cleans up background resources.
 
-	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	const timeout = 30 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 	iter := client.ListHMACKeys(ctx, projectID)
 	var keys []*storage.HMACKey
@@ -46,7 +48,7 @@ func listHMACKeys(w io.Writer, projectID string) ([]*storage.HMACKey, error) {
 			break
 		}
 		if err != nil {
-			return nil, fmt.Errorf("ListHMACKeys: %v", err)
+			return nil, fmt.Errorf("ListHMACKeys: %w", err)
 		}
 		fmt.Fprintf(w, "Service Account Email: %s\n", key.ServiceAccountEmail)
 		fmt.Fprintf(w, "Access ID: %s\n", key.AccessID)
@@ -22,23 +22,25 @@ import (
 	"time"
 
 	"cloud.google.com/go/storage"
+	"google.golang.org/api/option"
 )
 
 // getServiceAccount gets the default Cloud Storage service account email address.
-func getServiceAccount(w io.Writer, projectID string) error {
+func getServiceAccount(w io.Writer, projectID string, opts ...option.ClientOption) error {
 	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
+	client, err := storage.NewClient(ctx, opts...)
 	if err != nil {
-		return fmt.Errorf("storage.NewClient: %v", err)
+		return fmt.Errorf("storage.NewClient: %w", err)
 	}
 	defer client.Close()
 
-	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	const timeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	serviceAccount, err := client.ServiceAccount(ctx, projectID)
 	if err != nil {
-		return fmt.Errorf("ServiceAccount: %v", err)
+		return fmt.Errorf("ServiceAccount: %w", err)
 	}
 
 	fmt.Fprintf(w, "The GCS service account for project %v is: %v\n", projectID, serviceAccount)
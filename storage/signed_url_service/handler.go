@@ -0,0 +1,120 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+// [START storage_signed_url_service_handler]
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// signRequest is the body of a request for a signed URL.
+type signRequest struct {
+	// Method is GET to download the object, or PUT to upload it.
+	Method string `json:"method"`
+	Object string `json:"object"`
+	// ContentType is the Content-Type the upload must be sent with.
+	// Optional, and only for PUT.
+	ContentType string `json:"contentType,omitempty"`
+}
+
+// signResponse tells the caller how to make the request.
+type signResponse struct {
+	URL     string            `json:"url"`
+	Method  string            `json:"method"`
+	Expires time.Time         `json:"expires"`
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+// signHandler issues short-lived V4 signed URLs for objects in one bucket to
+// authenticated callers, as allowed by rules. Apps upload and download
+// directly to and from Cloud Storage with the URLs, without holding
+// credentials for the bucket and without the data passing through this
+// service.
+type signHandler struct {
+	bucket string
+	rules  []rule
+	// ttl is how long the URLs are valid. Keep it short: anyone holding
+	// a URL can use it until it expires.
+	ttl time.Duration
+	// verify authenticates the caller.
+	verify verifier
+	// sign returns a V4 signed URL, such as (*urlsigner.Signer).SignedURL.
+	// The request made with the URL must send headers, given as
+	// "Name: value", with the same values.
+	sign func(method, bucket, object string, expires time.Time, headers []string) (string, error)
+	now  func() time.Time
+}
+
+func (h *signHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	id, err := h.verify(r.Context(), r)
+	if err != nil {
+		if !errors.Is(err, errNoCredentials) {
+			log.Printf("Invalid credentials: %v", err)
+		}
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req signRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 1<<16)).Decode(&req); err != nil {
+		http.Error(w, "Bad request", http.StatusBadRequest)
+		return
+	}
+	req.Method = strings.ToUpper(req.Method)
+	if req.Method != "GET" && req.Method != "PUT" {
+		http.Error(w, "method must be GET or PUT", http.StatusBadRequest)
+		return
+	}
+	if req.ContentType != "" && req.Method != "PUT" {
+		http.Error(w, "contentType is only allowed with PUT", http.StatusBadRequest)
+		return
+	}
+	if !allowed(h.rules, id.UID, req.Method, req.Object) {
+		log.Printf("Denied %s %q to user %q", req.Method, req.Object, id.UID)
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
+	resp := signResponse{Method: req.Method, Expires: h.now().Add(h.ttl).UTC()}
+	var headers []string
+	if req.ContentType != "" {
+		headers = append(headers, "Content-Type: "+req.ContentType)
+		resp.Headers = map[string]string{"Content-Type": req.ContentType}
+	}
+	resp.URL, err = h.sign(req.Method, h.bucket, req.Object, resp.Expires, headers)
+	if err != nil {
+		log.Printf("Signing %s %q: %v", req.Method, req.Object, err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+	log.Printf("Signed %s %q for user %q until %v", req.Method, req.Object, id.UID, resp.Expires)
+
+	w.Header().Set("Content-Type", "application/json")
+	// The URL is a credential; keep it out of shared caches.
+	w.Header().Set("Cache-Control", "no-store")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// [END storage_signed_url_service_handler]
@@ -0,0 +1,86 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+// [START storage_signed_url_service_identity]
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"firebase.google.com/go/v4/auth"
+	"google.golang.org/api/idtoken"
+)
+
+// identity is an authenticated caller.
+type identity struct {
+	UID   string
+	Email string
+}
+
+// errNoCredentials is returned when a request carries no identity at all,
+// as opposed to an invalid one.
+var errNoCredentials = errors.New("no credentials")
+
+// A verifier returns the identity of the caller of r.
+type verifier func(ctx context.Context, r *http.Request) (*identity, error)
+
+// iapVerifier verifies the JWT that Identity-Aware Proxy adds to every
+// request it lets through. audience is
+// "/projects/PROJECT_NUMBER/global/backendServices/SERVICE_ID" behind a load
+// balancer, or "/projects/PROJECT_NUMBER/apps/PROJECT_ID" on App Engine.
+// Checking the JWT, not just the X-Goog-Authenticated-User-* headers, stops
+// requests that bypass the proxy.
+func iapVerifier(audience string) verifier {
+	return func(ctx context.Context, r *http.Request) (*identity, error) {
+		token := r.Header.Get("X-Goog-IAP-JWT-Assertion")
+		if token == "" {
+			return nil, errNoCredentials
+		}
+		payload, err := idtoken.Validate(ctx, token, audience)
+		if err != nil {
+			return nil, fmt.Errorf("idtoken.Validate: %w", err)
+		}
+		if payload.Issuer != "https://cloud.google.com/iap" {
+			return nil, fmt.Errorf("issuer %q is not IAP", payload.Issuer)
+		}
+		email, _ := payload.Claims["email"].(string)
+		// The subject is "accounts.google.com:NUMERIC_ID"; use the ID.
+		uid := payload.Subject[strings.LastIndex(payload.Subject, ":")+1:]
+		return &identity{UID: uid, Email: email}, nil
+	}
+}
+
+// firebaseVerifier verifies the Firebase ID token that a mobile or web app
+// sends as "Authorization: Bearer TOKEN". Get the token in the app with
+// getIdToken() after the user signs in.
+func firebaseVerifier(client *auth.Client) verifier {
+	return func(ctx context.Context, r *http.Request) (*identity, error) {
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if token == "" || token == r.Header.Get("Authorization") {
+			return nil, errNoCredentials
+		}
+		t, err := client.VerifyIDToken(ctx, token)
+		if err != nil {
+			return nil, fmt.Errorf("VerifyIDToken: %w", err)
+		}
+		email, _ := t.Claims["email"].(string)
+		return &identity{UID: t.UID, Email: email}, nil
+	}
+}
+
+// [END storage_signed_url_service_identity]
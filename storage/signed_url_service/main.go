@@ -0,0 +1,122 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command signed_url_service is a sample HTTP service that gives
+// authenticated users short-lived V4 signed URLs, so that a mobile or web
+// app can upload to and download from Cloud Storage directly.
+//
+// The app signs the user in with Firebase Authentication, or reaches the
+// service through Identity-Aware Proxy, and asks for a URL:
+//
+//	curl -X POST https://my-service.run.app/sign \
+//	    -H "Authorization: Bearer $FIREBASE_ID_TOKEN" \
+//	    -d '{"method": "PUT", "object": "users/UID/photo.jpg", "contentType": "image/jpeg"}'
+//
+// then sends the upload to the returned URL, with the returned headers.
+//
+// Run it with the bucket, and either the Firebase project or the IAP
+// audience:
+//
+//	BUCKET=my-bucket FIREBASE_PROJECT=my-project go run .
+//	BUCKET=my-bucket IAP_AUDIENCE=/projects/123/global/backendServices/456 go run .
+//
+// By default users may read and write the objects under "users/UID/". Set
+// RULES_FILE to a JSON list of rules to change that; see rule. Set URL_TTL,
+// such as "5m", to change how long the URLs are valid (15 minutes by
+// default).
+//
+// URLs are signed as the service account of the service, or as
+// SIGNER_SERVICE_ACCOUNT if it is set; see storage/internal/urlsigner. On
+// Cloud Run, the signing account needs the roles/iam.serviceAccountTokenCreator
+// role on itself, and access to the bucket.
+package main
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"os"
+	"time"
+
+	firebase "firebase.google.com/go/v4"
+	"github.com/GoogleCloudPlatform/golang-samples/storage/internal/urlsigner"
+)
+
+func main() {
+	ctx := context.Background()
+
+	h := &signHandler{
+		bucket: os.Getenv("BUCKET"),
+		rules:  defaultRules,
+		ttl:    15 * time.Minute,
+		now:    time.Now,
+	}
+	if h.bucket == "" {
+		log.Fatal("BUCKET must be set")
+	}
+	if f := os.Getenv("RULES_FILE"); f != "" {
+		r, err := os.Open(f)
+		if err != nil {
+			log.Fatal(err)
+		}
+		h.rules, err = parseRules(r)
+		r.Close()
+		if err != nil {
+			log.Fatalf("%s: %v", f, err)
+		}
+	}
+	if v := os.Getenv("URL_TTL"); v != "" {
+		ttl, err := time.ParseDuration(v)
+		if err != nil || ttl <= 0 || ttl > 7*24*time.Hour {
+			log.Fatalf("URL_TTL=%q: want a duration between 0 and 168h", v)
+		}
+		h.ttl = ttl
+	}
+
+	switch project, audience := os.Getenv("FIREBASE_PROJECT"), os.Getenv("IAP_AUDIENCE"); {
+	case project != "" && audience == "":
+		app, err := firebase.NewApp(ctx, &firebase.Config{ProjectID: project})
+		if err != nil {
+			log.Fatalf("firebase.NewApp: %v", err)
+		}
+		client, err := app.Auth(ctx)
+		if err != nil {
+			log.Fatalf("app.Auth: %v", err)
+		}
+		h.verify = firebaseVerifier(client)
+	case audience != "" && project == "":
+		h.verify = iapVerifier(audience)
+	default:
+		log.Fatal("set exactly one of FIREBASE_PROJECT and IAP_AUDIENCE")
+	}
+
+	signer, err := urlsigner.New(ctx, os.Getenv("SIGNER_SERVICE_ACCOUNT"))
+	if err != nil {
+		log.Fatalf("urlsigner.New: %v", err)
+	}
+	defer signer.Close()
+	h.sign = signer.SignedURL
+
+	http.Handle("/sign", h)
+
+	port := os.Getenv("PORT")
+	if port == "" {
+		port = "8080"
+		log.Printf("Defaulting to port %s", port)
+	}
+	log.Printf("Listening on port %s", port)
+	if err := http.ListenAndServe(":"+port, nil); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+// [START storage_signed_url_service_rules]
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// A rule allows callers to get URLs for objects under a prefix. In the
+// prefix, {uid} stands for the caller's user ID, so that
+//
+//	{"prefix": "users/{uid}/", "methods": ["GET", "PUT"]}
+//
+// lets every user read and write their own objects, and nobody else's.
+type rule struct {
+	Prefix  string   `json:"prefix"`
+	Methods []string `json:"methods"`
+}
+
+// defaultRules are used when no rules file is given.
+var defaultRules = []rule{
+	{Prefix: "users/{uid}/", Methods: []string{"GET", "PUT"}},
+}
+
+// parseRules reads a JSON list of rules.
+func parseRules(r io.Reader) ([]rule, error) {
+	var rules []rule
+	if err := json.NewDecoder(r).Decode(&rules); err != nil {
+		return nil, fmt.Errorf("json.Decode: %w", err)
+	}
+	for i, rl := range rules {
+		if rl.Prefix == "" {
+			return nil, fmt.Errorf("rule %d: empty prefix would allow every object", i)
+		}
+		for j, m := range rl.Methods {
+			m = strings.ToUpper(m)
+			if m != "GET" && m != "PUT" {
+				return nil, fmt.Errorf("rule %d: method %q, want GET or PUT", i, m)
+			}
+			rules[i].Methods[j] = m
+		}
+	}
+	return rules, nil
+}
+
+// allowed reports whether a caller with user ID uid may use method on
+// object. The first rule whose prefix matches decides.
+func allowed(rules []rule, uid, method, object string) bool {
+	if !validObject(object) {
+		return false
+	}
+	for _, rl := range rules {
+		prefix := rl.Prefix
+		if strings.Contains(prefix, "{uid}") {
+			// A user ID that contains "/" could reach another
+			// user's prefix.
+			if uid == "" || strings.Contains(uid, "/") {
+				continue
+			}
+			prefix = strings.ReplaceAll(prefix, "{uid}", uid)
+		}
+		if !strings.HasPrefix(object, prefix) {
+			continue
+		}
+		for _, m := range rl.Methods {
+			if m == method {
+				return true
+			}
+		}
+		return false
+	}
+	return false
+}
+
+// validObject rejects object names that Cloud Storage accepts but that
+// would make prefix rules misleading, such as "users/me/../you/photo.jpg",
+// which some clients normalize to "users/you/photo.jpg".
+func validObject(object string) bool {
+	if object == "" || strings.HasPrefix(object, "/") {
+		return false
+	}
+	for _, part := range strings.Split(object, "/") {
+		if part == "." || part == ".." {
+			return false
+		}
+	}
+	return !strings.ContainsAny(object, "\r\n")
+}
+
+// [END storage_signed_url_service_rules]
@@ -0,0 +1,181 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/GoogleCloudPlatform/golang-samples/storage/internal/urlsigner"
+)
+
+// fakeVerify accepts "Bearer alice" and "Bearer bob" as those users.
+func fakeVerify(_ context.Context, r *http.Request) (*identity, error) {
+	switch r.Header.Get("Authorization") {
+	case "":
+		return nil, errNoCredentials
+	case "Bearer alice":
+		return &identity{UID: "alice"}, nil
+	case "Bearer bob":
+		return &identity{UID: "bob"}, nil
+	}
+	return nil, errors.New("invalid token")
+}
+
+func testSigner(t *testing.T) *urlsigner.Signer {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("rsa.GenerateKey: %v", err)
+	}
+	pemKey := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	return urlsigner.FromKey("signer@my-project.iam.gserviceaccount.com", pemKey)
+}
+
+func TestSignHandler(t *testing.T) {
+	// storage.SignedURL dates the signature with the current time, so the
+	// handler must use it too.
+	now := time.Now()
+	h := &signHandler{
+		bucket: "my-bucket",
+		rules: []rule{
+			{Prefix: "public/", Methods: []string{"GET"}},
+			{Prefix: "users/{uid}/", Methods: []string{"GET", "PUT"}},
+		},
+		ttl:    5 * time.Minute,
+		verify: fakeVerify,
+		sign:   testSigner(t).SignedURL,
+		now:    func() time.Time { return now },
+	}
+
+	tests := []struct {
+		name       string
+		auth, body string
+		wantStatus int
+	}{
+		{"upload own", "Bearer alice", `{"method":"put","object":"users/alice/cat.jpg","contentType":"image/jpeg"}`, http.StatusOK},
+		{"read public", "Bearer bob", `{"method":"GET","object":"public/index.html"}`, http.StatusOK},
+		{"no credentials", "", `{"method":"GET","object":"public/index.html"}`, http.StatusUnauthorized},
+		{"bad token", "Bearer mallory", `{"method":"GET","object":"public/index.html"}`, http.StatusUnauthorized},
+		{"other user", "Bearer bob", `{"method":"GET","object":"users/alice/cat.jpg"}`, http.StatusForbidden},
+		{"write public", "Bearer bob", `{"method":"PUT","object":"public/index.html"}`, http.StatusForbidden},
+		{"dot dot", "Bearer bob", `{"method":"PUT","object":"users/bob/../alice/cat.jpg"}`, http.StatusForbidden},
+		{"no rule", "Bearer bob", `{"method":"GET","object":"private/key"}`, http.StatusForbidden},
+		{"delete", "Bearer alice", `{"method":"DELETE","object":"users/alice/cat.jpg"}`, http.StatusBadRequest},
+		{"content type on GET", "Bearer alice", `{"method":"GET","object":"users/alice/cat.jpg","contentType":"image/jpeg"}`, http.StatusBadRequest},
+		{"not JSON", "Bearer alice", `users/alice/cat.jpg`, http.StatusBadRequest},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest("POST", "/sign", strings.NewReader(tc.body))
+			if tc.auth != "" {
+				req.Header.Set("Authorization", tc.auth)
+			}
+			rr := httptest.NewRecorder()
+			h.ServeHTTP(rr, req)
+			if rr.Code != tc.wantStatus {
+				t.Fatalf("got status %d, want %d: %s", rr.Code, tc.wantStatus, rr.Body)
+			}
+			if rr.Code != http.StatusOK {
+				return
+			}
+			if got := rr.Header().Get("Cache-Control"); got != "no-store" {
+				t.Errorf("Cache-Control got %q, want no-store", got)
+			}
+			var resp signResponse
+			if err := json.NewDecoder(rr.Body).Decode(&resp); err != nil {
+				t.Fatalf("json.Decode: %v", err)
+			}
+			if want := now.Add(h.ttl); !resp.Expires.Equal(want.UTC()) {
+				t.Errorf("expires got %v, want %v", resp.Expires, want)
+			}
+			u, err := url.Parse(resp.URL)
+			if err != nil {
+				t.Fatalf("url.Parse(%q): %v", resp.URL, err)
+			}
+			q := u.Query()
+			// A second may pass between the handler and the signature.
+			if got := q.Get("X-Goog-Expires"); got != "300" && got != "299" {
+				t.Errorf("X-Goog-Expires got %q, want 300", got)
+			}
+			if q.Get("X-Goog-Signature") == "" {
+				t.Errorf("URL %q is not signed", resp.URL)
+			}
+		})
+	}
+}
+
+func TestSignHandlerContentType(t *testing.T) {
+	h := &signHandler{
+		bucket: "my-bucket",
+		rules:  defaultRules,
+		ttl:    time.Minute,
+		verify: fakeVerify,
+		sign:   testSigner(t).SignedURL,
+		now:    time.Now,
+	}
+	req := httptest.NewRequest("POST", "/sign", strings.NewReader(`{"method":"PUT","object":"users/alice/cat.jpg","contentType":"image/jpeg"}`))
+	req.Header.Set("Authorization", "Bearer alice")
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, req)
+	var resp signResponse
+	if err := json.NewDecoder(rr.Body).Decode(&resp); err != nil {
+		t.Fatalf("json.Decode: %v", err)
+	}
+	if got := resp.Headers["Content-Type"]; got != "image/jpeg" {
+		t.Errorf("headers got Content-Type %q, want image/jpeg", got)
+	}
+	// The upload must send the Content-Type it was signed for.
+	if !strings.Contains(resp.URL, "content-type") {
+		t.Errorf("URL %q does not sign the content-type header", resp.URL)
+	}
+}
+
+func TestParseRules(t *testing.T) {
+	rules, err := parseRules(strings.NewReader(`[{"prefix":"uploads/{uid}/","methods":["put"]}]`))
+	if err != nil {
+		t.Fatalf("parseRules: %v", err)
+	}
+	if !allowed(rules, "alice", "PUT", "uploads/alice/a.txt") {
+		t.Errorf("PUT to own prefix not allowed")
+	}
+	if allowed(rules, "alice", "GET", "uploads/alice/a.txt") {
+		t.Errorf("GET allowed by a PUT-only rule")
+	}
+	if allowed(rules, "a/b", "PUT", "uploads/a/b/c.txt") {
+		t.Errorf("user ID with a slash matched a {uid} rule")
+	}
+
+	for _, bad := range []string{
+		`[{"prefix":"","methods":["GET"]}]`,
+		`[{"prefix":"a/","methods":["DELETE"]}]`,
+		`{}`,
+	} {
+		if _, err := parseRules(strings.NewReader(bad)); err == nil {
+			t.Errorf("parseRules(%s) got nil error, want non-nil", bad)
+		}
+	}
+}
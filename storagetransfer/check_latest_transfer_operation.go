@@ -0,0 +1,72 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storagetransfer
+
+// [START storagetransfer_get_latest_transfer_operation]
+import (
+	"context"
+	"fmt"
+	"io"
+
+	storagetransfer "cloud.google.com/go/storagetransfer/apiv1"
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/genproto/googleapis/longrunning"
+	storagetransferpb "google.golang.org/genproto/googleapis/storagetransfer/v1"
+)
+
+// checkLatestTransferOperation prints the status of the most recent run of a
+// transfer job.
+func checkLatestTransferOperation(w io.Writer, projectID, jobName string) (*storagetransferpb.TransferOperation, error) {
+	// projectID := "my-project-id"
+	// jobName := "transferJobs/1234567890"
+	ctx := context.Background()
+	client, err := storagetransfer.NewClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("storagetransfer.NewClient: %w", err)
+	}
+	defer client.Close()
+
+	job, err := client.GetTransferJob(ctx, &storagetransferpb.GetTransferJobRequest{
+		JobName:   jobName,
+		ProjectId: projectID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("GetTransferJob: %w", err)
+	}
+	if job.LatestOperationName == "" {
+		fmt.Fprintf(w, "Transfer job %v has not run yet\n", jobName)
+		return nil, nil
+	}
+
+	op, err := client.LROClient.GetOperation(ctx, &longrunning.GetOperationRequest{
+		Name: job.LatestOperationName,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("GetOperation: %w", err)
+	}
+	// The details of a transfer operation are stored in its metadata.
+	var transferOp storagetransferpb.TransferOperation
+	if err := ptypes.UnmarshalAny(op.Metadata, &transferOp); err != nil {
+		return nil, fmt.Errorf("ptypes.UnmarshalAny: %w", err)
+	}
+	fmt.Fprintf(w, "Latest operation of transfer job %v: %v\n", jobName, transferOp.Name)
+	fmt.Fprintf(w, "Status: %v\n", transferOp.Status)
+	if c := transferOp.Counters; c != nil {
+		fmt.Fprintf(w, "Objects copied: %d, bytes copied: %d\n", c.ObjectsCopiedToSink, c.BytesCopiedToSink)
+	}
+	return &transferOp, nil
+}
+
+// [END storagetransfer_get_latest_transfer_operation]
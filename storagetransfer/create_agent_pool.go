@@ -0,0 +1,57 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storagetransfer
+
+// [START storagetransfer_create_agent_pool]
+import (
+	"context"
+	"fmt"
+	"io"
+
+	storagetransfer "cloud.google.com/go/storagetransfer/apiv1"
+	storagetransferpb "google.golang.org/genproto/googleapis/storagetransfer/v1"
+)
+
+// createAgentPool creates an agent pool. Transfer agents installed on your
+// own machines join a pool and carry out the transfers that use it.
+func createAgentPool(w io.Writer, projectID, agentPoolID string) (*storagetransferpb.AgentPool, error) {
+	// projectID := "my-project-id"
+	// agentPoolID := "my-agent-pool"
+	ctx := context.Background()
+	client, err := storagetransfer.NewClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("storagetransfer.NewClient: %w", err)
+	}
+	defer client.Close()
+
+	req := &storagetransferpb.CreateAgentPoolRequest{
+		ProjectId:   projectID,
+		AgentPoolId: agentPoolID,
+		AgentPool: &storagetransferpb.AgentPool{
+			Name:        fmt.Sprintf("projects/%s/agentPools/%s", projectID, agentPoolID),
+			DisplayName: agentPoolID,
+		},
+	}
+	pool, err := client.CreateAgentPool(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("CreateAgentPool: %w", err)
+	}
+	fmt.Fprintf(w, "Created agent pool: %v\n", pool.Name)
+	fmt.Fprintln(w, "Install transfer agents into this pool with:")
+	fmt.Fprintf(w, "gcloud transfer agents install --pool=%s\n", agentPoolID)
+	return pool, nil
+}
+
+// [END storagetransfer_create_agent_pool]
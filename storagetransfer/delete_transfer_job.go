@@ -0,0 +1,58 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storagetransfer
+
+// [START storagetransfer_delete_transfer_job]
+import (
+	"context"
+	"fmt"
+	"io"
+
+	storagetransfer "cloud.google.com/go/storagetransfer/apiv1"
+	storagetransferpb "google.golang.org/genproto/googleapis/storagetransfer/v1"
+	fieldmask "google.golang.org/genproto/protobuf/field_mask"
+)
+
+// deleteTransferJob deletes a transfer job. Deleted jobs stop running and are
+// garbage collected by the service about 30 days later.
+func deleteTransferJob(w io.Writer, projectID, jobName string) error {
+	// projectID := "my-project-id"
+	// jobName := "transferJobs/1234567890"
+	ctx := context.Background()
+	client, err := storagetransfer.NewClient(ctx)
+	if err != nil {
+		return fmt.Errorf("storagetransfer.NewClient: %w", err)
+	}
+	defer client.Close()
+
+	req := &storagetransferpb.UpdateTransferJobRequest{
+		JobName:   jobName,
+		ProjectId: projectID,
+		TransferJob: &storagetransferpb.TransferJob{
+			Name:   jobName,
+			Status: storagetransferpb.TransferJob_DELETED,
+		},
+		UpdateTransferJobFieldMask: &fieldmask.FieldMask{
+			Paths: []string{"status"},
+		},
+	}
+	if _, err := client.UpdateTransferJob(ctx, req); err != nil {
+		return fmt.Errorf("UpdateTransferJob: %w", err)
+	}
+	fmt.Fprintf(w, "Deleted transfer job %v\n", jobName)
+	return nil
+}
+
+// [END storagetransfer_delete_transfer_job]
@@ -0,0 +1,59 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storagetransfer
+
+// [START storagetransfer_list_transfer_jobs]
+import (
+	"context"
+	"fmt"
+	"io"
+
+	storagetransfer "cloud.google.com/go/storagetransfer/apiv1"
+	"google.golang.org/api/iterator"
+	storagetransferpb "google.golang.org/genproto/googleapis/storagetransfer/v1"
+)
+
+// listTransferJobs lists the transfer jobs of a project.
+func listTransferJobs(w io.Writer, projectID string) ([]*storagetransferpb.TransferJob, error) {
+	// projectID := "my-project-id"
+	ctx := context.Background()
+	client, err := storagetransfer.NewClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("storagetransfer.NewClient: %w", err)
+	}
+	defer client.Close()
+
+	// The filter is a JSON object. projectId is required; jobNames and
+	// jobStatuses can be added to narrow the results.
+	req := &storagetransferpb.ListTransferJobsRequest{
+		Filter: fmt.Sprintf(`{"projectId": %q}`, projectID),
+	}
+	var jobs []*storagetransferpb.TransferJob
+	it := client.ListTransferJobs(ctx, req)
+	for {
+		job, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("ListTransferJobs: %w", err)
+		}
+		fmt.Fprintf(w, "%v: %v\n", job.Name, job.Status)
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
+
+// [END storagetransfer_list_transfer_jobs]
@@ -0,0 +1,46 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storagetransfer
+
+// [START storagetransfer_resume_transfer_operation]
+import (
+	"context"
+	"fmt"
+	"io"
+
+	storagetransfer "cloud.google.com/go/storagetransfer/apiv1"
+	storagetransferpb "google.golang.org/genproto/googleapis/storagetransfer/v1"
+)
+
+// resumeTransferOperation resumes a paused transfer operation.
+func resumeTransferOperation(w io.Writer, operationName string) error {
+	// operationName := "transferOperations/transferJobs-1234567890-0987654321"
+	ctx := context.Background()
+	client, err := storagetransfer.NewClient(ctx)
+	if err != nil {
+		return fmt.Errorf("storagetransfer.NewClient: %w", err)
+	}
+	defer client.Close()
+
+	if err := client.ResumeTransferOperation(ctx, &storagetransferpb.ResumeTransferOperationRequest{
+		Name: operationName,
+	}); err != nil {
+		return fmt.Errorf("ResumeTransferOperation: %w", err)
+	}
+	fmt.Fprintf(w, "Resumed transfer operation %v\n", operationName)
+	return nil
+}
+
+// [END storagetransfer_resume_transfer_operation]
@@ -0,0 +1,245 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storagetransfer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/iam"
+	"cloud.google.com/go/storage"
+	storagetransfer "cloud.google.com/go/storagetransfer/apiv1"
+	"github.com/GoogleCloudPlatform/golang-samples/internal/testutil"
+	storagetransferpb "google.golang.org/genproto/googleapis/storagetransfer/v1"
+)
+
+func TestTransferFromPosix(t *testing.T) {
+	tc := testutil.SystemTest(t)
+	ctx := context.Background()
+	client, err := storagetransfer.NewClient(ctx)
+	if err != nil {
+		t.Fatalf("storagetransfer.NewClient: %v", err)
+	}
+	defer client.Close()
+
+	var (
+		bucket      = testutil.UniqueName("transfer-posix-sink")
+		agentPoolID = testutil.UniqueName("transfer-pool")
+	)
+	testutil.CleanBucket(ctx, t, tc.ProjectID, bucket)
+	t.Cleanup(func() { testutil.DeleteBucket(context.Background(), t, bucket) })
+	grantBucketAccess(ctx, t, client, tc.ProjectID, bucket)
+
+	buf := new(bytes.Buffer)
+	pool, err := createAgentPool(buf, tc.ProjectID, agentPoolID)
+	if err != nil {
+		t.Fatalf("createAgentPool: %v", err)
+	}
+	defer deleteAgentPool(ctx, t, client, pool.Name)
+	if got, want := buf.String(), "Created agent pool"; !strings.Contains(got, want) {
+		t.Errorf("createAgentPool got %q, want to contain %q", got, want)
+	}
+
+	buf.Reset()
+	job, err := transferFromPosix(buf, tc.ProjectID, pool.Name, "/tmp/go-samples-posix-source", bucket)
+	if err != nil {
+		t.Fatalf("transferFromPosix: %v", err)
+	}
+	defer deleteTransferJob(ioutil.Discard, tc.ProjectID, job.Name)
+	if got, want := buf.String(), job.Name; !strings.Contains(got, want) {
+		t.Errorf("transferFromPosix got %q, want to contain %q", got, want)
+	}
+}
+
+func TestTransferUsingManifest(t *testing.T) {
+	tc := testutil.SystemTest(t)
+	ctx := context.Background()
+	client, err := storagetransfer.NewClient(ctx)
+	if err != nil {
+		t.Fatalf("storagetransfer.NewClient: %v", err)
+	}
+	defer client.Close()
+
+	var (
+		srcBucket = testutil.UniqueName("transfer-manifest-src")
+		dstBucket = testutil.UniqueName("transfer-manifest-dst")
+		listed    = "listed.txt"
+		unlisted  = "unlisted.txt"
+		manifest  = "manifest.csv"
+	)
+	for _, b := range []string{srcBucket, dstBucket} {
+		testutil.CleanBucket(ctx, t, tc.ProjectID, b)
+		t.Cleanup(func() { testutil.DeleteBucket(context.Background(), t, b) })
+	}
+	grantBucketAccess(ctx, t, client, tc.ProjectID, srcBucket)
+	grantBucketAccess(ctx, t, client, tc.ProjectID, dstBucket)
+
+	sc, err := storage.NewClient(ctx)
+	if err != nil {
+		t.Fatalf("storage.NewClient: %v", err)
+	}
+	defer sc.Close()
+	for name, content := range map[string]string{
+		listed:   "hello",
+		unlisted: "world",
+		manifest: listed + "\n",
+	} {
+		wc := sc.Bucket(srcBucket).Object(name).NewWriter(ctx)
+		if _, err := wc.Write([]byte(content)); err != nil {
+			t.Fatalf("Writer.Write(%q): %v", name, err)
+		}
+		if err := wc.Close(); err != nil {
+			t.Fatalf("Writer.Close(%q): %v", name, err)
+		}
+	}
+
+	buf := new(bytes.Buffer)
+	manifestLocation := fmt.Sprintf("gs://%s/%s", srcBucket, manifest)
+	job, err := transferUsingManifest(buf, tc.ProjectID, srcBucket, dstBucket, manifestLocation)
+	if err != nil {
+		t.Fatalf("transferUsingManifest: %v", err)
+	}
+	defer deleteTransferJob(ioutil.Discard, tc.ProjectID, job.Name)
+
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 10 * time.Second, Max: 20 * time.Second, Multiplier: 2, MaxAttempts: 20}, func(r *testutil.R) {
+		if _, err := sc.Bucket(dstBucket).Object(listed).Attrs(ctx); err != nil {
+			r.Errorf("Object(%q).Attrs: %v", listed, err)
+		}
+	})
+	if _, err := sc.Bucket(dstBucket).Object(unlisted).Attrs(ctx); err != storage.ErrObjectNotExist {
+		t.Errorf("Object(%q).Attrs got err %v, want %v", unlisted, err, storage.ErrObjectNotExist)
+	}
+}
+
+func TestTransferJobManagement(t *testing.T) {
+	tc := testutil.SystemTest(t)
+	ctx := context.Background()
+	client, err := storagetransfer.NewClient(ctx)
+	if err != nil {
+		t.Fatalf("storagetransfer.NewClient: %v", err)
+	}
+	defer client.Close()
+
+	var (
+		srcBucket = testutil.UniqueName("transfer-manage-src")
+		dstBucket = testutil.UniqueName("transfer-manage-dst")
+	)
+	for _, b := range []string{srcBucket, dstBucket} {
+		testutil.CleanBucket(ctx, t, tc.ProjectID, b)
+		t.Cleanup(func() { testutil.DeleteBucket(context.Background(), t, b) })
+	}
+	grantBucketAccess(ctx, t, client, tc.ProjectID, srcBucket)
+	grantBucketAccess(ctx, t, client, tc.ProjectID, dstBucket)
+
+	manifestLocation := fmt.Sprintf("gs://%s/manifest.csv", srcBucket)
+	job, err := transferUsingManifest(ioutil.Discard, tc.ProjectID, srcBucket, dstBucket, manifestLocation)
+	if err != nil {
+		t.Fatalf("transferUsingManifest: %v", err)
+	}
+
+	t.Run("listTransferJobs", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		if _, err := listTransferJobs(buf, tc.ProjectID); err != nil {
+			t.Fatalf("listTransferJobs: %v", err)
+		}
+		if got, want := buf.String(), job.Name; !strings.Contains(got, want) {
+			t.Errorf("listTransferJobs got %q, want to contain %q", got, want)
+		}
+	})
+
+	var op *storagetransferpb.TransferOperation
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 5 * time.Second, Max: 10 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
+		op, err = checkLatestTransferOperation(ioutil.Discard, tc.ProjectID, job.Name)
+		if err != nil {
+			r.Errorf("checkLatestTransferOperation: %v", err)
+			return
+		}
+		if op == nil {
+			r.Errorf("checkLatestTransferOperation: job %q has no operation yet", job.Name)
+		}
+	})
+
+	t.Run("pauseAndResume", func(t *testing.T) {
+		if op == nil || op.Status != storagetransferpb.TransferOperation_IN_PROGRESS {
+			t.Skip("transfer operation is no longer running")
+		}
+		if err := pauseTransferOperation(ioutil.Discard, op.Name); err != nil {
+			t.Fatalf("pauseTransferOperation: %v", err)
+		}
+		if err := resumeTransferOperation(ioutil.Discard, op.Name); err != nil {
+			t.Fatalf("resumeTransferOperation: %v", err)
+		}
+	})
+
+	buf := new(bytes.Buffer)
+	if err := deleteTransferJob(buf, tc.ProjectID, job.Name); err != nil {
+		t.Fatalf("deleteTransferJob: %v", err)
+	}
+	if got, want := buf.String(), "Deleted transfer job"; !strings.Contains(got, want) {
+		t.Errorf("deleteTransferJob got %q, want to contain %q", got, want)
+	}
+}
+
+// grantBucketAccess makes sure the Storage Transfer Service agent can write to
+// the sink bucket.
+func grantBucketAccess(ctx context.Context, t *testing.T, client *storagetransfer.Client, projectID, bucket string) {
+	t.Helper()
+
+	sa, err := client.GetGoogleServiceAccount(ctx, &storagetransferpb.GetGoogleServiceAccountRequest{
+		ProjectId: projectID,
+	})
+	if err != nil {
+		t.Fatalf("GetGoogleServiceAccount: %v", err)
+	}
+	if err := addBucketMember(ctx, t, bucket, "serviceAccount:"+sa.AccountEmail, "roles/storage.admin"); err != nil {
+		t.Fatalf("addBucketMember: %v", err)
+	}
+}
+
+func addBucketMember(ctx context.Context, t *testing.T, bucket, member string, role iam.RoleName) error {
+	client, err := storage.NewClient(ctx)
+	if err != nil {
+		return fmt.Errorf("storage.NewClient: %w", err)
+	}
+	defer client.Close()
+
+	bucketIAM := client.Bucket(bucket).IAM()
+	policy, err := bucketIAM.Policy(ctx)
+	if err != nil {
+		return fmt.Errorf("Bucket(%q).IAM().Policy: %w", bucket, err)
+	}
+	policy.Add(member, role)
+	testutil.IAMLimiter.Take(t)
+	if err := bucketIAM.SetPolicy(ctx, policy); err != nil {
+		return fmt.Errorf("Bucket(%q).IAM().SetPolicy: %w", bucket, err)
+	}
+	return nil
+}
+
+func deleteAgentPool(ctx context.Context, t *testing.T, client *storagetransfer.Client, name string) {
+	t.Helper()
+
+	// The pool can only be deleted once no job references it.
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 5 * time.Second, Max: 10 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
+		if err := client.DeleteAgentPool(ctx, &storagetransferpb.DeleteAgentPoolRequest{Name: name}); err != nil {
+			r.Errorf("DeleteAgentPool(%q): %v", name, err)
+		}
+	})
+}
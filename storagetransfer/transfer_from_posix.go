@@ -0,0 +1,77 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storagetransfer
+
+// [START storagetransfer_transfer_from_posix]
+import (
+	"context"
+	"fmt"
+	"io"
+
+	storagetransfer "cloud.google.com/go/storagetransfer/apiv1"
+	storagetransferpb "google.golang.org/genproto/googleapis/storagetransfer/v1"
+)
+
+// transferFromPosix creates and runs a transfer job that copies a directory
+// on a POSIX filesystem into a Cloud Storage bucket. The directory must be
+// reachable by the agents in sourceAgentPoolName.
+func transferFromPosix(w io.Writer, projectID, sourceAgentPoolName, rootDirectory, gcsSinkBucket string) (*storagetransferpb.TransferJob, error) {
+	// projectID := "my-project-id"
+	// sourceAgentPoolName := "projects/my-project-id/agentPools/transfer_service_default"
+	// rootDirectory := "/directory/to/transfer/source"
+	// gcsSinkBucket := "my-sink-bucket"
+	ctx := context.Background()
+	client, err := storagetransfer.NewClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("storagetransfer.NewClient: %w", err)
+	}
+	defer client.Close()
+
+	req := &storagetransferpb.CreateTransferJobRequest{
+		TransferJob: &storagetransferpb.TransferJob{
+			ProjectId: projectID,
+			TransferSpec: &storagetransferpb.TransferSpec{
+				SourceAgentPoolName: sourceAgentPoolName,
+				DataSource: &storagetransferpb.TransferSpec_PosixDataSource{
+					PosixDataSource: &storagetransferpb.PosixFilesystem{
+						RootDirectory: rootDirectory,
+					},
+				},
+				DataSink: &storagetransferpb.TransferSpec_GcsDataSink{
+					GcsDataSink: &storagetransferpb.GcsData{
+						BucketName: gcsSinkBucket,
+					},
+				},
+			},
+			Status: storagetransferpb.TransferJob_ENABLED,
+		},
+	}
+	job, err := client.CreateTransferJob(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("CreateTransferJob: %w", err)
+	}
+
+	// A job without a schedule only runs when asked to.
+	if _, err := client.RunTransferJob(ctx, &storagetransferpb.RunTransferJobRequest{
+		ProjectId: projectID,
+		JobName:   job.Name,
+	}); err != nil {
+		return nil, fmt.Errorf("RunTransferJob: %w", err)
+	}
+	fmt.Fprintf(w, "Created and ran transfer job from %v to gs://%v with name %v\n", rootDirectory, gcsSinkBucket, job.Name)
+	return job, nil
+}
+
+// [END storagetransfer_transfer_from_posix]
@@ -0,0 +1,82 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storagetransfer
+
+// [START storagetransfer_manifest_request]
+import (
+	"context"
+	"fmt"
+	"io"
+
+	storagetransfer "cloud.google.com/go/storagetransfer/apiv1"
+	storagetransferpb "google.golang.org/genproto/googleapis/storagetransfer/v1"
+)
+
+// transferUsingManifest creates and runs a transfer job that only copies the
+// objects listed in a CSV manifest. Each line of the manifest holds an object
+// name, optionally followed by a generation, e.g.:
+//
+//	photos/2020/beach.jpg
+//	photos/2020/mountain.jpg,1600000000000000
+func transferUsingManifest(w io.Writer, projectID, gcsSourceBucket, gcsSinkBucket, manifestLocation string) (*storagetransferpb.TransferJob, error) {
+	// projectID := "my-project-id"
+	// gcsSourceBucket := "my-source-bucket"
+	// gcsSinkBucket := "my-sink-bucket"
+	// manifestLocation := "gs://my-manifest-bucket/manifest.csv"
+	ctx := context.Background()
+	client, err := storagetransfer.NewClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("storagetransfer.NewClient: %w", err)
+	}
+	defer client.Close()
+
+	req := &storagetransferpb.CreateTransferJobRequest{
+		TransferJob: &storagetransferpb.TransferJob{
+			ProjectId: projectID,
+			TransferSpec: &storagetransferpb.TransferSpec{
+				DataSource: &storagetransferpb.TransferSpec_GcsDataSource{
+					GcsDataSource: &storagetransferpb.GcsData{
+						BucketName: gcsSourceBucket,
+					},
+				},
+				DataSink: &storagetransferpb.TransferSpec_GcsDataSink{
+					GcsDataSink: &storagetransferpb.GcsData{
+						BucketName: gcsSinkBucket,
+					},
+				},
+				// Objects that are not listed in the manifest are skipped.
+				TransferManifest: &storagetransferpb.TransferManifest{
+					Location: manifestLocation,
+				},
+			},
+			Status: storagetransferpb.TransferJob_ENABLED,
+		},
+	}
+	job, err := client.CreateTransferJob(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("CreateTransferJob: %w", err)
+	}
+
+	if _, err := client.RunTransferJob(ctx, &storagetransferpb.RunTransferJobRequest{
+		ProjectId: projectID,
+		JobName:   job.Name,
+	}); err != nil {
+		return nil, fmt.Errorf("RunTransferJob: %w", err)
+	}
+	fmt.Fprintf(w, "Created and ran transfer job from gs://%v to gs://%v using manifest %v with name %v\n", gcsSourceBucket, gcsSinkBucket, manifestLocation, job.Name)
+	return job, nil
+}
+
+// [END storagetransfer_manifest_request]
@@ -33,7 +33,7 @@ func createHTTPTask(projectID, locationID, queueID, url, message string) (*tasks
 	ctx := context.Background()
 	client, err := cloudtasks.NewClient(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("NewClient: %v", err)
+		return nil, fmt.Errorf("NewClient: %w", err)
 	}
 
 	// Build the Task queue path.
@@ -59,7 +59,7 @@ func createHTTPTask(projectID, locationID, queueID, url, message string) (*tasks
 
 	createdTask, err := client.CreateTask(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("cloudtasks.CreateTask: %v", err)
+		return nil, fmt.Errorf("cloudtasks.CreateTask: %w", err)
 	}
 
 	return createdTask, nil
@@ -32,7 +32,7 @@ func createHTTPTaskWithToken(projectID, locationID, queueID, url, email, message
 	ctx := context.Background()
 	client, err := cloudtasks.NewClient(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("NewClient: %v", err)
+		return nil, fmt.Errorf("NewClient: %w", err)
 	}
 
 	// Build the Task queue path.
@@ -63,7 +63,7 @@ func createHTTPTaskWithToken(projectID, locationID, queueID, url, email, message
 
 	createdTask, err := client.CreateTask(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("cloudtasks.CreateTask: %v", err)
+		return nil, fmt.Errorf("cloudtasks.CreateTask: %w", err)
 	}
 
 	return createdTask, nil
@@ -180,6 +180,8 @@ goVersionShouldSkip() {
 
 if [[ $RUN_ALL_TESTS = "1" ]]; then
   echo "Running all tests"
+  # Let internal/testutil delete resources leaked by earlier runs.
+  export GOLANG_SAMPLES_GC_MAX_AGE=24h
   # shellcheck disable=SC2044
   for i in $(find . -name go.mod); do
     pushd "$(dirname "$i")" > /dev/null;
@@ -37,7 +37,7 @@ func batchTranslateText(w io.Writer, projectID string, location string, inputURI
 	ctx := context.Background()
 	client, err := translate.NewTranslationClient(ctx)
 	if err != nil {
-		return fmt.Errorf("NewTranslationClient: %v", err)
+		return fmt.Errorf("NewTranslationClient: %w", err)
 	}
 	defer client.Close()
 
@@ -66,13 +66,13 @@ func batchTranslateText(w io.Writer, projectID string, location string, inputURI
 	// The BatchTranslateText operation is async.
 	op, err := client.BatchTranslateText(ctx, req)
 	if err != nil {
-		return fmt.Errorf("BatchTranslateText: %v", err)
+		return fmt.Errorf("BatchTranslateText: %w", err)
 	}
 	fmt.Fprintf(w, "Processing operation name: %q\n", op.Name())
 
 	resp, err := op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("Wait: %v", err)
+		return fmt.Errorf("Wait: %w", err)
 	}
 
 	fmt.Fprintf(w, "Total characters: %v\n", resp.GetTotalCharacters())
@@ -53,7 +53,7 @@ func TestBatchTranslateText(t *testing.T) {
 	defer deleteBucket(ctx, t, bucket)
 
 	// Translate a sample text and check the number of translated characters.
-	testutil.Retry(t, 3, 10*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 10 * time.Second, Max: 20 * time.Second, Multiplier: 2, MaxAttempts: 3}, func(r *testutil.R) {
 		var buf bytes.Buffer
 		if err := batchTranslateText(&buf, tc.ProjectID, location, inputURI, outputURI, sourceLang, targetLang); err != nil {
 			r.Errorf("batchTranslateText: %v", err)
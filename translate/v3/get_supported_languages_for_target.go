@@ -33,7 +33,7 @@ func getSupportedLanguagesForTarget(w io.Writer, projectID string, languageCode
 	ctx := context.Background()
 	client, err := translate.NewTranslationClient(ctx)
 	if err != nil {
-		return fmt.Errorf("NewTranslationClient: %v", err)
+		return fmt.Errorf("NewTranslationClient: %w", err)
 	}
 	defer client.Close()
 
@@ -44,7 +44,7 @@ func getSupportedLanguagesForTarget(w io.Writer, projectID string, languageCode
 
 	resp, err := client.GetSupportedLanguages(ctx, req)
 	if err != nil {
-		return fmt.Errorf("GetSupportedLanguages: %v", err)
+		return fmt.Errorf("GetSupportedLanguages: %w", err)
 	}
 
 	// List language codes of supported languages
@@ -38,7 +38,7 @@ func translateTextWithGlossaryAndModel(w io.Writer, projectID string, location s
 	ctx := context.Background()
 	client, err := translate.NewTranslationClient(ctx)
 	if err != nil {
-		return fmt.Errorf("NewTranslationClient: %v", err)
+		return fmt.Errorf("NewTranslationClient: %w", err)
 	}
 	defer client.Close()
 
@@ -56,7 +56,7 @@ func translateTextWithGlossaryAndModel(w io.Writer, projectID string, location s
 
 	resp, err := client.TranslateText(ctx, req)
 	if err != nil {
-		return fmt.Errorf("TranslateText: %v", err)
+		return fmt.Errorf("TranslateText: %w", err)
 	}
 
 	// Display the translation for each input text provided
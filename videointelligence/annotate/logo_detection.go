@@ -36,13 +36,13 @@ func logoDetection(w io.Writer, filename string) error {
 	// Creates a client.
 	client, err := video.NewClient(ctx)
 	if err != nil {
-		return fmt.Errorf("video.NewClient: %v", err)
+		return fmt.Errorf("video.NewClient: %w", err)
 	}
 	defer client.Close()
 
 	fileBytes, err := ioutil.ReadFile(filename)
 	if err != nil {
-		return fmt.Errorf("ioutil.ReadFile: %v", err)
+		return fmt.Errorf("ioutil.ReadFile: %w", err)
 	}
 
 	op, err := client.AnnotateVideo(ctx, &videopb.AnnotateVideoRequest{
@@ -52,12 +52,12 @@ func logoDetection(w io.Writer, filename string) error {
 		},
 	})
 	if err != nil {
-		return fmt.Errorf("AnnotateVideo: %v", err)
+		return fmt.Errorf("AnnotateVideo: %w", err)
 	}
 
 	resp, err := op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("Wait: %v", err)
+		return fmt.Errorf("Wait: %w", err)
 	}
 
 	// Only one video was processed, so get the first result.
@@ -35,7 +35,7 @@ func logoDetectionGCS(w io.Writer, gcsURI string) error {
 	// Creates a client.
 	client, err := video.NewClient(ctx)
 	if err != nil {
-		return fmt.Errorf("video.NewClient: %v", err)
+		return fmt.Errorf("video.NewClient: %w", err)
 	}
 	defer client.Close()
 
@@ -46,12 +46,12 @@ func logoDetectionGCS(w io.Writer, gcsURI string) error {
 		},
 	})
 	if err != nil {
-		return fmt.Errorf("AnnotateVideo: %v", err)
+		return fmt.Errorf("AnnotateVideo: %w", err)
 	}
 
 	resp, err := op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("Wait: %v", err)
+		return fmt.Errorf("Wait: %w", err)
 	}
 
 	// Only one video was processed, so get the first result.
@@ -28,7 +28,7 @@ func TestLogoDetection(t *testing.T) {
 
 	file := "../testdata/googlework_short.mp4"
 
-	testutil.Retry(t, 10, 20*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 20 * time.Second, Max: 40 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		var buf bytes.Buffer
 		if err := logoDetection(&buf, file); err != nil {
 			r.Errorf("logoDetection: %v", err)
@@ -29,7 +29,7 @@ func TestObjectTracking(t *testing.T) {
 
 	filename := "../testdata/cat.mp4"
 
-	testutil.Retry(t, 10, 20*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 20 * time.Second, Max: 40 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		var buf bytes.Buffer
 		if err := objectTracking(&buf, filename); err != nil {
 			r.Errorf("objectTracking: %v", err)
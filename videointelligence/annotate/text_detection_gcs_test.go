@@ -32,7 +32,7 @@ func TestTextDetectionGCS(t *testing.T) {
 		"LONDRES", "OMAR", "PARIS", "METRO", "RUE", "CARLO",
 	}
 
-	testutil.Retry(t, 10, 20*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 20 * time.Second, Max: 40 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 		var buf bytes.Buffer
 		if err := textDetectionGCS(&buf, gcsURI); err != nil {
 			r.Errorf("textDetectionGCS: %v", err)
@@ -47,7 +47,7 @@ func TestAnalyze(t *testing.T) {
 			t.Fatal("gcs not set")
 		}
 
-		testutil.Retry(t, 10, 20*time.Second, func(r *testutil.R) {
+		testutil.RetryBackoff(t, testutil.Backoff{Initial: 20 * time.Second, Max: 40 * time.Second, Multiplier: 2, MaxAttempts: 10}, func(r *testutil.R) {
 			var buf bytes.Buffer
 			if err := tt.gcs(&buf, tt.path); err != nil {
 				r.Errorf("GCS %s(%q): got %v, want nil err", tt.name, tt.path, err)
@@ -31,7 +31,7 @@ func createReferenceImage(w io.Writer, projectID string, location string, produc
 	ctx := context.Background()
 	c, err := vision.NewProductSearchClient(ctx)
 	if err != nil {
-		return fmt.Errorf("NewProductSearchClient: %v", err)
+		return fmt.Errorf("NewProductSearchClient: %w", err)
 	}
 	defer c.Close()
 
@@ -45,7 +45,7 @@ func createReferenceImage(w io.Writer, projectID string, location string, produc
 
 	resp, err := c.CreateReferenceImage(ctx, req)
 	if err != nil {
-		return fmt.Errorf("CreateReferenceImage: %v", err)
+		return fmt.Errorf("CreateReferenceImage: %w", err)
 	}
 
 	fmt.Fprintf(w, "Reference image name: %s\n", resp.Name)
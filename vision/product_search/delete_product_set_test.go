@@ -18,7 +18,6 @@ import (
 	"bytes"
 	"strings"
 	"testing"
-	"time"
 
 	"github.com/GoogleCloudPlatform/golang-samples/internal/testutil"
 )
@@ -52,7 +51,7 @@ func TestDeleteProductSet(t *testing.T) {
 	}
 
 	// Delete the product set.
-	testutil.Retry(t, 10, 1*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.DefaultBackoff, func(r *testutil.R) {
 		if err := deleteProductSet(&buf, tc.ProjectID, location, productSetID); err != nil {
 			r.Errorf("deleteProductSet: %v", err)
 		}
@@ -31,7 +31,7 @@ func getReferenceImage(w io.Writer, projectID string, location string, productID
 	ctx := context.Background()
 	c, err := vision.NewProductSearchClient(ctx)
 	if err != nil {
-		return fmt.Errorf("NewProductSearchClient: %v", err)
+		return fmt.Errorf("NewProductSearchClient: %w", err)
 	}
 	defer c.Close()
 
@@ -41,7 +41,7 @@ func getReferenceImage(w io.Writer, projectID string, location string, productID
 
 	resp, err := c.GetReferenceImage(ctx, req)
 	if err != nil {
-		return fmt.Errorf("GetReferenceImage: %v", err)
+		return fmt.Errorf("GetReferenceImage: %w", err)
 	}
 
 	fmt.Fprintf(w, "Reference image name: %s\n", resp.Name)
@@ -33,7 +33,7 @@ func TestRemoveProductFromProductSet(t *testing.T) {
 	const productCategory = "homegoods"
 	const productID = "fake_product_id_for_testing"
 
-	testutil.Retry(t, 5, 10*time.Second, func(r *testutil.R) {
+	testutil.RetryBackoff(t, testutil.Backoff{Initial: 10 * time.Second, Max: 20 * time.Second, Multiplier: 2, MaxAttempts: 5}, func(r *testutil.R) {
 		var buf bytes.Buffer
 
 		// Ensure re-used resource names don't exist prior to test start.